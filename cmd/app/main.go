@@ -9,12 +9,21 @@ import (
 	"os/signal"
 	"payhere/config"
 	"payhere/internal/auth_token"
+	"payhere/internal/category"
+	"payhere/internal/inventory"
+	"payhere/internal/markdown"
+	"payhere/internal/notifier"
 	"payhere/internal/product"
+	"payhere/internal/report"
+	"payhere/internal/storage"
+	"payhere/internal/tag"
 	"payhere/internal/user"
 	"payhere/pkg/db"
 	"payhere/pkg/router"
 	"syscall"
 	"time"
+	// 실행 환경에 시간대 정보가 없어도 매장 시간대를 읽을 수 있게 함께 빌드한다.
+	_ "time/tzdata"
 )
 
 // @securityDefinitions.apikey BearerAuth
@@ -31,23 +40,72 @@ func main() {
 		log.Fatal(err)
 	}
 	router := router.NewServeRouter(cfg)
+	imageStorage, err := storage.NewImageStorage(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// 로컬 스토리지에 저장한 이미지는 서버가 직접 내려준다.
+	if cfg.Storage.Driver != storage.DriverS3 {
+		router.Static(cfg.Storage.Local.Path, cfg.Storage.Local.Dir)
+	}
 
 	// domain
 	authTokenRepository := auth_token.NewAuthTokenRepository(db)
 	userRepsitory := user.NewUserRepository(db)
 	productRepository := product.NewProductRepository(db)
+	categoryRepository := category.NewCategoryRepository(db)
+	inventoryRepository := inventory.NewInventoryRepository(db)
+	markdownRepository := markdown.NewMarkdownRepository(db)
+	reportRepository := report.NewReportRepository(db)
+	tagRepository := tag.NewTagRepository(db)
 
 	// service
 	userService := user.NewUserService(userRepsitory, authTokenRepository, cfg)
-	productService := product.NewProductService(userRepsitory, productRepository)
+	productService := product.NewProductService(userRepsitory, productRepository, categoryRepository, imageStorage, cfg)
+	categoryService := category.NewCategoryService(categoryRepository)
+	inventoryService := inventory.NewInventoryService(productRepository, inventoryRepository)
+	markdownService := markdown.NewMarkdownService(productRepository, categoryRepository, markdownRepository)
+	reportService := report.NewReportService(userRepsitory, reportRepository, cfg)
+	tagService := tag.NewTagService(tagRepository)
 
 	// controller
 	userController := user.NewUserController(userService)
 	productController := product.NewProductController(productService)
+	categoryController := category.NewCategoryController(categoryService)
+	inventoryController := inventory.NewInventoryController(inventoryService)
+	markdownController := markdown.NewMarkdownController(markdownService)
+	reportController := report.NewReportController(reportService)
+	tagController := tag.NewTagController(tagService)
 
 	// routes
 	user.RegisterRoutes(router, userController, authTokenRepository, cfg)
 	product.RegisterRoutes(router, productController, authTokenRepository, cfg)
+	category.RegisterRoutes(router, categoryController, authTokenRepository, cfg)
+	inventory.RegisterRoutes(router, inventoryController, authTokenRepository, cfg)
+	markdown.RegisterRoutes(router, markdownController, authTokenRepository, cfg)
+	report.RegisterRoutes(router, reportController, authTokenRepository, cfg)
+	tag.RegisterRoutes(router, tagController, authTokenRepository, cfg)
+
+	// background jobs
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	logNotifier := notifier.NewLogNotifier(log.Default())
+
+	lowStockEvaluator := inventory.NewLowStockEvaluator(inventoryRepository, logNotifier, cfg.Inventory.LowStockInterval)
+	go lowStockEvaluator.Run(jobCtx)
+
+	expiryDigestJob := product.NewExpiryDigestJob(userRepsitory, productRepository, logNotifier, cfg.ExpiryDigest.Hour, cfg.ExpiryDigest.Within, cfg.ExpiryDigest.Interval)
+	go expiryDigestJob.Run(jobCtx)
+
+	markdownJob := markdown.NewMarkdownJob(userRepsitory, markdownRepository, cfg.Markdown.Interval)
+	go markdownJob.Run(jobCtx)
+
+	trashPurgeJob := product.NewTrashPurgeJob(productRepository, imageStorage, cfg.Trash.RetentionDays, cfg.Trash.Interval)
+	go trashPurgeJob.Run(jobCtx)
+
+	scheduledPriceJob := product.NewScheduledPriceJob(productRepository, cfg.ScheduledPrice.Interval)
+	go scheduledPriceJob.Run(jobCtx)
 
 	// http server
 	srv := &http.Server{Addr: cfg.HTTP.Port, Handler: router}
@@ -58,10 +116,11 @@ func main() {
 		}
 	}()
 
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Println("Shutdown Server ...")
+	stopJobs()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
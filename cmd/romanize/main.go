@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"log"
+	"payhere/config"
+	"payhere/internal/category"
+	"payhere/internal/product"
+	"payhere/internal/storage"
+	"payhere/internal/user"
+	"payhere/pkg/db"
+	"time"
+)
+
+// 상품명의 로마자 표기(romanized)를 모든 상품에 대해 다시 계산한다.
+// romanized 컬럼 추가 전에 등록된 상품을 채우거나 표기 규칙이 바뀌었을 때 실행한다.
+func main() {
+	cfg, err := config.NewConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+	db, err := db.NewSql(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	imageStorage, err := storage.NewImageStorage(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	productService := product.NewProductService(user.NewUserRepository(db), product.NewProductRepository(db), category.NewCategoryRepository(db), imageStorage, cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	updated, err := productService.BackfillRomanized(ctx)
+	if err != nil {
+		log.Fatalf("backfill romanized: %v", err)
+	}
+	log.Printf("backfill romanized: %d products updated", updated)
+}
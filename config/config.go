@@ -3,17 +3,28 @@ package config
 import (
 	"github.com/spf13/viper"
 	"log"
+	"time"
 )
 
 type Config struct {
-	App   `mapstructure:"app"`
-	HTTP  `mapstructure:"http"`
-	Mysql `mapstructure:"mysql"`
-	Auth  `mapstructure:"auth"`
+	App            `mapstructure:"app"`
+	HTTP           `mapstructure:"http"`
+	Mysql          `mapstructure:"mysql"`
+	Auth           `mapstructure:"auth"`
+	Label          `mapstructure:"label"`
+	Inventory      `mapstructure:"inventory"`
+	ExpiryDigest   `mapstructure:"expiryDigest"`
+	Markdown       `mapstructure:"markdown"`
+	Storage        `mapstructure:"storage"`
+	Report         `mapstructure:"report"`
+	Trash          `mapstructure:"trash"`
+	ScheduledPrice `mapstructure:"scheduledPrice"`
 }
 
 type App struct {
 	Name string `mapstructure:"name"`
+	// 상품 QR 코드에 담을 URL 의 앞부분 (예: https://payhere.in)
+	PublicURL string `mapstructure:"publicURL"`
 }
 
 type HTTP struct {
@@ -33,6 +44,72 @@ type Auth struct {
 	ExpiryHours int    `mapstructure:"expiryHours"`
 }
 
+type Label struct {
+	// 가격표 라벨에 쓸 한글 TTF 폰트 경로
+	FontPath string `mapstructure:"fontPath"`
+}
+
+type Inventory struct {
+	// 재고 부족 상품을 확인하는 주기 (예: 1m)
+	LowStockInterval time.Duration `mapstructure:"lowStockInterval"`
+}
+
+type ExpiryDigest struct {
+	// 매장 시간대 기준으로 유통기한 요약을 보내는 시각 (0 ~ 23)
+	Hour int `mapstructure:"hour"`
+	// 요약에 담을 유통기한 임박 기간 (예: 72h)
+	Within time.Duration `mapstructure:"within"`
+	// 요약을 보낼 사장님을 확인하는 주기 (예: 5m)
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+type Markdown struct {
+	// 유통기한 임박 할인가를 적용하고 되돌리는 주기 (예: 1m)
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+type Storage struct {
+	// 상품 이미지를 저장할 곳 (local, s3)
+	Driver string       `mapstructure:"driver"`
+	Local  LocalStorage `mapstructure:"local"`
+	S3     S3Storage    `mapstructure:"s3"`
+}
+
+type LocalStorage struct {
+	// 이미지를 저장할 디렉터리
+	Dir string `mapstructure:"dir"`
+	// 저장한 이미지를 내려주는 경로 (예: /uploads)
+	Path string `mapstructure:"path"`
+}
+
+type S3Storage struct {
+	// S3 호환 스토리지 주소 (예: https://s3.ap-northeast-2.amazonaws.com, http://localhost:9000)
+	Endpoint        string `mapstructure:"endpoint"`
+	Region          string `mapstructure:"region"`
+	Bucket          string `mapstructure:"bucket"`
+	AccessKeyID     string `mapstructure:"accessKeyID"`
+	SecretAccessKey string `mapstructure:"secretAccessKey"`
+	// 이미지를 내려받을 주소. 비우면 endpoint/bucket 을 쓴다. (예: CDN 주소)
+	PublicURL string `mapstructure:"publicURL"`
+}
+
+type Report struct {
+	// 마진 리포트에서 목표 마진율(%)을 보내지 않았을 때 쓰는 값 (예: 30)
+	TargetMarginPercent float64 `mapstructure:"targetMarginPercent"`
+}
+
+type Trash struct {
+	// 삭제한 상품을 휴지통에 보관하는 일 수. 지나면 완전히 지운다. (예: 30)
+	RetentionDays int `mapstructure:"retentionDays"`
+	// 보관 기간이 지난 상품을 확인하는 주기 (예: 1h)
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+type ScheduledPrice struct {
+	// 적용할 시각이 지난 가격 예약을 확인하는 주기. 예약은 최대 이 주기만큼 늦게 적용된다. (예: 1m)
+	Interval time.Duration `mapstructure:"interval"`
+}
+
 var configMode = "dev"
 
 func NewConfig() (*Config, error) {
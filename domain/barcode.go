@@ -0,0 +1,58 @@
+package domain
+
+const MaxBarcodeLength = 50
+
+// IsValidGTIN
+// EAN-8, UPC-A(12자리), EAN-13 바코드의 자릿수와 체크 디지트를 확인한다.
+func IsValidGTIN(code string) bool {
+	switch len(code) {
+	case 8, 12, 13:
+	default:
+		return false
+	}
+
+	digits := make([]int, len(code))
+	for i, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+		digits[i] = int(c - '0')
+	}
+
+	return gtinCheckDigit(digits[:len(digits)-1]) == digits[len(digits)-1]
+}
+
+// gtinCheckDigit
+// 체크 디지트 바로 앞자리부터 거꾸로 3, 1 가중치를 번갈아 곱해 합한 값으로 체크 디지트를 계산한다.
+func gtinCheckDigit(digits []int) int {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		if (len(digits)-1-i)%2 == 0 {
+			sum += digits[i] * 3
+		} else {
+			sum += digits[i]
+		}
+	}
+	return (10 - sum%10) % 10
+}
+
+// isValidInternalBarcode
+// 매장에서 자체적으로 붙이는 바코드는 체크 디지트 없이 공백을 제외한 출력 가능한 ASCII 문자만 허용한다.
+func isValidInternalBarcode(code string) bool {
+	if code == "" || len(code) > MaxBarcodeLength {
+		return false
+	}
+	for _, c := range code {
+		if c <= ' ' || c > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func isValidBarcode(code string, internal bool) bool {
+	if internal {
+		return isValidInternalBarcode(code)
+	}
+	return IsValidGTIN(code)
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsValidGTIN(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{input: "8801234567893", expected: true},
+		{input: "8800000000015", expected: true},
+		{input: "12345670", expected: true},
+		{input: "036000291452", expected: true},
+		{input: "8801234567890", expected: false},
+		{input: "880123456789", expected: false},
+		{input: "880123456789a", expected: false},
+		{input: "", expected: false},
+	}
+
+	for _, test := range tests {
+		if got := IsValidGTIN(test.input); got != test.expected {
+			t.Errorf("For input '%s', expected %t, but got %t", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestIsValidBarcode(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		internal bool
+		expected bool
+	}{
+		{name: "EAN-13", code: "8801234567893", internal: false, expected: true},
+		{name: "매장 자체 바코드를 EAN 으로 검사", code: "STORE-001", internal: false, expected: false},
+		{name: "매장 자체 바코드", code: "STORE-001", internal: true, expected: true},
+		{name: "공백이 있는 매장 자체 바코드", code: "STORE 001", internal: true, expected: false},
+		{name: "한글이 있는 매장 자체 바코드", code: "매장001", internal: true, expected: false},
+		{name: "너무 긴 매장 자체 바코드", code: strings.Repeat("1", MaxBarcodeLength+1), internal: true, expected: false},
+	}
+
+	for _, test := range tests {
+		if got := isValidBarcode(test.code, test.internal); got != test.expected {
+			t.Errorf("%s: expected %t, but got %t", test.name, test.expected, got)
+		}
+	}
+}
+
+func TestEAN13Content(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		ok       bool
+	}{
+		{input: "8801234567893", expected: "8801234567893", ok: true},
+		{input: "036000291452", expected: "0036000291452", ok: true},
+		{input: "12345670", expected: "", ok: false},
+		{input: "STORE-001", expected: "", ok: false},
+	}
+
+	for _, test := range tests {
+		got, ok := EAN13Content(test.input)
+		if got != test.expected || ok != test.ok {
+			t.Errorf("For input '%s', expected (%s, %t), but got (%s, %t)", test.input, test.expected, test.ok, got, ok)
+		}
+	}
+}
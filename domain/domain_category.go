@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+)
+
+type CategoryRepository interface {
+	CreateCategory(ctx context.Context, category Category) (int, error)
+	GetCategory(ctx context.Context, categoryID int) (*Category, error)
+	FindCategoryByName(ctx context.Context, params FindCategoryByNameParams) (*Category, error)
+	UpdateCategory(ctx context.Context, category Category) error
+	DeleteCategory(ctx context.Context, categoryID int) error
+	ListCategories(ctx context.Context, userID int) ([]Category, error)
+}
+
+type CategoryService interface {
+	CreateCategory(ctx context.Context, req CreateCategoryRequest) error
+	GetCategory(ctx context.Context, req GetCategoryRequest) (GetCategoryResponse, error)
+	PatchCategory(ctx context.Context, req PatchCategoryRequest) error
+	DeleteCategory(ctx context.Context, req DeleteCategoryRequest) error
+	ListCategories(ctx context.Context, req ListCategoriesRequest) (ListCategoriesResponse, error)
+}
+
+type CategoryController interface {
+	CreateCategory(c *gin.Context)
+	GetCategory(c *gin.Context)
+	PatchCategory(c *gin.Context)
+	DeleteCategory(c *gin.Context)
+	ListCategories(c *gin.Context)
+}
+
+type Category struct {
+	Base
+	UserID       int
+	ParentID     *int
+	Name         string
+	DisplayOrder int
+	ProductCount int
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"time"
+)
+
+type InventoryRepository interface {
+	CreateStockMovement(ctx context.Context, movement StockMovement) (StockMovement, error)
+	CreateDisposal(ctx context.Context, disposal Disposal) (Disposal, error)
+	ListStockMovements(ctx context.Context, params ListStockMovementsParams) ([]StockMovement, error)
+	ListStockLots(ctx context.Context, params ListStockLotsParams) ([]StockLot, error)
+	ResetLowStockAlerts(ctx context.Context) error
+	ListPendingLowStockAlerts(ctx context.Context, limit int) ([]LowStockAlert, error)
+	ClaimLowStockAlert(ctx context.Context, alert LowStockAlert) (bool, error)
+	ReleaseLowStockAlert(ctx context.Context, productID int) error
+}
+
+type InventoryService interface {
+	CreateStockMovement(ctx context.Context, req CreateStockMovementRequest) (CreateStockMovementResponse, error)
+	CreateDisposal(ctx context.Context, req CreateDisposalRequest) (CreateDisposalResponse, error)
+	ListStockMovements(ctx context.Context, req ListStockMovementsRequest) (ListStockMovementsResponse, error)
+	ListStockLots(ctx context.Context, req ListStockLotsRequest) (ListStockLotsResponse, error)
+	ListLowStockProducts(ctx context.Context, req ListLowStockProductsRequest) (ListLowStockProductsResponse, error)
+}
+
+type InventoryController interface {
+	CreateStockMovement(c *gin.Context)
+	CreateDisposal(c *gin.Context)
+	ListStockMovements(c *gin.Context)
+	ListStockLots(c *gin.Context)
+	ListLowStockProducts(c *gin.Context)
+}
+
+type StockMovementType string
+
+const (
+	StockMovementTypeReceive    StockMovementType = "receive"
+	StockMovementTypeSale       StockMovementType = "sale"
+	StockMovementTypeAdjustment StockMovementType = "adjustment"
+	StockMovementTypeWaste      StockMovementType = "waste"
+)
+
+// StockMovement
+// 재고 원장의 한 줄. 한 번 기록한 입출고는 수정하거나 지우지 않고, 잘못 기록한 경우 조정(adjustment)으로 바로잡는다.
+type StockMovement struct {
+	ID            int
+	ProductID     int
+	UserID        int
+	Type          StockMovementType
+	Quantity      int // 재고 증감량 (입고는 양수, 판매와 폐기는 음수)
+	QuantityAfter int // 이 입출고를 반영한 뒤의 재고
+	Note          string
+	CreateDate    time.Time
+	// 재고를 늘리는 입출고는 새 로트로 들어온다. 재고를 줄이는 입출고는 유통기한이 먼저 끝나는 로트부터 꺼낸다. (FEFO)
+	Lot *StockLot
+}
+
+// StockLot
+// 같은 날 같은 유통기한으로 들어온 재고 묶음. 상품의 유통기한은 재고가 남은 로트 중 가장 먼저 끝나는 로트의 유통기한이다.
+type StockLot struct {
+	ID                int
+	ProductID         int
+	LotNumber         string
+	ReceivedDate      time.Time
+	ExpiryDate        time.Time
+	Quantity          int // 입고 수량
+	RemainingQuantity int // 남은 수량
+	CreateDate        time.Time
+}
+
+type DisposalReason string
+
+const (
+	DisposalReasonExpired DisposalReason = "expired"
+	DisposalReasonDamaged DisposalReason = "damaged"
+	DisposalReasonOther   DisposalReason = "other"
+)
+
+// Disposal
+// 폐기 기록. 폐기하면 재고 원장에 폐기(waste)가 함께 기록되고, 손실액은 폐기한 시점의 원가로 계산해 남긴다.
+// 나중에 상품의 원가나 카테고리가 바뀌어도 폐기 리포트가 달라지지 않도록 폐기 시점의 값을 그대로 저장한다.
+// QuantityAfter 는 저장하지 않고 폐기 직후의 재고를 응답에 담기 위해서만 쓴다.
+type Disposal struct {
+	ID            int
+	ProductID     int
+	UserID        int
+	CategoryID    int
+	MovementID    int
+	Quantity      int
+	Reason        DisposalReason
+	Note          string
+	UnitCost      float64
+	LossAmount    float64
+	QuantityAfter int
+	CreateDate    time.Time
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"math"
+	"time"
+)
+
+type MarkdownRepository interface {
+	CreateMarkdownRule(ctx context.Context, rule MarkdownRule) (int, error)
+	GetMarkdownRule(ctx context.Context, ruleID int) (*MarkdownRule, error)
+	DeleteMarkdownRule(ctx context.Context, ruleID int) error
+	ListMarkdownRules(ctx context.Context, userID int) ([]MarkdownRule, error)
+	ListMarkdownCandidates(ctx context.Context, params ListMarkdownCandidatesParams) ([]Product, error)
+	ApplyMarkdown(ctx context.Context, change MarkdownChange) (bool, error)
+}
+
+type MarkdownService interface {
+	CreateMarkdownRule(ctx context.Context, req CreateMarkdownRuleRequest) error
+	DeleteMarkdownRule(ctx context.Context, req DeleteMarkdownRuleRequest) error
+	ListMarkdownRules(ctx context.Context, req ListMarkdownRulesRequest) (ListMarkdownRulesResponse, error)
+}
+
+type MarkdownController interface {
+	CreateMarkdownRule(c *gin.Context)
+	DeleteMarkdownRule(c *gin.Context)
+	ListMarkdownRules(c *gin.Context)
+}
+
+// MarkdownRule
+// 유통기한이 Within 안으로 남은 상품을 DiscountPercent 만큼 할인한다.
+// 카테고리 규칙과 상품 규칙 중 하나만 정하고, 같은 상품에 둘 다 걸리면 상품 규칙을 쓴다.
+type MarkdownRule struct {
+	Base
+	UserID          int
+	CategoryID      *int
+	ProductID       *int
+	Within          time.Duration
+	DiscountPercent int
+}
+
+// Matches
+// 상품이 규칙 대상이고 지금이 유통기한 Within 전부터 유통기한 전까지 사이이면 true 다.
+// 유통기한이 지난 상품은 팔지 않고 폐기하므로 할인하지 않는다.
+func (r MarkdownRule) Matches(product Product, now time.Time) bool {
+	if r.ProductID != nil && *r.ProductID != product.ID {
+		return false
+	}
+	if r.CategoryID != nil && *r.CategoryID != product.CategoryID {
+		return false
+	}
+
+	return !now.Before(product.ExpiryDate.Add(-r.Within)) && now.Before(product.ExpiryDate)
+}
+
+// MarkdownPriceFor
+// 상품에 지금 적용할 할인가와 규칙. 걸리는 규칙이 여러 개면 할인율이 가장 큰 규칙을 쓰고, 걸리는 규칙이 없으면 nil 이다.
+func MarkdownPriceFor(product Product, rules []MarkdownRule, now time.Time) (*float64, *MarkdownRule) {
+	var productRule, categoryRule *MarkdownRule
+	for i := range rules {
+		rule := &rules[i]
+		if !rule.Matches(product, now) {
+			continue
+		}
+		if rule.ProductID != nil {
+			if productRule == nil || rule.DiscountPercent > productRule.DiscountPercent {
+				productRule = rule
+			}
+			continue
+		}
+		if categoryRule == nil || rule.DiscountPercent > categoryRule.DiscountPercent {
+			categoryRule = rule
+		}
+	}
+
+	rule := productRule
+	if rule == nil {
+		rule = categoryRule
+	}
+	if rule == nil {
+		return nil, nil
+	}
+
+	// 원 단위 미만은 버린다.
+	price := math.Floor(product.Price * float64(100-rule.DiscountPercent) / 100)
+	return &price, rule
+}
+
+// MarkdownChange
+// 상품의 할인가를 From 에서 To 로 바꾼다. nil 은 할인하지 않는 상태다.
+type MarkdownChange struct {
+	ProductID int
+	From      *float64
+	To        *float64
+	History   PriceHistory
+}
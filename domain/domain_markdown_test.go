@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"k8s.io/utils/pointer"
+	"testing"
+	"time"
+)
+
+func TestMarkdownPriceFor(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	product := Product{Base: Base{ID: 7}, CategoryID: 2, Price: 3000, ExpiryDate: now.Add(10 * time.Hour)}
+
+	tests := []struct {
+		name       string
+		product    Product
+		rules      []MarkdownRule
+		wantPrice  *float64
+		wantRuleID int
+	}{
+		{
+			name:    "PASS - 카테고리 규칙 적용",
+			product: product,
+			rules: []MarkdownRule{
+				{Base: Base{ID: 1}, CategoryID: pointer.Int(2), Within: 24 * time.Hour, DiscountPercent: 30},
+			},
+			wantPrice:  pointer.Float64(2100),
+			wantRuleID: 1,
+		},
+		{
+			name:    "PASS - 상품 규칙이 카테고리 규칙보다 우선",
+			product: product,
+			rules: []MarkdownRule{
+				{Base: Base{ID: 1}, CategoryID: pointer.Int(2), Within: 24 * time.Hour, DiscountPercent: 50},
+				{Base: Base{ID: 2}, ProductID: pointer.Int(7), Within: 12 * time.Hour, DiscountPercent: 20},
+			},
+			wantPrice:  pointer.Float64(2400),
+			wantRuleID: 2,
+		},
+		{
+			name:    "PASS - 같은 종류의 규칙은 할인율이 큰 규칙 적용",
+			product: product,
+			rules: []MarkdownRule{
+				{Base: Base{ID: 1}, CategoryID: pointer.Int(2), Within: 24 * time.Hour, DiscountPercent: 30},
+				{Base: Base{ID: 3}, CategoryID: pointer.Int(2), Within: 12 * time.Hour, DiscountPercent: 50},
+			},
+			wantPrice:  pointer.Float64(1500),
+			wantRuleID: 3,
+		},
+		{
+			name:    "PASS - 아직 할인 시작 전",
+			product: product,
+			rules: []MarkdownRule{
+				{Base: Base{ID: 1}, CategoryID: pointer.Int(2), Within: 6 * time.Hour, DiscountPercent: 30},
+			},
+		},
+		{
+			name:    "PASS - 유통기한이 지난 상품은 할인하지 않음",
+			product: Product{Base: Base{ID: 7}, CategoryID: 2, Price: 3000, ExpiryDate: now.Add(-time.Minute)},
+			rules: []MarkdownRule{
+				{Base: Base{ID: 1}, CategoryID: pointer.Int(2), Within: 24 * time.Hour, DiscountPercent: 30},
+			},
+		},
+		{
+			name:    "PASS - 다른 카테고리 규칙",
+			product: product,
+			rules: []MarkdownRule{
+				{Base: Base{ID: 1}, CategoryID: pointer.Int(3), Within: 24 * time.Hour, DiscountPercent: 30},
+			},
+		},
+		{
+			name:    "PASS - 원 단위 미만은 버림",
+			product: Product{Base: Base{ID: 7}, CategoryID: 2, Price: 1250, ExpiryDate: now.Add(time.Hour)},
+			rules: []MarkdownRule{
+				{Base: Base{ID: 1}, CategoryID: pointer.Int(2), Within: 24 * time.Hour, DiscountPercent: 33},
+			},
+			wantPrice:  pointer.Float64(837),
+			wantRuleID: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, rule := MarkdownPriceFor(tt.product, tt.rules, now)
+			if (price == nil) != (tt.wantPrice == nil) || (price != nil && *price != *tt.wantPrice) {
+				t.Errorf("MarkdownPriceFor() price = %v, want %v", price, tt.wantPrice)
+			}
+			if tt.wantPrice != nil && rule.ID != tt.wantRuleID {
+				t.Errorf("MarkdownPriceFor() rule = %d, want %d", rule.ID, tt.wantRuleID)
+			}
+		})
+	}
+}
+
+func TestCreateMarkdownRuleRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     CreateMarkdownRuleRequest
+		wantErr bool
+	}{
+		{name: "PASS - 카테고리 규칙", req: CreateMarkdownRuleRequest{CategoryID: pointer.Int(1), WithinHours: 24, DiscountPercent: 30}},
+		{name: "PASS - 상품 규칙", req: CreateMarkdownRuleRequest{ProductID: pointer.Int(1), WithinHours: 6, DiscountPercent: 50}},
+		{name: "FAIL - 대상 없음", req: CreateMarkdownRuleRequest{WithinHours: 24, DiscountPercent: 30}, wantErr: true},
+		{name: "FAIL - 대상 둘 다", req: CreateMarkdownRuleRequest{CategoryID: pointer.Int(1), ProductID: pointer.Int(1), WithinHours: 24, DiscountPercent: 30}, wantErr: true},
+		{name: "FAIL - 할인 시작 시점 없음", req: CreateMarkdownRuleRequest{CategoryID: pointer.Int(1), DiscountPercent: 30}, wantErr: true},
+		{name: "FAIL - 할인율 초과", req: CreateMarkdownRuleRequest{CategoryID: pointer.Int(1), WithinHours: 24, DiscountPercent: 95}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.req.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Notifier
+// 사장님에게 알림을 보낸다. 로컬에서는 로그로 남기고, 푸시나 메신저로 보내려면 구현체를 바꿔 끼운다.
+type Notifier interface {
+	NotifyLowStock(ctx context.Context, alert LowStockAlert) error
+	NotifyExpiryDigest(ctx context.Context, digest ExpiryDigest) error
+}
+
+// LowStockAlert
+// 재고가 재고 알림 기준 수량 이하로 떨어진 상품. 기준 수량 위로 다시 올라가기 전까지 한 번만 보낸다.
+type LowStockAlert struct {
+	UserID          int
+	ProductID       int
+	ProductName     string
+	Barcode         string
+	StockQuantity   int
+	ReorderPoint    int
+	ReorderQuantity int
+	CreateDate      time.Time
+}
+
+// ExpiryDigest
+// 하루 한 번 보내는 유통기한 요약. 날짜와 유통기한은 사장님의 매장 시간대 기준이다.
+type ExpiryDigest struct {
+	UserID   int
+	Date     string // 매장 시간대 기준 날짜 (2006-01-02)
+	Expired  []ExpiryDigestItem
+	Expiring []ExpiryDigestItem
+}
+
+type ExpiryDigestItem struct {
+	ProductID     int
+	ProductName   string
+	Barcode       string
+	ExpiryDate    time.Time
+	StockQuantity int
+}
+
+func ExpiryDigestItemFrom(product Product, loc *time.Location) ExpiryDigestItem {
+	return ExpiryDigestItem{
+		ProductID:     product.ID,
+		ProductName:   product.Name,
+		Barcode:       product.Barcode,
+		ExpiryDate:    product.ExpiryDate.In(loc),
+		StockQuantity: product.StockQuantity,
+	}
+}
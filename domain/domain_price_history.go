@@ -0,0 +1,50 @@
+package domain
+
+import "time"
+
+type PriceChangeReason string
+
+const (
+	PriceChangeReasonManual      PriceChangeReason = "manual"       // 사장님이 정가나 원가를 수정
+	PriceChangeReasonMarkdown    PriceChangeReason = "markdown"     // 유통기한 임박 할인 시작 또는 할인율 변경
+	PriceChangeReasonMarkdownEnd PriceChangeReason = "markdown_end" // 유통기한 임박 할인 종료
+	PriceChangeReasonScheduled   PriceChangeReason = "scheduled"    // 사장님이 예약한 정가나 원가를 적용
+)
+
+// PriceHistory
+// 상품의 정가, 원가, 실제 판매가가 바뀐 기록. UserID 가 nil 이면 사장님이 아니라 서버 작업이 바꾼 것이다.
+// 예약한 가격을 적용한 기록은 가격을 예약한 사장님을 UserID 로 남긴다.
+type PriceHistory struct {
+	ID                int
+	ProductID         int
+	UserID            *int
+	Reason            PriceChangeReason
+	OldPrice          float64
+	NewPrice          float64
+	OldCost           float64
+	NewCost           float64
+	OldEffectivePrice float64
+	NewEffectivePrice float64
+	CreateDate        time.Time
+}
+
+// PriceHistoryFrom
+// 상품을 before 에서 after 로 바꾼 기록. 정가와 원가가 모두 그대로면 nil 이다.
+func PriceHistoryFrom(before, after Product, userID *int, reason PriceChangeReason, now time.Time) *PriceHistory {
+	if before.Price == after.Price && before.Cost == after.Cost {
+		return nil
+	}
+
+	return &PriceHistory{
+		ProductID:         after.ID,
+		UserID:            userID,
+		Reason:            reason,
+		OldPrice:          before.Price,
+		NewPrice:          after.Price,
+		OldCost:           before.Cost,
+		NewCost:           after.Cost,
+		OldEffectivePrice: before.EffectivePrice(),
+		NewEffectivePrice: after.EffectivePrice(),
+		CreateDate:        now,
+	}
+}
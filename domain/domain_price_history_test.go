@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPriceHistoryFrom(t *testing.T) {
+	now := time.Date(2024, time.February, 28, 9, 0, 0, 0, time.UTC)
+	userID := 1
+	markdownPrice := 4000.0
+	before := Product{Base: Base{ID: 1}, Price: 5000, Cost: 1800, Name: "우유"}
+
+	tests := []struct {
+		name                  string
+		after                 Product
+		wantNil               bool
+		wantNewEffectivePrice float64
+	}{
+		{name: "PASS - 정가 변경", after: Product{Base: Base{ID: 1}, Price: 5500, Cost: 1800}, wantNewEffectivePrice: 5500},
+		{name: "PASS - 원가 변경", after: Product{Base: Base{ID: 1}, Price: 5000, Cost: 2000}, wantNewEffectivePrice: 5000},
+		{name: "PASS - 할인 중이면 실제 판매가는 할인가", after: Product{Base: Base{ID: 1}, Price: 5500, Cost: 1800, MarkdownPrice: &markdownPrice}, wantNewEffectivePrice: 4000},
+		{name: "PASS - 가격이 그대로면 기록하지 않는다", after: Product{Base: Base{ID: 1}, Price: 5000, Cost: 1800, Name: "저지방 우유"}, wantNil: true},
+	}
+
+	for _, test := range tests {
+		got := PriceHistoryFrom(before, test.after, &userID, PriceChangeReasonManual, now)
+		if (got == nil) != test.wantNil {
+			t.Errorf("%s: expected nil %t, but got %v", test.name, test.wantNil, got)
+			continue
+		}
+		if got == nil {
+			continue
+		}
+		if got.OldPrice != before.Price || got.NewPrice != test.after.Price || got.OldCost != before.Cost || got.NewCost != test.after.Cost {
+			t.Errorf("%s: unexpected history %+v", test.name, *got)
+		}
+		if got.NewEffectivePrice != test.wantNewEffectivePrice {
+			t.Errorf("%s: expected new effective price %v, but got %v", test.name, test.wantNewEffectivePrice, got.NewEffectivePrice)
+		}
+	}
+}
@@ -3,48 +3,134 @@ package domain
 import (
 	"context"
 	"github.com/gin-gonic/gin"
+	"io"
 	"time"
 )
 
 type ProductRepository interface {
 	CreateProduct(ctx context.Context, product Product) (int, error)
+	CreateProducts(ctx context.Context, products []Product) ([]int, error)
 	GetProduct(ctx context.Context, productID int) (*Product, error)
+	GetProductByBarcode(ctx context.Context, userID int, barcode string) (*Product, error)
 	UpdateProduct(ctx context.Context, product Product) error
-	DeleteProduct(ctx context.Context, productID int) error
+	DeleteProduct(ctx context.Context, productID int, version int) error
 	ListProducts(ctx context.Context, params ListProductsParams) ([]Product, error)
+	EachProduct(ctx context.Context, params ListProductsParams, fn func(product Product) error) error
+	ListProductsByIDs(ctx context.Context, userID int, productIDs []int) ([]Product, error)
+	ListLowStockProducts(ctx context.Context, params ListLowStockProductsParams) ([]Product, error)
+	ListExpiringProducts(ctx context.Context, params ListExpiringProductsParams) ([]Product, error)
+	ClaimExpiryDigest(ctx context.Context, userID int, date string) (bool, error)
+	ReleaseExpiryDigest(ctx context.Context, userID int, date string) error
+	ListProductNames(ctx context.Context, userID int) ([]ProductName, error)
+	ListAllProductNamesAfter(ctx context.Context, cursor int, limit int) ([]ProductName, error)
+	UpdateProductRomanized(ctx context.Context, productID int, romanized string) error
+	ListProductOptionGroups(ctx context.Context, productIDs []int) ([]ProductOptionGroup, error)
+	ReplaceProductOptionGroups(ctx context.Context, productID int, groups []ProductOptionGroup) error
+	ReplaceProductTags(ctx context.Context, userID int, productID int, names []string) error
+	ListProductTags(ctx context.Context, productIDs []int) ([]ProductTag, error)
+	CreateProductImage(ctx context.Context, image ProductImage) (ProductImage, error)
+	ListProductImages(ctx context.Context, productIDs []int) ([]ProductImage, error)
+	UpdateProductImages(ctx context.Context, productID int, imageIDs []int, primaryImageID *int) error
+	DeleteProductImage(ctx context.Context, image ProductImage) error
+	CreatePriceHistory(ctx context.Context, history PriceHistory) error
+	ListPriceHistory(ctx context.Context, productID int, since time.Time) ([]PriceHistory, error)
+	GetDeletedProduct(ctx context.Context, productID int) (*Product, error)
+	ListDeletedProducts(ctx context.Context, userID int, cursor *int) ([]Product, error)
+	ListPurgeableProductIDs(ctx context.Context, deletedBefore time.Time, limit int) ([]int, error)
+	RestoreProduct(ctx context.Context, productID int) (bool, error)
+	PurgeProduct(ctx context.Context, productID int) (bool, error)
+	CreateProductTemplate(ctx context.Context, template ProductTemplate) (int, error)
+	GetProductTemplate(ctx context.Context, templateID int) (*ProductTemplate, error)
+	ListProductTemplates(ctx context.Context, userID int) ([]ProductTemplate, error)
+	DeleteProductTemplate(ctx context.Context, templateID int) error
+	CreateScheduledPriceChange(ctx context.Context, change ScheduledPriceChange) (int, error)
+	GetScheduledPriceChange(ctx context.Context, scheduleID int) (*ScheduledPriceChange, error)
+	ListScheduledPriceChanges(ctx context.Context, params ListScheduledPriceChangesParams) ([]ScheduledPriceChange, error)
+	ListDueScheduledPriceChanges(ctx context.Context, now time.Time, limit int) ([]ScheduledPriceChange, error)
+	ClaimScheduledPriceChange(ctx context.Context, scheduleID int, now time.Time) (bool, error)
+	CancelScheduledPriceChange(ctx context.Context, scheduleID int, now time.Time) (bool, error)
+	WithTx(ctx context.Context, fn func(repository ProductRepository) error) error
 }
 
 type ProductService interface {
 	CreateProduct(ctx context.Context, req CreateProductRequest) error
+	ImportProducts(ctx context.Context, req ImportProductsRequest) (ImportProductsResponse, error)
+	ExportProducts(ctx context.Context, req ExportProductsRequest, w io.Writer) error
 	GetProduct(ctx context.Context, req GetProductRequest) (GetProductResponse, error)
+	GetProductByBarcode(ctx context.Context, req GetProductByBarcodeRequest) (GetProductResponse, error)
+	GetProductBarcodeImage(ctx context.Context, req GetProductBarcodeImageRequest) (BarcodeImage, error)
+	GetProductQRCode(ctx context.Context, req GetProductQRCodeRequest) (BarcodeImage, error)
+	CreateProductLabels(ctx context.Context, req CreateProductLabelsRequest) ([]byte, error)
 	PatchProduct(ctx context.Context, req PatchProductRequest) error
+	ReplaceProduct(ctx context.Context, req ReplaceProductRequest) error
+	ApplyProductPatch(ctx context.Context, req ApplyProductPatchRequest) error
 	DeleteProduct(ctx context.Context, req DeleteProductRequest) error
+	BatchProducts(ctx context.Context, req BatchProductsRequest) (BatchProductsResponse, error)
+	UploadProductImage(ctx context.Context, req UploadProductImageRequest) (UploadProductImageResponse, error)
+	PatchProductImages(ctx context.Context, req PatchProductImagesRequest) (PatchProductImagesResponse, error)
+	DeleteProductImage(ctx context.Context, req DeleteProductImageRequest) error
+	GetProductPriceHistory(ctx context.Context, req GetProductPriceHistoryRequest) (GetProductPriceHistoryResponse, error)
+	ListTrashProducts(ctx context.Context, req ListTrashProductsRequest) (ListTrashProductsResponse, error)
+	RestoreProduct(ctx context.Context, req RestoreProductRequest) (GetProductResponse, error)
+	PurgeProduct(ctx context.Context, req PurgeProductRequest) error
+	DuplicateProduct(ctx context.Context, req DuplicateProductRequest) (GetProductResponse, error)
+	CreateProductTemplate(ctx context.Context, req CreateProductTemplateRequest) (CreateProductTemplateResponse, error)
+	ListProductTemplates(ctx context.Context, req ListProductTemplatesRequest) (ListProductTemplatesResponse, error)
+	DeleteProductTemplate(ctx context.Context, req DeleteProductTemplateRequest) error
+	CreateScheduledPriceChange(ctx context.Context, req CreateScheduledPriceChangeRequest) (CreateScheduledPriceChangeResponse, error)
+	ListScheduledPriceChanges(ctx context.Context, req ListScheduledPriceChangesRequest) (ListScheduledPriceChangesResponse, error)
+	CancelScheduledPriceChange(ctx context.Context, req CancelScheduledPriceChangeRequest) error
 	ListProducts(ctx context.Context, req ListProductsRequest) (ListProductsResponse, error)
+	SuggestProducts(ctx context.Context, req SuggestProductsRequest) (SuggestProductsResponse, error)
+	ListExpiringProducts(ctx context.Context, req ListExpiringProductsRequest) (ListExpiringProductsResponse, error)
 }
 
 type ProductController interface {
 	CreateProduct(c *gin.Context)
+	ImportProducts(c *gin.Context)
+	ExportProducts(c *gin.Context)
 	GetProduct(c *gin.Context)
+	GetProductByBarcode(c *gin.Context)
+	GetProductBarcodeImage(c *gin.Context)
+	GetProductQRCode(c *gin.Context)
+	CreateProductLabels(c *gin.Context)
 	PatchProduct(c *gin.Context)
+	ReplaceProduct(c *gin.Context)
+	ApplyProductPatch(c *gin.Context)
 	DeleteProduct(c *gin.Context)
+	BatchProducts(c *gin.Context)
+	UploadProductImage(c *gin.Context)
+	PatchProductImages(c *gin.Context)
+	DeleteProductImage(c *gin.Context)
+	GetProductPriceHistory(c *gin.Context)
+	ListTrashProducts(c *gin.Context)
+	RestoreProduct(c *gin.Context)
+	PurgeProduct(c *gin.Context)
+	DuplicateProduct(c *gin.Context)
+	CreateProductTemplate(c *gin.Context)
+	ListProductTemplates(c *gin.Context)
+	DeleteProductTemplate(c *gin.Context)
+	CreateScheduledPriceChange(c *gin.Context)
+	ListScheduledPriceChanges(c *gin.Context)
+	CancelScheduledPriceChange(c *gin.Context)
 	ListProducts(c *gin.Context)
+	SuggestProducts(c *gin.Context)
+	ListExpiringProducts(c *gin.Context)
 }
 
-type ProductSizeType string
-
-func (p ProductSizeType) ToPointer() *ProductSizeType {
-	return &p
-}
+type ProductOptionSelectType string
 
 const (
-	ProductSizeTypeSmall ProductSizeType = "small"
-	ProductSizeTypeLarge ProductSizeType = "large"
+	ProductOptionSelectTypeSingle ProductOptionSelectType = "single"
+	ProductOptionSelectTypeMulti  ProductOptionSelectType = "multi"
 )
 
 type Product struct {
 	Base
 	UserID      int
 	Initial     string
+	Romanized   string
+	CategoryID  int
 	Category    string
 	Price       float64
 	Cost        float64
@@ -52,5 +138,68 @@ type Product struct {
 	Description string
 	Barcode     string
 	ExpiryDate  time.Time
-	Size        ProductSizeType
+	// 재고 원장(stock_movements)에 기록된 입출고를 모두 반영한 현재 재고
+	StockQuantity int
+	// 재고가 이 수량 이하로 떨어지면 재고 부족 알림을 보낸다. nil 이면 재고 알림을 보내지 않는다.
+	ReorderPoint    *int
+	ReorderQuantity int // 재고가 부족할 때 발주할 수량
+	// 유통기한 임박 할인(markdown) 중인 판매가. nil 이면 할인 중이 아니다.
+	MarkdownPrice *float64
+	// 사장님이 상품을 수정하거나 삭제할 때마다 1 씩 오른다. 재고나 할인가가 바뀔 때는 그대로다.
+	Version      int
+	OptionGroups []ProductOptionGroup
+	Images       []ProductImage
+	Tags         []Tag
+}
+
+// EffectivePrice
+// 지금 실제로 받는 판매가. 할인 중이면 할인가, 아니면 정가(Price)다.
+func (p Product) EffectivePrice() float64 {
+	if p.MarkdownPrice != nil {
+		return *p.MarkdownPrice
+	}
+	return p.Price
+}
+
+// SetPrice
+// 정가를 바꾼다. 정가가 바뀌면 이전 정가로 계산한 할인가를 지우고, 할인 규칙이 남아 있으면 할인 작업이 새 정가로 다시 할인한다.
+func (p *Product) SetPrice(price float64) {
+	if price != p.Price {
+		p.MarkdownPrice = nil
+	}
+	p.Price = price
+}
+
+// ProductOptionGroup
+// 사이즈, 온도, 샷 추가처럼 상품에 붙는 옵션 묶음. 단일 선택 그룹은 항상 하나만 고를 수 있다.
+type ProductOptionGroup struct {
+	ID           int
+	ProductID    int
+	Name         string
+	SelectType   ProductOptionSelectType
+	Required     bool
+	MinSelect    int
+	MaxSelect    int
+	DisplayOrder int
+	Options      []ProductOption
+}
+
+// ProductOption
+// 옵션을 선택했을 때 상품 가격과 원가에 더해지는 금액을 갖는다. (음수면 할인)
+type ProductOption struct {
+	ID            int
+	OptionGroupID int
+	Name          string
+	PriceDelta    float64
+	CostDelta     float64
+	DisplayOrder  int
+}
+
+// ProductName
+// 자동완성 인덱스를 만들기 위해 필요한 최소한의 상품 정보
+type ProductName struct {
+	ID        int
+	Name      string
+	Initial   string
+	Romanized string
 }
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"path"
+	"time"
+)
+
+// ProductImageVariant
+// 업로드한 이미지마다 함께 만드는 크기. 긴 변이 MaxSize 보다 크면 비율을 유지한 채 줄이고, 작으면 그대로 둔다.
+type ProductImageVariant struct {
+	Name    string
+	MaxSize int
+}
+
+var (
+	ProductImageVariantOriginal  = ProductImageVariant{Name: "original", MaxSize: 2048}
+	ProductImageVariantMedium    = ProductImageVariant{Name: "medium", MaxSize: 640}
+	ProductImageVariantThumbnail = ProductImageVariant{Name: "thumbnail", MaxSize: 160}
+)
+
+var ProductImageVariants = []ProductImageVariant{
+	ProductImageVariantOriginal,
+	ProductImageVariantMedium,
+	ProductImageVariantThumbnail,
+}
+
+// ProductImage
+// 크기별 파일은 StorageKey 아래에 "<크기>.<확장자>" 로 저장한다. 상품마다 대표 이미지(Primary)는 하나다.
+// URLs 는 저장하지 않고 응답할 때 스토리지로 크기별 주소를 채운다.
+type ProductImage struct {
+	ID           int
+	ProductID    int
+	StorageKey   string
+	ContentType  string
+	Width        int
+	Height       int
+	DisplayOrder int
+	Primary      bool
+	CreateDate   time.Time
+	URLs         map[string]string
+}
+
+// Key
+// 크기별 파일의 스토리지 key
+func (i ProductImage) Key(variant ProductImageVariant) string {
+	ext := ".jpg"
+	if i.ContentType == "image/png" {
+		ext = ".png"
+	}
+	return path.Join(i.StorageKey, variant.Name+ext)
+}
+
+// Keys
+// 이미지를 지울 때 함께 지울 모든 크기의 스토리지 key
+func (i ProductImage) Keys() []string {
+	keys := make([]string, 0, len(ProductImageVariants))
+	for _, variant := range ProductImageVariants {
+		keys = append(keys, i.Key(variant))
+	}
+	return keys
+}
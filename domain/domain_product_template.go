@@ -0,0 +1,78 @@
+package domain
+
+import "time"
+
+const MaxProductTemplateNameLength = 100
+
+// ProductTemplate
+// 상품을 만들 때 시작점으로 쓰는 상품 정보. 상품마다 달라야 하는 바코드와 유통기한은 담지 않는다.
+// 태그도 담지 않으므로 템플릿으로 만든 상품에 태그를 붙이려면 생성 요청에 tags 를 보낸다.
+type ProductTemplate struct {
+	ID              int
+	UserID          int
+	Name            string
+	CategoryID      int
+	Price           float64
+	Cost            float64
+	ProductName     string
+	Description     string
+	ReorderPoint    *int
+	ReorderQuantity int
+	OptionGroups    []ProductOptionGroupRequest
+	CreateDate      time.Time
+}
+
+// ProductTemplateFrom
+// 옵션 그룹을 채운 상품으로 템플릿을 만든다. name 이 비어 있으면 상품명을 템플릿 이름으로 쓴다.
+func ProductTemplateFrom(product Product, name string) ProductTemplate {
+	if name == "" {
+		name = product.Name
+	}
+
+	return ProductTemplate{
+		UserID:          product.UserID,
+		Name:            name,
+		CategoryID:      product.CategoryID,
+		Price:           product.Price,
+		Cost:            product.Cost,
+		ProductName:     product.Name,
+		Description:     product.Description,
+		ReorderPoint:    product.ReorderPoint,
+		ReorderQuantity: product.ReorderQuantity,
+		OptionGroups:    ProductOptionGroupRequestsFrom(product.OptionGroups),
+	}
+}
+
+// Apply
+// 생성 요청에 없는(SentFields 에 없는) 항목을 템플릿 값으로 채우므로 price 를 0 으로 보내면 0 으로 만든다.
+// 바코드와 유통기한, 태그는 요청 값을 그대로 쓴다.
+func (template ProductTemplate) Apply(req CreateProductRequest) CreateProductRequest {
+	if !req.SentFields["categoryID"] {
+		req.CategoryID = template.CategoryID
+	}
+	if !req.SentFields["price"] {
+		req.Price = template.Price
+	}
+	if !req.SentFields["cost"] {
+		req.Cost = template.Cost
+	}
+	if !req.SentFields["name"] {
+		req.Name = template.ProductName
+	}
+	if !req.SentFields["description"] {
+		req.Description = template.Description
+	}
+	if !req.SentFields["reorderPoint"] {
+		req.ReorderPoint = template.ReorderPoint
+	}
+	if !req.SentFields["reorderQuantity"] {
+		req.ReorderQuantity = template.ReorderQuantity
+	}
+	if !req.SentFields["optionGroups"] {
+		req.OptionGroups = template.OptionGroups
+	}
+	req.TemplateID = nil
+	req.SentFields = nil
+
+	return req
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+)
+
+type ReportRepository interface {
+	ListWasteTotals(ctx context.Context, params ListWasteTotalsParams) ([]WasteTotal, error)
+	ListCategoryMargins(ctx context.Context, params ListMarginsParams) ([]CategoryMargin, error)
+	ListProductMargins(ctx context.Context, params ListMarginsParams) ([]ProductMargin, error)
+	ListProductsBelowTargetMargin(ctx context.Context, params ListMarginsParams) ([]ProductMargin, error)
+}
+
+type ReportService interface {
+	GetWasteReport(ctx context.Context, req GetWasteReportRequest) (GetWasteReportResponse, error)
+	GetMarginReport(ctx context.Context, req GetMarginReportRequest) (GetMarginReportResponse, error)
+}
+
+type ReportController interface {
+	GetWasteReport(c *gin.Context)
+	GetMarginReport(c *gin.Context)
+}
+
+type ReportGroupBy string
+
+const (
+	ReportGroupByDay   ReportGroupBy = "day"
+	ReportGroupByMonth ReportGroupBy = "month"
+)
+
+// PeriodFormat
+// 기간을 묶을 때 쓰는 MySQL DATE_FORMAT 형식.
+func (g ReportGroupBy) PeriodFormat() string {
+	if g == ReportGroupByMonth {
+		return "%Y-%m"
+	}
+	return "%Y-%m-%d"
+}
+
+// WasteTotal
+// 카테고리, 기간, 폐기 사유별 폐기 수량과 손실액 합계.
+type WasteTotal struct {
+	CategoryID   int
+	CategoryName string
+	Period       string
+	Reason       DisposalReason
+	Quantity     int
+	LossAmount   float64
+}
+
+// CategoryMargin
+// 카테고리별 상품 수와 정가, 원가, 마진(정가 - 원가) 합계. 마진율은 정가 합계가 0 이면 nil 이다.
+type CategoryMargin struct {
+	CategoryID       int
+	CategoryName     string
+	ProductCount     int
+	BelowTargetCount int
+	TotalPrice       float64
+	TotalCost        float64
+	TotalMargin      float64
+	MarginPercent    *float64
+}
+
+// ProductMargin
+// 상품 하나의 마진(정가 - 원가)과 마진율. 마진율은 정가가 0 이면 nil 이다.
+type ProductMargin struct {
+	ProductID     int
+	CategoryID    int
+	CategoryName  string
+	Name          string
+	Price         float64
+	Cost          float64
+	Margin        float64
+	MarginPercent *float64
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// ScheduledPriceChange
+// EffectiveDate 가 지나면 서버 작업이 정가나 원가를 바꾸는 가격 예약. Price, Cost 가 nil 이면 그 값은 바꾸지 않는다.
+// UserID 는 가격을 예약한 사장님이고, 적용할 때 가격 변경 기록에 남긴다.
+type ScheduledPriceChange struct {
+	ID            int
+	ProductID     int
+	ProductName   string
+	UserID        int
+	Price         *float64
+	Cost          *float64
+	EffectiveDate time.Time
+	ApplyDate     sql.NullTime
+	CancelDate    sql.NullTime
+	CreateDate    time.Time
+}
+
+// Pending
+// 아직 적용하지도 취소하지도 않은 예약인지 확인한다.
+func (change ScheduledPriceChange) Pending() bool {
+	return !change.ApplyDate.Valid && !change.CancelDate.Valid
+}
+
+// Apply
+// 상품의 정가와 원가를 예약한 값으로 바꾼다. 정가는 SetPrice 로 바꿔 이전 정가로 계산한 할인가를 지운다.
+func (change ScheduledPriceChange) Apply(product Product) Product {
+	if change.Price != nil {
+		product.SetPrice(*change.Price)
+	}
+	if change.Cost != nil {
+		product.Cost = *change.Cost
+	}
+
+	return product
+}
+
+// ListScheduledPriceChangesParams
+// ProductID 가 nil 이면 사장님의 모든 상품의 예약을 조회한다.
+type ListScheduledPriceChangesParams struct {
+	UserID    int
+	ProductID *int
+}
+
+func (lp ListScheduledPriceChangesParams) EqualProduct() string {
+	if lp.ProductID == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("AND s.product_id = %d", *lp.ProductID)
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "context"
+
+// ImageStorage
+// 상품 이미지 파일을 저장한다. 로컬에서는 파일 시스템에, 운영에서는 S3 호환 스토리지에 저장하도록 구현체를 바꿔 끼운다.
+// key 는 "products/1/ab12.../thumbnail.jpg" 처럼 / 로 구분한 경로이고, URL 은 클라이언트가 바로 내려받을 수 있는 주소다.
+type ImageStorage interface {
+	Put(ctx context.Context, key string, contentType string, data []byte) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
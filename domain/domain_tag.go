@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"strings"
+	"time"
+)
+
+const (
+	MaxTagNameLength = 30
+	MaxProductTags   = 20
+)
+
+type TagRepository interface {
+	CreateTag(ctx context.Context, tag Tag) (int, error)
+	GetTag(ctx context.Context, tagID int) (*Tag, error)
+	FindTagByName(ctx context.Context, userID int, name string) (*Tag, error)
+	UpdateTag(ctx context.Context, tag Tag) error
+	DeleteTag(ctx context.Context, tagID int) error
+	ListTags(ctx context.Context, userID int) ([]Tag, error)
+}
+
+type TagService interface {
+	CreateTag(ctx context.Context, req CreateTagRequest) (CreateTagResponse, error)
+	PatchTag(ctx context.Context, req PatchTagRequest) error
+	DeleteTag(ctx context.Context, req DeleteTagRequest) error
+	ListTags(ctx context.Context, req ListTagsRequest) (ListTagsResponse, error)
+}
+
+type TagController interface {
+	CreateTag(c *gin.Context)
+	PatchTag(c *gin.Context)
+	DeleteTag(c *gin.Context)
+	ListTags(c *gin.Context)
+}
+
+type Tag struct {
+	ID           int
+	UserID       int
+	Name         string
+	ProductCount int
+	CreateDate   time.Time
+}
+
+// ProductTag
+// 상품 목록에 태그를 한 번에 채우기 위한 상품과 태그의 연결.
+type ProductTag struct {
+	ProductID int
+	Tag       Tag
+}
+
+// NormalizeTagName
+// 앞뒤 공백과 앞에 붙인 '#' 을 뺀다. "#비건" 과 "비건" 은 같은 태그다.
+func NormalizeTagName(name string) string {
+	return strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(name), "#"))
+}
+
+// NormalizeTagNames
+// 이름을 정리하고 대소문자만 다른 이름은 처음 나온 이름 하나만 남긴다.
+func NormalizeTagNames(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		name = NormalizeTagName(name)
+		key := strings.ToLower(name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, name)
+	}
+
+	return normalized
+}
+
+func TagNamesFrom(tags []Tag) []string {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+
+	return names
+}
+
+func TagsFrom(names []string) []Tag {
+	var tags []Tag
+	for _, name := range NormalizeTagNames(names) {
+		tags = append(tags, Tag{Name: name})
+	}
+
+	return tags
+}
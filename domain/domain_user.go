@@ -3,11 +3,14 @@ package domain
 import (
 	"context"
 	"github.com/gin-gonic/gin"
+	"time"
 )
 
 type UserRepository interface {
 	CreateUser(ctx context.Context, user User) (int, error)
 	FindUserByMobileID(ctx context.Context, userID string) (*User, error)
+	GetUser(ctx context.Context, userID int) (*User, error)
+	ListUsersAfter(ctx context.Context, cursor int, limit int) ([]User, error)
 }
 
 type UserService interface {
@@ -28,9 +31,28 @@ const (
 	UserUseTypePlace UserUseType = "PLACE"
 )
 
+// DefaultTimeZone
+// 매장 시간대를 정하지 않은 사장님은 한국 시간을 쓴다.
+const DefaultTimeZone = "Asia/Seoul"
+
 type User struct {
 	Base
 	MobileID string
 	Password string
 	UseType  UserUseType
+	TimeZone string // 매장 시간대 (IANA 이름, 예: Asia/Seoul)
+}
+
+// Location
+// 매장 시간대. 시간대를 읽을 수 없으면 한국 시간을 쓴다.
+func (u User) Location() *time.Location {
+	if u.TimeZone != "" {
+		if loc, err := time.LoadLocation(u.TimeZone); err == nil {
+			return loc
+		}
+	}
+	if loc, err := time.LoadLocation(DefaultTimeZone); err == nil {
+		return loc
+	}
+	return time.UTC
 }
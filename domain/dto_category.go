@@ -0,0 +1,134 @@
+package domain
+
+import (
+	cerrors "payhere/pkg/cerrors"
+	"strings"
+)
+
+type CategoryDTO struct {
+	BaseDTO
+	UserID       int           `json:"userID" validate:"required" example:"1"`
+	ParentID     *int          `json:"parentID" validate:"omitempty" example:"1"`
+	Name         string        `json:"name" validate:"required" example:"payhere"`
+	DisplayOrder int           `json:"displayOrder" validate:"required" example:"0"`
+	ProductCount int           `json:"productCount" validate:"required" example:"22"`
+	Children     []CategoryDTO `json:"children"`
+}
+
+func CategoryDTOFrom(domain Category) CategoryDTO {
+	dto := CategoryDTO{
+		BaseDTO: BaseDTO{
+			ID:         domain.ID,
+			CreateDate: domain.CreateDate,
+			UpdateDate: domain.UpdateDate,
+		},
+		UserID:       domain.UserID,
+		ParentID:     domain.ParentID,
+		Name:         domain.Name,
+		DisplayOrder: domain.DisplayOrder,
+		ProductCount: domain.ProductCount,
+		Children:     []CategoryDTO{},
+	}
+
+	return dto
+}
+
+type CreateCategoryRequest struct {
+	UserID       int    `swaggerignore:"true"`
+	ParentID     *int   `json:"parentID" validate:"omitempty" example:"1"`
+	Name         string `json:"name" validate:"required" example:"payhere"`
+	DisplayOrder int    `json:"displayOrder" validate:"omitempty" example:"0"`
+}
+
+func (req CreateCategoryRequest) Validate() error {
+	const op cerrors.Op = "domain/CreateCategoryRequest.Validate"
+
+	if strings.TrimSpace(req.Name) == "" {
+		return cerrors.E(op, cerrors.Invalid, "카테고리명을 확인해주세요.")
+	}
+	if req.ParentID != nil && *req.ParentID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상위 카테고리를 확인해주세요.")
+	}
+	if req.DisplayOrder < 0 {
+		return cerrors.E(op, cerrors.Invalid, "노출 순서를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type GetCategoryRequest struct {
+	UserID     int `json:"userID"`
+	CategoryID int `json:"categoryID" uri:"categoryID"`
+}
+
+func (req GetCategoryRequest) Validate() error {
+	const op cerrors.Op = "domain/GetCategoryRequest.Validate"
+
+	if req.CategoryID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "카테고리 ID를 입력해주세요.")
+	}
+
+	return nil
+}
+
+type GetCategoryResponse struct {
+	Category CategoryDTO `json:"category"`
+}
+
+// PatchCategoryRequest
+// 최상위 카테고리로 옮기려면 parentID 를 0 으로 보낸다.
+type PatchCategoryRequest struct {
+	UserID       int     `swaggerignore:"true"`
+	ID           int     `json:"id" validate:"required" example:"1"`
+	ParentID     *int    `json:"parentID" validate:"omitempty" example:"0"`
+	Name         *string `json:"name" validate:"omitempty" example:"payhere"`
+	DisplayOrder *int    `json:"displayOrder" validate:"omitempty" example:"1"`
+}
+
+func (req PatchCategoryRequest) Validate() error {
+	const op cerrors.Op = "domain/PatchCategoryRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "카테고리 ID를 확인해주세요.")
+	}
+	if req.Name != nil && strings.TrimSpace(*req.Name) == "" {
+		return cerrors.E(op, cerrors.Invalid, "카테고리명을 확인해주세요.")
+	}
+	if req.ParentID != nil && (*req.ParentID < 0 || *req.ParentID == req.ID) {
+		return cerrors.E(op, cerrors.Invalid, "상위 카테고리를 확인해주세요.")
+	}
+	if req.DisplayOrder != nil && *req.DisplayOrder < 0 {
+		return cerrors.E(op, cerrors.Invalid, "노출 순서를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	UserID int
+	ID     int `uri:"categoryID"`
+}
+
+func (req DeleteCategoryRequest) Validate() error {
+	const op cerrors.Op = "domain/DeleteCategoryRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "카테고리 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type FindCategoryByNameParams struct {
+	UserID   int
+	ParentID *int
+	Name     string
+}
+
+type ListCategoriesRequest struct {
+	UserID int
+}
+
+type ListCategoriesResponse struct {
+	Categories []CategoryDTO `json:"categories"`
+}
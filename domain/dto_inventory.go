@@ -0,0 +1,313 @@
+package domain
+
+import (
+	"fmt"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+const (
+	MaxStockMovementQuantity   = 1000000
+	MaxStockMovementNoteLength = 255
+	MaxLotNumberLength         = 50
+	ListStockMovementsLimit    = 20
+	ListLowStockProductsLimit  = 20
+)
+
+type StockMovementDTO struct {
+	ID            int               `json:"id" validate:"required" example:"1"`
+	ProductID     int               `json:"productID" validate:"required" example:"1"`
+	Type          StockMovementType `json:"type" validate:"required" enum:"receive,sale,adjustment,waste" example:"receive"`
+	Quantity      int               `json:"quantity" validate:"required" example:"10"`
+	QuantityAfter int               `json:"quantityAfter" validate:"required" example:"22"`
+	Note          string            `json:"note" example:"오전 입고"`
+	CreateDate    time.Time         `json:"createDate" validate:"required" example:"2024-02-28T15:04:05Z"`
+}
+
+func StockMovementDTOFrom(movement StockMovement) StockMovementDTO {
+	return StockMovementDTO{
+		ID:            movement.ID,
+		ProductID:     movement.ProductID,
+		Type:          movement.Type,
+		Quantity:      movement.Quantity,
+		QuantityAfter: movement.QuantityAfter,
+		Note:          movement.Note,
+		CreateDate:    movement.CreateDate,
+	}
+}
+
+// CreateStockMovementRequest
+// 입고, 판매는 수량을 양수로 보내고 재고를 늘리거나 줄이는 방향은 종류로 정한다.
+// 조정은 실사 결과에 맞춰 재고를 늘릴 때 양수, 줄일 때 음수로 보낸다.
+// 폐기는 손실액을 함께 남겨야 하므로 폐기 기록(POST /products/:productID/disposals)으로만 기록한다.
+type CreateStockMovementRequest struct {
+	UserID    int               `json:"-" swaggerignore:"true"`
+	ProductID int               `json:"-" uri:"productID" swaggerignore:"true"`
+	Type      StockMovementType `json:"type" validate:"required" enum:"receive,sale,adjustment" example:"receive"`
+	Quantity  int               `json:"quantity" validate:"required" example:"10"`
+	Note      string            `json:"note" validate:"omitempty" example:"오전 입고"`
+	// 재고를 늘리는 입고와 조정에만 사용한다. 유통기한은 필수이고, 입고일을 보내지 않으면 지금으로 기록한다.
+	LotNumber    string     `json:"lotNumber" validate:"omitempty" example:"L240228-01"`
+	ReceivedDate *time.Time `json:"receivedDate" validate:"omitempty" example:"2024-02-28T09:00:00Z"`
+	ExpiryDate   *time.Time `json:"expiryDate" validate:"omitempty" example:"2024-03-10T00:00:00Z"`
+}
+
+func (req CreateStockMovementRequest) Validate() error {
+	const op cerrors.Op = "domain/CreateStockMovementRequest.Validate"
+
+	if req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+
+	switch req.Type {
+	case StockMovementTypeReceive, StockMovementTypeSale:
+		if req.Quantity <= 0 || req.Quantity > MaxStockMovementQuantity {
+			return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("수량은 1 ~ %d 사이로 입력해주세요.", MaxStockMovementQuantity))
+		}
+	case StockMovementTypeAdjustment:
+		if req.Quantity == 0 || req.Quantity < -MaxStockMovementQuantity || req.Quantity > MaxStockMovementQuantity {
+			return cerrors.E(op, cerrors.Invalid, "조정 수량을 확인해주세요.")
+		}
+	case StockMovementTypeWaste:
+		return cerrors.E(op, cerrors.Invalid, "폐기는 손실액과 함께 기록하도록 POST /products/:productID/disposals 로 기록해주세요.")
+	default:
+		return cerrors.E(op, cerrors.Invalid, "입출고 종류는 receive, sale, adjustment 중 하나로 입력해주세요.")
+	}
+
+	if len([]rune(req.Note)) > MaxStockMovementNoteLength {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("메모는 %d자까지 입력할 수 있습니다.", MaxStockMovementNoteLength))
+	}
+
+	if req.Delta() > 0 {
+		if req.ExpiryDate == nil || req.ExpiryDate.IsZero() {
+			return cerrors.E(op, cerrors.Invalid, "입고하는 재고의 유통기한을 입력해주세요.")
+		}
+		if len([]rune(req.LotNumber)) > MaxLotNumberLength {
+			return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("로트 번호는 %d자까지 입력할 수 있습니다.", MaxLotNumberLength))
+		}
+		if req.ReceivedDate != nil && req.ReceivedDate.After(*req.ExpiryDate) {
+			return cerrors.E(op, cerrors.Invalid, "입고일은 유통기한보다 늦을 수 없습니다.")
+		}
+	} else if req.LotNumber != "" || req.ReceivedDate != nil || req.ExpiryDate != nil {
+		return cerrors.E(op, cerrors.Invalid, "재고를 줄일 때는 로트를 지정할 수 없습니다. 유통기한이 먼저 끝나는 로트부터 꺼냅니다.")
+	}
+
+	return nil
+}
+
+// Delta
+// 종류에 맞춰 부호를 붙인 재고 증감량
+func (req CreateStockMovementRequest) Delta() int {
+	switch req.Type {
+	case StockMovementTypeSale:
+		return -req.Quantity
+	}
+	return req.Quantity
+}
+
+type CreateStockMovementResponse struct {
+	Movement StockMovementDTO `json:"movement"`
+}
+
+type DisposalDTO struct {
+	ID         int            `json:"id" validate:"required" example:"1"`
+	ProductID  int            `json:"productID" validate:"required" example:"1"`
+	CategoryID int            `json:"categoryID" validate:"required" example:"1"`
+	MovementID int            `json:"movementID" validate:"required" example:"12"`
+	Quantity   int            `json:"quantity" validate:"required" example:"3"`
+	Reason     DisposalReason `json:"reason" validate:"required" enum:"expired,damaged,other" example:"expired"`
+	Note       string         `json:"note" example:"유통기한 경과"`
+	UnitCost   float64        `json:"unitCost" validate:"required" example:"500"`
+	LossAmount float64        `json:"lossAmount" validate:"required" example:"1500"`
+	CreateDate time.Time      `json:"createDate" validate:"required" example:"2024-02-28T15:04:05Z"`
+}
+
+func DisposalDTOFrom(disposal Disposal) DisposalDTO {
+	return DisposalDTO{
+		ID:         disposal.ID,
+		ProductID:  disposal.ProductID,
+		CategoryID: disposal.CategoryID,
+		MovementID: disposal.MovementID,
+		Quantity:   disposal.Quantity,
+		Reason:     disposal.Reason,
+		Note:       disposal.Note,
+		UnitCost:   disposal.UnitCost,
+		LossAmount: disposal.LossAmount,
+		CreateDate: disposal.CreateDate,
+	}
+}
+
+type CreateDisposalRequest struct {
+	UserID    int            `json:"-" swaggerignore:"true"`
+	ProductID int            `json:"-" uri:"productID" swaggerignore:"true"`
+	Quantity  int            `json:"quantity" validate:"required" example:"3"`
+	Reason    DisposalReason `json:"reason" validate:"required" enum:"expired,damaged,other" example:"expired"`
+	Note      string         `json:"note" validate:"omitempty" example:"유통기한 경과"`
+}
+
+func (req CreateDisposalRequest) Validate() error {
+	const op cerrors.Op = "domain/CreateDisposalRequest.Validate"
+
+	if req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+	if req.Quantity <= 0 || req.Quantity > MaxStockMovementQuantity {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("수량은 1 ~ %d 사이로 입력해주세요.", MaxStockMovementQuantity))
+	}
+
+	switch req.Reason {
+	case DisposalReasonExpired, DisposalReasonDamaged, DisposalReasonOther:
+	default:
+		return cerrors.E(op, cerrors.Invalid, "폐기 사유는 expired, damaged, other 중 하나로 입력해주세요.")
+	}
+
+	if len([]rune(req.Note)) > MaxStockMovementNoteLength {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("메모는 %d자까지 입력할 수 있습니다.", MaxStockMovementNoteLength))
+	}
+
+	return nil
+}
+
+type CreateDisposalResponse struct {
+	StockQuantity int         `json:"stockQuantity" validate:"required" example:"7"`
+	Disposal      DisposalDTO `json:"disposal"`
+}
+
+type ListStockMovementsParams struct {
+	ProductID int
+	Cursor    *int
+	Type      *StockMovementType
+	Limit     int
+}
+
+// BeforeCursor
+// 최근 내역부터 조회하므로 cursor 보다 ID 가 작은 내역을 가져온다.
+func (lp ListStockMovementsParams) BeforeCursor() string {
+	if lp.Cursor == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("AND id < %d", *lp.Cursor)
+}
+
+func (lp ListStockMovementsParams) EqualType() string {
+	if lp.Type == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("AND type = '%s'", *lp.Type)
+}
+
+type ListStockMovementsRequest struct {
+	UserID    int
+	ProductID int                `uri:"productID"`
+	Cursor    *int               `form:"cursor"`
+	Type      *StockMovementType `form:"type"`
+}
+
+func (req ListStockMovementsRequest) Validate() error {
+	const op cerrors.Op = "domain/ListStockMovementsRequest.Validate"
+
+	if req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+	if req.Type != nil {
+		switch *req.Type {
+		case StockMovementTypeReceive, StockMovementTypeSale, StockMovementTypeAdjustment, StockMovementTypeWaste:
+		default:
+			return cerrors.E(op, cerrors.Invalid, "입출고 종류는 receive, sale, adjustment, waste 중 하나로 입력해주세요.")
+		}
+	}
+
+	return nil
+}
+
+// ListStockMovementsResponse
+// 입출고 내역은 최근 순으로 조회하고, cursor 에는 마지막 내역의 ID 를 담는다.
+type ListStockMovementsResponse struct {
+	StockQuantity int                `json:"stockQuantity" example:"22"`
+	Movements     []StockMovementDTO `json:"movements"`
+	Cursor        *int               `json:"cursor"`
+}
+
+type StockLotDTO struct {
+	ID                int       `json:"id" validate:"required" example:"1"`
+	LotNumber         string    `json:"lotNumber" example:"L240228-01"`
+	ReceivedDate      time.Time `json:"receivedDate" validate:"required" example:"2024-02-28T09:00:00Z"`
+	ExpiryDate        time.Time `json:"expiryDate" validate:"required" example:"2024-03-10T00:00:00Z"`
+	Quantity          int       `json:"quantity" validate:"required" example:"10"`
+	RemainingQuantity int       `json:"remainingQuantity" validate:"required" example:"4"`
+}
+
+func StockLotDTOFrom(lot StockLot) StockLotDTO {
+	return StockLotDTO{
+		ID:                lot.ID,
+		LotNumber:         lot.LotNumber,
+		ReceivedDate:      lot.ReceivedDate,
+		ExpiryDate:        lot.ExpiryDate,
+		Quantity:          lot.Quantity,
+		RemainingQuantity: lot.RemainingQuantity,
+	}
+}
+
+type ListStockLotsParams struct {
+	ProductID    int
+	IncludeEmpty bool
+}
+
+func (lp ListStockLotsParams) ExcludeEmpty() string {
+	if lp.IncludeEmpty {
+		return ""
+	}
+
+	return "AND remaining_quantity > 0"
+}
+
+type ListStockLotsRequest struct {
+	UserID    int
+	ProductID int  `uri:"productID"`
+	All       bool `form:"all"`
+}
+
+func (req ListStockLotsRequest) Validate() error {
+	const op cerrors.Op = "domain/ListStockLotsRequest.Validate"
+
+	if req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+// ListStockLotsResponse
+// 로트는 꺼내는 순서(유통기한이 먼저 끝나는 순)로 조회한다.
+type ListStockLotsResponse struct {
+	StockQuantity int           `json:"stockQuantity" example:"22"`
+	Lots          []StockLotDTO `json:"lots"`
+}
+
+type ListLowStockProductsParams struct {
+	UserID int
+	Cursor *int
+	Limit  int
+}
+
+func (lp ListLowStockProductsParams) AfterCursor() string {
+	if lp.Cursor == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("AND p.id > %d", *lp.Cursor)
+}
+
+type ListLowStockProductsRequest struct {
+	UserID int
+	Cursor *int `form:"cursor"`
+}
+
+// ListLowStockProductsResponse
+// 재고가 재고 알림 기준 수량 이하인 상품을 상품 ID 순으로 조회한다.
+type ListLowStockProductsResponse struct {
+	Products []ProductDTO `json:"products"`
+	Cursor   *int         `json:"cursor"`
+}
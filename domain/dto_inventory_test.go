@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateStockMovementRequest_Validate(t *testing.T) {
+	expiryDate := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	lateReceivedDate := expiryDate.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name    string
+		input   CreateStockMovementRequest
+		wantErr bool
+	}{
+		{name: "PASS - 입고", input: CreateStockMovementRequest{ProductID: 1, Type: StockMovementTypeReceive, Quantity: 10, LotNumber: "L240228-01", ExpiryDate: &expiryDate}, wantErr: false},
+		{name: "PASS - 재고를 줄이는 조정", input: CreateStockMovementRequest{ProductID: 1, Type: StockMovementTypeAdjustment, Quantity: -3}, wantErr: false},
+		{name: "FAIL - 유통기한 없는 입고", input: CreateStockMovementRequest{ProductID: 1, Type: StockMovementTypeReceive, Quantity: 10}, wantErr: true},
+		{name: "FAIL - 유통기한보다 늦은 입고일", input: CreateStockMovementRequest{ProductID: 1, Type: StockMovementTypeReceive, Quantity: 10, ReceivedDate: &lateReceivedDate, ExpiryDate: &expiryDate}, wantErr: true},
+		{name: "FAIL - 로트를 지정한 판매", input: CreateStockMovementRequest{ProductID: 1, Type: StockMovementTypeSale, Quantity: 1, ExpiryDate: &expiryDate}, wantErr: true},
+		{name: "FAIL - 음수의 판매 수량", input: CreateStockMovementRequest{ProductID: 1, Type: StockMovementTypeSale, Quantity: -1}, wantErr: true},
+		{name: "FAIL - 0 인 조정 수량", input: CreateStockMovementRequest{ProductID: 1, Type: StockMovementTypeAdjustment}, wantErr: true},
+		{name: "FAIL - 잘못된 입출고 종류", input: CreateStockMovementRequest{ProductID: 1, Type: "refund", Quantity: 1}, wantErr: true},
+		{name: "FAIL - 폐기는 폐기 기록으로만 기록", input: CreateStockMovementRequest{ProductID: 1, Type: StockMovementTypeWaste, Quantity: 1}, wantErr: true},
+		{name: "FAIL - 너무 긴 메모", input: CreateStockMovementRequest{ProductID: 1, Type: StockMovementTypeSale, Quantity: 1, Note: strings.Repeat("가", MaxStockMovementNoteLength+1)}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		err := test.input.Validate()
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %t, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
+
+func TestCreateStockMovementRequest_Delta(t *testing.T) {
+	tests := []struct {
+		input    CreateStockMovementRequest
+		expected int
+	}{
+		{input: CreateStockMovementRequest{Type: StockMovementTypeReceive, Quantity: 5}, expected: 5},
+		{input: CreateStockMovementRequest{Type: StockMovementTypeSale, Quantity: 5}, expected: -5},
+		{input: CreateStockMovementRequest{Type: StockMovementTypeAdjustment, Quantity: -2}, expected: -2},
+	}
+
+	for _, test := range tests {
+		if got := test.input.Delta(); got != test.expected {
+			t.Errorf("For %s %d, expected %d, but got %d", test.input.Type, test.input.Quantity, test.expected, got)
+		}
+	}
+}
+
+func TestCreateDisposalRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   CreateDisposalRequest
+		wantErr bool
+	}{
+		{name: "PASS - 유통기한 경과 폐기", input: CreateDisposalRequest{ProductID: 1, Quantity: 3, Reason: DisposalReasonExpired, Note: "유통기한 경과"}, wantErr: false},
+		{name: "FAIL - 0 인 폐기 수량", input: CreateDisposalRequest{ProductID: 1, Reason: DisposalReasonDamaged}, wantErr: true},
+		{name: "FAIL - 잘못된 폐기 사유", input: CreateDisposalRequest{ProductID: 1, Quantity: 1, Reason: "lost"}, wantErr: true},
+		{name: "FAIL - 너무 긴 메모", input: CreateDisposalRequest{ProductID: 1, Quantity: 1, Reason: DisposalReasonOther, Note: strings.Repeat("가", MaxStockMovementNoteLength+1)}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		err := test.input.Validate()
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %t, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+const (
+	MaxMarkdownWithinHours = 720
+	MaxMarkdownDiscount    = 90
+)
+
+type MarkdownRuleDTO struct {
+	ID              int       `json:"id" validate:"required" example:"1"`
+	CategoryID      *int      `json:"categoryID" example:"1"`
+	ProductID       *int      `json:"productID" example:"1"`
+	WithinHours     int       `json:"withinHours" validate:"required" example:"24"`
+	DiscountPercent int       `json:"discountPercent" validate:"required" example:"30"`
+	CreateDate      time.Time `json:"createDate" validate:"required" example:"2024-02-28T15:04:05Z"`
+}
+
+func MarkdownRuleDTOFrom(rule MarkdownRule) MarkdownRuleDTO {
+	return MarkdownRuleDTO{
+		ID:              rule.ID,
+		CategoryID:      rule.CategoryID,
+		ProductID:       rule.ProductID,
+		WithinHours:     int(rule.Within / time.Hour),
+		DiscountPercent: rule.DiscountPercent,
+		CreateDate:      rule.CreateDate,
+	}
+}
+
+// CreateMarkdownRuleRequest
+// categoryID 와 productID 중 하나만 보낸다.
+type CreateMarkdownRuleRequest struct {
+	UserID          int  `json:"-" swaggerignore:"true"`
+	CategoryID      *int `json:"categoryID" validate:"omitempty" example:"1"`
+	ProductID       *int `json:"productID" validate:"omitempty" example:"1"`
+	WithinHours     int  `json:"withinHours" validate:"required" example:"24"`
+	DiscountPercent int  `json:"discountPercent" validate:"required" example:"30"`
+}
+
+func (req CreateMarkdownRuleRequest) Validate() error {
+	const op cerrors.Op = "domain/CreateMarkdownRuleRequest.Validate"
+
+	if (req.CategoryID == nil) == (req.ProductID == nil) {
+		return cerrors.E(op, cerrors.Invalid, "할인 대상은 카테고리와 상품 중 하나만 정해주세요.")
+	}
+	if req.CategoryID != nil && *req.CategoryID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "카테고리 ID를 확인해주세요.")
+	}
+	if req.ProductID != nil && *req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+	if req.WithinHours <= 0 || req.WithinHours > MaxMarkdownWithinHours {
+		return cerrors.E(op, cerrors.Invalid, "할인 시작 시점은 유통기한 1 ~ 720시간 전으로 입력해주세요.")
+	}
+	if req.DiscountPercent <= 0 || req.DiscountPercent > MaxMarkdownDiscount {
+		return cerrors.E(op, cerrors.Invalid, "할인율은 1 ~ 90% 사이로 입력해주세요.")
+	}
+
+	return nil
+}
+
+type DeleteMarkdownRuleRequest struct {
+	UserID int
+	ID     int `uri:"ruleID"`
+}
+
+func (req DeleteMarkdownRuleRequest) Validate() error {
+	const op cerrors.Op = "domain/DeleteMarkdownRuleRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "할인 규칙 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type ListMarkdownRulesRequest struct {
+	UserID int
+}
+
+type ListMarkdownRulesResponse struct {
+	Rules []MarkdownRuleDTO `json:"rules"`
+}
+
+// ListMarkdownCandidatesParams
+// 지금 할인 중이거나 유통기한이 Since 와 Until 사이인 사장님의 상품을 조회한다.
+type ListMarkdownCandidatesParams struct {
+	UserID int
+	Since  time.Time
+	Until  time.Time
+}
@@ -1,23 +1,35 @@
 package domain
 
 import (
+	"encoding/json"
 	"fmt"
 	cerrors "payhere/pkg/cerrors"
+	"strconv"
+	"strings"
 	"time"
 )
 
 type ProductDTO struct {
 	BaseDTO
-	UserID      int             `json:"userID" validate:"required" example:"1"`
-	Initial     string          `json:"initial" validate:"required" example:"ㅅㅋㄹ ㄹㄸ"`
-	Category    string          `json:"category" validate:"required" example:"payhere"`
-	Price       float64         `json:"price" validate:"required" example:"1000"`
-	Cost        float64         `json:"cost" validate:"required" example:"500"`
-	Name        string          `json:"name" validate:"required" example:"슈크림 라떼"`
-	Description string          `json:"description" validate:"required" example:"슈크림 라떼 팔아요"`
-	Barcode     string          `json:"barcode" validate:"required" example:"25611234"`
-	ExpiryDate  time.Time       `json:"expiryDate" validate:"required" example:"2024-02-28T15:04:05Z"`
-	Size        ProductSizeType `json:"size" validate:"required" enum:"small,large" example:"large"`
+	UserID          int                     `json:"userID" validate:"required" example:"1"`
+	Initial         string                  `json:"initial" validate:"required" example:"ㅅㅋㄹ ㄹㄸ"`
+	Romanized       string                  `json:"romanized" validate:"required" example:"syukeurim ratte"`
+	CategoryID      int                     `json:"categoryID" validate:"required" example:"1"`
+	Category        string                  `json:"category" validate:"required" example:"payhere"`
+	Price           float64                 `json:"price" validate:"required" example:"1000"`
+	EffectivePrice  float64                 `json:"effectivePrice" validate:"required" example:"700"`
+	Cost            float64                 `json:"cost" validate:"required" example:"500"`
+	Name            string                  `json:"name" validate:"required" example:"슈크림 라떼"`
+	Description     string                  `json:"description" validate:"required" example:"슈크림 라떼 팔아요"`
+	Barcode         string                  `json:"barcode" validate:"required" example:"25611234"`
+	ExpiryDate      time.Time               `json:"expiryDate" validate:"required" example:"2024-02-28T15:04:05Z"`
+	StockQuantity   int                     `json:"stockQuantity" validate:"required" example:"12"`
+	ReorderPoint    *int                    `json:"reorderPoint" example:"5"`
+	ReorderQuantity int                     `json:"reorderQuantity" example:"20"`
+	Version         int                     `json:"version" validate:"required" example:"3"`
+	OptionGroups    []ProductOptionGroupDTO `json:"optionGroups"`
+	Images          []ProductImageDTO       `json:"images"`
+	Tags            []ProductTagDTO         `json:"tags"`
 }
 
 func ProductDTOFrom(domain Product) ProductDTO {
@@ -27,37 +39,121 @@ func ProductDTOFrom(domain Product) ProductDTO {
 			CreateDate: domain.CreateDate,
 			UpdateDate: domain.UpdateDate,
 		},
-		UserID:      domain.UserID,
-		Initial:     domain.Initial,
-		Category:    domain.Category,
-		Price:       domain.Price,
-		Cost:        domain.Cost,
-		Name:        domain.Name,
-		Description: domain.Description,
-		Barcode:     domain.Barcode,
-		ExpiryDate:  domain.ExpiryDate,
-		Size:        domain.Size,
+		UserID:          domain.UserID,
+		Initial:         domain.Initial,
+		Romanized:       domain.Romanized,
+		CategoryID:      domain.CategoryID,
+		Category:        domain.Category,
+		Price:           domain.Price,
+		EffectivePrice:  domain.EffectivePrice(),
+		Cost:            domain.Cost,
+		Name:            domain.Name,
+		Description:     domain.Description,
+		Barcode:         domain.Barcode,
+		ExpiryDate:      domain.ExpiryDate,
+		StockQuantity:   domain.StockQuantity,
+		ReorderPoint:    domain.ReorderPoint,
+		ReorderQuantity: domain.ReorderQuantity,
+		Version:         domain.Version,
+		OptionGroups:    ProductOptionGroupDTOsFrom(domain.OptionGroups),
+		Images:          ProductImageDTOsFrom(domain.Images),
+		Tags:            ProductTagDTOsFrom(domain.Tags),
 	}
 
 	return dto
 }
 
 type CreateProductRequest struct {
-	UserID      int             `swaggerignore:"true"`
-	Category    string          `json:"category" validate:"required" example:"payhere"`
-	Price       float64         `json:"price" validate:"required" example:"1000"`
-	Cost        float64         `json:"cost" validate:"required" example:"500"`
-	Name        string          `json:"name" validate:"required" example:"슈크림 라떼"`
-	Description string          `json:"description" validate:"required" example:"슈크림 라떼 팔아요"`
-	Barcode     string          `json:"barcode" validate:"required" example:"25611234"`
-	ExpiryDate  time.Time       `json:"expiryDate" validate:"required" example:"2024-02-28T15:04:05Z"`
-	Size        ProductSizeType `json:"size" validate:"required" enum:"small,large" example:"large"`
+	UserID      int     `swaggerignore:"true"`
+	CategoryID  int     `json:"categoryID" validate:"required" example:"1"`
+	Price       float64 `json:"price" validate:"required" example:"1000"`
+	Cost        float64 `json:"cost" validate:"required" example:"500"`
+	Name        string  `json:"name" validate:"required" example:"슈크림 라떼"`
+	Description string  `json:"description" validate:"required" example:"슈크림 라떼 팔아요"`
+	Barcode     string  `json:"barcode" validate:"required" example:"8801234567893"`
+	// 매장 자체 바코드는 EAN/UPC 체크 디지트 검사를 하지 않는다.
+	InternalBarcode bool      `json:"internalBarcode" validate:"omitempty" example:"false"`
+	ExpiryDate      time.Time `json:"expiryDate" validate:"required" example:"2024-02-28T15:04:05Z"`
+	// 보내지 않으면 재고 부족 알림을 보내지 않는다.
+	ReorderPoint    *int                        `json:"reorderPoint" validate:"omitempty" example:"5"`
+	ReorderQuantity int                         `json:"reorderQuantity" validate:"omitempty" example:"20"`
+	OptionGroups    []ProductOptionGroupRequest `json:"optionGroups" validate:"omitempty"`
+	// 사장님의 태그 중 없는 이름은 새로 만든다.
+	Tags []string `json:"tags" validate:"omitempty" example:"신메뉴,비건"`
+	// 템플릿으로 시작하면 보내지 않은 항목을 템플릿 값으로 채운다. 바코드와 유통기한은 보내야 한다.
+	TemplateID *int `json:"templateID" validate:"omitempty" example:"1"`
+	// 템플릿으로 시작할 때 요청 json 에 있던 항목 이름. 0 이나 빈 값을 보낸 항목도 템플릿 값으로 채우지 않는다.
+	SentFields map[string]bool `json:"-" swaggerignore:"true"`
 }
 
+// UnmarshalJSON
+// 템플릿으로 시작하면 보낸 항목을 SentFields 에 기록해 0 을 보낸 것과 보내지 않은 것을 구분한다.
+func (req *CreateProductRequest) UnmarshalJSON(data []byte) error {
+	type createProductRequest CreateProductRequest
+	var decoded createProductRequest
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*req = CreateProductRequest(decoded)
+	if req.TemplateID == nil {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	req.SentFields = make(map[string]bool, len(fields))
+	for name := range fields {
+		req.SentFields[name] = true
+	}
+
+	return nil
+}
+
+// CreateProductRequestFrom
+// 옵션 그룹을 채운 상품을 같은 상품을 만드는 요청으로 바꾼다. 바코드가 EAN/UPC 가 아니면 매장 자체 바코드로 본다.
+func CreateProductRequestFrom(product Product) CreateProductRequest {
+	return CreateProductRequest{
+		UserID:          product.UserID,
+		CategoryID:      product.CategoryID,
+		Price:           product.Price,
+		Cost:            product.Cost,
+		Name:            product.Name,
+		Description:     product.Description,
+		Barcode:         product.Barcode,
+		InternalBarcode: !IsValidGTIN(product.Barcode),
+		ExpiryDate:      product.ExpiryDate,
+		ReorderPoint:    product.ReorderPoint,
+		ReorderQuantity: product.ReorderQuantity,
+		OptionGroups:    ProductOptionGroupRequestsFrom(product.OptionGroups),
+		Tags:            TagNamesFrom(product.Tags),
+	}
+}
+
+// Validate
+// 템플릿으로 시작하면 템플릿 ID 만 확인하고, 나머지는 템플릿 값을 채운 뒤 다시 검사한다.
 func (req CreateProductRequest) Validate() error {
 	var op cerrors.Op = "domain/CreateProductRequest.Validate"
 
-	if req.Category == "" {
+	if req.TemplateID != nil {
+		if *req.TemplateID <= 0 {
+			return cerrors.E(op, cerrors.Invalid, "템플릿 ID를 확인해주세요.")
+		}
+		return nil
+	}
+
+	if req.ExpiryDate.IsZero() {
+		return cerrors.E(op, cerrors.Invalid, "유통기한을 확인해주세요.")
+	}
+
+	return req.validateFields(op)
+}
+
+// validateFields
+// 상품 생성과 전체 수정이 함께 쓰는 검사. 유통기한은 만든 뒤에는 로트로만 바뀌므로 여기서 확인하지 않는다.
+func (req CreateProductRequest) validateFields(op cerrors.Op) error {
+	if req.CategoryID <= 0 {
 		return cerrors.E(op, cerrors.Invalid, "카테고리를 확인해주세요.")
 	}
 
@@ -77,16 +173,24 @@ func (req CreateProductRequest) Validate() error {
 		return cerrors.E(op, cerrors.Invalid, "상품 설명을 확인해주세요.")
 	}
 
-	if req.Barcode == "" {
+	if !isValidBarcode(req.Barcode, req.InternalBarcode) {
 		return cerrors.E(op, cerrors.Invalid, "바코드를 확인해주세요.")
 	}
 
-	if req.ExpiryDate.IsZero() {
-		return cerrors.E(op, cerrors.Invalid, "유통기한을 확인해주세요.")
+	if req.ReorderPoint != nil && *req.ReorderPoint < 0 {
+		return cerrors.E(op, cerrors.Invalid, "재고 알림 기준 수량을 확인해주세요.")
+	}
+
+	if req.ReorderQuantity < 0 {
+		return cerrors.E(op, cerrors.Invalid, "발주 수량을 확인해주세요.")
 	}
 
-	if req.Size != ProductSizeTypeSmall && req.Size != ProductSizeTypeLarge {
-		return cerrors.E(op, cerrors.Invalid, "상품 사이즈를 확인해주세요.")
+	if err := validateProductOptionGroups(req.OptionGroups); err != nil {
+		return err
+	}
+
+	if err := validateTagNames(op, req.Tags); err != nil {
+		return err
 	}
 
 	return nil
@@ -111,23 +215,60 @@ type GetProductResponse struct {
 	Product ProductDTO `json:"product"`
 }
 
+// ProductETag
+// 상품 버전을 강한 ETag 로 바꾼다. (예: "3")
+func ProductETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// ProductVersionFromIfMatch
+// If-Match 헤더의 ETag 를 상품 버전으로 바꾼다. 헤더가 없거나 * 이면 버전을 확인하지 않으므로 nil 을 반환한다.
+func ProductVersionFromIfMatch(header string) (*int, error) {
+	const op cerrors.Op = "domain/ProductVersionFromIfMatch"
+
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	unquoted, err := strconv.Unquote(header)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Invalid, err, "If-Match 헤더를 확인해주세요.")
+	}
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version <= 0 {
+		return nil, cerrors.E(op, cerrors.Invalid, "If-Match 헤더를 확인해주세요.")
+	}
+
+	return &version, nil
+}
+
 type PatchProductRequest struct {
-	UserID      int              `swaggerignore:"true"`
-	ID          int              `json:"id" validate:"required" example:"1"`
-	Category    *string          `json:"category" validate:"omitempty" example:"payhere"`
-	Price       *float64         `json:"price" validate:"omitempty" example:"1000"`
-	Cost        *float64         `json:"cost" validate:"omitempty" example:"500"`
-	Name        *string          `json:"name" validate:"omitempty" example:"슈크림 라떼"`
-	Description *string          `json:"description" validate:"omitempty" example:"슈크림 라떼 팔아요"`
-	Barcode     *string          `json:"barcode" validate:"omitempty" example:"25611234"`
-	ExpiryDate  *time.Time       `json:"expiryDate" validate:"omitempty" example:"2024-02-28T15:04:05Z"`
-	Size        *ProductSizeType `json:"size" validate:"omitempty" enum:"small,large" example:"large"`
+	UserID      int      `swaggerignore:"true"`
+	ID          int      `json:"id" validate:"required" example:"1"`
+	CategoryID  *int     `json:"categoryID" validate:"omitempty" example:"1"`
+	Price       *float64 `json:"price" validate:"omitempty" example:"1000"`
+	Cost        *float64 `json:"cost" validate:"omitempty" example:"500"`
+	Name        *string  `json:"name" validate:"omitempty" example:"슈크림 라떼"`
+	Description *string  `json:"description" validate:"omitempty" example:"슈크림 라떼 팔아요"`
+	Barcode     *string  `json:"barcode" validate:"omitempty" example:"8801234567893"`
+	// barcode 를 수정할 때만 사용한다.
+	InternalBarcode bool `json:"internalBarcode" validate:"omitempty" example:"false"`
+	ReorderPoint    *int `json:"reorderPoint" validate:"omitempty" example:"5"`
+	// true 로 보내면 재고 부족 알림을 끈다. reorderPoint 와 함께 보낼 수 없다.
+	ClearReorderPoint bool                         `json:"clearReorderPoint" validate:"omitempty" example:"false"`
+	ReorderQuantity   *int                         `json:"reorderQuantity" validate:"omitempty" example:"20"`
+	OptionGroups      *[]ProductOptionGroupRequest `json:"optionGroups" validate:"omitempty"`
+	// 보낸 태그로 모두 교체한다. 빈 목록을 보내면 태그를 모두 뗀다.
+	Tags *[]string `json:"tags" validate:"omitempty" example:"신메뉴,비건"`
+	// If-Match 헤더로 받은 버전. nil 이 아니면 현재 버전과 같을 때만 수정한다.
+	Version *int `json:"-" swaggerignore:"true"`
 }
 
 func (req PatchProductRequest) Validate() error {
 	const op cerrors.Op = "domain/PatchProductRequest.Validate"
 
-	if req.Category != nil && *req.Category == "" {
+	if req.CategoryID != nil && *req.CategoryID <= 0 {
 		return cerrors.E(op, cerrors.Invalid, "카테고리를 확인해주세요.")
 	}
 
@@ -147,24 +288,53 @@ func (req PatchProductRequest) Validate() error {
 		return cerrors.E(op, cerrors.Invalid, "상품 설명을 확인해주세요.")
 	}
 
-	if req.Barcode != nil && *req.Barcode == "" {
+	if req.Barcode != nil && !isValidBarcode(*req.Barcode, req.InternalBarcode) {
 		return cerrors.E(op, cerrors.Invalid, "바코드를 확인해주세요.")
 	}
 
-	if req.ExpiryDate != nil && req.ExpiryDate.IsZero() {
-		return cerrors.E(op, cerrors.Invalid, "유통기한을 확인해주세요.")
+	if req.ReorderPoint != nil && (*req.ReorderPoint < 0 || req.ClearReorderPoint) {
+		return cerrors.E(op, cerrors.Invalid, "재고 알림 기준 수량을 확인해주세요.")
 	}
 
-	if req.Size != nil && *req.Size != ProductSizeTypeSmall && *req.Size != ProductSizeTypeLarge {
-		return cerrors.E(op, cerrors.Invalid, "상품 사이즈를 확인해주세요.")
+	if req.ReorderQuantity != nil && *req.ReorderQuantity < 0 {
+		return cerrors.E(op, cerrors.Invalid, "발주 수량을 확인해주세요.")
+	}
+
+	if req.OptionGroups != nil {
+		if err := validateProductOptionGroups(*req.OptionGroups); err != nil {
+			return err
+		}
+	}
+
+	if req.Tags != nil {
+		if err := validateTagNames(op, *req.Tags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type GetProductByBarcodeRequest struct {
+	UserID  int    `json:"userID"`
+	Barcode string `json:"barcode" uri:"barcode"`
+}
+
+func (req GetProductByBarcodeRequest) Validate() error {
+	const op cerrors.Op = "domain/GetProductByBarcodeRequest.Validate"
+
+	if req.Barcode == "" || len(req.Barcode) > MaxBarcodeLength {
+		return cerrors.E(op, cerrors.Invalid, "바코드를 확인해주세요.")
 	}
 
 	return nil
 }
 
 type DeleteProductRequest struct {
-	UserID int
-	ID     int `uri:"productID"`
+	UserID int `swaggerignore:"true"`
+	ID     int `uri:"productID" json:"id" example:"1"`
+	// If-Match 헤더로 받은 버전. nil 이 아니면 현재 버전과 같을 때만 삭제한다.
+	Version *int `json:"-" swaggerignore:"true"`
 }
 
 func (req DeleteProductRequest) Validate() error {
@@ -178,26 +348,54 @@ func (req DeleteProductRequest) Validate() error {
 }
 
 type ListProductsParams struct {
-	UserID  int
-	Cursor  *int
-	Name    *string
-	Initial *string
+	UserID     int
+	Cursor     *int
+	CategoryID *int
+	Name       *string
+	Initial    *string
+	Romanized  *string
+	TagIDs     []int
+	TagMatch   TagMatch
 }
 
-func (lp ListProductsParams) LikeName() string {
+// Filter
+// 상품 목록 조회, 내보내기, 리포트, 라벨이 같은 조건(초성, 상품명, 카테고리, 태그)으로 상품을 고르도록 조건과 ? 에 넣을 값을 함께 만든다.
+func (lp ListProductsParams) Filter() (string, []any) {
+	likeInitial, initialArgs := lp.LikeInitial()
+	likeName, nameArgs := lp.LikeName()
+
+	return strings.Join([]string{likeInitial, likeName, lp.EqualCategory(), lp.HasTags()}, " "), append(initialArgs, nameArgs...)
+}
+
+// LikeName
+// 로마자 검색어가 함께 주어지면 상품명 또는 로마자 표기 중 하나만 일치해도 조회한다. (로마자 표기는 띄어쓰기를 무시하고 비교)
+// 검색어는 SQL 에 넣지 않고 ? 로 넘긴다.
+func (lp ListProductsParams) LikeName() (string, []any) {
 	if lp.Name == nil {
-		return ""
+		return "", nil
 	}
 
-	return fmt.Sprintf("AND name LIKE '%%%s%%'", *lp.Name)
+	if lp.Romanized != nil {
+		return "AND (p.name LIKE ? OR REPLACE(p.romanized, ' ', '') LIKE ?)", []any{likeContains(*lp.Name), likeContains(*lp.Romanized)}
+	}
+
+	return "AND p.name LIKE ?", []any{likeContains(*lp.Name)}
 }
 
-func (lp ListProductsParams) LikeInitial() string {
+func (lp ListProductsParams) LikeInitial() (string, []any) {
 	if lp.Initial == nil {
-		return ""
+		return "", nil
 	}
 
-	return fmt.Sprintf("AND initial LIKE '%%%s%%'", *lp.Initial)
+	return "AND p.initial LIKE ?", []any{likeContains(*lp.Initial)}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// likeContains
+// 검색어의 '%', '_' 도 글자 그대로 찾도록 이스케이프해서 LIKE 의 부분 일치 패턴을 만든다.
+func likeContains(s string) string {
+	return "%" + likeEscaper.Replace(s) + "%"
 }
 
 func (lp ListProductsParams) AfterCursor() string {
@@ -205,16 +403,202 @@ func (lp ListProductsParams) AfterCursor() string {
 		return ""
 	}
 
-	return fmt.Sprintf("AND id > %d", *lp.Cursor)
+	return fmt.Sprintf("AND p.id > %d", *lp.Cursor)
+}
+
+func (lp ListProductsParams) EqualCategory() string {
+	if lp.CategoryID == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("AND p.category_id = %d", *lp.CategoryID)
 }
 
+// HasTags
+// any 면 태그 중 하나라도 붙은 상품을, all 이면 태그가 모두 붙은 상품을 조회한다.
+func (lp ListProductsParams) HasTags() string {
+	if len(lp.TagIDs) == 0 {
+		return ""
+	}
+
+	tagIDs := make([]string, 0, len(lp.TagIDs))
+	seen := make(map[int]bool, len(lp.TagIDs))
+	for _, tagID := range lp.TagIDs {
+		if seen[tagID] {
+			continue
+		}
+		seen[tagID] = true
+		tagIDs = append(tagIDs, strconv.Itoa(tagID))
+	}
+
+	if lp.TagMatch == TagMatchAll {
+		return fmt.Sprintf("AND p.id IN (SELECT pt.product_id FROM product_tags pt WHERE pt.tag_id IN (%s) GROUP BY pt.product_id HAVING COUNT(*) = %d)", strings.Join(tagIDs, ", "), len(tagIDs))
+	}
+
+	return fmt.Sprintf("AND p.id IN (SELECT pt.product_id FROM product_tags pt WHERE pt.tag_id IN (%s))", strings.Join(tagIDs, ", "))
+}
+
+type TagMatch string
+
+const (
+	TagMatchAny TagMatch = "any"
+	TagMatchAll TagMatch = "all"
+)
+
+const MaxListProductsTagIDs = 20
+
 type ListProductsRequest struct {
-	UserID int
-	Cursor *int    `form:"cursor"`
-	Search *string `form:"search"`
+	UserID     int
+	Cursor     *int    `form:"cursor"`
+	Search     *string `form:"search"`
+	CategoryID *int    `form:"categoryID"`
+	// tagID=1&tagID=2 처럼 여러 번 보낸다.
+	TagIDs   []int    `form:"tagID"`
+	TagMatch TagMatch `form:"tagMatch"`
+}
+
+func (req ListProductsRequest) Validate() error {
+	const op cerrors.Op = "domain/ListProductsRequest.Validate"
+
+	if err := validateTagFilter(op, req.TagIDs, req.TagMatch); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTagFilter
+// 상품 목록 조회와 같은 태그 조건을 받는 요청(내보내기, 리포트, 라벨)이 함께 쓴다.
+func validateTagFilter(op cerrors.Op, tagIDs []int, tagMatch TagMatch) error {
+	if len(tagIDs) > MaxListProductsTagIDs {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("태그는 %d개까지 고를 수 있습니다.", MaxListProductsTagIDs))
+	}
+	for _, tagID := range tagIDs {
+		if tagID <= 0 {
+			return cerrors.E(op, cerrors.Invalid, "태그 ID를 확인해주세요.")
+		}
+	}
+	if tagMatch != "" && tagMatch != TagMatchAny && tagMatch != TagMatchAll {
+		return cerrors.E(op, cerrors.Invalid, "태그 조건은 any 또는 all 로 입력해주세요.")
+	}
+
+	return nil
 }
 
 type ListProductsResponse struct {
 	Products []ProductDTO `json:"products"`
 	Cursor   *int         `json:"cursor"`
 }
+
+const (
+	DefaultSuggestProductsLimit = 10
+	MaxSuggestProductsLimit     = 20
+)
+
+type SuggestProductsRequest struct {
+	UserID int
+	Query  string `form:"q"`
+	Limit  *int   `form:"limit"`
+}
+
+func (req SuggestProductsRequest) Validate() error {
+	const op cerrors.Op = "domain/SuggestProductsRequest.Validate"
+
+	if strings.TrimSpace(req.Query) == "" {
+		return cerrors.E(op, cerrors.Invalid, "검색어를 입력해주세요.")
+	}
+	if req.Limit != nil && (*req.Limit <= 0 || *req.Limit > MaxSuggestProductsLimit) {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("자동완성 개수는 1 ~ %d 사이로 입력해주세요.", MaxSuggestProductsLimit))
+	}
+
+	return nil
+}
+
+func (req SuggestProductsRequest) LimitOrDefault() int {
+	if req.Limit == nil {
+		return DefaultSuggestProductsLimit
+	}
+	return *req.Limit
+}
+
+type SuggestProductsResponse struct {
+	Suggestions []string `json:"suggestions" example:"슈크림 라떼"`
+}
+
+const (
+	DefaultExpiringWithin     = 72 * time.Hour
+	MaxExpiringWithin         = 90 * 24 * time.Hour
+	ListExpiringProductsLimit = 500
+)
+
+type ListExpiringProductsParams struct {
+	UserID     int
+	CategoryID *int
+	Since      *time.Time // nil 이면 이미 유통기한이 지난 상품도 조회한다.
+	Until      time.Time
+	InStock    bool
+	Limit      int
+}
+
+func (lp ListExpiringProductsParams) ExpiryAfter() string {
+	if lp.Since == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("AND p.expiry_date >= '%s'", lp.Since.UTC().Format("2006-01-02 15:04:05"))
+}
+
+func (lp ListExpiringProductsParams) EqualCategory() string {
+	if lp.CategoryID == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("AND p.category_id = %d", *lp.CategoryID)
+}
+
+func (lp ListExpiringProductsParams) HasStock() string {
+	if !lp.InStock {
+		return ""
+	}
+
+	return "AND p.stock_quantity > 0"
+}
+
+// ListExpiringProductsRequest
+// within 은 Go duration 형식(예: 72h, 90m)이고 보내지 않으면 72h 이다.
+type ListExpiringProductsRequest struct {
+	UserID         int
+	Within         string `form:"within"`
+	CategoryID     *int   `form:"categoryID"`
+	IncludeExpired bool   `form:"includeExpired"`
+}
+
+func (req ListExpiringProductsRequest) Validate() error {
+	const op cerrors.Op = "domain/ListExpiringProductsRequest.Validate"
+
+	if req.Within == "" {
+		return nil
+	}
+
+	within, err := time.ParseDuration(req.Within)
+	if err != nil || within <= 0 || within > MaxExpiringWithin {
+		return cerrors.E(op, cerrors.Invalid, "조회 기간은 2160h(90일) 이하의 양수로 입력해주세요. (예: 72h)")
+	}
+
+	return nil
+}
+
+func (req ListExpiringProductsRequest) WithinOrDefault() time.Duration {
+	within, err := time.ParseDuration(req.Within)
+	if err != nil {
+		return DefaultExpiringWithin
+	}
+	return within
+}
+
+// ListExpiringProductsResponse
+// 유통기한이 until 이전인 상품을 유통기한이 빠른 순으로 조회한다.
+type ListExpiringProductsResponse struct {
+	Products []ProductDTO `json:"products"`
+	Until    time.Time    `json:"until" example:"2024-03-04T09:00:00Z"`
+}
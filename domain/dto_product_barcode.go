@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"fmt"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+type BarcodeSymbology string
+
+const (
+	// BarcodeSymbologyAuto EAN/UPC 바코드는 EAN 으로, 매장 자체 바코드는 Code128 로 그린다.
+	BarcodeSymbologyAuto    BarcodeSymbology = "auto"
+	BarcodeSymbologyCode128 BarcodeSymbology = "code128"
+	BarcodeSymbologyEAN13   BarcodeSymbology = "ean13"
+)
+
+type BarcodeImageFormat string
+
+const (
+	BarcodeImageFormatPNG BarcodeImageFormat = "png"
+	BarcodeImageFormatSVG BarcodeImageFormat = "svg"
+)
+
+func (f BarcodeImageFormat) ContentType() string {
+	if f == BarcodeImageFormatSVG {
+		return "image/svg+xml"
+	}
+	return "image/png"
+}
+
+const (
+	DefaultBarcodeImageWidth  = 400
+	DefaultBarcodeImageHeight = 120
+	DefaultQRCodeImageSize    = 256
+	MinBarcodeImageSize       = 20
+	MaxBarcodeImageSize       = 2000
+)
+
+type GetProductBarcodeImageRequest struct {
+	UserID    int
+	ProductID int                `uri:"productID"`
+	Format    BarcodeImageFormat // 경로의 확장자(.png, .svg)로 정한다.
+	Symbology BarcodeSymbology   `form:"symbology"`
+	Width     int                `form:"width"`
+	Height    int                `form:"height"`
+}
+
+func (req GetProductBarcodeImageRequest) Validate() error {
+	const op cerrors.Op = "domain/GetProductBarcodeImageRequest.Validate"
+
+	if req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+	if err := validateBarcodeImageFormat(req.Format); err != nil {
+		return err
+	}
+	switch req.Symbology {
+	case "", BarcodeSymbologyAuto, BarcodeSymbologyCode128, BarcodeSymbologyEAN13:
+	default:
+		return cerrors.E(op, cerrors.Invalid, "바코드 종류는 auto, code128, ean13 중 하나로 입력해주세요.")
+	}
+	if !isValidBarcodeImageSize(req.Width) || !isValidBarcodeImageSize(req.Height) {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("이미지 크기는 %d ~ %d 사이로 입력해주세요.", MinBarcodeImageSize, MaxBarcodeImageSize))
+	}
+
+	return nil
+}
+
+func (req GetProductBarcodeImageRequest) SymbologyOrDefault() BarcodeSymbology {
+	if req.Symbology == "" {
+		return BarcodeSymbologyAuto
+	}
+	return req.Symbology
+}
+
+func (req GetProductBarcodeImageRequest) WidthOrDefault() int {
+	if req.Width == 0 {
+		return DefaultBarcodeImageWidth
+	}
+	return req.Width
+}
+
+func (req GetProductBarcodeImageRequest) HeightOrDefault() int {
+	if req.Height == 0 {
+		return DefaultBarcodeImageHeight
+	}
+	return req.Height
+}
+
+type GetProductQRCodeRequest struct {
+	UserID    int
+	ProductID int                `uri:"productID"`
+	Format    BarcodeImageFormat // 경로의 확장자(.png, .svg)로 정한다.
+	Size      int                `form:"size"`
+}
+
+func (req GetProductQRCodeRequest) Validate() error {
+	const op cerrors.Op = "domain/GetProductQRCodeRequest.Validate"
+
+	if req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+	if err := validateBarcodeImageFormat(req.Format); err != nil {
+		return err
+	}
+	if !isValidBarcodeImageSize(req.Size) {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("이미지 크기는 %d ~ %d 사이로 입력해주세요.", MinBarcodeImageSize, MaxBarcodeImageSize))
+	}
+
+	return nil
+}
+
+func (req GetProductQRCodeRequest) SizeOrDefault() int {
+	if req.Size == 0 {
+		return DefaultQRCodeImageSize
+	}
+	return req.Size
+}
+
+// BarcodeImage
+// 렌더링한 이미지와 캐시 검증에 쓸 ETag, 상품 수정 시각
+type BarcodeImage struct {
+	ContentType  string
+	Data         []byte
+	ETag         string
+	LastModified time.Time
+}
+
+func validateBarcodeImageFormat(format BarcodeImageFormat) error {
+	const op cerrors.Op = "domain/validateBarcodeImageFormat"
+
+	if format != BarcodeImageFormatPNG && format != BarcodeImageFormatSVG {
+		return cerrors.E(op, cerrors.Invalid, "이미지 형식은 png, svg 중 하나로 입력해주세요.")
+	}
+	return nil
+}
+
+// isValidBarcodeImageSize
+// 0 은 기본 크기를 뜻한다.
+func isValidBarcodeImageSize(size int) bool {
+	return size == 0 || (size >= MinBarcodeImageSize && size <= MaxBarcodeImageSize)
+}
+
+// EAN13Content
+// EAN-13 과 UPC-A(앞에 0 을 붙여 EAN-13 으로 표현) 바코드를 EAN-13 13자리로 바꾼다. 그 외 바코드는 false 를 반환한다.
+func EAN13Content(barcode string) (string, bool) {
+	if !IsValidGTIN(barcode) {
+		return "", false
+	}
+	switch len(barcode) {
+	case 13:
+		return barcode, true
+	case 12:
+		return "0" + barcode, true
+	}
+	return "", false
+}
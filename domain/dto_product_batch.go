@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"fmt"
+	cerrors "payhere/pkg/cerrors"
+)
+
+const MaxBatchProductOperations = 100
+
+type BatchProductOperationType string
+
+const (
+	BatchProductOperationCreate BatchProductOperationType = "create"
+	BatchProductOperationPatch  BatchProductOperationType = "patch"
+	BatchProductOperationDelete BatchProductOperationType = "delete"
+)
+
+// BatchProductOperation
+// op 에 맞는 요청 하나만 채운다. 수정, 삭제할 상품은 patch, delete 요청의 id 로 정한다.
+type BatchProductOperation struct {
+	Op     BatchProductOperationType `json:"op" validate:"required" enum:"create,patch,delete" example:"patch"`
+	Create *CreateProductRequest     `json:"create" validate:"omitempty"`
+	Patch  *PatchProductRequest      `json:"patch" validate:"omitempty"`
+	Delete *DeleteProductRequest     `json:"delete" validate:"omitempty"`
+}
+
+// BatchProductsRequest
+// 작업은 보낸 순서대로 한 트랜잭션 안에서 실행한다. 하나라도 실패하면 모든 작업을 되돌린다.
+type BatchProductsRequest struct {
+	UserID     int                     `swaggerignore:"true"`
+	Operations []BatchProductOperation `json:"operations" validate:"required"`
+}
+
+func (req BatchProductsRequest) Validate() error {
+	const op cerrors.Op = "domain/BatchProductsRequest.Validate"
+
+	if len(req.Operations) == 0 {
+		return cerrors.E(op, cerrors.Invalid, "작업을 확인해주세요.")
+	}
+	if len(req.Operations) > MaxBatchProductOperations {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("작업은 한 번에 %d개까지 보낼 수 있습니다.", MaxBatchProductOperations))
+	}
+
+	for i, operation := range req.Operations {
+		var ok bool
+		switch operation.Op {
+		case BatchProductOperationCreate:
+			ok = operation.Create != nil && operation.Patch == nil && operation.Delete == nil
+		case BatchProductOperationPatch:
+			ok = operation.Patch != nil && operation.Create == nil && operation.Delete == nil
+		case BatchProductOperationDelete:
+			ok = operation.Delete != nil && operation.Create == nil && operation.Patch == nil
+		}
+		if !ok {
+			return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("%d번째 작업을 확인해주세요.", i+1))
+		}
+	}
+
+	return nil
+}
+
+type BatchProductResultStatus string
+
+const (
+	BatchProductResultStatusApplied    BatchProductResultStatus = "applied"
+	BatchProductResultStatusFailed     BatchProductResultStatus = "failed"
+	BatchProductResultStatusRolledBack BatchProductResultStatus = "rolledBack"
+	BatchProductResultStatusSkipped    BatchProductResultStatus = "skipped"
+)
+
+// BatchProductResultDTO
+// index 는 요청한 작업의 순서로 0 부터 센다. 실패한 작업 앞의 작업은 rolledBack, 뒤의 작업은 실행하지 않아 skipped 다.
+// productID 는 작업이 반영된 경우에만 채운다.
+type BatchProductResultDTO struct {
+	Index     int                       `json:"index" example:"0"`
+	Op        BatchProductOperationType `json:"op" enum:"create,patch,delete" example:"patch"`
+	Status    BatchProductResultStatus  `json:"status" enum:"applied,failed,rolledBack,skipped" example:"applied"`
+	ProductID *int                      `json:"productID" example:"1"`
+	Error     string                    `json:"error,omitempty" example:"상품을 찾을 수 없습니다."`
+}
+
+type BatchProductsResponse struct {
+	Applied bool                    `json:"applied" example:"true"`
+	Results []BatchProductResultDTO `json:"results"`
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestBatchProductsRequest_Validate(t *testing.T) {
+	tooMany := make([]BatchProductOperation, MaxBatchProductOperations+1)
+	for i := range tooMany {
+		tooMany[i] = BatchProductOperation{Op: BatchProductOperationDelete, Delete: &DeleteProductRequest{ID: i + 1}}
+	}
+
+	tests := []struct {
+		name    string
+		input   BatchProductsRequest
+		wantErr bool
+	}{
+		{name: "PASS - 생성, 수정, 삭제", input: BatchProductsRequest{Operations: []BatchProductOperation{
+			{Op: BatchProductOperationCreate, Create: &CreateProductRequest{}},
+			{Op: BatchProductOperationPatch, Patch: &PatchProductRequest{}},
+			{Op: BatchProductOperationDelete, Delete: &DeleteProductRequest{}},
+		}}, wantErr: false},
+		{name: "FAIL - 작업 없음", input: BatchProductsRequest{}, wantErr: true},
+		{name: "FAIL - 너무 많은 작업", input: BatchProductsRequest{Operations: tooMany}, wantErr: true},
+		{name: "FAIL - op 와 다른 요청", input: BatchProductsRequest{Operations: []BatchProductOperation{{Op: BatchProductOperationPatch, Delete: &DeleteProductRequest{}}}}, wantErr: true},
+		{name: "FAIL - 요청이 두 개인 작업", input: BatchProductsRequest{Operations: []BatchProductOperation{{Op: BatchProductOperationCreate, Create: &CreateProductRequest{}, Patch: &PatchProductRequest{}}}}, wantErr: true},
+		{name: "FAIL - 잘못된 op", input: BatchProductsRequest{Operations: []BatchProductOperation{{Op: "upsert", Create: &CreateProductRequest{}}}}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		err := test.input.Validate()
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %t, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	cerrors "payhere/pkg/cerrors"
+)
+
+type ProductExportFormat string
+
+const (
+	ProductExportFormatCSV  ProductExportFormat = "csv"
+	ProductExportFormatXLSX ProductExportFormat = "xlsx"
+	ProductExportFormatJSON ProductExportFormat = "json"
+)
+
+// ContentType
+// CSV 는 엑셀에서 한글이 깨지지 않도록 UTF-8 BOM 을 붙여 내려준다.
+func (f ProductExportFormat) ContentType() string {
+	switch f {
+	case ProductExportFormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case ProductExportFormatJSON:
+		return "application/json; charset=utf-8"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// ProductExportColumns
+// CSV, XLSX 로 내보낼 때의 열 이름. 상품 가져오기와 같은 열 이름을 써서 내보낸 파일을 그대로 다시 가져올 수 있다.
+// 매장 자체 바코드도 다시 가져올 수 있도록 internalBarcode 를 함께 쓴다.
+var ProductExportColumns = []string{
+	"id", "categoryID", "category", "price", "effectivePrice", "cost", "name", "description", "barcode", "internalBarcode",
+	"expiryDate", "stockQuantity", "reorderPoint", "reorderQuantity", "optionGroups",
+}
+
+// ExportProductsRequest
+// 상품 목록 조회와 같은 검색 조건으로 삭제되지 않은 상품을 모두 내보낸다. format 을 보내지 않으면 CSV 로 내보낸다.
+type ExportProductsRequest struct {
+	UserID     int
+	Format     ProductExportFormat `form:"format"`
+	Search     *string             `form:"search"`
+	CategoryID *int                `form:"categoryID"`
+	// tagID=1&tagID=2 처럼 여러 번 보낸다.
+	TagIDs   []int    `form:"tagID"`
+	TagMatch TagMatch `form:"tagMatch"`
+}
+
+func (req ExportProductsRequest) Validate() error {
+	const op cerrors.Op = "domain/ExportProductsRequest.Validate"
+
+	switch req.FormatOrDefault() {
+	case ProductExportFormatCSV, ProductExportFormatXLSX, ProductExportFormatJSON:
+	default:
+		return cerrors.E(op, cerrors.Invalid, "format 은 csv, xlsx, json 중 하나로 입력해주세요.")
+	}
+
+	if req.CategoryID != nil && *req.CategoryID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "카테고리 ID를 확인해주세요.")
+	}
+
+	if err := validateTagFilter(op, req.TagIDs, req.TagMatch); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (req ExportProductsRequest) FormatOrDefault() ProductExportFormat {
+	if req.Format == "" {
+		return ProductExportFormatCSV
+	}
+	return req.Format
+}
+
+func (req ExportProductsRequest) FileName() string {
+	return "products." + string(req.FormatOrDefault())
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"fmt"
+	cerrors "payhere/pkg/cerrors"
+)
+
+const (
+	MaxProductImageFileSize = 10 << 20
+	// 압축을 풀면 메모리를 많이 차지하는 이미지를 막기 위한 최대 픽셀 수 (가로 x 세로)
+	MaxProductImagePixels = 40_000_000
+	MaxProductImages      = 10
+)
+
+// ProductImageContentTypes
+// 업로드할 수 있는 이미지 형식. 파일 이름이나 요청 헤더가 아니라 파일 내용으로 판단한다.
+var ProductImageContentTypes = []string{"image/jpeg", "image/png", "image/webp"}
+
+// ProductImageDTO
+// 대표 이미지(primary)는 상품마다 하나이고, 이미지는 displayOrder 순서로 응답한다.
+type ProductImageDTO struct {
+	ID           int    `json:"id" validate:"required" example:"1"`
+	Primary      bool   `json:"primary" example:"true"`
+	DisplayOrder int    `json:"displayOrder" example:"0"`
+	Width        int    `json:"width" example:"1600"`
+	Height       int    `json:"height" example:"1200"`
+	URL          string `json:"url" example:"http://localhost:3000/uploads/products/1/9f86d081884c7d65/original.jpg"`
+	MediumURL    string `json:"mediumURL" example:"http://localhost:3000/uploads/products/1/9f86d081884c7d65/medium.jpg"`
+	ThumbnailURL string `json:"thumbnailURL" example:"http://localhost:3000/uploads/products/1/9f86d081884c7d65/thumbnail.jpg"`
+}
+
+func ProductImageDTOFrom(image ProductImage) ProductImageDTO {
+	return ProductImageDTO{
+		ID:           image.ID,
+		Primary:      image.Primary,
+		DisplayOrder: image.DisplayOrder,
+		Width:        image.Width,
+		Height:       image.Height,
+		URL:          image.URLs[ProductImageVariantOriginal.Name],
+		MediumURL:    image.URLs[ProductImageVariantMedium.Name],
+		ThumbnailURL: image.URLs[ProductImageVariantThumbnail.Name],
+	}
+}
+
+func ProductImageDTOsFrom(images []ProductImage) []ProductImageDTO {
+	dtos := make([]ProductImageDTO, 0, len(images))
+	for _, image := range images {
+		dtos = append(dtos, ProductImageDTOFrom(image))
+	}
+	return dtos
+}
+
+type UploadProductImageRequest struct {
+	UserID    int
+	ProductID int `uri:"productID"`
+	File      []byte
+}
+
+func (req UploadProductImageRequest) Validate() error {
+	const op cerrors.Op = "domain/UploadProductImageRequest.Validate"
+
+	if req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+	if len(req.File) == 0 {
+		return cerrors.E(op, cerrors.Invalid, "이미지 파일을 확인해주세요.")
+	}
+	if len(req.File) > MaxProductImageFileSize {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("이미지는 %dMB까지 올릴 수 있습니다.", MaxProductImageFileSize>>20))
+	}
+
+	return nil
+}
+
+type UploadProductImageResponse struct {
+	Image ProductImageDTO `json:"image"`
+}
+
+// PatchProductImagesRequest
+// imageIDs 를 보내면 상품의 이미지 ID 를 보여줄 순서대로 빠짐없이 보내야 한다. primaryImageID 를 보내면 대표 이미지를 바꾼다.
+type PatchProductImagesRequest struct {
+	UserID         int   `json:"-" swaggerignore:"true"`
+	ProductID      int   `json:"-" uri:"productID" swaggerignore:"true"`
+	ImageIDs       []int `json:"imageIDs" validate:"omitempty" example:"3,1,2"`
+	PrimaryImageID *int  `json:"primaryImageID" validate:"omitempty" example:"3"`
+}
+
+func (req PatchProductImagesRequest) Validate() error {
+	const op cerrors.Op = "domain/PatchProductImagesRequest.Validate"
+
+	if req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+	if req.ImageIDs == nil && req.PrimaryImageID == nil {
+		return cerrors.E(op, cerrors.Invalid, "이미지 순서나 대표 이미지를 보내주세요.")
+	}
+	if req.PrimaryImageID != nil && *req.PrimaryImageID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "대표 이미지를 확인해주세요.")
+	}
+
+	seen := make(map[int]bool, len(req.ImageIDs))
+	for _, imageID := range req.ImageIDs {
+		if imageID <= 0 || seen[imageID] {
+			return cerrors.E(op, cerrors.Invalid, "이미지 순서를 확인해주세요.")
+		}
+		seen[imageID] = true
+	}
+
+	return nil
+}
+
+type PatchProductImagesResponse struct {
+	Images []ProductImageDTO `json:"images"`
+}
+
+type DeleteProductImageRequest struct {
+	UserID    int
+	ProductID int `uri:"productID"`
+	ImageID   int `uri:"imageID"`
+}
+
+func (req DeleteProductImageRequest) Validate() error {
+	const op cerrors.Op = "domain/DeleteProductImageRequest.Validate"
+
+	if req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+	if req.ImageID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "이미지 ID를 확인해주세요.")
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestPatchProductImagesRequest_Validate(t *testing.T) {
+	primaryImageID := 1
+	invalidImageID := 0
+
+	tests := []struct {
+		name    string
+		input   PatchProductImagesRequest
+		wantErr bool
+	}{
+		{name: "PASS - 순서 변경", input: PatchProductImagesRequest{ProductID: 1, ImageIDs: []int{2, 1}}, wantErr: false},
+		{name: "PASS - 대표 이미지 변경", input: PatchProductImagesRequest{ProductID: 1, PrimaryImageID: &primaryImageID}, wantErr: false},
+		{name: "FAIL - 상품 ID 없음", input: PatchProductImagesRequest{ImageIDs: []int{1}}, wantErr: true},
+		{name: "FAIL - 바꿀 내용 없음", input: PatchProductImagesRequest{ProductID: 1}, wantErr: true},
+		{name: "FAIL - 중복된 이미지 ID", input: PatchProductImagesRequest{ProductID: 1, ImageIDs: []int{1, 1}}, wantErr: true},
+		{name: "FAIL - 잘못된 이미지 ID", input: PatchProductImagesRequest{ProductID: 1, ImageIDs: []int{0}}, wantErr: true},
+		{name: "FAIL - 잘못된 대표 이미지 ID", input: PatchProductImagesRequest{ProductID: 1, PrimaryImageID: &invalidImageID}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		err := test.input.Validate()
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %t, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
+
+func TestUploadProductImageRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   UploadProductImageRequest
+		wantErr bool
+	}{
+		{name: "PASS - 이미지", input: UploadProductImageRequest{ProductID: 1, File: []byte("image")}, wantErr: false},
+		{name: "FAIL - 빈 파일", input: UploadProductImageRequest{ProductID: 1}, wantErr: true},
+		{name: "FAIL - 너무 큰 파일", input: UploadProductImageRequest{ProductID: 1, File: make([]byte, MaxProductImageFileSize+1)}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		err := test.input.Validate()
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %t, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
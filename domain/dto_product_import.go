@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"fmt"
+	"path/filepath"
+	cerrors "payhere/pkg/cerrors"
+	"strings"
+)
+
+const (
+	MaxProductImportFileSize = 5 << 20
+	MaxProductImportRows     = 1000
+)
+
+type ProductImportFormat string
+
+const (
+	ProductImportFormatCSV  ProductImportFormat = "csv"
+	ProductImportFormatXLSX ProductImportFormat = "xlsx"
+)
+
+// ProductImportColumns
+// 가져오기 파일의 첫 행에 쓰는 열 이름. CreateProductRequest 의 json 이름과 같고 순서는 상관없다.
+// optionGroups 는 CreateProductRequest 의 optionGroups 와 같은 JSON 을 그대로 적는다.
+var ProductImportColumns = []string{
+	"categoryID", "price", "cost", "name", "description", "barcode", "internalBarcode",
+	"expiryDate", "reorderPoint", "reorderQuantity", "optionGroups",
+}
+
+// RequiredProductImportColumns
+// 파일에 반드시 있어야 하는 열. 나머지 열은 없으면 기본값을 쓴다.
+var RequiredProductImportColumns = []string{"categoryID", "price", "cost", "name", "description", "barcode", "expiryDate"}
+
+// ImportProductsRequest
+// 파일 형식은 파일 이름의 확장자(.csv, .xlsx)로 정한다. dryRun 이면 검사만 하고 상품은 만들지 않는다.
+type ImportProductsRequest struct {
+	UserID   int
+	DryRun   bool `form:"dryRun"`
+	FileName string
+	File     []byte
+}
+
+func (req ImportProductsRequest) Validate() error {
+	const op cerrors.Op = "domain/ImportProductsRequest.Validate"
+
+	switch req.Format() {
+	case ProductImportFormatCSV, ProductImportFormatXLSX:
+	default:
+		return cerrors.E(op, cerrors.Invalid, "csv 또는 xlsx 파일만 가져올 수 있습니다.")
+	}
+
+	if len(req.File) == 0 {
+		return cerrors.E(op, cerrors.Invalid, "파일을 확인해주세요.")
+	}
+	if len(req.File) > MaxProductImportFileSize {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("파일은 %dMB까지 가져올 수 있습니다.", MaxProductImportFileSize>>20))
+	}
+
+	return nil
+}
+
+func (req ImportProductsRequest) Format() ProductImportFormat {
+	return ProductImportFormat(strings.ToLower(strings.TrimPrefix(filepath.Ext(req.FileName), ".")))
+}
+
+type ImportProductRowStatus string
+
+const (
+	ImportProductRowStatusAccepted ImportProductRowStatus = "accepted"
+	ImportProductRowStatusFailed   ImportProductRowStatus = "failed"
+)
+
+// ImportProductRowDTO
+// row 는 파일의 행 번호로 열 이름 행이 1행이다. productID 는 상품을 만든 경우에만 채운다.
+type ImportProductRowDTO struct {
+	Row       int                    `json:"row" validate:"required" example:"2"`
+	Name      string                 `json:"name" example:"슈크림 라떼"`
+	Barcode   string                 `json:"barcode" example:"8801234567893"`
+	Status    ImportProductRowStatus `json:"status" validate:"required" enum:"accepted,failed" example:"accepted"`
+	ProductID *int                   `json:"productID" example:"1"`
+	Error     string                 `json:"error,omitempty" example:"바코드를 확인해주세요."`
+}
+
+type ImportProductsResponse struct {
+	DryRun   bool                  `json:"dryRun" validate:"required" example:"false"`
+	Total    int                   `json:"total" validate:"required" example:"300"`
+	Accepted int                   `json:"accepted" validate:"required" example:"298"`
+	Failed   int                   `json:"failed" validate:"required" example:"2"`
+	Rows     []ImportProductRowDTO `json:"rows"`
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"fmt"
+	cerrors "payhere/pkg/cerrors"
+)
+
+const (
+	DefaultLabelTemplate = "a4-3x8"
+	MaxLabelProductIDs   = 200
+	MaxLabelCopies       = 100
+	MaxLabelsPerRequest  = 1000
+)
+
+// LabelTemplate
+// 라벨지 한 장의 배치. 길이는 모두 mm 단위이다.
+type LabelTemplate struct {
+	Name        string
+	PageWidth   float64
+	PageHeight  float64
+	Columns     int
+	Rows        int
+	LabelWidth  float64
+	LabelHeight float64
+	MarginTop   float64
+	MarginLeft  float64
+	GapX        float64
+	GapY        float64
+}
+
+func (t LabelTemplate) LabelsPerPage() int {
+	return t.Columns * t.Rows
+}
+
+// LabelTemplates
+// 시중에서 많이 쓰는 A4 라벨지 규격
+var LabelTemplates = map[string]LabelTemplate{
+	"a4-2x7": {
+		Name: "a4-2x7", PageWidth: 210, PageHeight: 297, Columns: 2, Rows: 7,
+		LabelWidth: 99.1, LabelHeight: 38.1, MarginTop: 15.15, MarginLeft: 4.65, GapX: 2.5,
+	},
+	"a4-3x8": {
+		Name: "a4-3x8", PageWidth: 210, PageHeight: 297, Columns: 3, Rows: 8,
+		LabelWidth: 70, LabelHeight: 37, MarginTop: 0.5,
+	},
+	"a4-4x10": {
+		Name: "a4-4x10", PageWidth: 210, PageHeight: 297, Columns: 4, Rows: 10,
+		LabelWidth: 52.5, LabelHeight: 29.7,
+	},
+}
+
+// ProductLabelFilter
+// 상품 목록 조회와 같은 조건으로 라벨을 출력할 상품을 고른다.
+type ProductLabelFilter struct {
+	Search     *string  `json:"search" validate:"omitempty" example:"라떼"`
+	CategoryID *int     `json:"categoryID" validate:"omitempty" example:"1"`
+	TagIDs     []int    `json:"tagIDs" validate:"omitempty"`
+	TagMatch   TagMatch `json:"tagMatch" validate:"omitempty" enum:"any,all" example:"any"`
+}
+
+// CreateProductLabelsRequest
+// productIDs 와 filter 중 하나만 보낸다. productIDs 를 보내면 보낸 순서대로 라벨을 출력한다.
+type CreateProductLabelsRequest struct {
+	UserID     int                 `swaggerignore:"true"`
+	ProductIDs []int               `json:"productIDs" validate:"omitempty"`
+	Filter     *ProductLabelFilter `json:"filter" validate:"omitempty"`
+	Template   string              `json:"template" validate:"omitempty" enum:"a4-2x7,a4-3x8,a4-4x10" example:"a4-3x8"`
+	Copies     int                 `json:"copies" validate:"omitempty" example:"1"`
+}
+
+func (req CreateProductLabelsRequest) Validate() error {
+	const op cerrors.Op = "domain/CreateProductLabelsRequest.Validate"
+
+	if (len(req.ProductIDs) == 0) == (req.Filter == nil) {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID 목록과 검색 조건 중 하나만 입력해주세요.")
+	}
+	if len(req.ProductIDs) > MaxLabelProductIDs {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("상품은 한 번에 %d개까지 출력할 수 있습니다.", MaxLabelProductIDs))
+	}
+	for _, id := range req.ProductIDs {
+		if id <= 0 {
+			return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+		}
+	}
+	if req.Filter != nil && req.Filter.CategoryID != nil && *req.Filter.CategoryID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "카테고리를 확인해주세요.")
+	}
+	if req.Filter != nil {
+		if err := validateTagFilter(op, req.Filter.TagIDs, req.Filter.TagMatch); err != nil {
+			return err
+		}
+	}
+	if req.Template != "" {
+		if _, ok := LabelTemplates[req.Template]; !ok {
+			return cerrors.E(op, cerrors.Invalid, "라벨지 규격을 확인해주세요.")
+		}
+	}
+	if req.Copies < 0 || req.Copies > MaxLabelCopies {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("상품별 라벨 수는 1 ~ %d 사이로 입력해주세요.", MaxLabelCopies))
+	}
+
+	return nil
+}
+
+func (req CreateProductLabelsRequest) TemplateOrDefault() LabelTemplate {
+	if template, ok := LabelTemplates[req.Template]; ok {
+		return template
+	}
+	return LabelTemplates[DefaultLabelTemplate]
+}
+
+func (req CreateProductLabelsRequest) CopiesOrDefault() int {
+	if req.Copies == 0 {
+		return 1
+	}
+	return req.Copies
+}
@@ -0,0 +1,204 @@
+package domain
+
+import (
+	"fmt"
+	cerrors "payhere/pkg/cerrors"
+	"strings"
+)
+
+const (
+	MaxProductOptionGroups   = 10
+	MaxProductOptionsInGroup = 30
+)
+
+type ProductOptionGroupDTO struct {
+	ID         int                     `json:"id" validate:"required" example:"1"`
+	Name       string                  `json:"name" validate:"required" example:"사이즈"`
+	SelectType ProductOptionSelectType `json:"selectType" validate:"required" enum:"single,multi" example:"single"`
+	Required   bool                    `json:"required" validate:"required" example:"true"`
+	MinSelect  int                     `json:"minSelect" validate:"required" example:"1"`
+	MaxSelect  int                     `json:"maxSelect" validate:"required" example:"1"`
+	Options    []ProductOptionDTO      `json:"options"`
+}
+
+type ProductOptionDTO struct {
+	ID         int     `json:"id" validate:"required" example:"1"`
+	Name       string  `json:"name" validate:"required" example:"벤티"`
+	PriceDelta float64 `json:"priceDelta" validate:"required" example:"1000"`
+	CostDelta  float64 `json:"costDelta" validate:"required" example:"300"`
+}
+
+func ProductOptionGroupDTOsFrom(groups []ProductOptionGroup) []ProductOptionGroupDTO {
+	dtos := make([]ProductOptionGroupDTO, 0, len(groups))
+	for _, group := range groups {
+		dto := ProductOptionGroupDTO{
+			ID:         group.ID,
+			Name:       group.Name,
+			SelectType: group.SelectType,
+			Required:   group.Required,
+			MinSelect:  group.MinSelect,
+			MaxSelect:  group.MaxSelect,
+			Options:    make([]ProductOptionDTO, 0, len(group.Options)),
+		}
+		for _, option := range group.Options {
+			dto.Options = append(dto.Options, ProductOptionDTO{
+				ID:         option.ID,
+				Name:       option.Name,
+				PriceDelta: option.PriceDelta,
+				CostDelta:  option.CostDelta,
+			})
+		}
+		dtos = append(dtos, dto)
+	}
+
+	return dtos
+}
+
+// ProductOptionGroupRequest
+// 단일 선택 그룹은 minSelect, maxSelect 를 보내지 않아도 필수 여부에 맞춰 정해진다.
+// 다중 선택 그룹의 maxSelect 를 보내지 않으면 옵션 개수만큼 고를 수 있다.
+type ProductOptionGroupRequest struct {
+	Name       string                  `json:"name" validate:"required" example:"사이즈"`
+	SelectType ProductOptionSelectType `json:"selectType" validate:"required" enum:"single,multi" example:"single"`
+	Required   bool                    `json:"required" validate:"omitempty" example:"true"`
+	MinSelect  int                     `json:"minSelect" validate:"omitempty" example:"1"`
+	MaxSelect  int                     `json:"maxSelect" validate:"omitempty" example:"1"`
+	Options    []ProductOptionRequest  `json:"options" validate:"required"`
+}
+
+type ProductOptionRequest struct {
+	Name       string  `json:"name" validate:"required" example:"벤티"`
+	PriceDelta float64 `json:"priceDelta" validate:"omitempty" example:"1000"`
+	CostDelta  float64 `json:"costDelta" validate:"omitempty" example:"300"`
+}
+
+func (req ProductOptionGroupRequest) Validate() error {
+	const op cerrors.Op = "domain/ProductOptionGroupRequest.Validate"
+
+	if strings.TrimSpace(req.Name) == "" {
+		return cerrors.E(op, cerrors.Invalid, "옵션 그룹명을 확인해주세요.")
+	}
+	if req.SelectType != ProductOptionSelectTypeSingle && req.SelectType != ProductOptionSelectTypeMulti {
+		return cerrors.E(op, cerrors.Invalid, "옵션 선택 방식을 확인해주세요.")
+	}
+	if len(req.Options) == 0 || len(req.Options) > MaxProductOptionsInGroup {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("옵션 그룹에는 1 ~ %d개의 옵션이 필요합니다.", MaxProductOptionsInGroup))
+	}
+
+	names := make(map[string]struct{})
+	for _, option := range req.Options {
+		name := strings.TrimSpace(option.Name)
+		if name == "" {
+			return cerrors.E(op, cerrors.Invalid, "옵션명을 확인해주세요.")
+		}
+		if _, ok := names[name]; ok {
+			return cerrors.E(op, cerrors.Invalid, "같은 옵션 그룹에 같은 이름의 옵션이 있습니다.")
+		}
+		names[name] = struct{}{}
+	}
+
+	if req.SelectType == ProductOptionSelectTypeMulti {
+		group := req.ToDomain(0)
+		if req.MinSelect < 0 || req.MaxSelect < 0 || group.MinSelect > group.MaxSelect || group.MaxSelect > len(req.Options) {
+			return cerrors.E(op, cerrors.Invalid, "옵션 선택 개수를 확인해주세요.")
+		}
+	}
+
+	return nil
+}
+
+// ToDomain
+// 선택 개수를 선택 방식과 필수 여부에 맞게 채운 옵션 그룹으로 변환한다.
+func (req ProductOptionGroupRequest) ToDomain(displayOrder int) ProductOptionGroup {
+	group := ProductOptionGroup{
+		Name:         strings.TrimSpace(req.Name),
+		SelectType:   req.SelectType,
+		Required:     req.Required,
+		MinSelect:    req.MinSelect,
+		MaxSelect:    req.MaxSelect,
+		DisplayOrder: displayOrder,
+	}
+
+	switch req.SelectType {
+	case ProductOptionSelectTypeSingle:
+		group.MinSelect, group.MaxSelect = 0, 1
+		if req.Required {
+			group.MinSelect = 1
+		}
+	case ProductOptionSelectTypeMulti:
+		if req.Required && group.MinSelect == 0 {
+			group.MinSelect = 1
+		}
+		if group.MaxSelect == 0 {
+			group.MaxSelect = len(req.Options)
+		}
+	}
+	// 최소 선택 개수가 있으면 필수 그룹이다.
+	group.Required = group.MinSelect > 0
+
+	for i, option := range req.Options {
+		group.Options = append(group.Options, ProductOption{
+			Name:         strings.TrimSpace(option.Name),
+			PriceDelta:   option.PriceDelta,
+			CostDelta:    option.CostDelta,
+			DisplayOrder: i,
+		})
+	}
+
+	return group
+}
+
+func validateProductOptionGroups(groups []ProductOptionGroupRequest) error {
+	const op cerrors.Op = "domain/validateProductOptionGroups"
+
+	if len(groups) > MaxProductOptionGroups {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("옵션 그룹은 %d개까지 등록할 수 있습니다.", MaxProductOptionGroups))
+	}
+
+	names := make(map[string]struct{})
+	for _, group := range groups {
+		if err := group.Validate(); err != nil {
+			return err
+		}
+		name := strings.TrimSpace(group.Name)
+		if _, ok := names[name]; ok {
+			return cerrors.E(op, cerrors.Invalid, "같은 이름의 옵션 그룹이 있습니다.")
+		}
+		names[name] = struct{}{}
+	}
+
+	return nil
+}
+
+func ProductOptionGroupsFrom(groups []ProductOptionGroupRequest) []ProductOptionGroup {
+	var result []ProductOptionGroup
+	for i, group := range groups {
+		result = append(result, group.ToDomain(i))
+	}
+	return result
+}
+
+// ProductOptionGroupRequestsFrom
+// 옵션 그룹을 다시 생성 요청으로 보낼 수 있는 형태로 변환한다. 상품을 내보낼 때 사용한다.
+func ProductOptionGroupRequestsFrom(groups []ProductOptionGroup) []ProductOptionGroupRequest {
+	requests := make([]ProductOptionGroupRequest, 0, len(groups))
+	for _, group := range groups {
+		request := ProductOptionGroupRequest{
+			Name:       group.Name,
+			SelectType: group.SelectType,
+			Required:   group.Required,
+			MinSelect:  group.MinSelect,
+			MaxSelect:  group.MaxSelect,
+			Options:    make([]ProductOptionRequest, 0, len(group.Options)),
+		}
+		for _, option := range group.Options {
+			request.Options = append(request.Options, ProductOptionRequest{
+				Name:       option.Name,
+				PriceDelta: option.PriceDelta,
+				CostDelta:  option.CostDelta,
+			})
+		}
+		requests = append(requests, request)
+	}
+	return requests
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestProductOptionGroupRequest_Validate(t *testing.T) {
+	options := []ProductOptionRequest{{Name: "바닐라 시럽"}, {Name: "헤이즐넛 시럽"}}
+
+	tests := []struct {
+		name    string
+		input   ProductOptionGroupRequest
+		wantErr bool
+	}{
+		{name: "PASS - 단일 선택 그룹", input: ProductOptionGroupRequest{Name: "사이즈", SelectType: ProductOptionSelectTypeSingle, Required: true, Options: options}, wantErr: false},
+		{name: "PASS - 최대 선택 개수가 없는 다중 선택 그룹", input: ProductOptionGroupRequest{Name: "시럽", SelectType: ProductOptionSelectTypeMulti, Options: options}, wantErr: false},
+		{name: "FAIL - 비어있는 그룹명", input: ProductOptionGroupRequest{Name: " ", SelectType: ProductOptionSelectTypeSingle, Options: options}, wantErr: true},
+		{name: "FAIL - 잘못된 선택 방식", input: ProductOptionGroupRequest{Name: "사이즈", SelectType: "payhere", Options: options}, wantErr: true},
+		{name: "FAIL - 옵션이 없는 그룹", input: ProductOptionGroupRequest{Name: "사이즈", SelectType: ProductOptionSelectTypeSingle}, wantErr: true},
+		{name: "FAIL - 중복된 옵션명", input: ProductOptionGroupRequest{Name: "사이즈", SelectType: ProductOptionSelectTypeSingle, Options: []ProductOptionRequest{{Name: "벤티"}, {Name: "벤티 "}}}, wantErr: true},
+		{name: "FAIL - 옵션 개수보다 많은 최대 선택 개수", input: ProductOptionGroupRequest{Name: "시럽", SelectType: ProductOptionSelectTypeMulti, MaxSelect: 3, Options: options}, wantErr: true},
+		{name: "FAIL - 최대보다 큰 최소 선택 개수", input: ProductOptionGroupRequest{Name: "시럽", SelectType: ProductOptionSelectTypeMulti, MinSelect: 2, MaxSelect: 1, Options: options}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		err := test.input.Validate()
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %t, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
+
+func TestProductOptionGroupRequest_ToDomain(t *testing.T) {
+	options := []ProductOptionRequest{{Name: "바닐라 시럽"}, {Name: "헤이즐넛 시럽"}}
+
+	tests := []struct {
+		name          string
+		input         ProductOptionGroupRequest
+		wantRequired  bool
+		wantMinSelect int
+		wantMaxSelect int
+	}{
+		{name: "필수 단일 선택", input: ProductOptionGroupRequest{SelectType: ProductOptionSelectTypeSingle, Required: true, MaxSelect: 2, Options: options}, wantRequired: true, wantMinSelect: 1, wantMaxSelect: 1},
+		{name: "선택 단일 선택", input: ProductOptionGroupRequest{SelectType: ProductOptionSelectTypeSingle, Options: options}, wantRequired: false, wantMinSelect: 0, wantMaxSelect: 1},
+		{name: "필수 다중 선택", input: ProductOptionGroupRequest{SelectType: ProductOptionSelectTypeMulti, Required: true, Options: options}, wantRequired: true, wantMinSelect: 1, wantMaxSelect: 2},
+		{name: "최소 선택 개수가 있는 다중 선택", input: ProductOptionGroupRequest{SelectType: ProductOptionSelectTypeMulti, MinSelect: 1, MaxSelect: 1, Options: options}, wantRequired: true, wantMinSelect: 1, wantMaxSelect: 1},
+	}
+
+	for _, test := range tests {
+		got := test.input.ToDomain(0)
+		if got.Required != test.wantRequired || got.MinSelect != test.wantMinSelect || got.MaxSelect != test.wantMaxSelect {
+			t.Errorf("%s: expected (%t, %d, %d), but got (%t, %d, %d)", test.name,
+				test.wantRequired, test.wantMinSelect, test.wantMaxSelect,
+				got.Required, got.MinSelect, got.MaxSelect)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+// GetProductPriceHistoryRequest
+// from 과 to 는 매장 시간대 기준 날짜이고 to 도 포함한다. 보내지 않으면 오늘까지 최근 30일을 조회한다.
+type GetProductPriceHistoryRequest struct {
+	UserID    int     `swaggerignore:"true"`
+	ProductID int     `uri:"productID"`
+	From      *string `form:"from"`
+	To        *string `form:"to"`
+}
+
+func (req GetProductPriceHistoryRequest) Validate() error {
+	const op cerrors.Op = "domain/GetProductPriceHistoryRequest.Validate"
+
+	if req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+
+	return validateReportDates(req.From, req.To)
+}
+
+// DateRange
+// 매장 시간대 loc 기준의 조회 기간을 [since, until) 로 반환한다. until 은 to 다음 날 0시이다.
+func (req GetProductPriceHistoryRequest) DateRange(now time.Time, loc *time.Location) (time.Time, time.Time, error) {
+	return reportDateRange(req.From, req.To, now, loc)
+}
+
+// PriceChangeDTO
+// actorID 가 없으면 사장님이 아니라 서버 작업(유통기한 임박 할인 등)이 바꾼 것이다.
+// 예약한 가격(scheduled)은 서버 작업이 적용하지만 actorID 는 가격을 예약한 사장님이다.
+type PriceChangeDTO struct {
+	ID                int               `json:"id" validate:"required" example:"1"`
+	Reason            PriceChangeReason `json:"reason" validate:"required" enum:"manual,markdown,markdown_end,scheduled" example:"manual"`
+	ActorID           *int              `json:"actorID" example:"1"`
+	OldPrice          float64           `json:"oldPrice" validate:"required" example:"4500"`
+	NewPrice          float64           `json:"newPrice" validate:"required" example:"5000"`
+	OldCost           float64           `json:"oldCost" validate:"required" example:"1500"`
+	NewCost           float64           `json:"newCost" validate:"required" example:"1800"`
+	OldEffectivePrice float64           `json:"oldEffectivePrice" validate:"required" example:"4500"`
+	NewEffectivePrice float64           `json:"newEffectivePrice" validate:"required" example:"5000"`
+	CreateDate        time.Time         `json:"createDate" validate:"required" example:"2024-02-28T09:00:00Z"`
+}
+
+func PriceChangeDTOFrom(history PriceHistory) PriceChangeDTO {
+	return PriceChangeDTO{
+		ID:                history.ID,
+		Reason:            history.Reason,
+		ActorID:           history.UserID,
+		OldPrice:          history.OldPrice,
+		NewPrice:          history.NewPrice,
+		OldCost:           history.OldCost,
+		NewCost:           history.NewCost,
+		OldEffectivePrice: history.OldEffectivePrice,
+		NewEffectivePrice: history.NewEffectivePrice,
+		CreateDate:        history.CreateDate,
+	}
+}
+
+// PricePointDTO
+// 매장 시간대 기준 날짜가 끝날 때(오늘은 지금)의 정가, 원가, 실제 판매가.
+type PricePointDTO struct {
+	Date           string  `json:"date" validate:"required" example:"2024-02-28"`
+	Price          float64 `json:"price" validate:"required" example:"5000"`
+	Cost           float64 `json:"cost" validate:"required" example:"1800"`
+	EffectivePrice float64 `json:"effectivePrice" validate:"required" example:"4000"`
+}
+
+// GetProductPriceHistoryResponse
+// changes 는 기간 안의 변경을 오래된 순서로, series 는 기간의 날짜마다 하나씩 담는다. 오지 않은 날짜는 담지 않는다.
+type GetProductPriceHistoryResponse struct {
+	From     string           `json:"from" validate:"required" example:"2024-02-01"`
+	To       string           `json:"to" validate:"required" example:"2024-02-29"`
+	TimeZone string           `json:"timeZone" validate:"required" example:"Asia/Seoul"`
+	Changes  []PriceChangeDTO `json:"changes"`
+	Series   []PricePointDTO  `json:"series"`
+}
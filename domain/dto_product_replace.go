@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	cerrors "payhere/pkg/cerrors"
+)
+
+const MaxProductPatchSize = 1 << 20
+
+// ReplaceProductRequest
+// 상품 전체 수정 요청. 유통기한은 로트로만 바뀌므로 받지 않는다. 보내지 않은 값은 비우므로 reorderPoint 가 없으면 재고 부족 알림을 끄고, optionGroups 와 tags 가 없으면 옵션과 태그를 모두 지운다.
+type ReplaceProductRequest struct {
+	UserID      int     `json:"-" swaggerignore:"true"`
+	ID          int     `json:"-" uri:"productID" swaggerignore:"true"`
+	CategoryID  int     `json:"categoryID" validate:"required" example:"1"`
+	Price       float64 `json:"price" validate:"required" example:"1000"`
+	Cost        float64 `json:"cost" validate:"required" example:"500"`
+	Name        string  `json:"name" validate:"required" example:"슈크림 라떼"`
+	Description string  `json:"description" validate:"required" example:"슈크림 라떼 팔아요"`
+	Barcode     string  `json:"barcode" validate:"required" example:"8801234567893"`
+	// 매장 자체 바코드는 EAN/UPC 체크 디지트 검사를 하지 않는다.
+	InternalBarcode bool                        `json:"internalBarcode" validate:"omitempty" example:"false"`
+	ReorderPoint    *int                        `json:"reorderPoint" validate:"omitempty" example:"5"`
+	ReorderQuantity int                         `json:"reorderQuantity" validate:"omitempty" example:"20"`
+	OptionGroups    []ProductOptionGroupRequest `json:"optionGroups" validate:"omitempty"`
+	Tags            []string                    `json:"tags" validate:"omitempty" example:"신메뉴,비건"`
+	// If-Match 헤더로 받은 버전. nil 이 아니면 현재 버전과 같을 때만 수정한다.
+	Version *int `json:"-" swaggerignore:"true"`
+}
+
+// ReplaceProductRequestFrom
+// 상품을 전체 수정 요청으로 바꾼다. patch 를 적용할 문서로 쓰므로 옵션 그룹과 태그도 채운 상품을 넘긴다.
+// 바코드가 EAN/UPC 가 아니면 매장 자체 바코드로 본다.
+func ReplaceProductRequestFrom(product Product) ReplaceProductRequest {
+	return ReplaceProductRequest{
+		UserID:          product.UserID,
+		ID:              product.ID,
+		CategoryID:      product.CategoryID,
+		Price:           product.Price,
+		Cost:            product.Cost,
+		Name:            product.Name,
+		Description:     product.Description,
+		Barcode:         product.Barcode,
+		InternalBarcode: !IsValidGTIN(product.Barcode),
+		ReorderPoint:    product.ReorderPoint,
+		ReorderQuantity: product.ReorderQuantity,
+		OptionGroups:    ProductOptionGroupRequestsFrom(product.OptionGroups),
+		Tags:            TagNamesFrom(product.Tags),
+	}
+}
+
+// Validate
+// 유통기한을 뺀 상품 생성과 같은 검사를 한다.
+func (req ReplaceProductRequest) Validate() error {
+	const op cerrors.Op = "domain/ReplaceProductRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+
+	return CreateProductRequest{
+		UserID:          req.UserID,
+		CategoryID:      req.CategoryID,
+		Price:           req.Price,
+		Cost:            req.Cost,
+		Name:            req.Name,
+		Description:     req.Description,
+		Barcode:         req.Barcode,
+		InternalBarcode: req.InternalBarcode,
+		ReorderPoint:    req.ReorderPoint,
+		ReorderQuantity: req.ReorderQuantity,
+		OptionGroups:    req.OptionGroups,
+		Tags:            req.Tags,
+	}.validateFields(op)
+}
+
+// PatchRequest
+// 모든 값을 채운 부분 수정 요청으로 바꿔 부분 수정과 같은 경로(카테고리, 바코드 검사와 가격 변경 기록)로 저장한다.
+func (req ReplaceProductRequest) PatchRequest() PatchProductRequest {
+	optionGroups := req.OptionGroups
+	if optionGroups == nil {
+		optionGroups = []ProductOptionGroupRequest{}
+	}
+	tags := req.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return PatchProductRequest{
+		UserID:            req.UserID,
+		ID:                req.ID,
+		CategoryID:        &req.CategoryID,
+		Price:             &req.Price,
+		Cost:              &req.Cost,
+		Name:              &req.Name,
+		Description:       &req.Description,
+		Barcode:           &req.Barcode,
+		InternalBarcode:   req.InternalBarcode,
+		ReorderPoint:      req.ReorderPoint,
+		ClearReorderPoint: req.ReorderPoint == nil,
+		ReorderQuantity:   &req.ReorderQuantity,
+		OptionGroups:      &optionGroups,
+		Tags:              &tags,
+		Version:           req.Version,
+	}
+}
+
+// ApplyProductPatchRequest
+// contentType 에 따라 patch 를 JSON Merge Patch(RFC 7396) 또는 JSON Patch(RFC 6902) 로 읽는다.
+// patch 는 ReplaceProductRequest 의 json 형태에 적용한다.
+type ApplyProductPatchRequest struct {
+	UserID      int
+	ID          int `uri:"productID"`
+	ContentType string
+	Patch       []byte
+	// If-Match 헤더로 받은 버전. nil 이 아니면 현재 버전과 같을 때만 수정한다.
+	Version *int
+}
+
+func (req ApplyProductPatchRequest) Validate() error {
+	const op cerrors.Op = "domain/ApplyProductPatchRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+
+	if req.ContentType != MergePatchContentType && req.ContentType != JSONPatchContentType {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("Content-Type 은 %s 또는 %s 이어야 합니다.", MergePatchContentType, JSONPatchContentType))
+	}
+
+	if len(bytes.TrimSpace(req.Patch)) == 0 {
+		return cerrors.E(op, cerrors.Invalid, "수정할 내용을 확인해주세요.")
+	}
+	if len(req.Patch) > MaxProductPatchSize {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("수정할 내용은 %dMB까지 보낼 수 있습니다.", MaxProductPatchSize>>20))
+	}
+
+	return nil
+}
+
+// Apply
+// 현재 상품(current)에 patch 를 적용한 전체 수정 요청을 만들고 상품 생성과 같은 검사를 다시 한다.
+// 전체 수정 요청에 없는 값(stockQuantity 등)을 바꾸려 하면 에러를 반환한다.
+func (req ApplyProductPatchRequest) Apply(current ReplaceProductRequest) (ReplaceProductRequest, error) {
+	const op cerrors.Op = "domain/ApplyProductPatchRequest.Apply"
+
+	encoded, err := json.Marshal(current)
+	if err != nil {
+		return ReplaceProductRequest{}, cerrors.E(op, cerrors.Internal, err, "상품을 변환하는 중에 에러가 발생했습니다.")
+	}
+	var document any
+	if err := json.Unmarshal(encoded, &document); err != nil {
+		return ReplaceProductRequest{}, cerrors.E(op, cerrors.Internal, err, "상품을 변환하는 중에 에러가 발생했습니다.")
+	}
+
+	switch req.ContentType {
+	case MergePatchContentType:
+		var patch map[string]any
+		if err := json.Unmarshal(req.Patch, &patch); err != nil || patch == nil {
+			return ReplaceProductRequest{}, cerrors.E(op, cerrors.Invalid, "merge patch 는 JSON 객체로 보내주세요.")
+		}
+		document = mergePatch(document, patch)
+	case JSONPatchContentType:
+		var operations []JSONPatchOperation
+		if err := json.Unmarshal(req.Patch, &operations); err != nil {
+			return ReplaceProductRequest{}, cerrors.E(op, cerrors.Invalid, err, "JSON patch 는 작업의 배열로 보내주세요.")
+		}
+		document, err = applyJSONPatch(document, operations)
+		if err != nil {
+			return ReplaceProductRequest{}, err
+		}
+	default:
+		return ReplaceProductRequest{}, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("Content-Type 은 %s 또는 %s 이어야 합니다.", MergePatchContentType, JSONPatchContentType))
+	}
+
+	patched, err := json.Marshal(document)
+	if err != nil {
+		return ReplaceProductRequest{}, cerrors.E(op, cerrors.Internal, err, "상품을 변환하는 중에 에러가 발생했습니다.")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+	var replaced ReplaceProductRequest
+	if err := decoder.Decode(&replaced); err != nil {
+		return ReplaceProductRequest{}, cerrors.E(op, cerrors.Invalid, err, "수정할 수 없는 항목이 있거나 값의 형식이 맞지 않습니다.")
+	}
+	replaced.UserID = req.UserID
+	replaced.ID = req.ID
+	replaced.Version = req.Version
+
+	if err := replaced.Validate(); err != nil {
+		return ReplaceProductRequest{}, err
+	}
+
+	return replaced, nil
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	cerrors "payhere/pkg/cerrors"
+	"testing"
+	"time"
+)
+
+func TestApplyProductPatchRequest_Apply(t *testing.T) {
+	reorderPoint := 5
+	current := ReplaceProductRequestFrom(Product{
+		Base:            Base{ID: 100},
+		UserID:          1,
+		CategoryID:      1,
+		Price:           1000,
+		Cost:            500,
+		Name:            "슈크림 라떼",
+		Description:     "description",
+		Barcode:         "8801234567893",
+		ExpiryDate:      time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+		ReorderPoint:    &reorderPoint,
+		ReorderQuantity: 20,
+	})
+
+	tests := []struct {
+		name        string
+		contentType string
+		patch       string
+		check       func(got ReplaceProductRequest) bool
+		wantErr     cerrors.Kind
+	}{
+		{
+			name:        "PASS - merge patch 로 가격을 바꾸고 재고 알림을 끈다",
+			contentType: MergePatchContentType,
+			patch:       `{"price":2000,"reorderPoint":null}`,
+			check: func(got ReplaceProductRequest) bool {
+				return got.Price == 2000 && got.ReorderPoint == nil && got.Name == "슈크림 라떼" && got.ID == 100
+			},
+		},
+		{
+			name:        "PASS - JSON patch 로 상품명을 바꾼다",
+			contentType: JSONPatchContentType,
+			patch:       `[{"op":"test","path":"/name","value":"슈크림 라떼"},{"op":"replace","path":"/name","value":"바닐라 라떼"}]`,
+			check: func(got ReplaceProductRequest) bool {
+				return got.Name == "바닐라 라떼" && got.Price == 1000 && *got.ReorderPoint == 5
+			},
+		},
+		{
+			name:        "FAIL - 결과가 상품 검사를 통과하지 못함",
+			contentType: MergePatchContentType,
+			patch:       `{"description":null}`,
+			wantErr:     cerrors.Invalid,
+		},
+		{
+			name:        "FAIL - 전체 수정 요청에 없는 값",
+			contentType: MergePatchContentType,
+			patch:       `{"stockQuantity":100}`,
+			wantErr:     cerrors.Invalid,
+		},
+		{
+			name:        "FAIL - 유통기한은 로트로만 바뀜",
+			contentType: JSONPatchContentType,
+			patch:       `[{"op":"add","path":"/expiryDate","value":"2025-07-01T00:00:00Z"}]`,
+			wantErr:     cerrors.Invalid,
+		},
+		{
+			name:        "FAIL - 형식이 맞지 않는 값",
+			contentType: MergePatchContentType,
+			patch:       `{"price":"천원"}`,
+			wantErr:     cerrors.Invalid,
+		},
+		{
+			name:        "FAIL - 객체가 아닌 merge patch",
+			contentType: MergePatchContentType,
+			patch:       `[1]`,
+			wantErr:     cerrors.Invalid,
+		},
+		{
+			name:        "FAIL - test 실패",
+			contentType: JSONPatchContentType,
+			patch:       `[{"op":"test","path":"/price","value":999}]`,
+			wantErr:     cerrors.PreconditionFailed,
+		},
+	}
+
+	for _, test := range tests {
+		req := ApplyProductPatchRequest{UserID: 1, ID: 100, ContentType: test.contentType, Patch: []byte(test.patch)}
+		got, err := req.Apply(current)
+		if test.wantErr != cerrors.Other {
+			if !cerrors.Is(test.wantErr, err) {
+				t.Errorf("%s: expected %s error, but got %v", test.name, test.wantErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if !test.check(got) {
+			t.Errorf("%s: unexpected result %+v", test.name, got)
+		}
+	}
+}
+
+func TestApplyProductPatchRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     ApplyProductPatchRequest
+		wantErr bool
+	}{
+		{name: "PASS - merge patch", req: ApplyProductPatchRequest{ID: 1, ContentType: MergePatchContentType, Patch: []byte(`{}`)}},
+		{name: "PASS - JSON patch", req: ApplyProductPatchRequest{ID: 1, ContentType: JSONPatchContentType, Patch: []byte(`[]`)}},
+		{name: "FAIL - 지원하지 않는 Content-Type", req: ApplyProductPatchRequest{ID: 1, ContentType: "application/json", Patch: []byte(`{}`)}, wantErr: true},
+		{name: "FAIL - 빈 본문", req: ApplyProductPatchRequest{ID: 1, ContentType: MergePatchContentType, Patch: []byte(" ")}, wantErr: true},
+		{name: "FAIL - 상품 ID", req: ApplyProductPatchRequest{ContentType: MergePatchContentType, Patch: []byte(`{}`)}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		if err := test.req.Validate(); (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
+
+func TestReplaceProductRequest_PatchRequest(t *testing.T) {
+	got := ReplaceProductRequest{ID: 1, Name: "라떼"}.PatchRequest()
+
+	if got.ReorderPoint != nil || !got.ClearReorderPoint {
+		t.Errorf("expected reorder point to be cleared, but got %v, %v", got.ReorderPoint, got.ClearReorderPoint)
+	}
+	if got.OptionGroups == nil || len(*got.OptionGroups) != 0 {
+		t.Errorf("expected empty option groups, but got %v", got.OptionGroups)
+	}
+	if got.Name == nil || *got.Name != "라떼" {
+		t.Errorf("expected name 라떼, but got %v", got.Name)
+	}
+}
@@ -0,0 +1,106 @@
+package domain
+
+import (
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+type ScheduledPriceChangeDTO struct {
+	ID            int       `json:"id" validate:"required" example:"1"`
+	ProductID     int       `json:"productID" validate:"required" example:"1"`
+	ProductName   string    `json:"productName" validate:"required" example:"슈크림 라떼"`
+	Price         *float64  `json:"price" example:"5000"`
+	Cost          *float64  `json:"cost" example:"1800"`
+	EffectiveDate time.Time `json:"effectiveDate" validate:"required" example:"2024-03-01T00:00:00+09:00"`
+	ActorID       int       `json:"actorID" validate:"required" example:"1"`
+	CreateDate    time.Time `json:"createDate" validate:"required" example:"2024-02-28T09:00:00Z"`
+}
+
+func ScheduledPriceChangeDTOFrom(change ScheduledPriceChange) ScheduledPriceChangeDTO {
+	return ScheduledPriceChangeDTO{
+		ID:            change.ID,
+		ProductID:     change.ProductID,
+		ProductName:   change.ProductName,
+		Price:         change.Price,
+		Cost:          change.Cost,
+		EffectiveDate: change.EffectiveDate,
+		ActorID:       change.UserID,
+		CreateDate:    change.CreateDate,
+	}
+}
+
+// CreateScheduledPriceChangeRequest
+// price 와 cost 중 바꿀 값만 보낸다. effectiveDate 는 지금 이후여야 한다.
+type CreateScheduledPriceChangeRequest struct {
+	UserID        int       `json:"-" swaggerignore:"true"`
+	ProductID     int       `json:"-" uri:"productID" swaggerignore:"true"`
+	Price         *float64  `json:"price" validate:"omitempty" example:"5000"`
+	Cost          *float64  `json:"cost" validate:"omitempty" example:"1800"`
+	EffectiveDate time.Time `json:"effectiveDate" validate:"required" example:"2024-03-01T00:00:00+09:00"`
+}
+
+func (req CreateScheduledPriceChangeRequest) Validate() error {
+	const op cerrors.Op = "domain/CreateScheduledPriceChangeRequest.Validate"
+
+	if req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+
+	if req.Price == nil && req.Cost == nil {
+		return cerrors.E(op, cerrors.Invalid, "바꿀 가격이나 원가를 입력해주세요.")
+	}
+
+	if req.Price != nil && *req.Price < 0 {
+		return cerrors.E(op, cerrors.Invalid, "가격을 확인해주세요.")
+	}
+
+	if req.Cost != nil && *req.Cost < 0 {
+		return cerrors.E(op, cerrors.Invalid, "원가를 확인해주세요.")
+	}
+
+	if req.EffectiveDate.IsZero() {
+		return cerrors.E(op, cerrors.Invalid, "가격을 바꿀 시각을 확인해주세요.")
+	}
+
+	return nil
+}
+
+type CreateScheduledPriceChangeResponse struct {
+	ScheduledPrice ScheduledPriceChangeDTO `json:"scheduledPrice"`
+}
+
+// ListScheduledPriceChangesRequest
+// productID 를 보내지 않으면 모든 상품의 예약을 조회한다.
+type ListScheduledPriceChangesRequest struct {
+	UserID    int  `swaggerignore:"true"`
+	ProductID *int `form:"productID"`
+}
+
+func (req ListScheduledPriceChangesRequest) Validate() error {
+	const op cerrors.Op = "domain/ListScheduledPriceChangesRequest.Validate"
+
+	if req.ProductID != nil && *req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type ListScheduledPriceChangesResponse struct {
+	ScheduledPrices []ScheduledPriceChangeDTO `json:"scheduledPrices"`
+}
+
+type CancelScheduledPriceChangeRequest struct {
+	UserID int `swaggerignore:"true"`
+	ID     int `uri:"scheduleID"`
+}
+
+func (req CancelScheduledPriceChangeRequest) Validate() error {
+	const op cerrors.Op = "domain/CancelScheduledPriceChangeRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "가격 예약 ID를 확인해주세요.")
+	}
+
+	return nil
+}
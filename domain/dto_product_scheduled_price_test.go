@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestCreateScheduledPriceChangeRequest_Validate(t *testing.T) {
+	price, cost, negative := float64(5000), float64(1800), float64(-1)
+	effectiveDate := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		req     CreateScheduledPriceChangeRequest
+		wantErr bool
+	}{
+		{name: "PASS - 가격만 예약", req: CreateScheduledPriceChangeRequest{ProductID: 1, Price: &price, EffectiveDate: effectiveDate}},
+		{name: "PASS - 원가만 예약", req: CreateScheduledPriceChangeRequest{ProductID: 1, Cost: &cost, EffectiveDate: effectiveDate}},
+		{name: "FAIL - 바꿀 값이 없음", req: CreateScheduledPriceChangeRequest{ProductID: 1, EffectiveDate: effectiveDate}, wantErr: true},
+		{name: "FAIL - 음수 가격", req: CreateScheduledPriceChangeRequest{ProductID: 1, Price: &negative, EffectiveDate: effectiveDate}, wantErr: true},
+		{name: "FAIL - 음수 원가", req: CreateScheduledPriceChangeRequest{ProductID: 1, Cost: &negative, EffectiveDate: effectiveDate}, wantErr: true},
+		{name: "FAIL - 시각 없음", req: CreateScheduledPriceChangeRequest{ProductID: 1, Price: &price}, wantErr: true},
+		{name: "FAIL - 상품 ID", req: CreateScheduledPriceChangeRequest{Price: &price, EffectiveDate: effectiveDate}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		if err := test.req.Validate(); (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
+
+func TestScheduledPriceChange_Apply(t *testing.T) {
+	price := float64(5000)
+	product := Product{Base: Base{ID: 1}, Price: 4500, Cost: 1500}
+
+	got := ScheduledPriceChange{ProductID: 1, Price: &price}.Apply(product)
+
+	if got.Price != 5000 || got.Cost != 1500 {
+		t.Errorf("expected price 5000 and cost 1500, but got %v and %v", got.Price, got.Cost)
+	}
+	if product.Price != 4500 {
+		t.Errorf("expected original product to be unchanged, but got %v", product.Price)
+	}
+}
+
+func TestScheduledPriceChange_Apply_Markdown(t *testing.T) {
+	price, cost, markdownPrice := float64(5000), float64(1800), float64(3150)
+	product := Product{Base: Base{ID: 1}, Price: 4500, Cost: 1500, MarkdownPrice: &markdownPrice}
+
+	if got := (ScheduledPriceChange{ProductID: 1, Price: &price}).Apply(product); got.MarkdownPrice != nil || got.EffectivePrice() != 5000 {
+		t.Errorf("expected markdown to be cleared, but got %v", got.MarkdownPrice)
+	}
+	if got := (ScheduledPriceChange{ProductID: 1, Cost: &cost}).Apply(product); got.MarkdownPrice == nil || *got.MarkdownPrice != 3150 {
+		t.Errorf("expected markdown to be kept when only cost changes, but got %v", got.MarkdownPrice)
+	}
+}
+
+func TestScheduledPriceChange_Pending(t *testing.T) {
+	applied := sql.NullTime{Time: time.Now(), Valid: true}
+
+	if !(ScheduledPriceChange{}).Pending() {
+		t.Errorf("expected new change to be pending")
+	}
+	if (ScheduledPriceChange{ApplyDate: applied}).Pending() {
+		t.Errorf("expected applied change not to be pending")
+	}
+	if (ScheduledPriceChange{CancelDate: applied}).Pending() {
+		t.Errorf("expected canceled change not to be pending")
+	}
+}
+
+func TestListScheduledPriceChangesParams_EqualProduct(t *testing.T) {
+	productID := 3
+
+	if got := (ListScheduledPriceChangesParams{UserID: 1}).EqualProduct(); got != "" {
+		t.Errorf("expected empty condition, but got %q", got)
+	}
+	if got := (ListScheduledPriceChangesParams{UserID: 1, ProductID: &productID}).EqualProduct(); got != "AND s.product_id = 3" {
+		t.Errorf("expected product condition, but got %q", got)
+	}
+}
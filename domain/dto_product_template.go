@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"fmt"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+	"unicode/utf8"
+)
+
+// DuplicateProductRequest
+// 보낸 값만 원본 상품과 다르게 복제한다. 바코드는 상품마다 달라야 하므로 반드시 보낸다.
+// 재고, 이미지, 가격 변경 기록은 복제하지 않는다.
+type DuplicateProductRequest struct {
+	UserID      int      `json:"-" swaggerignore:"true"`
+	ID          int      `json:"-" uri:"productID" swaggerignore:"true"`
+	CategoryID  *int     `json:"categoryID" validate:"omitempty" example:"1"`
+	Price       *float64 `json:"price" validate:"omitempty" example:"1500"`
+	Cost        *float64 `json:"cost" validate:"omitempty" example:"700"`
+	Name        *string  `json:"name" validate:"omitempty" example:"슈크림 라떼 라지"`
+	Description *string  `json:"description" validate:"omitempty" example:"슈크림 라떼 큰 사이즈"`
+	Barcode     string   `json:"barcode" validate:"required" example:"8801234567894"`
+	// 매장 자체 바코드는 EAN/UPC 체크 디지트 검사를 하지 않는다.
+	InternalBarcode bool       `json:"internalBarcode" validate:"omitempty" example:"false"`
+	ExpiryDate      *time.Time `json:"expiryDate" validate:"omitempty" example:"2024-02-28T15:04:05Z"`
+	ReorderPoint    *int       `json:"reorderPoint" validate:"omitempty" example:"5"`
+	// true 로 보내면 재고 부족 알림을 끈다. reorderPoint 와 함께 보낼 수 없다.
+	ClearReorderPoint bool                         `json:"clearReorderPoint" validate:"omitempty" example:"false"`
+	ReorderQuantity   *int                         `json:"reorderQuantity" validate:"omitempty" example:"20"`
+	OptionGroups      *[]ProductOptionGroupRequest `json:"optionGroups" validate:"omitempty"`
+	Tags              *[]string                    `json:"tags" validate:"omitempty" example:"신메뉴"`
+}
+
+// Validate
+// 바꾸는 값은 원본에 덮어쓴 뒤 상품 생성과 같은 검사를 한다.
+func (req DuplicateProductRequest) Validate() error {
+	const op cerrors.Op = "domain/DuplicateProductRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+
+	if !isValidBarcode(req.Barcode, req.InternalBarcode) {
+		return cerrors.E(op, cerrors.Invalid, "바코드를 확인해주세요.")
+	}
+
+	if req.ReorderPoint != nil && (*req.ReorderPoint < 0 || req.ClearReorderPoint) {
+		return cerrors.E(op, cerrors.Invalid, "재고 알림 기준 수량을 확인해주세요.")
+	}
+
+	return nil
+}
+
+// Apply
+// 원본 상품의 생성 요청(source)에 바꾸는 값을 덮어쓴다.
+func (req DuplicateProductRequest) Apply(source CreateProductRequest) CreateProductRequest {
+	source.UserID = req.UserID
+	source.Barcode = req.Barcode
+	source.InternalBarcode = req.InternalBarcode
+	if req.CategoryID != nil {
+		source.CategoryID = *req.CategoryID
+	}
+	if req.Price != nil {
+		source.Price = *req.Price
+	}
+	if req.Cost != nil {
+		source.Cost = *req.Cost
+	}
+	if req.Name != nil {
+		source.Name = *req.Name
+	}
+	if req.Description != nil {
+		source.Description = *req.Description
+	}
+	if req.ExpiryDate != nil {
+		source.ExpiryDate = *req.ExpiryDate
+	}
+	if req.ReorderPoint != nil {
+		source.ReorderPoint = req.ReorderPoint
+	}
+	if req.ClearReorderPoint {
+		source.ReorderPoint = nil
+	}
+	if req.ReorderQuantity != nil {
+		source.ReorderQuantity = *req.ReorderQuantity
+	}
+	if req.OptionGroups != nil {
+		source.OptionGroups = *req.OptionGroups
+	}
+	if req.Tags != nil {
+		source.Tags = *req.Tags
+	}
+
+	return source
+}
+
+type ProductTemplateDTO struct {
+	ID              int                         `json:"id" validate:"required" example:"1"`
+	Name            string                      `json:"name" validate:"required" example:"라떼 기본"`
+	CategoryID      int                         `json:"categoryID" validate:"required" example:"1"`
+	Price           float64                     `json:"price" validate:"required" example:"1000"`
+	Cost            float64                     `json:"cost" validate:"required" example:"500"`
+	ProductName     string                      `json:"productName" validate:"required" example:"슈크림 라떼"`
+	Description     string                      `json:"description" validate:"required" example:"슈크림 라떼 팔아요"`
+	ReorderPoint    *int                        `json:"reorderPoint" example:"5"`
+	ReorderQuantity int                         `json:"reorderQuantity" example:"20"`
+	OptionGroups    []ProductOptionGroupRequest `json:"optionGroups"`
+	CreateDate      time.Time                   `json:"createDate" validate:"required" example:"2024-02-28T09:00:00Z"`
+}
+
+func ProductTemplateDTOFrom(template ProductTemplate) ProductTemplateDTO {
+	return ProductTemplateDTO{
+		ID:              template.ID,
+		Name:            template.Name,
+		CategoryID:      template.CategoryID,
+		Price:           template.Price,
+		Cost:            template.Cost,
+		ProductName:     template.ProductName,
+		Description:     template.Description,
+		ReorderPoint:    template.ReorderPoint,
+		ReorderQuantity: template.ReorderQuantity,
+		OptionGroups:    template.OptionGroups,
+		CreateDate:      template.CreateDate,
+	}
+}
+
+// CreateProductTemplateRequest
+// name 을 보내지 않으면 상품명을 템플릿 이름으로 쓴다.
+type CreateProductTemplateRequest struct {
+	UserID    int    `json:"-" swaggerignore:"true"`
+	ProductID int    `json:"-" uri:"productID" swaggerignore:"true"`
+	Name      string `json:"name" validate:"omitempty" example:"라떼 기본"`
+}
+
+func (req CreateProductTemplateRequest) Validate() error {
+	const op cerrors.Op = "domain/CreateProductTemplateRequest.Validate"
+
+	if req.ProductID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+
+	if utf8.RuneCountInString(req.Name) > MaxProductTemplateNameLength {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("템플릿 이름은 %d자까지 쓸 수 있습니다.", MaxProductTemplateNameLength))
+	}
+
+	return nil
+}
+
+type CreateProductTemplateResponse struct {
+	Template ProductTemplateDTO `json:"template"`
+}
+
+type ListProductTemplatesRequest struct {
+	UserID int `swaggerignore:"true"`
+}
+
+type ListProductTemplatesResponse struct {
+	Templates []ProductTemplateDTO `json:"templates"`
+}
+
+type DeleteProductTemplateRequest struct {
+	UserID int `swaggerignore:"true"`
+	ID     int `uri:"templateID"`
+}
+
+func (req DeleteProductTemplateRequest) Validate() error {
+	const op cerrors.Op = "domain/DeleteProductTemplateRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "템플릿 ID를 확인해주세요.")
+	}
+
+	return nil
+}
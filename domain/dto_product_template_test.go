@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestProductTemplate_Apply(t *testing.T) {
+	reorderPoint := 5
+	template := ProductTemplate{
+		CategoryID:      1,
+		Price:           4500,
+		Cost:            1500,
+		ProductName:     "아메리카노",
+		Description:     "원두 2샷",
+		ReorderPoint:    &reorderPoint,
+		ReorderQuantity: 20,
+		OptionGroups:    []ProductOptionGroupRequest{{Name: "온도", SelectType: ProductOptionSelectTypeSingle, Options: []ProductOptionRequest{{Name: "HOT"}}}},
+	}
+	templateID := 1
+	expiryDate := time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		req  CreateProductRequest
+		want CreateProductRequest
+	}{
+		{
+			name: "PASS - 비어 있는 값을 템플릿으로 채운다",
+			req:  CreateProductRequest{UserID: 1, Barcode: "8801234567893", ExpiryDate: expiryDate, TemplateID: &templateID},
+			want: CreateProductRequest{
+				UserID:          1,
+				CategoryID:      1,
+				Price:           4500,
+				Cost:            1500,
+				Name:            "아메리카노",
+				Description:     "원두 2샷",
+				Barcode:         "8801234567893",
+				ExpiryDate:      expiryDate,
+				ReorderPoint:    &reorderPoint,
+				ReorderQuantity: 20,
+				OptionGroups:    template.OptionGroups,
+			},
+		},
+		{
+			name: "PASS - 보낸 값은 그대로 쓴다",
+			req: CreateProductRequest{
+				UserID:       1,
+				Price:        5000,
+				Name:         "아이스 아메리카노",
+				Barcode:      "8801234567893",
+				ExpiryDate:   expiryDate,
+				OptionGroups: []ProductOptionGroupRequest{},
+				TemplateID:   &templateID,
+				SentFields:   map[string]bool{"price": true, "name": true, "barcode": true, "expiryDate": true, "optionGroups": true},
+			},
+			want: CreateProductRequest{
+				UserID:          1,
+				CategoryID:      1,
+				Price:           5000,
+				Cost:            1500,
+				Name:            "아이스 아메리카노",
+				Description:     "원두 2샷",
+				Barcode:         "8801234567893",
+				ExpiryDate:      expiryDate,
+				ReorderPoint:    &reorderPoint,
+				ReorderQuantity: 20,
+				OptionGroups:    []ProductOptionGroupRequest{},
+			},
+		},
+		{
+			name: "PASS - 0 으로 보낸 가격과 발주 수량은 템플릿 값으로 채우지 않는다",
+			req: CreateProductRequest{
+				UserID:     1,
+				Barcode:    "8801234567893",
+				ExpiryDate: expiryDate,
+				TemplateID: &templateID,
+				SentFields: map[string]bool{"price": true, "reorderQuantity": true, "reorderPoint": true},
+			},
+			want: CreateProductRequest{
+				UserID:       1,
+				CategoryID:   1,
+				Cost:         1500,
+				Name:         "아메리카노",
+				Description:  "원두 2샷",
+				Barcode:      "8801234567893",
+				ExpiryDate:   expiryDate,
+				OptionGroups: template.OptionGroups,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got := template.Apply(test.req)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected %+v, but got %+v", test.name, test.want, got)
+		}
+		if err := got.Validate(); err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		}
+	}
+}
+
+func TestCreateProductRequest_ValidateWithTemplate(t *testing.T) {
+	valid, invalid := 1, 0
+
+	if err := (CreateProductRequest{TemplateID: &valid}).Validate(); err != nil {
+		t.Errorf("expected template request to be checked after applying template, but got %v", err)
+	}
+	if err := (CreateProductRequest{TemplateID: &invalid}).Validate(); err == nil {
+		t.Errorf("expected error for invalid template ID")
+	}
+}
+
+func TestDuplicateProductRequest_Apply(t *testing.T) {
+	reorderPoint := 5
+	source := CreateProductRequest{
+		UserID:          1,
+		CategoryID:      1,
+		Price:           1000,
+		Cost:            500,
+		Name:            "슈크림 라떼",
+		Description:     "description",
+		Barcode:         "8801234567893",
+		ExpiryDate:      time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+		ReorderPoint:    &reorderPoint,
+		ReorderQuantity: 20,
+	}
+	name, price := "슈크림 라떼 라지", float64(1500)
+
+	got := DuplicateProductRequest{
+		UserID:            1,
+		ID:                100,
+		Name:              &name,
+		Price:             &price,
+		Barcode:           "LATTE-L",
+		ClearReorderPoint: true,
+	}.Apply(source)
+
+	want := source
+	want.Name = name
+	want.Price = price
+	want.Barcode = "LATTE-L"
+	want.ReorderPoint = nil
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, but got %+v", want, got)
+	}
+}
+
+func TestDuplicateProductRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     DuplicateProductRequest
+		wantErr bool
+	}{
+		{name: "PASS - 바코드만 보냄", req: DuplicateProductRequest{ID: 1, Barcode: "8801234567893"}},
+		{name: "PASS - 매장 자체 바코드", req: DuplicateProductRequest{ID: 1, Barcode: "LATTE-L", InternalBarcode: true}},
+		{name: "FAIL - 바코드 없음", req: DuplicateProductRequest{ID: 1}, wantErr: true},
+		{name: "FAIL - 체크 디지트가 틀린 바코드", req: DuplicateProductRequest{ID: 1, Barcode: "8801234567890"}, wantErr: true},
+		{name: "FAIL - 상품 ID", req: DuplicateProductRequest{Barcode: "8801234567893"}, wantErr: true},
+		{name: "FAIL - -1 은 재고 알림을 끄지 않음", req: DuplicateProductRequest{ID: 1, Barcode: "8801234567893", ReorderPoint: func() *int { v := -1; return &v }()}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		if err := test.req.Validate(); (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
+
+func TestCreateProductRequest_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want map[string]bool
+	}{
+		{name: "PASS - 템플릿으로 시작하면 보낸 항목을 기록", body: `{"templateID":1,"price":0,"reorderPoint":null}`, want: map[string]bool{"templateID": true, "price": true, "reorderPoint": true}},
+		{name: "PASS - 템플릿 없이 만들면 기록하지 않음", body: `{"price":0}`, want: nil},
+	}
+
+	for _, test := range tests {
+		var req CreateProductRequest
+		if err := json.Unmarshal([]byte(test.body), &req); err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(req.SentFields, test.want) {
+			t.Errorf("%s: expected %v, but got %v", test.name, test.want, req.SentFields)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	cerrors "payhere/pkg/cerrors"
+	"testing"
+)
+
+func TestProductVersionFromIfMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		want    int
+		wantErr cerrors.Kind
+	}{
+		{name: "PASS - 헤더가 없으면 버전을 확인하지 않는다", header: ""},
+		{name: "PASS - * 는 버전을 확인하지 않는다", header: "*"},
+		{name: "PASS - GetProduct 가 준 ETag", header: ProductETag(3), want: 3},
+		{name: "FAIL - 따옴표가 없는 ETag", header: "3", wantErr: cerrors.Invalid},
+		{name: "FAIL - 약한 ETag", header: `W/"3"`, wantErr: cerrors.Invalid},
+		{name: "FAIL - 숫자가 아닌 버전", header: `"abc"`, wantErr: cerrors.Invalid},
+		{name: "FAIL - 0 버전", header: `"0"`, wantErr: cerrors.Invalid},
+	}
+
+	for _, test := range tests {
+		got, err := ProductVersionFromIfMatch(test.header)
+		if test.wantErr != cerrors.Other {
+			if !cerrors.Is(test.wantErr, err) {
+				t.Errorf("%s: expected %s error, but got %v", test.name, test.wantErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if test.want == 0 && got != nil {
+			t.Errorf("%s: expected no version, but got %d", test.name, *got)
+		}
+		if test.want != 0 && (got == nil || *got != test.want) {
+			t.Errorf("%s: expected version %d, but got %v", test.name, test.want, got)
+		}
+	}
+}
+
+func TestPatchProductRequest_Validate_ReorderPoint(t *testing.T) {
+	reorderPoint, negative := 5, -1
+
+	tests := []struct {
+		name    string
+		req     PatchProductRequest
+		wantErr bool
+	}{
+		{name: "PASS - 재고 알림 기준 수량 수정", req: PatchProductRequest{ID: 1, ReorderPoint: &reorderPoint}},
+		{name: "PASS - 재고 알림 끄기", req: PatchProductRequest{ID: 1, ClearReorderPoint: true}},
+		{name: "FAIL - -1 은 재고 알림을 끄지 않음", req: PatchProductRequest{ID: 1, ReorderPoint: &negative}, wantErr: true},
+		{name: "FAIL - 기준 수량과 알림 끄기를 함께 보냄", req: PatchProductRequest{ID: 1, ReorderPoint: &reorderPoint, ClearReorderPoint: true}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		if err := test.req.Validate(); (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+// 휴지통 보관 일 수를 설정하지 않았을 때 쓰는 값
+const DefaultTrashRetentionDays = 30
+
+// TrashRetentionFrom
+// 설정한 보관 일 수를 기간으로 바꾼다. 0 이하면 기본값을 쓴다.
+func TrashRetentionFrom(days int) time.Duration {
+	if days <= 0 {
+		days = DefaultTrashRetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+type ListTrashProductsRequest struct {
+	UserID int  `swaggerignore:"true"`
+	Cursor *int `form:"cursor"`
+}
+
+// TrashProductDTO
+// purgeDate 가 지나면 상품이 휴지통에서 완전히 지워져 더 복원할 수 없다.
+type TrashProductDTO struct {
+	ProductDTO
+	DeleteDate time.Time `json:"deleteDate" validate:"required" example:"2024-02-28T15:04:05Z"`
+	PurgeDate  time.Time `json:"purgeDate" validate:"required" example:"2024-03-29T15:04:05Z"`
+}
+
+func TrashProductDTOFrom(product Product, retention time.Duration) TrashProductDTO {
+	return TrashProductDTO{
+		ProductDTO: ProductDTOFrom(product),
+		DeleteDate: product.DeleteDate.Time,
+		PurgeDate:  product.DeleteDate.Time.Add(retention),
+	}
+}
+
+type ListTrashProductsResponse struct {
+	Products []TrashProductDTO `json:"products"`
+	Cursor   *int              `json:"cursor"`
+}
+
+type RestoreProductRequest struct {
+	UserID int `swaggerignore:"true"`
+	ID     int `uri:"productID" json:"id" example:"1"`
+}
+
+func (req RestoreProductRequest) Validate() error {
+	const op cerrors.Op = "domain/RestoreProductRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type PurgeProductRequest struct {
+	UserID int `swaggerignore:"true"`
+	ID     int `uri:"productID" json:"id" example:"1"`
+}
+
+func (req PurgeProductRequest) Validate() error {
+	const op cerrors.Op = "domain/PurgeProductRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "상품 ID를 확인해주세요.")
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestTrashProductDTOFrom(t *testing.T) {
+	deleteDate := time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		retentionDays int
+		want          time.Time
+	}{
+		{name: "PASS - 설정한 보관 일 수", retentionDays: 7, want: time.Date(2024, time.March, 8, 9, 0, 0, 0, time.UTC)},
+		{name: "PASS - 설정하지 않으면 30일", retentionDays: 0, want: time.Date(2024, time.March, 31, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, test := range tests {
+		product := Product{Base: Base{ID: 1, DeleteDate: sql.NullTime{Time: deleteDate, Valid: true}}}
+		got := TrashProductDTOFrom(product, TrashRetentionFrom(test.retentionDays))
+		if !got.DeleteDate.Equal(deleteDate) || !got.PurgeDate.Equal(test.want) {
+			t.Errorf("%s: expected purge date %s, but got %s", test.name, test.want, got.PurgeDate)
+		}
+	}
+}
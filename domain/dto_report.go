@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"fmt"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+const (
+	ReportDateLayout  = "2006-01-02"
+	DefaultReportDays = 30
+	MaxReportDays     = 366
+)
+
+// GetWasteReportRequest
+// from 과 to 는 매장 시간대 기준 날짜이고 to 도 포함한다. 보내지 않으면 오늘까지 최근 30일을 조회한다.
+type GetWasteReportRequest struct {
+	UserID  int
+	From    *string        `form:"from"`
+	To      *string        `form:"to"`
+	GroupBy *ReportGroupBy `form:"groupBy"`
+}
+
+func (req GetWasteReportRequest) Validate() error {
+	const op cerrors.Op = "domain/GetWasteReportRequest.Validate"
+
+	if req.GroupBy != nil {
+		switch *req.GroupBy {
+		case ReportGroupByDay, ReportGroupByMonth:
+		default:
+			return cerrors.E(op, cerrors.Invalid, "groupBy 는 day, month 중 하나로 입력해주세요.")
+		}
+	}
+
+	return validateReportDates(req.From, req.To)
+}
+
+func (req GetWasteReportRequest) GroupByOrDefault() ReportGroupBy {
+	if req.GroupBy == nil {
+		return ReportGroupByDay
+	}
+	return *req.GroupBy
+}
+
+// DateRange
+// 매장 시간대 loc 기준의 조회 기간을 [since, until) 로 반환한다. until 은 to 다음 날 0시이다.
+func (req GetWasteReportRequest) DateRange(now time.Time, loc *time.Location) (time.Time, time.Time, error) {
+	return reportDateRange(req.From, req.To, now, loc)
+}
+
+func validateReportDates(from, to *string) error {
+	const op cerrors.Op = "domain/validateReportDates"
+
+	for _, date := range []*string{from, to} {
+		if date == nil {
+			continue
+		}
+		if _, err := time.Parse(ReportDateLayout, *date); err != nil {
+			return cerrors.E(op, cerrors.Invalid, err, "날짜는 2024-02-28 형식으로 입력해주세요.")
+		}
+	}
+
+	return nil
+}
+
+// reportDateRange
+// from, to 를 보내지 않으면 오늘까지 최근 DefaultReportDays 일이다.
+func reportDateRange(fromDate, toDate *string, now time.Time, loc *time.Location) (time.Time, time.Time, error) {
+	const op cerrors.Op = "domain/reportDateRange"
+
+	localNow := now.In(loc)
+	to := time.Date(localNow.Year(), localNow.Month(), localNow.Day(), 0, 0, 0, 0, loc)
+	if toDate != nil {
+		parsed, err := time.ParseInLocation(ReportDateLayout, *toDate, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, cerrors.E(op, cerrors.Invalid, err, "날짜는 2024-02-28 형식으로 입력해주세요.")
+		}
+		to = parsed
+	}
+
+	from := to.AddDate(0, 0, -(DefaultReportDays - 1))
+	if fromDate != nil {
+		parsed, err := time.ParseInLocation(ReportDateLayout, *fromDate, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, cerrors.E(op, cerrors.Invalid, err, "날짜는 2024-02-28 형식으로 입력해주세요.")
+		}
+		from = parsed
+	}
+
+	until := to.AddDate(0, 0, 1)
+	if !from.Before(until) {
+		return time.Time{}, time.Time{}, cerrors.E(op, cerrors.Invalid, "시작일은 종료일보다 늦을 수 없습니다.")
+	}
+	if from.AddDate(0, 0, MaxReportDays).Before(until) {
+		return time.Time{}, time.Time{}, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("조회 기간은 최대 %d일입니다.", MaxReportDays))
+	}
+
+	return from, until, nil
+}
+
+// ListWasteTotalsParams
+// Since 이상 Until 미만에 폐기한 기록을 OffsetSeconds 만큼 옮긴 시각의 PeriodFormat 으로 묶는다.
+type ListWasteTotalsParams struct {
+	UserID        int
+	Since         time.Time
+	Until         time.Time
+	OffsetSeconds int
+	PeriodFormat  string
+}
+
+type WasteSummaryDTO struct {
+	Quantity   int     `json:"quantity" validate:"required" example:"12"`
+	LossAmount float64 `json:"lossAmount" validate:"required" example:"18000"`
+}
+
+type WasteCategoryDTO struct {
+	CategoryID   int     `json:"categoryID" validate:"required" example:"1"`
+	CategoryName string  `json:"categoryName" validate:"required" example:"음료"`
+	Quantity     int     `json:"quantity" validate:"required" example:"12"`
+	LossAmount   float64 `json:"lossAmount" validate:"required" example:"18000"`
+}
+
+type WastePeriodDTO struct {
+	Period     string  `json:"period" validate:"required" example:"2024-02-28"`
+	Quantity   int     `json:"quantity" validate:"required" example:"12"`
+	LossAmount float64 `json:"lossAmount" validate:"required" example:"18000"`
+}
+
+type WasteReasonDTO struct {
+	Reason     DisposalReason `json:"reason" validate:"required" enum:"expired,damaged,other" example:"expired"`
+	Quantity   int            `json:"quantity" validate:"required" example:"12"`
+	LossAmount float64        `json:"lossAmount" validate:"required" example:"18000"`
+}
+
+// WasteItemDTO
+// 카테고리와 기간별 손실액.
+type WasteItemDTO struct {
+	CategoryID int     `json:"categoryID" validate:"required" example:"1"`
+	Period     string  `json:"period" validate:"required" example:"2024-02-28"`
+	Quantity   int     `json:"quantity" validate:"required" example:"12"`
+	LossAmount float64 `json:"lossAmount" validate:"required" example:"18000"`
+}
+
+type GetWasteReportResponse struct {
+	From       string             `json:"from" validate:"required" example:"2024-02-01"`
+	To         string             `json:"to" validate:"required" example:"2024-02-29"`
+	GroupBy    ReportGroupBy      `json:"groupBy" validate:"required" enum:"day,month" example:"day"`
+	TimeZone   string             `json:"timeZone" validate:"required" example:"Asia/Seoul"`
+	Total      WasteSummaryDTO    `json:"total"`
+	Categories []WasteCategoryDTO `json:"categories"`
+	Periods    []WastePeriodDTO   `json:"periods"`
+	Reasons    []WasteReasonDTO   `json:"reasons"`
+	Items      []WasteItemDTO     `json:"items"`
+}
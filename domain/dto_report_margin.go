@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"math"
+	cerrors "payhere/pkg/cerrors"
+)
+
+const (
+	// 마진 리포트의 상품 목록 한 페이지 크기
+	MarginReportPageSize = 100
+	// 목표 마진율에 못 미치는 상품은 마진율이 낮은 순서로 이만큼만 응답한다.
+	MarginReportBelowTargetLimit = 100
+)
+
+// GetMarginReportRequest
+// search, categoryID, tagID, tagMatch, cursor 는 상품 목록 조회와 같다. targetMargin 을 보내지 않으면 설정의 목표 마진율을 쓴다.
+type GetMarginReportRequest struct {
+	UserID       int      `swaggerignore:"true"`
+	Cursor       *int     `form:"cursor"`
+	Search       *string  `form:"search"`
+	CategoryID   *int     `form:"categoryID"`
+	TagIDs       []int    `form:"tagID"`
+	TagMatch     TagMatch `form:"tagMatch"`
+	TargetMargin *float64 `form:"targetMargin"`
+}
+
+func (req GetMarginReportRequest) Validate() error {
+	const op cerrors.Op = "domain/GetMarginReportRequest.Validate"
+
+	if req.TargetMargin != nil && (math.IsNaN(*req.TargetMargin) || *req.TargetMargin < 0 || *req.TargetMargin > 100) {
+		return cerrors.E(op, cerrors.Invalid, "목표 마진율은 0 ~ 100 사이로 입력해주세요.")
+	}
+
+	if err := validateTagFilter(op, req.TagIDs, req.TagMatch); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (req GetMarginReportRequest) ListProductsRequest() ListProductsRequest {
+	return ListProductsRequest{
+		UserID:     req.UserID,
+		Cursor:     req.Cursor,
+		Search:     req.Search,
+		CategoryID: req.CategoryID,
+		TagIDs:     req.TagIDs,
+		TagMatch:   req.TagMatch,
+	}
+}
+
+// ListMarginsParams
+// 정가가 0 이 아니면서 (정가 - 원가) / 정가 * 100 이 TargetMargin 보다 작거나, 정가가 0 인데 원가가 있으면 목표에 못 미치는 상품이다.
+type ListMarginsParams struct {
+	Products     ListProductsParams
+	TargetMargin float64
+	Limit        int
+}
+
+type MarginSummaryDTO struct {
+	ProductCount     int      `json:"productCount" validate:"required" example:"120"`
+	BelowTargetCount int      `json:"belowTargetCount" validate:"required" example:"8"`
+	TotalPrice       float64  `json:"totalPrice" validate:"required" example:"540000"`
+	TotalCost        float64  `json:"totalCost" validate:"required" example:"302000"`
+	TotalMargin      float64  `json:"totalMargin" validate:"required" example:"238000"`
+	MarginPercent    *float64 `json:"marginPercent" example:"44.07"`
+}
+
+type CategoryMarginDTO struct {
+	CategoryID       int      `json:"categoryID" validate:"required" example:"1"`
+	CategoryName     string   `json:"categoryName" validate:"required" example:"음료"`
+	ProductCount     int      `json:"productCount" validate:"required" example:"40"`
+	BelowTargetCount int      `json:"belowTargetCount" validate:"required" example:"3"`
+	TotalPrice       float64  `json:"totalPrice" validate:"required" example:"180000"`
+	TotalCost        float64  `json:"totalCost" validate:"required" example:"72000"`
+	TotalMargin      float64  `json:"totalMargin" validate:"required" example:"108000"`
+	MarginPercent    *float64 `json:"marginPercent" example:"60"`
+}
+
+func CategoryMarginDTOFrom(margin CategoryMargin) CategoryMarginDTO {
+	return CategoryMarginDTO{
+		CategoryID:       margin.CategoryID,
+		CategoryName:     margin.CategoryName,
+		ProductCount:     margin.ProductCount,
+		BelowTargetCount: margin.BelowTargetCount,
+		TotalPrice:       margin.TotalPrice,
+		TotalCost:        margin.TotalCost,
+		TotalMargin:      margin.TotalMargin,
+		MarginPercent:    margin.MarginPercent,
+	}
+}
+
+type ProductMarginDTO struct {
+	ProductID     int      `json:"productID" validate:"required" example:"1"`
+	CategoryID    int      `json:"categoryID" validate:"required" example:"1"`
+	CategoryName  string   `json:"categoryName" validate:"required" example:"음료"`
+	Name          string   `json:"name" validate:"required" example:"슈크림 라떼"`
+	Price         float64  `json:"price" validate:"required" example:"4500"`
+	Cost          float64  `json:"cost" validate:"required" example:"1800"`
+	Margin        float64  `json:"margin" validate:"required" example:"2700"`
+	MarginPercent *float64 `json:"marginPercent" example:"60"`
+	BelowTarget   bool     `json:"belowTarget" example:"false"`
+}
+
+func ProductMarginDTOFrom(margin ProductMargin, targetMargin float64) ProductMarginDTO {
+	return ProductMarginDTO{
+		ProductID:     margin.ProductID,
+		CategoryID:    margin.CategoryID,
+		CategoryName:  margin.CategoryName,
+		Name:          margin.Name,
+		Price:         margin.Price,
+		Cost:          margin.Cost,
+		Margin:        margin.Margin,
+		MarginPercent: margin.MarginPercent,
+		BelowTarget:   margin.Margin < margin.Price*targetMargin/100,
+	}
+}
+
+// GetMarginReportResponse
+// summary 와 categories 는 조건에 맞는 모든 상품을, products 는 cursor 다음의 한 페이지를 담는다.
+// belowTarget 은 목표 마진율에 못 미치는 상품을 마진율이 낮은 순서로 담는다.
+type GetMarginReportResponse struct {
+	TargetMargin float64             `json:"targetMargin" validate:"required" example:"30"`
+	Summary      MarginSummaryDTO    `json:"summary"`
+	Categories   []CategoryMarginDTO `json:"categories"`
+	Products     []ProductMarginDTO  `json:"products"`
+	BelowTarget  []ProductMarginDTO  `json:"belowTarget"`
+	Cursor       *int                `json:"cursor"`
+}
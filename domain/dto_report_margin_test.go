@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGetMarginReportRequest_Validate(t *testing.T) {
+	zero, full, negative, over, nan := 0.0, 100.0, -1.0, 100.5, math.NaN()
+
+	tests := []struct {
+		name    string
+		input   GetMarginReportRequest
+		wantErr bool
+	}{
+		{name: "PASS - 목표 마진율을 보내지 않음", input: GetMarginReportRequest{UserID: 1}},
+		{name: "PASS - 0%", input: GetMarginReportRequest{UserID: 1, TargetMargin: &zero}},
+		{name: "PASS - 100%", input: GetMarginReportRequest{UserID: 1, TargetMargin: &full}},
+		{name: "FAIL - 음수", input: GetMarginReportRequest{UserID: 1, TargetMargin: &negative}, wantErr: true},
+		{name: "FAIL - 100% 초과", input: GetMarginReportRequest{UserID: 1, TargetMargin: &over}, wantErr: true},
+		{name: "FAIL - NaN", input: GetMarginReportRequest{UserID: 1, TargetMargin: &nan}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		if err := test.input.Validate(); (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %t, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
+
+func TestProductMarginDTOFrom(t *testing.T) {
+	tests := []struct {
+		name  string
+		input ProductMargin
+		want  bool
+	}{
+		{name: "PASS - 목표 이상", input: ProductMargin{Price: 1000, Cost: 700, Margin: 300}, want: false},
+		{name: "PASS - 목표 미만", input: ProductMargin{Price: 1000, Cost: 701, Margin: 299}, want: true},
+		{name: "PASS - 정가 0 원가 있음", input: ProductMargin{Price: 0, Cost: 100, Margin: -100}, want: true},
+		{name: "PASS - 정가와 원가 모두 0", input: ProductMargin{}, want: false},
+	}
+
+	for _, test := range tests {
+		if got := ProductMarginDTOFrom(test.input, 30).BelowTarget; got != test.want {
+			t.Errorf("%s: expected belowTarget %t, but got %t", test.name, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetWasteReportRequest_DateRange(t *testing.T) {
+	seoul, _ := time.LoadLocation("Asia/Seoul")
+	// 서울 기준 2024-03-01 08:00
+	now := time.Date(2024, time.February, 29, 23, 0, 0, 0, time.UTC)
+	from, to, early, long := "2024-02-01", "2024-02-29", "2024-03-02", "2023-01-01"
+
+	tests := []struct {
+		name      string
+		input     GetWasteReportRequest
+		wantSince time.Time
+		wantUntil time.Time
+		wantErr   bool
+	}{
+		{
+			name:      "PASS - 기본값은 매장 시간대 기준 오늘까지 최근 30일",
+			input:     GetWasteReportRequest{},
+			wantSince: time.Date(2024, time.February, 1, 0, 0, 0, 0, seoul),
+			wantUntil: time.Date(2024, time.March, 2, 0, 0, 0, 0, seoul),
+		},
+		{
+			name:      "PASS - 종료일을 포함한다",
+			input:     GetWasteReportRequest{From: &from, To: &to},
+			wantSince: time.Date(2024, time.February, 1, 0, 0, 0, 0, seoul),
+			wantUntil: time.Date(2024, time.March, 1, 0, 0, 0, 0, seoul),
+		},
+		{name: "FAIL - 종료일보다 늦은 시작일", input: GetWasteReportRequest{From: &early, To: &to}, wantErr: true},
+		{name: "FAIL - 366일보다 긴 기간", input: GetWasteReportRequest{From: &long, To: &to}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		since, until, err := test.input.DateRange(now, seoul)
+		if (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %t, but got %v", test.name, test.wantErr, err)
+			continue
+		}
+		if test.wantErr {
+			continue
+		}
+		if !since.Equal(test.wantSince) || !until.Equal(test.wantUntil) {
+			t.Errorf("%s: expected [%s, %s), but got [%s, %s)", test.name, test.wantSince, test.wantUntil, since, until)
+		}
+	}
+}
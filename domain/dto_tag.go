@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"fmt"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+	"unicode/utf8"
+)
+
+type TagDTO struct {
+	ID           int       `json:"id" validate:"required" example:"1"`
+	Name         string    `json:"name" validate:"required" example:"신메뉴"`
+	ProductCount int       `json:"productCount" validate:"required" example:"3"`
+	CreateDate   time.Time `json:"createDate" validate:"required" example:"2024-02-28T09:00:00Z"`
+}
+
+func TagDTOFrom(tag Tag) TagDTO {
+	return TagDTO{
+		ID:           tag.ID,
+		Name:         tag.Name,
+		ProductCount: tag.ProductCount,
+		CreateDate:   tag.CreateDate,
+	}
+}
+
+type ProductTagDTO struct {
+	ID   int    `json:"id" validate:"required" example:"1"`
+	Name string `json:"name" validate:"required" example:"신메뉴"`
+}
+
+func ProductTagDTOsFrom(tags []Tag) []ProductTagDTO {
+	dtos := make([]ProductTagDTO, 0, len(tags))
+	for _, tag := range tags {
+		dtos = append(dtos, ProductTagDTO{
+			ID:   tag.ID,
+			Name: tag.Name,
+		})
+	}
+
+	return dtos
+}
+
+func validateTagName(op cerrors.Op, name string) error {
+	name = NormalizeTagName(name)
+	if name == "" {
+		return cerrors.E(op, cerrors.Invalid, "태그 이름을 확인해주세요.")
+	}
+	if utf8.RuneCountInString(name) > MaxTagNameLength {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("태그 이름은 %d자까지 쓸 수 있습니다.", MaxTagNameLength))
+	}
+
+	return nil
+}
+
+// validateTagNames
+// 상품에 붙이는 태그 이름을 확인한다. 겹치는 이름은 하나로 세어 개수를 확인한다.
+func validateTagNames(op cerrors.Op, names []string) error {
+	for _, name := range names {
+		if err := validateTagName(op, name); err != nil {
+			return err
+		}
+	}
+	if len(NormalizeTagNames(names)) > MaxProductTags {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("태그는 상품마다 %d개까지 붙일 수 있습니다.", MaxProductTags))
+	}
+
+	return nil
+}
+
+type CreateTagRequest struct {
+	UserID int    `json:"-" swaggerignore:"true"`
+	Name   string `json:"name" validate:"required" example:"신메뉴"`
+}
+
+func (req CreateTagRequest) Validate() error {
+	const op cerrors.Op = "domain/CreateTagRequest.Validate"
+
+	return validateTagName(op, req.Name)
+}
+
+type CreateTagResponse struct {
+	Tag TagDTO `json:"tag"`
+}
+
+// PatchTagRequest
+// 태그 이름을 바꾸면 태그를 붙인 상품에도 바뀐 이름이 보인다.
+type PatchTagRequest struct {
+	UserID int    `json:"-" swaggerignore:"true"`
+	ID     int    `json:"-" uri:"tagID" swaggerignore:"true"`
+	Name   string `json:"name" validate:"required" example:"시즌"`
+}
+
+func (req PatchTagRequest) Validate() error {
+	const op cerrors.Op = "domain/PatchTagRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "태그 ID를 확인해주세요.")
+	}
+
+	return validateTagName(op, req.Name)
+}
+
+type DeleteTagRequest struct {
+	UserID int `swaggerignore:"true"`
+	ID     int `uri:"tagID"`
+}
+
+func (req DeleteTagRequest) Validate() error {
+	const op cerrors.Op = "domain/DeleteTagRequest.Validate"
+
+	if req.ID <= 0 {
+		return cerrors.E(op, cerrors.Invalid, "태그 ID를 확인해주세요.")
+	}
+
+	return nil
+}
+
+type ListTagsRequest struct {
+	UserID int `swaggerignore:"true"`
+}
+
+type ListTagsResponse struct {
+	Tags []TagDTO `json:"tags"`
+}
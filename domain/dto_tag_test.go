@@ -0,0 +1,166 @@
+package domain
+
+import (
+	cerrors "payhere/pkg/cerrors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNormalizeTagNames(t *testing.T) {
+	tests := []struct {
+		name  string
+		names []string
+		want  []string
+	}{
+		{name: "PASS - 앞뒤 공백과 '#' 을 뺀다", names: []string{" #비건 ", "신메뉴"}, want: []string{"비건", "신메뉴"}},
+		{name: "PASS - 대소문자만 다른 이름은 처음 이름만 남긴다", names: []string{"Vegan", "#vegan", "VEGAN"}, want: []string{"Vegan"}},
+		{name: "PASS - 빈 목록", names: nil, want: []string{}},
+	}
+
+	for _, test := range tests {
+		got := NormalizeTagNames(test.names)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected %v, but got %v", test.name, test.want, got)
+		}
+	}
+}
+
+func TestListProductsParams_HasTags(t *testing.T) {
+	tests := []struct {
+		name   string
+		params ListProductsParams
+		want   string
+	}{
+		{name: "PASS - 태그를 고르지 않음", params: ListProductsParams{}, want: ""},
+		{
+			name:   "PASS - 하나라도 붙은 상품",
+			params: ListProductsParams{TagIDs: []int{1, 2, 1}},
+			want:   "AND p.id IN (SELECT pt.product_id FROM product_tags pt WHERE pt.tag_id IN (1, 2))",
+		},
+		{
+			name:   "PASS - 모두 붙은 상품",
+			params: ListProductsParams{TagIDs: []int{1, 2, 1}, TagMatch: TagMatchAll},
+			want:   "AND p.id IN (SELECT pt.product_id FROM product_tags pt WHERE pt.tag_id IN (1, 2) GROUP BY pt.product_id HAVING COUNT(*) = 2)",
+		},
+	}
+
+	for _, test := range tests {
+		if got := test.params.HasTags(); got != test.want {
+			t.Errorf("%s: expected %q, but got %q", test.name, test.want, got)
+		}
+	}
+}
+
+func TestListProductsRequest_Validate(t *testing.T) {
+	tooMany := make([]int, MaxListProductsTagIDs+1)
+	for i := range tooMany {
+		tooMany[i] = i + 1
+	}
+
+	tests := []struct {
+		name     string
+		req      ListProductsRequest
+		wantKind cerrors.Kind
+	}{
+		{name: "PASS - 태그 없이 조회", req: ListProductsRequest{}},
+		{name: "PASS - 모두 붙은 상품", req: ListProductsRequest{TagIDs: []int{1, 2}, TagMatch: TagMatchAll}},
+		{name: "FAIL - 태그 ID", req: ListProductsRequest{TagIDs: []int{0}}, wantKind: cerrors.Invalid},
+		{name: "FAIL - 태그를 너무 많이 고름", req: ListProductsRequest{TagIDs: tooMany}, wantKind: cerrors.Invalid},
+		{name: "FAIL - 태그 조건", req: ListProductsRequest{TagIDs: []int{1}, TagMatch: "some"}, wantKind: cerrors.Invalid},
+	}
+
+	for _, test := range tests {
+		err := test.req.Validate()
+		if test.wantKind != cerrors.Other {
+			if !cerrors.Is(test.wantKind, err) {
+				t.Errorf("%s: expected %s error, but got %v", test.name, test.wantKind, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		}
+	}
+}
+
+func TestCreateTagRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     CreateTagRequest
+		wantErr bool
+	}{
+		{name: "PASS - 태그 이름", req: CreateTagRequest{Name: "#신메뉴"}},
+		{name: "FAIL - '#' 만 보냄", req: CreateTagRequest{Name: " # "}, wantErr: true},
+		{name: "FAIL - 너무 긴 이름", req: CreateTagRequest{Name: strings.Repeat("가", MaxTagNameLength+1)}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		if err := test.req.Validate(); (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
+
+func TestCreateProductRequest_ValidateTags(t *testing.T) {
+	tooMany := make([]string, MaxProductTags+1)
+	for i := range tooMany {
+		tooMany[i] = strings.Repeat("가", i+1)
+	}
+	sameNames := make([]string, MaxProductTags+1)
+	for i := range sameNames {
+		sameNames[i] = "비건"
+	}
+
+	tests := []struct {
+		name    string
+		tags    []string
+		wantErr bool
+	}{
+		{name: "PASS - 겹치는 이름은 하나로 센다", tags: sameNames},
+		{name: "FAIL - 빈 태그 이름", tags: []string{"비건", ""}, wantErr: true},
+		{name: "FAIL - 태그가 너무 많음", tags: tooMany, wantErr: true},
+	}
+
+	for _, test := range tests {
+		req := CreateProductRequest{
+			CategoryID:  1,
+			Price:       1000,
+			Cost:        500,
+			Name:        "슈크림 라떼",
+			Description: "description",
+			Barcode:     "8801234567893",
+			ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+			Tags:        test.tags,
+		}
+		if err := req.Validate(); (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
+
+func TestValidateTagFilter(t *testing.T) {
+	tooMany := make([]int, MaxListProductsTagIDs+1)
+	for i := range tooMany {
+		tooMany[i] = i + 1
+	}
+
+	tests := []struct {
+		name    string
+		req     interface{ Validate() error }
+		wantErr bool
+	}{
+		{name: "PASS - 내보내기 태그 조건", req: ExportProductsRequest{TagIDs: []int{1, 2}, TagMatch: TagMatchAll}},
+		{name: "FAIL - 내보내기 태그 ID", req: ExportProductsRequest{TagIDs: []int{0}}, wantErr: true},
+		{name: "PASS - 마진 리포트 태그 조건", req: GetMarginReportRequest{TagIDs: []int{1}}},
+		{name: "FAIL - 마진 리포트 태그 개수", req: GetMarginReportRequest{TagIDs: tooMany}, wantErr: true},
+		{name: "FAIL - 라벨 태그 조건", req: CreateProductLabelsRequest{Filter: &ProductLabelFilter{TagIDs: []int{1}, TagMatch: "some"}}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		if err := test.req.Validate(); (err != nil) != test.wantErr {
+			t.Errorf("%s: expected error %v, but got %v", test.name, test.wantErr, err)
+		}
+	}
+}
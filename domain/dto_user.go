@@ -3,6 +3,7 @@ package domain
 import (
 	cerrors "payhere/pkg/cerrors"
 	"regexp"
+	"time"
 )
 
 var (
@@ -13,6 +14,8 @@ var (
 type CreateUserRequest struct {
 	MobileID string `json:"mobileID" validate:"required" example:"01012345678"`
 	Password string `json:"password" validate:"required" example:"1234"`
+	// 매장 시간대. 보내지 않으면 Asia/Seoul 로 저장한다.
+	TimeZone string `json:"timeZone" validate:"omitempty" example:"Asia/Seoul"`
 }
 
 func (ur CreateUserRequest) Validate() error {
@@ -26,9 +29,22 @@ func (ur CreateUserRequest) Validate() error {
 		return cerrors.E(op, cerrors.Invalid, "잘못된 비밀번호입니다.")
 	}
 
+	if ur.TimeZone != "" {
+		if _, err := time.LoadLocation(ur.TimeZone); err != nil {
+			return cerrors.E(op, cerrors.Invalid, "매장 시간대를 확인해주세요. (예: Asia/Seoul)")
+		}
+	}
+
 	return nil
 }
 
+func (ur CreateUserRequest) TimeZoneOrDefault() string {
+	if ur.TimeZone == "" {
+		return DefaultTimeZone
+	}
+	return ur.TimeZone
+}
+
 // IsValidPhoneNumber
 // 요구사항에 별도의 휴대폰번호 형식이 특정되어 있지 않아 하이픈이 있는 경우와 없는 경우를 모두 허용하도록 구현
 func isValidMobileID(userID string) bool {
@@ -77,3 +77,44 @@ func Test_isValidPassword(t *testing.T) {
 		}
 	}
 }
+
+func TestCreateUserRequest_Validate_TimeZone(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeZone string
+		wantErr  bool
+	}{
+		{name: "PASS - 시간대 없음", timeZone: "", wantErr: false},
+		{name: "PASS - IANA 시간대", timeZone: "America/New_York", wantErr: false},
+		{name: "FAIL - 잘못된 시간대", timeZone: "Seoul", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := CreateUserRequest{MobileID: "01012345678", Password: "payhere", TimeZone: tt.timeZone}
+			if err := req.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUser_Location(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeZone string
+		want     string
+	}{
+		{name: "PASS - 매장 시간대", timeZone: "America/New_York", want: "America/New_York"},
+		{name: "PASS - 시간대 없으면 한국 시간", timeZone: "", want: DefaultTimeZone},
+		{name: "PASS - 읽을 수 없는 시간대면 한국 시간", timeZone: "Seoul", want: DefaultTimeZone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (User{TimeZone: tt.timeZone}).Location().String(); got != tt.want {
+				t.Errorf("Location() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	cerrors "payhere/pkg/cerrors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	MergePatchContentType = "application/merge-patch+json"
+	JSONPatchContentType  = "application/json-patch+json"
+)
+
+type JSONPatchOperationType string
+
+const (
+	JSONPatchOperationTest    JSONPatchOperationType = "test"
+	JSONPatchOperationReplace JSONPatchOperationType = "replace"
+	JSONPatchOperationRemove  JSONPatchOperationType = "remove"
+)
+
+// JSONPatchOperation
+// RFC 6902 의 작업 중 test, replace, remove 만 지원한다. value 가 null 인 것과 없는 것을 구분하기 위해 그대로 받는다.
+type JSONPatchOperation struct {
+	Op    JSONPatchOperationType `json:"op" enum:"test,replace,remove" example:"replace"`
+	Path  string                 `json:"path" example:"/price"`
+	Value json.RawMessage        `json:"value,omitempty" swaggertype:"object"`
+}
+
+// mergePatch
+// RFC 7396 에 따라 patch 의 null 은 지우고, 객체는 재귀적으로 합치고, 그 외 값은 통째로 바꾼다.
+func mergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+
+	return targetObject
+}
+
+// applyJSONPatch
+// 작업을 순서대로 적용하고, 하나라도 실패하면 몇 번째 작업인지와 함께 에러를 반환한다.
+func applyJSONPatch(document any, operations []JSONPatchOperation) (any, error) {
+	const op cerrors.Op = "domain/applyJSONPatch"
+
+	for i, operation := range operations {
+		tokens, ok := parseJSONPointer(operation.Path)
+		if !ok || len(tokens) == 0 {
+			return nil, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("%d번째 작업의 path 를 확인해주세요.", i+1))
+		}
+
+		var value any
+		if operation.Op == JSONPatchOperationTest || operation.Op == JSONPatchOperationReplace {
+			if len(operation.Value) == 0 {
+				return nil, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("%d번째 작업의 value 를 확인해주세요.", i+1))
+			}
+			if err := json.Unmarshal(operation.Value, &value); err != nil {
+				return nil, cerrors.E(op, cerrors.Invalid, err, fmt.Sprintf("%d번째 작업의 value 를 확인해주세요.", i+1))
+			}
+		}
+
+		switch operation.Op {
+		case JSONPatchOperationTest:
+			current, ok := jsonPointerValue(document, tokens)
+			if !ok {
+				return nil, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("%d번째 작업의 path 를 확인해주세요.", i+1))
+			}
+			if !reflect.DeepEqual(current, value) {
+				return nil, cerrors.E(op, cerrors.PreconditionFailed, fmt.Sprintf("%d번째 test 작업의 값이 현재 상품과 다릅니다.", i+1))
+			}
+		case JSONPatchOperationReplace:
+			document, ok = updateJSONPointer(document, tokens, func(container any, token string) (any, bool) {
+				if _, ok := jsonChild(container, token); !ok {
+					return nil, false
+				}
+				return withJSONChild(container, token, value), true
+			})
+			if !ok {
+				return nil, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("%d번째 작업의 path 를 확인해주세요.", i+1))
+			}
+		case JSONPatchOperationRemove:
+			document, ok = updateJSONPointer(document, tokens, withoutJSONChild)
+			if !ok {
+				return nil, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("%d번째 작업의 path 를 확인해주세요.", i+1))
+			}
+		default:
+			return nil, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("%d번째 작업의 op 는 test, replace, remove 만 사용할 수 있습니다.", i+1))
+		}
+	}
+
+	return document, nil
+}
+
+// parseJSONPointer
+// RFC 6901 의 JSON Pointer 를 토큰으로 나눈다. 빈 문자열은 문서 전체를 가리킨다.
+func parseJSONPointer(pointer string) ([]string, bool) {
+	if pointer == "" {
+		return nil, true
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, false
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+
+	return tokens, true
+}
+
+func jsonPointerValue(document any, tokens []string) (any, bool) {
+	for _, token := range tokens {
+		child, ok := jsonChild(document, token)
+		if !ok {
+			return nil, false
+		}
+		document = child
+	}
+	return document, true
+}
+
+// updateJSONPointer
+// 마지막 토큰의 부모를 update 가 돌려준 값으로 바꾼 문서를 반환한다. 배열에서 지우면 부모도 바뀌므로 위로 올라가며 다시 넣는다.
+func updateJSONPointer(node any, tokens []string, update func(container any, token string) (any, bool)) (any, bool) {
+	if len(tokens) == 1 {
+		return update(node, tokens[0])
+	}
+
+	child, ok := jsonChild(node, tokens[0])
+	if !ok {
+		return nil, false
+	}
+	updated, ok := updateJSONPointer(child, tokens[1:], update)
+	if !ok {
+		return nil, false
+	}
+
+	return withJSONChild(node, tokens[0], updated), true
+}
+
+func jsonChild(node any, token string) (any, bool) {
+	switch node := node.(type) {
+	case map[string]any:
+		child, ok := node[token]
+		return child, ok
+	case []any:
+		index, ok := jsonArrayIndex(node, token)
+		if !ok {
+			return nil, false
+		}
+		return node[index], true
+	}
+	return nil, false
+}
+
+// withJSONChild
+// token 이 이미 있는 위치를 가리키는지는 부른 쪽에서 확인한다.
+func withJSONChild(node any, token string, value any) any {
+	switch node := node.(type) {
+	case map[string]any:
+		node[token] = value
+	case []any:
+		index, _ := jsonArrayIndex(node, token)
+		node[index] = value
+	}
+	return node
+}
+
+func withoutJSONChild(node any, token string) (any, bool) {
+	switch node := node.(type) {
+	case map[string]any:
+		if _, ok := node[token]; !ok {
+			return nil, false
+		}
+		delete(node, token)
+		return node, true
+	case []any:
+		index, ok := jsonArrayIndex(node, token)
+		if !ok {
+			return nil, false
+		}
+		return append(node[:index:index], node[index+1:]...), true
+	}
+	return nil, false
+}
+
+// jsonArrayIndex
+// 배열 위치는 0 으로 시작하지 않는 숫자만 허용한다. 배열 끝을 뜻하는 - 는 add 에서만 쓰므로 받지 않는다.
+func jsonArrayIndex(array []any, token string) (int, bool) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, false
+	}
+	for _, c := range token {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil || index >= len(array) {
+		return 0, false
+	}
+	return index, true
+}
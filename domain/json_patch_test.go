@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"encoding/json"
+	cerrors "payhere/pkg/cerrors"
+	"reflect"
+	"testing"
+)
+
+func decodeTestJSON(t *testing.T, s string) any {
+	t.Helper()
+
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		patch  string
+		want   string
+	}{
+		{name: "PASS - 값 바꾸기", target: `{"a":"b"}`, patch: `{"a":"c"}`, want: `{"a":"c"}`},
+		{name: "PASS - null 은 지운다", target: `{"a":"b","c":"d"}`, patch: `{"a":null}`, want: `{"c":"d"}`},
+		{name: "PASS - 객체는 재귀적으로 합친다", target: `{"a":{"b":"c","d":"e"}}`, patch: `{"a":{"d":null,"f":"g"}}`, want: `{"a":{"b":"c","f":"g"}}`},
+		{name: "PASS - 배열은 통째로 바꾼다", target: `{"a":[1,2]}`, patch: `{"a":[3]}`, want: `{"a":[3]}`},
+		{name: "PASS - 객체가 아닌 값은 객체로 바꾼다", target: `{"a":"b"}`, patch: `{"a":{"c":null,"d":1}}`, want: `{"a":{"d":1}}`},
+	}
+
+	for _, test := range tests {
+		got := mergePatch(decodeTestJSON(t, test.target), decodeTestJSON(t, test.patch))
+		if want := decodeTestJSON(t, test.want); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: expected %v, but got %v", test.name, want, got)
+		}
+	}
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	document := `{"price":1000,"reorderPoint":5,"optionGroups":[{"name":"온도","options":[{"name":"HOT"},{"name":"ICE"}]}],"a/b":1}`
+
+	tests := []struct {
+		name    string
+		patch   string
+		want    string
+		wantErr cerrors.Kind
+	}{
+		{
+			name:  "PASS - test 후 replace",
+			patch: `[{"op":"test","path":"/price","value":1000},{"op":"replace","path":"/price","value":2000}]`,
+			want:  `{"price":2000,"reorderPoint":5,"optionGroups":[{"name":"온도","options":[{"name":"HOT"},{"name":"ICE"}]}],"a/b":1}`,
+		},
+		{
+			name:  "PASS - 배열 안의 값 바꾸기와 지우기",
+			patch: `[{"op":"replace","path":"/optionGroups/0/options/1/name","value":"ICED"},{"op":"remove","path":"/optionGroups/0/options/0"}]`,
+			want:  `{"price":1000,"reorderPoint":5,"optionGroups":[{"name":"온도","options":[{"name":"ICED"}]}],"a/b":1}`,
+		},
+		{
+			name:  "PASS - 이스케이프한 path 와 remove",
+			patch: `[{"op":"remove","path":"/a~1b"},{"op":"remove","path":"/reorderPoint"}]`,
+			want:  `{"price":1000,"optionGroups":[{"name":"온도","options":[{"name":"HOT"},{"name":"ICE"}]}]}`,
+		},
+		{
+			name:    "FAIL - test 가 실패하면 PreconditionFailed",
+			patch:   `[{"op":"test","path":"/price","value":1500},{"op":"replace","path":"/price","value":2000}]`,
+			wantErr: cerrors.PreconditionFailed,
+		},
+		{
+			name:    "FAIL - 없는 값을 replace",
+			patch:   `[{"op":"replace","path":"/stockQuantity","value":10}]`,
+			wantErr: cerrors.Invalid,
+		},
+		{
+			name:    "FAIL - 0 으로 시작하는 배열 위치",
+			patch:   `[{"op":"remove","path":"/optionGroups/00"}]`,
+			wantErr: cerrors.Invalid,
+		},
+		{
+			name:    "FAIL - value 없는 replace",
+			patch:   `[{"op":"replace","path":"/price"}]`,
+			wantErr: cerrors.Invalid,
+		},
+		{
+			name:    "FAIL - 문서 전체를 바꾸는 path",
+			patch:   `[{"op":"replace","path":"","value":{}}]`,
+			wantErr: cerrors.Invalid,
+		},
+		{
+			name:    "FAIL - 지원하지 않는 op",
+			patch:   `[{"op":"add","path":"/name","value":"라떼"}]`,
+			wantErr: cerrors.Invalid,
+		},
+	}
+
+	for _, test := range tests {
+		var operations []JSONPatchOperation
+		if err := json.Unmarshal([]byte(test.patch), &operations); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := applyJSONPatch(decodeTestJSON(t, document), operations)
+		if test.wantErr != cerrors.Other {
+			if !cerrors.Is(test.wantErr, err) {
+				t.Errorf("%s: expected %s error, but got %v", test.name, test.wantErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if want := decodeTestJSON(t, test.want); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: expected %v, but got %v", test.name, want, got)
+		}
+	}
+}
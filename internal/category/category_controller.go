@@ -0,0 +1,228 @@
+package category
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"payhere/config"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"payhere/pkg/router"
+	"time"
+)
+
+func RegisterRoutes(e *gin.Engine, controller domain.CategoryController, authTokenRepository domain.AuthTokenRepository, cfg *config.Config) {
+	categories := e.Group("/categories")
+	{
+		categories.POST("", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.CreateCategory)
+		categories.GET("/:categoryID", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.GetCategory)
+		categories.PATCH("", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.PatchCategory)
+		categories.DELETE("/:categoryID", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.DeleteCategory)
+		categories.GET("", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ListCategories)
+	}
+}
+
+type categoryController struct {
+	categoryService domain.CategoryService
+}
+
+func NewCategoryController(service domain.CategoryService) *categoryController {
+	return &categoryController{
+		categoryService: service,
+	}
+}
+
+var _ domain.CategoryController = (*categoryController)(nil)
+
+// CreateCategory
+// @Summary 카테고리 생성
+// @Description 같은 상위 카테고리 아래에 대소문자만 다른 이름을 포함해 같은 이름의 카테고리는 만들 수 없습니다. 상위 카테고리를 지정하지 않으면 최상위 카테고리로 생성합니다.
+// @Tags Category
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param CreateCategoryRequest body domain.CreateCategoryRequest true "카테고리 생성 요청"
+// @Success 204
+// @Router /categories [post]
+func (cc categoryController) CreateCategory(c *gin.Context) {
+	var req domain.CreateCategoryRequest
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := cc.categoryService.CreateCategory(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetCategory
+// @Summary 단일 카테고리 조회
+// @Description 카테고리 ID로 하위 카테고리와 상품 수를 포함한 카테고리를 조회합니다. (단 자신의 카테고리만 조회 가능)
+// @Tags Category
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "카테고리 ID"
+// @Success 200 {object} domain.GetCategoryResponse "카테고리 상세 정보"
+// @Router /categories/{id} [get]
+func (cc categoryController) GetCategory(c *gin.Context) {
+	var req domain.GetCategoryRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := cc.categoryService.GetCategory(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// PatchCategory
+// @Summary 카테고리 이름, 상위 카테고리, 노출 순서 수정
+// @Description 이름을 바꾸면 카테고리에 속한 모든 상품에 반영됩니다. parentID를 0으로 보내면 최상위 카테고리로 이동합니다. (단 자신의 카테고리만 수정 가능)
+// @Tags Category
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param PatchCategoryRequest body domain.PatchCategoryRequest true "카테고리 수정 요청"
+// @Success 204
+// @Router /categories [patch]
+func (cc categoryController) PatchCategory(c *gin.Context) {
+	var req domain.PatchCategoryRequest
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := cc.categoryService.PatchCategory(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DeleteCategory
+// @Summary 카테고리 삭제
+// @Description 상품이나 하위 카테고리가 없는 카테고리만 삭제할 수 있습니다. (단 자신의 카테고리만 삭제 가능)
+// @Tags Category
+// @Produce json
+// @Param id path int true "카테고리 ID"
+// @Security BearerAuth
+// @Success 204
+// @Router /categories/{id} [delete]
+func (cc categoryController) DeleteCategory(c *gin.Context) {
+	var req domain.DeleteCategoryRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := cc.categoryService.DeleteCategory(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListCategories
+// @Summary 카테고리 목록 조회
+// @Description 카테고리를 노출 순서대로 상위/하위 관계에 맞춘 트리 형태로 조회합니다. 상품 수는 해당 카테고리에 직접 속한 상품의 수입니다. (단 자신의 카테고리만 조회 가능)
+// @Tags Category
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} domain.ListCategoriesResponse "카테고리 목록"
+// @Router /categories [get]
+func (cc categoryController) ListCategories(c *gin.Context) {
+	var req domain.ListCategoriesRequest
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := cc.categoryService.ListCategories(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
@@ -0,0 +1,391 @@
+package category
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/utils/pointer"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"payhere/config"
+	"payhere/domain"
+	"payhere/internal/auth_token"
+	"payhere/mocks"
+	"testing"
+	"time"
+)
+
+type categoryControllerTestSuite struct {
+	router             *gin.Engine
+	cfg                *config.Config
+	autRepository      *mocks.AuthTokenRepository
+	categoryService    *mocks.CategoryService
+	categoryController domain.CategoryController
+}
+
+func setupCategoryControllerTestSuite(t *testing.T) categoryControllerTestSuite {
+	var us categoryControllerTestSuite
+
+	gin.SetMode(gin.TestMode)
+	us.router = gin.Default()
+	us.autRepository = mocks.NewAuthTokenRepository(t)
+	us.categoryService = mocks.NewCategoryService(t)
+	us.cfg = &config.Config{
+		Auth: config.Auth{
+			Secret: "payhere_test_secret",
+		},
+	}
+
+	us.categoryController = NewCategoryController(us.categoryService)
+	RegisterRoutes(
+		us.router, us.categoryController,
+		us.autRepository,
+		us.cfg,
+	)
+
+	return us
+}
+
+func (ts categoryControllerTestSuite) newRequest(method string, path string, body *bytes.Reader) *http.Request {
+	var req *http.Request
+	if body == nil {
+		req, _ = http.NewRequest(method, path, nil)
+	} else {
+		req, _ = http.NewRequest(method, path, body)
+		req.Header.Set("Content-Type", "application/json")
+	}
+	token, _ := auth_token.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return req
+}
+
+func (ts categoryControllerTestSuite) expectAuthToken() {
+	ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+		mock.Anything,
+		mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+	).Return(domain.AuthToken{
+		ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+		Active:         true,
+	}, nil).Once()
+}
+
+func Test_categoryController_CreateCategory(t *testing.T) {
+	tests := []struct {
+		name string
+		body func() *bytes.Reader
+		mock func(ts categoryControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 최상위 카테고리 생성",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateCategoryRequest{
+					Name: "payhere",
+				})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts categoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.categoryService.EXPECT().CreateCategory(mock.Anything, domain.CreateCategoryRequest{
+					UserID: 1,
+					Name:   "payhere",
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "PASS - 하위 카테고리 생성",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateCategoryRequest{
+					ParentID:     pointer.Int(1),
+					Name:         "커피",
+					DisplayOrder: 2,
+				})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts categoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.categoryService.EXPECT().CreateCategory(mock.Anything, domain.CreateCategoryRequest{
+					UserID:       1,
+					ParentID:     pointer.Int(1),
+					Name:         "커피",
+					DisplayOrder: 2,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 비어있는 카테고리명",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateCategoryRequest{
+					Name: " ",
+				})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts categoryControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 음수의 노출 순서",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateCategoryRequest{
+					Name:         "payhere",
+					DisplayOrder: -1,
+				})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts categoryControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCategoryControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodPost, "/categories", tt.body())
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.categoryService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_categoryController_GetCategory(t *testing.T) {
+	tests := []struct {
+		name string
+		path func() string
+		mock func(ts categoryControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 유효한 카테고리 ID",
+			path: func() string {
+				path, _ := url.JoinPath("/categories", "1")
+				return path
+			},
+			mock: func(ts categoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.categoryService.EXPECT().GetCategory(mock.Anything, domain.GetCategoryRequest{
+					UserID:     1,
+					CategoryID: 1,
+				}).Return(domain.GetCategoryResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 유효하지 않은 카테고리 ID",
+			path: func() string {
+				path, _ := url.JoinPath("/categories", "payhere")
+				return path
+			},
+			mock: func(ts categoryControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCategoryControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodGet, tt.path(), nil)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.categoryService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_categoryController_PatchCategory(t *testing.T) {
+	tests := []struct {
+		name string
+		body func() *bytes.Reader
+		mock func(ts categoryControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 카테고리명 수정",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.PatchCategoryRequest{
+					ID:   1,
+					Name: pointer.String("Payhere"),
+				})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts categoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.categoryService.EXPECT().PatchCategory(mock.Anything, domain.PatchCategoryRequest{
+					UserID: 1,
+					ID:     1,
+					Name:   pointer.String("Payhere"),
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "PASS - 최상위 카테고리로 이동",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.PatchCategoryRequest{
+					ID:       2,
+					ParentID: pointer.Int(0),
+				})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts categoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.categoryService.EXPECT().PatchCategory(mock.Anything, domain.PatchCategoryRequest{
+					UserID:   1,
+					ID:       2,
+					ParentID: pointer.Int(0),
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 자기 자신을 상위 카테고리로 지정",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.PatchCategoryRequest{
+					ID:       2,
+					ParentID: pointer.Int(2),
+				})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts categoryControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCategoryControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodPatch, "/categories", tt.body())
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.categoryService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_categoryController_DeleteCategory(t *testing.T) {
+	tests := []struct {
+		name string
+		path func() string
+		mock func(ts categoryControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 유효한 카테고리 ID",
+			path: func() string {
+				path, _ := url.JoinPath("/categories", "1")
+				return path
+			},
+			mock: func(ts categoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.categoryService.EXPECT().DeleteCategory(mock.Anything, domain.DeleteCategoryRequest{
+					UserID: 1,
+					ID:     1,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 유효하지 않은 카테고리 ID",
+			path: func() string {
+				path, _ := url.JoinPath("/categories", "0")
+				return path
+			},
+			mock: func(ts categoryControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCategoryControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodDelete, tt.path(), nil)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.categoryService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_categoryController_ListCategories(t *testing.T) {
+	tests := []struct {
+		name string
+		mock func(ts categoryControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 카테고리 목록 조회",
+			mock: func(ts categoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.categoryService.EXPECT().ListCategories(mock.Anything, domain.ListCategoriesRequest{
+					UserID: 1,
+				}).Return(domain.ListCategoriesResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCategoryControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodGet, "/categories", nil)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.categoryService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package category
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+type categoryRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewCategoryRepository(sqlDB *sql.DB) *categoryRepository {
+	return &categoryRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.CategoryRepository = (*categoryRepository)(nil)
+
+func (cr categoryRepository) CreateCategory(ctx context.Context, category domain.Category) (int, error) {
+	const op cerrors.Op = "category/categoryRepository/CreateCategory"
+
+	result, err := cr.sqlDB.ExecContext(
+		ctx,
+		createCategoryQuery,
+		category.UserID,
+		category.ParentID,
+		category.Name,
+		category.DisplayOrder,
+	)
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	categoryID, err := result.LastInsertId()
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return int(categoryID), nil
+}
+
+func (cr categoryRepository) GetCategory(ctx context.Context, categoryID int) (*domain.Category, error) {
+	const op cerrors.Op = "category/categoryRepository/GetCategory"
+
+	category, err := scanCategory(cr.sqlDB.QueryRowContext(ctx, findCategoryByIDQuery, categoryID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return category, nil
+}
+
+func (cr categoryRepository) FindCategoryByName(ctx context.Context, params domain.FindCategoryByNameParams) (*domain.Category, error) {
+	const op cerrors.Op = "category/categoryRepository/FindCategoryByName"
+
+	category, err := scanCategory(cr.sqlDB.QueryRowContext(ctx, findCategoryByNameQuery, params.UserID, params.ParentID, params.Name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return category, nil
+}
+
+func (cr categoryRepository) UpdateCategory(ctx context.Context, category domain.Category) error {
+	const op cerrors.Op = "category/categoryRepository/UpdateCategory"
+
+	_, err := cr.sqlDB.ExecContext(
+		ctx,
+		updateCategoryQuery,
+		category.ParentID,
+		category.Name,
+		category.DisplayOrder,
+		category.ID,
+	)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (cr categoryRepository) DeleteCategory(ctx context.Context, categoryID int) error {
+	const op cerrors.Op = "category/categoryRepository/DeleteCategory"
+
+	_, err := cr.sqlDB.ExecContext(ctx, deleteCategoryQuery, time.Now().UTC(), categoryID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (cr categoryRepository) ListCategories(ctx context.Context, userID int) ([]domain.Category, error) {
+	const op cerrors.Op = "category/categoryRepository/ListCategories"
+
+	var categories []domain.Category
+
+	rows, err := cr.sqlDB.QueryContext(ctx, listCategoriesQuery, userID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var category domain.Category
+		err := rows.Scan(
+			&category.ID,
+			&category.CreateDate,
+			&category.UpdateDate,
+			&category.DeleteDate,
+			&category.UserID,
+			&category.ParentID,
+			&category.Name,
+			&category.DisplayOrder,
+			&category.ProductCount,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		categories = append(categories, category)
+	}
+
+	return categories, nil
+}
+
+func scanCategory(row *sql.Row) (*domain.Category, error) {
+	var category domain.Category
+
+	err := row.Scan(
+		&category.ID,
+		&category.CreateDate,
+		&category.UpdateDate,
+		&category.DeleteDate,
+		&category.UserID,
+		&category.ParentID,
+		&category.Name,
+		&category.DisplayOrder,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
@@ -0,0 +1,205 @@
+package category
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+	"payhere/domain"
+	"testing"
+	"time"
+)
+
+type categoryRepositoryTestSuite struct {
+	sqlDB              *sql.DB
+	sqlMock            sqlmock.Sqlmock
+	categoryRepository domain.CategoryRepository
+}
+
+func setupCategoryRepositoryTestSuite() categoryRepositoryTestSuite {
+	var us categoryRepositoryTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.categoryRepository = NewCategoryRepository(mockDB)
+
+	return us
+}
+
+func Test_categoryRepository_CreateCategory(t *testing.T) {
+	// given
+	ts := setupCategoryRepositoryTestSuite()
+	ts.sqlMock.ExpectExec("INSERT INTO categories").
+		WithArgs(1, pointer.Int(1), "커피", 2).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+
+	// when
+	got, err := ts.categoryRepository.CreateCategory(context.Background(), domain.Category{
+		UserID:       1,
+		ParentID:     pointer.Int(1),
+		Name:         "커피",
+		DisplayOrder: 2,
+	})
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, got)
+}
+
+func Test_categoryRepository_GetCategory(t *testing.T) {
+	createDate := time.Now()
+	updateDate := time.Now()
+
+	tests := []struct {
+		name       string
+		categoryID int
+		mock       func(ts categoryRepositoryTestSuite)
+		want       *domain.Category
+		wantErr    bool
+	}{
+		{
+			name:       "PASS - 존재하는 카테고리",
+			categoryID: 3,
+			mock: func(ts categoryRepositoryTestSuite) {
+				rows := sqlmock.NewRows([]string{"id", "create_date", "update_date", "delete_date", "user_id", "parent_id", "name", "display_order"}).
+					AddRow(3, createDate, updateDate, nil, 1, 1, "커피", 0)
+				ts.sqlMock.ExpectQuery("SELECT (.+) FROM categories WHERE delete_date IS NULL AND id = ?").
+					WithArgs(3).
+					WillReturnRows(rows)
+			},
+			want: &domain.Category{
+				Base: domain.Base{
+					ID:         3,
+					CreateDate: createDate,
+					UpdateDate: updateDate,
+				},
+				UserID:   1,
+				ParentID: pointer.Int(1),
+				Name:     "커피",
+			},
+			wantErr: false,
+		},
+		{
+			name:       "PASS - 존재하지 않는 카테고리",
+			categoryID: 10,
+			mock: func(ts categoryRepositoryTestSuite) {
+				ts.sqlMock.ExpectQuery("SELECT (.+) FROM categories WHERE delete_date IS NULL AND id = ?").
+					WithArgs(10).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCategoryRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.categoryRepository.GetCategory(context.Background(), tt.categoryID)
+
+			// then
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_categoryRepository_FindCategoryByName(t *testing.T) {
+	// given
+	ts := setupCategoryRepositoryTestSuite()
+	ts.sqlMock.ExpectQuery("SELECT (.+) FROM categories WHERE (.+) AND parent_id <=> \\? AND name = \\?").
+		WithArgs(1, nil, "payhere").
+		WillReturnError(sql.ErrNoRows)
+
+	// when
+	got, err := ts.categoryRepository.FindCategoryByName(context.Background(), domain.FindCategoryByNameParams{
+		UserID: 1,
+		Name:   "payhere",
+	})
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func Test_categoryRepository_UpdateCategory(t *testing.T) {
+	// given
+	ts := setupCategoryRepositoryTestSuite()
+	ts.sqlMock.ExpectExec("UPDATE categories SET parent_id = \\?, name = \\?, display_order = \\? WHERE id = \\?").
+		WithArgs(nil, "payhere", 1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// when
+	err := ts.categoryRepository.UpdateCategory(context.Background(), domain.Category{
+		Base:         domain.Base{ID: 1},
+		UserID:       1,
+		Name:         "payhere",
+		DisplayOrder: 1,
+	})
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func Test_categoryRepository_DeleteCategory(t *testing.T) {
+	// given
+	ts := setupCategoryRepositoryTestSuite()
+	ts.sqlMock.ExpectExec("UPDATE categories SET delete_date = \\? WHERE id = \\?").
+		WithArgs(sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// when
+	err := ts.categoryRepository.DeleteCategory(context.Background(), 1)
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func Test_categoryRepository_ListCategories(t *testing.T) {
+	// given
+	ts := setupCategoryRepositoryTestSuite()
+	createDate := time.Now()
+	updateDate := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "create_date", "update_date", "delete_date", "user_id", "parent_id", "name", "display_order", "count"}).
+		AddRow(1, createDate, updateDate, nil, 1, nil, "payhere", 0, 2).
+		AddRow(3, createDate, updateDate, nil, 1, 1, "커피", 0, 1)
+	ts.sqlMock.ExpectQuery("SELECT (.+) FROM categories c LEFT JOIN products p (.+) GROUP BY c.id ORDER BY c.display_order, c.id").
+		WithArgs(1).
+		WillReturnRows(rows)
+
+	// when
+	got, err := ts.categoryRepository.ListCategories(context.Background(), 1)
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Category{
+		{
+			Base:         domain.Base{ID: 1, CreateDate: createDate, UpdateDate: updateDate},
+			UserID:       1,
+			Name:         "payhere",
+			ProductCount: 2,
+		},
+		{
+			Base:         domain.Base{ID: 3, CreateDate: createDate, UpdateDate: updateDate},
+			UserID:       1,
+			ParentID:     pointer.Int(1),
+			Name:         "커피",
+			ProductCount: 1,
+		},
+	}, got)
+}
@@ -0,0 +1,275 @@
+package category
+
+import (
+	"context"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"strings"
+)
+
+type categoryService struct {
+	categoryRepository domain.CategoryRepository
+}
+
+func NewCategoryService(categoryRepository domain.CategoryRepository) *categoryService {
+	return &categoryService{
+		categoryRepository: categoryRepository,
+	}
+}
+
+var _ domain.CategoryService = (*categoryService)(nil)
+
+func (cs categoryService) CreateCategory(ctx context.Context, req domain.CreateCategoryRequest) error {
+	const op cerrors.Op = "category/service/CreateCategory"
+
+	if req.ParentID != nil {
+		parent, err := cs.categoryRepository.GetCategory(ctx, *req.ParentID)
+		if err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "카테고리를 조회하는 중에 에러가 발생했습니다.")
+		}
+		if parent == nil || parent.UserID != req.UserID {
+			return cerrors.E(op, cerrors.Invalid, "상위 카테고리를 확인해주세요.")
+		}
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if err := cs.checkDuplicateName(ctx, req.UserID, req.ParentID, name, 0); err != nil {
+		return err
+	}
+
+	_, err := cs.categoryRepository.CreateCategory(ctx, domain.Category{
+		UserID:       req.UserID,
+		ParentID:     req.ParentID,
+		Name:         name,
+		DisplayOrder: req.DisplayOrder,
+	})
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "카테고리를 생성하는 중에 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (cs categoryService) GetCategory(ctx context.Context, req domain.GetCategoryRequest) (domain.GetCategoryResponse, error) {
+	const op cerrors.Op = "category/service/GetCategory"
+
+	category, err := cs.categoryRepository.GetCategory(ctx, req.CategoryID)
+	if err != nil {
+		return domain.GetCategoryResponse{}, cerrors.E(op, cerrors.Internal, err, "카테고리를 조회하는 중에 에러가 발생했습니다.")
+	}
+	if category == nil {
+		return domain.GetCategoryResponse{}, cerrors.E(op, cerrors.NotExist, "카테고리를 찾을 수 없습니다.")
+	}
+	if category.UserID != req.UserID {
+		return domain.GetCategoryResponse{}, cerrors.E(op, cerrors.Permission, "카테고리를 조회할 권한이 없습니다.")
+	}
+
+	// 상품 수와 하위 카테고리를 함께 내려주기 위해 전체 트리에서 찾는다.
+	categories, err := cs.categoryRepository.ListCategories(ctx, req.UserID)
+	if err != nil {
+		return domain.GetCategoryResponse{}, cerrors.E(op, cerrors.Internal, err, "카테고리를 조회하는 중에 에러가 발생했습니다.")
+	}
+	for _, tree := range buildCategoryTree(categories) {
+		if found, ok := findCategoryDTO(tree, req.CategoryID); ok {
+			return domain.GetCategoryResponse{
+				Category: found,
+			}, nil
+		}
+	}
+
+	return domain.GetCategoryResponse{
+		Category: domain.CategoryDTOFrom(*category),
+	}, nil
+}
+
+func (cs categoryService) PatchCategory(ctx context.Context, req domain.PatchCategoryRequest) error {
+	const op cerrors.Op = "category/service/PatchCategory"
+
+	category, err := cs.categoryRepository.GetCategory(ctx, req.ID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "카테고리를 조회하는 중에 에러가 발생했습니다.")
+	}
+	if category == nil {
+		return cerrors.E(op, cerrors.NotExist, "카테고리를 찾을 수 없습니다.")
+	}
+	if category.UserID != req.UserID {
+		return cerrors.E(op, cerrors.Permission, "카테고리를 수정할 권한이 없습니다.")
+	}
+
+	if req.ParentID != nil {
+		if *req.ParentID == 0 {
+			category.ParentID = nil
+		} else {
+			categories, err := cs.categoryRepository.ListCategories(ctx, req.UserID)
+			if err != nil {
+				return cerrors.E(op, cerrors.Internal, err, "카테고리를 조회하는 중에 에러가 발생했습니다.")
+			}
+			if !containsCategory(categories, *req.ParentID) {
+				return cerrors.E(op, cerrors.Invalid, "상위 카테고리를 확인해주세요.")
+			}
+			if isDescendant(categories, *req.ParentID, category.ID) {
+				return cerrors.E(op, cerrors.Invalid, "하위 카테고리를 상위 카테고리로 지정할 수 없습니다.")
+			}
+			category.ParentID = req.ParentID
+		}
+	}
+	if req.Name != nil {
+		category.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.DisplayOrder != nil {
+		category.DisplayOrder = *req.DisplayOrder
+	}
+
+	if req.Name != nil || req.ParentID != nil {
+		if err := cs.checkDuplicateName(ctx, req.UserID, category.ParentID, category.Name, category.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := cs.categoryRepository.UpdateCategory(ctx, *category); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "카테고리를 수정하는 중에 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (cs categoryService) DeleteCategory(ctx context.Context, req domain.DeleteCategoryRequest) error {
+	const op cerrors.Op = "category/service/DeleteCategory"
+
+	category, err := cs.categoryRepository.GetCategory(ctx, req.ID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "카테고리를 조회하는 중에 에러가 발생했습니다.")
+	}
+	if category == nil {
+		return cerrors.E(op, cerrors.NotExist, "카테고리를 찾을 수 없습니다.")
+	}
+	if category.UserID != req.UserID {
+		return cerrors.E(op, cerrors.Permission, "카테고리를 삭제할 권한이 없습니다.")
+	}
+
+	categories, err := cs.categoryRepository.ListCategories(ctx, req.UserID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "카테고리를 조회하는 중에 에러가 발생했습니다.")
+	}
+	for _, c := range categories {
+		if c.ID == category.ID && c.ProductCount > 0 {
+			return cerrors.E(op, cerrors.Invalid, "상품이 있는 카테고리는 삭제할 수 없습니다.")
+		}
+		if c.ParentID != nil && *c.ParentID == category.ID {
+			return cerrors.E(op, cerrors.Invalid, "하위 카테고리가 있는 카테고리는 삭제할 수 없습니다.")
+		}
+	}
+
+	if err := cs.categoryRepository.DeleteCategory(ctx, req.ID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "카테고리를 삭제하는 중에 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (cs categoryService) ListCategories(ctx context.Context, req domain.ListCategoriesRequest) (domain.ListCategoriesResponse, error) {
+	const op cerrors.Op = "category/service/ListCategories"
+
+	categories, err := cs.categoryRepository.ListCategories(ctx, req.UserID)
+	if err != nil {
+		return domain.ListCategoriesResponse{}, cerrors.E(op, cerrors.Internal, err, "카테고리를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	return domain.ListCategoriesResponse{
+		Categories: buildCategoryTree(categories),
+	}, nil
+}
+
+// checkDuplicateName
+// 같은 상위 카테고리 아래에 같은 이름의 카테고리가 있는지 확인한다. (대소문자는 DB collation에 따라 구분하지 않음)
+func (cs categoryService) checkDuplicateName(ctx context.Context, userID int, parentID *int, name string, exceptID int) error {
+	const op cerrors.Op = "category/service/checkDuplicateName"
+
+	duplicated, err := cs.categoryRepository.FindCategoryByName(ctx, domain.FindCategoryByNameParams{
+		UserID:   userID,
+		ParentID: parentID,
+		Name:     name,
+	})
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "카테고리를 조회하는 중에 에러가 발생했습니다.")
+	}
+	if duplicated != nil && duplicated.ID != exceptID {
+		return cerrors.E(op, cerrors.Exist, "이미 같은 이름의 카테고리가 있습니다.")
+	}
+
+	return nil
+}
+
+// buildCategoryTree
+// 노출 순서대로 정렬된 카테고리 목록을 상위/하위 관계에 맞춰 트리로 만든다.
+func buildCategoryTree(categories []domain.Category) []domain.CategoryDTO {
+	children := make(map[int][]domain.Category)
+	exists := make(map[int]bool)
+	for _, category := range categories {
+		exists[category.ID] = true
+	}
+
+	var roots []domain.Category
+	for _, category := range categories {
+		if category.ParentID == nil || !exists[*category.ParentID] {
+			roots = append(roots, category)
+			continue
+		}
+		children[*category.ParentID] = append(children[*category.ParentID], category)
+	}
+
+	var build func(category domain.Category) domain.CategoryDTO
+	build = func(category domain.Category) domain.CategoryDTO {
+		dto := domain.CategoryDTOFrom(category)
+		for _, child := range children[category.ID] {
+			dto.Children = append(dto.Children, build(child))
+		}
+		return dto
+	}
+
+	tree := []domain.CategoryDTO{}
+	for _, root := range roots {
+		tree = append(tree, build(root))
+	}
+
+	return tree
+}
+
+func findCategoryDTO(dto domain.CategoryDTO, categoryID int) (domain.CategoryDTO, bool) {
+	if dto.ID == categoryID {
+		return dto, true
+	}
+	for _, child := range dto.Children {
+		if found, ok := findCategoryDTO(child, categoryID); ok {
+			return found, true
+		}
+	}
+	return domain.CategoryDTO{}, false
+}
+
+func containsCategory(categories []domain.Category, categoryID int) bool {
+	for _, category := range categories {
+		if category.ID == categoryID {
+			return true
+		}
+	}
+	return false
+}
+
+// isDescendant
+// categoryID 가 ancestorID 자신이거나 그 하위 카테고리인지 상위 방향으로 따라가며 확인한다.
+func isDescendant(categories []domain.Category, categoryID int, ancestorID int) bool {
+	parents := make(map[int]*int)
+	for _, category := range categories {
+		parents[category.ID] = category.ParentID
+	}
+
+	visited := make(map[int]bool)
+	for current := &categoryID; current != nil && !visited[*current]; current = parents[*current] {
+		if *current == ancestorID {
+			return true
+		}
+		visited[*current] = true
+	}
+	return false
+}
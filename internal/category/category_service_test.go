@@ -0,0 +1,470 @@
+package category
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/utils/pointer"
+	"payhere/domain"
+	"payhere/mocks"
+	"testing"
+)
+
+type categoryServiceTestSuite struct {
+	categoryRepository *mocks.CategoryRepository
+	categoryService    domain.CategoryService
+}
+
+func setupCategoryServiceTestSuite(t *testing.T) categoryServiceTestSuite {
+	var us categoryServiceTestSuite
+
+	us.categoryRepository = mocks.NewCategoryRepository(t)
+	us.categoryService = NewCategoryService(us.categoryRepository)
+
+	return us
+}
+
+// 1 payhere
+// ├── 3 커피
+// │   └── 4 라떼
+// 2 fashion
+func testCategories() []domain.Category {
+	return []domain.Category{
+		{Base: domain.Base{ID: 1}, UserID: 1, Name: "payhere", DisplayOrder: 0, ProductCount: 2},
+		{Base: domain.Base{ID: 3}, UserID: 1, ParentID: pointer.Int(1), Name: "커피", DisplayOrder: 0, ProductCount: 1},
+		{Base: domain.Base{ID: 4}, UserID: 1, ParentID: pointer.Int(3), Name: "라떼", DisplayOrder: 0},
+		{Base: domain.Base{ID: 2}, UserID: 1, Name: "fashion", DisplayOrder: 1},
+	}
+}
+
+func Test_categoryService_CreateCategory(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.CreateCategoryRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts categoryServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 최상위 카테고리 생성",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateCategoryRequest{
+					UserID: 1,
+					Name:   " payhere ",
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().FindCategoryByName(mock.Anything, domain.FindCategoryByNameParams{
+					UserID: 1,
+					Name:   "payhere",
+				}).Return(nil, nil).Once()
+				ts.categoryRepository.EXPECT().CreateCategory(mock.Anything, domain.Category{
+					UserID: 1,
+					Name:   "payhere",
+				}).Return(1, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 하위 카테고리 생성",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateCategoryRequest{
+					UserID:   1,
+					ParentID: pointer.Int(1),
+					Name:     "커피",
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&domain.Category{
+					Base:   domain.Base{ID: 1},
+					UserID: 1,
+					Name:   "payhere",
+				}, nil).Once()
+				ts.categoryRepository.EXPECT().FindCategoryByName(mock.Anything, domain.FindCategoryByNameParams{
+					UserID:   1,
+					ParentID: pointer.Int(1),
+					Name:     "커피",
+				}).Return(nil, nil).Once()
+				ts.categoryRepository.EXPECT().CreateCategory(mock.Anything, domain.Category{
+					UserID:   1,
+					ParentID: pointer.Int(1),
+					Name:     "커피",
+				}).Return(3, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 다른 사장님의 상위 카테고리",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateCategoryRequest{
+					UserID:   1,
+					ParentID: pointer.Int(5),
+					Name:     "커피",
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 5).Return(&domain.Category{
+					Base:   domain.Base{ID: 5},
+					UserID: 2,
+					Name:   "payhere",
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 같은 이름의 카테고리",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateCategoryRequest{
+					UserID: 1,
+					Name:   "Payhere",
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().FindCategoryByName(mock.Anything, domain.FindCategoryByNameParams{
+					UserID: 1,
+					Name:   "Payhere",
+				}).Return(&domain.Category{
+					Base:   domain.Base{ID: 1},
+					UserID: 1,
+					Name:   "payhere",
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCategoryServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.categoryService.CreateCategory(tt.args.ctx, tt.args.req)
+
+			// then
+			ts.categoryRepository.AssertExpectations(t)
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			} else {
+				assert.False(t, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_categoryService_GetCategory(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.GetCategoryRequest
+	}
+
+	tests := []struct {
+		name         string
+		args         args
+		mock         func(ts categoryServiceTestSuite)
+		wantName     string
+		wantChildren int
+		wantErr      bool
+	}{
+		{
+			name: "PASS - 하위 카테고리를 포함한 조회",
+			args: args{
+				ctx: context.Background(),
+				req: domain.GetCategoryRequest{
+					UserID:     1,
+					CategoryID: 3,
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 3).Return(&testCategories()[1], nil).Once()
+				ts.categoryRepository.EXPECT().ListCategories(mock.Anything, 1).Return(testCategories(), nil).Once()
+			},
+			wantName:     "커피",
+			wantChildren: 1,
+			wantErr:      false,
+		},
+		{
+			name: "FAIL - 존재하지 않는 카테고리",
+			args: args{
+				ctx: context.Background(),
+				req: domain.GetCategoryRequest{
+					UserID:     1,
+					CategoryID: 10,
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 10).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 다른 사장님의 카테고리",
+			args: args{
+				ctx: context.Background(),
+				req: domain.GetCategoryRequest{
+					UserID:     2,
+					CategoryID: 1,
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&testCategories()[0], nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCategoryServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.categoryService.GetCategory(tt.args.ctx, tt.args.req)
+
+			// then
+			ts.categoryRepository.AssertExpectations(t)
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+				return
+			}
+			assert.Equal(t, tt.wantName, got.Category.Name)
+			assert.Len(t, got.Category.Children, tt.wantChildren)
+		})
+	}
+}
+
+func Test_categoryService_PatchCategory(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.PatchCategoryRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts categoryServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 다른 상위 카테고리로 이동",
+			args: args{
+				ctx: context.Background(),
+				req: domain.PatchCategoryRequest{
+					UserID:   1,
+					ID:       4,
+					ParentID: pointer.Int(2),
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 4).Return(&testCategories()[2], nil).Once()
+				ts.categoryRepository.EXPECT().ListCategories(mock.Anything, 1).Return(testCategories(), nil).Once()
+				ts.categoryRepository.EXPECT().FindCategoryByName(mock.Anything, domain.FindCategoryByNameParams{
+					UserID:   1,
+					ParentID: pointer.Int(2),
+					Name:     "라떼",
+				}).Return(nil, nil).Once()
+				ts.categoryRepository.EXPECT().UpdateCategory(mock.Anything, domain.Category{
+					Base:     domain.Base{ID: 4},
+					UserID:   1,
+					ParentID: pointer.Int(2),
+					Name:     "라떼",
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 노출 순서만 수정",
+			args: args{
+				ctx: context.Background(),
+				req: domain.PatchCategoryRequest{
+					UserID:       1,
+					ID:           2,
+					DisplayOrder: pointer.Int(0),
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 2).Return(&testCategories()[3], nil).Once()
+				ts.categoryRepository.EXPECT().UpdateCategory(mock.Anything, domain.Category{
+					Base:   domain.Base{ID: 2},
+					UserID: 1,
+					Name:   "fashion",
+				}).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 하위 카테고리 아래로 이동",
+			args: args{
+				ctx: context.Background(),
+				req: domain.PatchCategoryRequest{
+					UserID:   1,
+					ID:       1,
+					ParentID: pointer.Int(4),
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&testCategories()[0], nil).Once()
+				ts.categoryRepository.EXPECT().ListCategories(mock.Anything, 1).Return(testCategories(), nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 다른 사장님의 카테고리",
+			args: args{
+				ctx: context.Background(),
+				req: domain.PatchCategoryRequest{
+					UserID: 2,
+					ID:     1,
+					Name:   pointer.String("payhere"),
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&testCategories()[0], nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCategoryServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.categoryService.PatchCategory(tt.args.ctx, tt.args.req)
+
+			// then
+			ts.categoryRepository.AssertExpectations(t)
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			} else {
+				assert.False(t, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_categoryService_DeleteCategory(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.DeleteCategoryRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts categoryServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 비어있는 카테고리 삭제",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteCategoryRequest{
+					UserID: 1,
+					ID:     4,
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 4).Return(&testCategories()[2], nil).Once()
+				ts.categoryRepository.EXPECT().ListCategories(mock.Anything, 1).Return(testCategories(), nil).Once()
+				ts.categoryRepository.EXPECT().DeleteCategory(mock.Anything, 4).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 상품이 있는 카테고리",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteCategoryRequest{
+					UserID: 1,
+					ID:     3,
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 3).Return(&testCategories()[1], nil).Once()
+				ts.categoryRepository.EXPECT().ListCategories(mock.Anything, 1).Return(testCategories(), nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 하위 카테고리가 있는 카테고리",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteCategoryRequest{
+					UserID: 1,
+					ID:     3,
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				categories := testCategories()
+				categories[1].ProductCount = 0
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 3).Return(&categories[1], nil).Once()
+				ts.categoryRepository.EXPECT().ListCategories(mock.Anything, 1).Return(categories, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 존재하지 않는 카테고리",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteCategoryRequest{
+					UserID: 1,
+					ID:     10,
+				},
+			},
+			mock: func(ts categoryServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 10).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupCategoryServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.categoryService.DeleteCategory(tt.args.ctx, tt.args.req)
+
+			// then
+			ts.categoryRepository.AssertExpectations(t)
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			} else {
+				assert.False(t, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_categoryService_ListCategories(t *testing.T) {
+	// given
+	ts := setupCategoryServiceTestSuite(t)
+	ts.categoryRepository.EXPECT().ListCategories(mock.Anything, 1).Return(testCategories(), nil).Once()
+
+	// when
+	got, err := ts.categoryService.ListCategories(context.Background(), domain.ListCategoriesRequest{UserID: 1})
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, got.Categories, 2)
+	assert.Equal(t, "payhere", got.Categories[0].Name)
+	assert.Equal(t, "커피", got.Categories[0].Children[0].Name)
+	assert.Equal(t, "라떼", got.Categories[0].Children[0].Children[0].Name)
+	assert.Equal(t, "fashion", got.Categories[1].Name)
+	assert.Empty(t, got.Categories[1].Children)
+}
@@ -0,0 +1,66 @@
+package category
+
+const createCategoryQuery = `INSERT INTO categories (user_id, parent_id, name, display_order) VALUES (?, ?, ?, ?)`
+
+const findCategoryByIDQuery = `
+	SELECT 
+		id, 
+		create_date, 
+		update_date, 
+		delete_date, 
+		user_id, 
+		parent_id, 
+		name, 
+		display_order 
+	FROM 
+		categories 
+	WHERE 
+		delete_date IS NULL 
+		AND id = ?
+`
+
+const findCategoryByNameQuery = `
+	SELECT 
+		id, 
+		create_date, 
+		update_date, 
+		delete_date, 
+		user_id, 
+		parent_id, 
+		name, 
+		display_order 
+	FROM 
+		categories 
+	WHERE 
+		delete_date IS NULL 
+		AND user_id = ? 
+		AND parent_id <=> ? 
+		AND name = ?
+`
+
+const updateCategoryQuery = `UPDATE categories SET parent_id = ?, name = ?, display_order = ? WHERE id = ?`
+
+const deleteCategoryQuery = `UPDATE categories SET delete_date = ? WHERE id = ?`
+
+const listCategoriesQuery = `
+	SELECT 
+		c.id, 
+		c.create_date, 
+		c.update_date, 
+		c.delete_date, 
+		c.user_id, 
+		c.parent_id, 
+		c.name, 
+		c.display_order, 
+		COUNT(p.id) 
+	FROM 
+		categories c 
+		LEFT JOIN products p ON p.category_id = c.id AND p.delete_date IS NULL 
+	WHERE 
+		c.user_id = ? 
+		AND c.delete_date IS NULL 
+	GROUP BY 
+		c.id 
+	ORDER BY 
+		c.display_order, c.id
+`
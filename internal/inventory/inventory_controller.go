@@ -0,0 +1,262 @@
+package inventory
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"payhere/config"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"payhere/pkg/router"
+	"time"
+)
+
+func RegisterRoutes(e *gin.Engine, controller domain.InventoryController, authTokenRepository domain.AuthTokenRepository, cfg *config.Config) {
+	products := e.Group("/products")
+	{
+		products.POST("/:productID/stock-movements", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.CreateStockMovement)
+		products.POST("/:productID/disposals", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.CreateDisposal)
+		products.GET("/:productID/stock-movements", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ListStockMovements)
+		products.GET("/:productID/lots", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ListStockLots)
+		products.GET("/low-stock", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ListLowStockProducts)
+	}
+}
+
+type inventoryController struct {
+	inventoryService domain.InventoryService
+}
+
+func NewInventoryController(service domain.InventoryService) *inventoryController {
+	return &inventoryController{
+		inventoryService: service,
+	}
+}
+
+var _ domain.InventoryController = (*inventoryController)(nil)
+
+// CreateStockMovement
+// @Summary 입출고 기록
+// @Description 입고(receive), 판매(sale), 조정(adjustment)을 재고 원장에 기록하고 상품의 재고를 바꿉니다. 폐기는 손실액과 함께 남기도록 폐기 기록(POST /products/{productID}/disposals)으로 기록합니다. 입고, 판매는 수량을 양수로 보내고, 조정은 늘릴 때 양수, 줄일 때 음수로 보냅니다. 재고를 늘릴 때는 유통기한(expiryDate)을 함께 보내 새 로트로 입고하고, 재고를 줄일 때는 유통기한이 먼저 끝나는 로트부터 꺼냅니다. 재고보다 많이 판매할 수 없습니다. (단 자신의 상품만 기록 가능)
+// @Tags Inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param productID path int true "상품 ID"
+// @Param CreateStockMovementRequest body domain.CreateStockMovementRequest true "입출고 기록 요청"
+// @Success 200 {object} domain.CreateStockMovementResponse "기록한 입출고"
+// @Router /products/{productID}/stock-movements [post]
+func (ic inventoryController) CreateStockMovement(c *gin.Context) {
+	var req domain.CreateStockMovementRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := ic.inventoryService.CreateStockMovement(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// CreateDisposal
+// @Summary 상품 폐기
+// @Description 유통기한 경과(expired), 파손(damaged), 기타(other) 사유로 상품을 폐기합니다. 폐기한 수량만큼 재고가 줄고 재고 원장에 폐기(waste)로 기록되며, 손실액은 폐기한 시점의 원가로 계산합니다. 재고보다 많이 폐기할 수 없습니다. (단 자신의 상품만 폐기 가능)
+// @Tags Inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param productID path int true "상품 ID"
+// @Param CreateDisposalRequest body domain.CreateDisposalRequest true "폐기 요청"
+// @Success 200 {object} domain.CreateDisposalResponse "폐기 기록"
+// @Router /products/{productID}/disposals [post]
+func (ic inventoryController) CreateDisposal(c *gin.Context) {
+	var req domain.CreateDisposalRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := ic.inventoryService.CreateDisposal(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// ListStockMovements
+// @Summary 입출고 내역 조회
+// @Description 상품의 현재 재고와 입출고 내역을 최근 순으로 20개씩 조회합니다. 다음 페이지는 응답의 cursor 를 넘겨 조회합니다. (단 자신의 상품만 조회 가능)
+// @Tags Inventory
+// @Produce json
+// @Security BearerAuth
+// @Param productID path int true "상품 ID"
+// @Param cursor query int false "이전 페이지의 마지막 입출고 ID"
+// @Param type query string false "입출고 종류 (receive, sale, adjustment, waste)"
+// @Success 200 {object} domain.ListStockMovementsResponse "입출고 내역"
+// @Router /products/{productID}/stock-movements [get]
+func (ic inventoryController) ListStockMovements(c *gin.Context) {
+	var req domain.ListStockMovementsRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := ic.inventoryService.ListStockMovements(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// ListStockLots
+// @Summary 로트 조회
+// @Description 상품의 로트를 유통기한이 먼저 끝나는 순(판매, 폐기 시 꺼내는 순서)으로 조회합니다. 기본은 재고가 남은 로트만 조회하고, all=true 면 다 쓴 로트도 함께 조회합니다. (단 자신의 상품만 조회 가능)
+// @Tags Inventory
+// @Produce json
+// @Security BearerAuth
+// @Param productID path int true "상품 ID"
+// @Param all query bool false "다 쓴 로트 포함 여부"
+// @Success 200 {object} domain.ListStockLotsResponse "로트 목록"
+// @Router /products/{productID}/lots [get]
+func (ic inventoryController) ListStockLots(c *gin.Context) {
+	var req domain.ListStockLotsRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := ic.inventoryService.ListStockLots(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// ListLowStockProducts
+// @Summary 재고 부족 상품 조회
+// @Description 재고가 재고 알림 기준 수량(reorderPoint) 이하인 상품을 상품 ID 순으로 20개씩 조회합니다. 기준 수량을 정하지 않은 상품은 조회하지 않습니다. (단 자신의 상품만 조회 가능)
+// @Tags Inventory
+// @Produce json
+// @Security BearerAuth
+// @Param cursor query int false "이전 페이지의 마지막 상품 ID"
+// @Success 200 {object} domain.ListLowStockProductsResponse "재고 부족 상품 목록"
+// @Router /products/low-stock [get]
+func (ic inventoryController) ListLowStockProducts(c *gin.Context) {
+	var req domain.ListLowStockProductsRequest
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := ic.inventoryService.ListLowStockProducts(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
@@ -0,0 +1,441 @@
+package inventory
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"payhere/config"
+	"payhere/domain"
+	"payhere/internal/auth_token"
+	"payhere/mocks"
+	cerrors "payhere/pkg/cerrors"
+	"testing"
+	"time"
+)
+
+type inventoryControllerTestSuite struct {
+	router              *gin.Engine
+	cfg                 *config.Config
+	autRepository       *mocks.AuthTokenRepository
+	inventoryService    *mocks.InventoryService
+	inventoryController domain.InventoryController
+}
+
+func setupInventoryControllerTestSuite(t *testing.T) inventoryControllerTestSuite {
+	var us inventoryControllerTestSuite
+
+	gin.SetMode(gin.TestMode)
+	us.router = gin.Default()
+	us.autRepository = mocks.NewAuthTokenRepository(t)
+	us.inventoryService = mocks.NewInventoryService(t)
+	us.cfg = &config.Config{
+		Auth: config.Auth{
+			Secret: "payhere_test_secret",
+		},
+	}
+
+	us.inventoryController = NewInventoryController(us.inventoryService)
+	RegisterRoutes(
+		us.router, us.inventoryController,
+		us.autRepository,
+		us.cfg,
+	)
+
+	return us
+}
+
+func (ts inventoryControllerTestSuite) newRequest(method string, path string, body *bytes.Reader) *http.Request {
+	var req *http.Request
+	if body == nil {
+		req, _ = http.NewRequest(method, path, nil)
+	} else {
+		req, _ = http.NewRequest(method, path, body)
+		req.Header.Set("Content-Type", "application/json")
+	}
+	token, _ := auth_token.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return req
+}
+
+func (ts inventoryControllerTestSuite) expectAuthToken() {
+	ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+		mock.Anything,
+		mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+	).Return(domain.AuthToken{
+		ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+		Active:         true,
+	}, nil).Once()
+}
+
+func Test_inventoryController_CreateStockMovement(t *testing.T) {
+	expiryDate := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		path string
+		body func() *bytes.Reader
+		mock func(ts inventoryControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 입고 기록",
+			path: "/products/1/stock-movements",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateStockMovementRequest{
+					Type:       domain.StockMovementTypeReceive,
+					Quantity:   10,
+					Note:       "오전 입고",
+					LotNumber:  "L240228-01",
+					ExpiryDate: &expiryDate,
+				})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.inventoryService.EXPECT().CreateStockMovement(mock.Anything, domain.CreateStockMovementRequest{
+					UserID:     1,
+					ProductID:  1,
+					Type:       domain.StockMovementTypeReceive,
+					Quantity:   10,
+					Note:       "오전 입고",
+					LotNumber:  "L240228-01",
+					ExpiryDate: &expiryDate,
+				}).Return(domain.CreateStockMovementResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 재고보다 많은 판매",
+			path: "/products/1/stock-movements",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateStockMovementRequest{
+					Type:     domain.StockMovementTypeSale,
+					Quantity: 100,
+				})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.inventoryService.EXPECT().CreateStockMovement(mock.Anything, mock.Anything).
+					Return(domain.CreateStockMovementResponse{}, cerrors.E(cerrors.Op("test"), cerrors.Invalid, "재고가 부족합니다. (현재 재고: 3개)")).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 음수의 판매 수량",
+			path: "/products/1/stock-movements",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateStockMovementRequest{
+					Type:     domain.StockMovementTypeSale,
+					Quantity: -1,
+				})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 입출고 기록으로 폐기",
+			path: "/products/1/stock-movements",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateStockMovementRequest{
+					Type:     domain.StockMovementTypeWaste,
+					Quantity: 1,
+				})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 유효하지 않은 상품 ID",
+			path: "/products/payhere/stock-movements",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateStockMovementRequest{
+					Type:     domain.StockMovementTypeReceive,
+					Quantity: 1,
+				})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupInventoryControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodPost, tt.path, tt.body())
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.inventoryService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_inventoryController_CreateDisposal(t *testing.T) {
+	tests := []struct {
+		name string
+		body domain.CreateDisposalRequest
+		mock func(ts inventoryControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 폐기",
+			body: domain.CreateDisposalRequest{Quantity: 3, Reason: domain.DisposalReasonExpired, Note: "유통기한 경과"},
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.inventoryService.EXPECT().CreateDisposal(mock.Anything, domain.CreateDisposalRequest{
+					UserID:    1,
+					ProductID: 1,
+					Quantity:  3,
+					Reason:    domain.DisposalReasonExpired,
+					Note:      "유통기한 경과",
+				}).Return(domain.CreateDisposalResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 알 수 없는 폐기 사유",
+			body: domain.CreateDisposalRequest{Quantity: 3, Reason: "lost"},
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 재고보다 많은 폐기",
+			body: domain.CreateDisposalRequest{Quantity: 100, Reason: domain.DisposalReasonDamaged},
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.inventoryService.EXPECT().CreateDisposal(mock.Anything, mock.Anything).
+					Return(domain.CreateDisposalResponse{}, cerrors.E(cerrors.Op("test"), cerrors.Invalid, "재고가 부족합니다. (현재 재고: 3개)")).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupInventoryControllerTestSuite(t)
+			tt.mock(ts)
+			jsonData, _ := json.Marshal(tt.body)
+			req := ts.newRequest(http.MethodPost, "/products/1/disposals", bytes.NewReader(jsonData))
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.inventoryService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_inventoryController_ListStockMovements(t *testing.T) {
+	cursor := 30
+	saleType := domain.StockMovementTypeSale
+
+	tests := []struct {
+		name string
+		path string
+		mock func(ts inventoryControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 입출고 내역 조회",
+			path: "/products/1/stock-movements",
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.inventoryService.EXPECT().ListStockMovements(mock.Anything, domain.ListStockMovementsRequest{
+					UserID:    1,
+					ProductID: 1,
+				}).Return(domain.ListStockMovementsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "PASS - 종류와 cursor 로 조회",
+			path: "/products/1/stock-movements?type=sale&cursor=30",
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.inventoryService.EXPECT().ListStockMovements(mock.Anything, domain.ListStockMovementsRequest{
+					UserID:    1,
+					ProductID: 1,
+					Cursor:    &cursor,
+					Type:      &saleType,
+				}).Return(domain.ListStockMovementsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 유효하지 않은 입출고 종류",
+			path: "/products/1/stock-movements?type=refund",
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupInventoryControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodGet, tt.path, nil)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.inventoryService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_inventoryController_ListLowStockProducts(t *testing.T) {
+	cursor := 3
+
+	tests := []struct {
+		name string
+		path string
+		mock func(ts inventoryControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 재고 부족 상품 조회",
+			path: "/products/low-stock",
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.inventoryService.EXPECT().ListLowStockProducts(mock.Anything, domain.ListLowStockProductsRequest{
+					UserID: 1,
+				}).Return(domain.ListLowStockProductsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "PASS - cursor 로 조회",
+			path: "/products/low-stock?cursor=3",
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.inventoryService.EXPECT().ListLowStockProducts(mock.Anything, domain.ListLowStockProductsRequest{
+					UserID: 1,
+					Cursor: &cursor,
+				}).Return(domain.ListLowStockProductsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 유효하지 않은 cursor",
+			path: "/products/low-stock?cursor=payhere",
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupInventoryControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodGet, tt.path, nil)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.inventoryService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_inventoryController_ListStockLots(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts inventoryControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 재고가 남은 로트 조회",
+			path: "/products/1/lots",
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.inventoryService.EXPECT().ListStockLots(mock.Anything, domain.ListStockLotsRequest{
+					UserID:    1,
+					ProductID: 1,
+				}).Return(domain.ListStockLotsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "PASS - 다 쓴 로트까지 조회",
+			path: "/products/1/lots?all=true",
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.inventoryService.EXPECT().ListStockLots(mock.Anything, domain.ListStockLotsRequest{
+					UserID:    1,
+					ProductID: 1,
+					All:       true,
+				}).Return(domain.ListStockLotsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 유효하지 않은 상품 ID",
+			path: "/products/0/lots",
+			mock: func(ts inventoryControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupInventoryControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodGet, tt.path, nil)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.inventoryService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
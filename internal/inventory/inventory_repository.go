@@ -0,0 +1,385 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+type inventoryRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewInventoryRepository(sqlDB *sql.DB) *inventoryRepository {
+	return &inventoryRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.InventoryRepository = (*inventoryRepository)(nil)
+
+// CreateStockMovement
+// 상품 행을 잠근 뒤 재고를 바꾸고 원장에 기록한다. 같은 상품의 입출고가 동시에 들어와도 차례로 반영되므로
+// 재고가 음수가 되는 판매, 폐기, 조정은 거절된다.
+// 재고를 늘리면 새 로트를 만들고, 줄이면 유통기한이 먼저 끝나는 로트부터 꺼낸 뒤 상품의 유통기한을 다시 계산한다.
+func (ir inventoryRepository) CreateStockMovement(ctx context.Context, movement domain.StockMovement) (domain.StockMovement, error) {
+	const op cerrors.Op = "inventory/inventoryRepository/CreateStockMovement"
+
+	tx, err := ir.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.StockMovement{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer tx.Rollback()
+
+	var quantity int
+	err = tx.QueryRowContext(ctx, lockProductStockQuery, movement.ProductID).Scan(&quantity)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.StockMovement{}, cerrors.E(op, cerrors.NotExist, err, "상품을 찾을 수 없습니다.")
+	}
+	if err != nil {
+		return domain.StockMovement{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	if err := recordStockMovement(ctx, tx, &movement, quantity); err != nil {
+		return domain.StockMovement{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.StockMovement{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return movement, nil
+}
+
+// CreateDisposal
+// 상품 행을 잠근 뒤 폐기 수량만큼 재고를 줄여 원장에 폐기(waste)로 기록하고, 잠근 시점의 원가로 손실액을 계산해 폐기 기록을 남긴다.
+func (ir inventoryRepository) CreateDisposal(ctx context.Context, disposal domain.Disposal) (domain.Disposal, error) {
+	const op cerrors.Op = "inventory/inventoryRepository/CreateDisposal"
+
+	tx, err := ir.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.Disposal{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer tx.Rollback()
+
+	var quantity int
+	err = tx.QueryRowContext(ctx, lockProductCostQuery, disposal.ProductID).Scan(&quantity, &disposal.UnitCost, &disposal.CategoryID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Disposal{}, cerrors.E(op, cerrors.NotExist, err, "상품을 찾을 수 없습니다.")
+	}
+	if err != nil {
+		return domain.Disposal{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	movement := domain.StockMovement{
+		ProductID: disposal.ProductID,
+		UserID:    disposal.UserID,
+		Type:      domain.StockMovementTypeWaste,
+		Quantity:  -disposal.Quantity,
+		Note:      disposal.Note,
+	}
+	if err := recordStockMovement(ctx, tx, &movement, quantity); err != nil {
+		return domain.Disposal{}, err
+	}
+
+	disposal.MovementID = movement.ID
+	disposal.QuantityAfter = movement.QuantityAfter
+	disposal.LossAmount = disposal.UnitCost * float64(disposal.Quantity)
+	disposal.CreateDate = movement.CreateDate
+
+	result, err := tx.ExecContext(
+		ctx,
+		createDisposalQuery,
+		disposal.ProductID,
+		disposal.UserID,
+		disposal.CategoryID,
+		disposal.MovementID,
+		disposal.Quantity,
+		disposal.Reason,
+		disposal.Note,
+		disposal.UnitCost,
+		disposal.LossAmount,
+		disposal.CreateDate,
+	)
+	if err != nil {
+		return domain.Disposal{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	disposalID, err := result.LastInsertId()
+	if err != nil {
+		return domain.Disposal{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	disposal.ID = int(disposalID)
+
+	if err := tx.Commit(); err != nil {
+		return domain.Disposal{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return disposal, nil
+}
+
+// recordStockMovement
+// 잠근 상품의 현재 재고(quantity)에 movement 를 반영하고 원장에 기록한다. 호출하는 쪽에서 상품 행을 잠그고 커밋한다.
+func recordStockMovement(ctx context.Context, tx *sql.Tx, movement *domain.StockMovement, quantity int) error {
+	const op cerrors.Op = "inventory/recordStockMovement"
+
+	movement.QuantityAfter = quantity + movement.Quantity
+	if movement.QuantityAfter < 0 {
+		return cerrors.E(op, cerrors.Invalid, fmt.Sprintf("재고가 부족합니다. (현재 재고: %d개)", quantity))
+	}
+	movement.CreateDate = time.Now().UTC()
+
+	if movement.Lot != nil {
+		if err := createStockLot(ctx, tx, movement.Lot); err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+	} else if movement.Quantity < 0 {
+		if err := consumeStockLots(ctx, tx, movement.ProductID, -movement.Quantity); err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, updateProductStockQuery, movement.QuantityAfter, movement.ProductID, movement.ProductID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	result, err := tx.ExecContext(
+		ctx,
+		createStockMovementQuery,
+		movement.ProductID,
+		movement.UserID,
+		movement.Type,
+		movement.Quantity,
+		movement.QuantityAfter,
+		movement.Note,
+		movement.CreateDate,
+	)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	movementID, err := result.LastInsertId()
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	movement.ID = int(movementID)
+
+	return nil
+}
+
+func createStockLot(ctx context.Context, tx *sql.Tx, lot *domain.StockLot) error {
+	result, err := tx.ExecContext(
+		ctx,
+		createStockLotQuery,
+		lot.ProductID,
+		lot.LotNumber,
+		lot.ReceivedDate,
+		lot.ExpiryDate,
+		lot.Quantity,
+		lot.RemainingQuantity,
+	)
+	if err != nil {
+		return err
+	}
+
+	lotID, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	lot.ID = int(lotID)
+
+	return nil
+}
+
+// consumeStockLots
+// 재고가 남은 로트를 잠그고 유통기한이 먼저 끝나는 로트부터 quantity 만큼 꺼낸다.
+func consumeStockLots(ctx context.Context, tx *sql.Tx, productID int, quantity int) error {
+	rows, err := tx.QueryContext(ctx, lockStockLotsQuery, productID)
+	if err != nil {
+		return err
+	}
+	lots, err := scanStockLots(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, lot := range takeFEFO(lots, quantity) {
+		if _, err := tx.ExecContext(ctx, updateStockLotRemainingQuery, lot.RemainingQuantity, lot.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// takeFEFO
+// lots 는 유통기한이 먼저 끝나는 순으로 정렬되어 있어야 한다. 앞의 로트부터 quantity 만큼 꺼내고 남은 수량이 바뀐 로트만 반환한다.
+// 로트가 없던 시절의 재고처럼 로트의 남은 수량이 모자라면 있는 만큼만 꺼낸다.
+func takeFEFO(lots []domain.StockLot, quantity int) []domain.StockLot {
+	var changed []domain.StockLot
+	for _, lot := range lots {
+		if quantity <= 0 {
+			break
+		}
+		taken := min(lot.RemainingQuantity, quantity)
+		lot.RemainingQuantity -= taken
+		quantity -= taken
+		changed = append(changed, lot)
+	}
+	return changed
+}
+
+func (ir inventoryRepository) ListStockLots(ctx context.Context, params domain.ListStockLotsParams) ([]domain.StockLot, error) {
+	const op cerrors.Op = "inventory/inventoryRepository/ListStockLots"
+
+	rows, err := ir.sqlDB.QueryContext(ctx, fmt.Sprintf(listStockLotsQuery, params.ExcludeEmpty()), params.ProductID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	lots, err := scanStockLots(rows)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return lots, nil
+}
+
+func scanStockLots(rows *sql.Rows) ([]domain.StockLot, error) {
+	defer rows.Close()
+
+	var lots []domain.StockLot
+	for rows.Next() {
+		var lot domain.StockLot
+		err := rows.Scan(
+			&lot.ID,
+			&lot.ProductID,
+			&lot.LotNumber,
+			&lot.ReceivedDate,
+			&lot.ExpiryDate,
+			&lot.Quantity,
+			&lot.RemainingQuantity,
+			&lot.CreateDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+		lots = append(lots, lot)
+	}
+
+	return lots, rows.Err()
+}
+
+func (ir inventoryRepository) ListStockMovements(ctx context.Context, params domain.ListStockMovementsParams) ([]domain.StockMovement, error) {
+	const op cerrors.Op = "inventory/inventoryRepository/ListStockMovements"
+
+	var movements []domain.StockMovement
+
+	query := fmt.Sprintf(listStockMovementsQuery,
+		params.EqualType(),
+		params.BeforeCursor(),
+	)
+
+	rows, err := ir.sqlDB.QueryContext(ctx, query, params.ProductID, params.Limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var movement domain.StockMovement
+		err := rows.Scan(
+			&movement.ID,
+			&movement.ProductID,
+			&movement.UserID,
+			&movement.Type,
+			&movement.Quantity,
+			&movement.QuantityAfter,
+			&movement.Note,
+			&movement.CreateDate,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		movements = append(movements, movement)
+	}
+
+	return movements, nil
+}
+
+func (ir inventoryRepository) ResetLowStockAlerts(ctx context.Context) error {
+	const op cerrors.Op = "inventory/inventoryRepository/ResetLowStockAlerts"
+
+	if _, err := ir.sqlDB.ExecContext(ctx, resetLowStockAlertsQuery); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// ListPendingLowStockAlerts
+// 재고가 기준 수량 이하이지만 아직 알림을 보내지 않은 상품을 조회한다.
+func (ir inventoryRepository) ListPendingLowStockAlerts(ctx context.Context, limit int) ([]domain.LowStockAlert, error) {
+	const op cerrors.Op = "inventory/inventoryRepository/ListPendingLowStockAlerts"
+
+	var alerts []domain.LowStockAlert
+
+	rows, err := ir.sqlDB.QueryContext(ctx, listPendingLowStockAlertsQuery, limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var alert domain.LowStockAlert
+		err := rows.Scan(
+			&alert.UserID,
+			&alert.ProductID,
+			&alert.ProductName,
+			&alert.Barcode,
+			&alert.StockQuantity,
+			&alert.ReorderPoint,
+			&alert.ReorderQuantity,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, nil
+}
+
+// ClaimLowStockAlert
+// 상품의 알림을 보냈다고 기록한다. 다른 서버가 먼저 기록했으면 false 를 반환하므로 알림은 한 번만 나간다.
+func (ir inventoryRepository) ClaimLowStockAlert(ctx context.Context, alert domain.LowStockAlert) (bool, error) {
+	const op cerrors.Op = "inventory/inventoryRepository/ClaimLowStockAlert"
+
+	result, err := ir.sqlDB.ExecContext(ctx, claimLowStockAlertQuery, alert.ProductID, alert.StockQuantity, alert.CreateDate)
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return affected == 1, nil
+}
+
+// ReleaseLowStockAlert
+// 알림을 보내지 못한 경우 기록을 지워 다음 평가 때 다시 보낸다.
+func (ir inventoryRepository) ReleaseLowStockAlert(ctx context.Context, productID int) error {
+	const op cerrors.Op = "inventory/inventoryRepository/ReleaseLowStockAlert"
+
+	if _, err := ir.sqlDB.ExecContext(ctx, releaseLowStockAlertQuery, productID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
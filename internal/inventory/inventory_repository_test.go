@@ -0,0 +1,437 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"testing"
+	"time"
+)
+
+type inventoryRepositoryTestSuite struct {
+	sqlDB               *sql.DB
+	sqlMock             sqlmock.Sqlmock
+	inventoryRepository domain.InventoryRepository
+}
+
+func setupInventoryRepositoryTestSuite() inventoryRepositoryTestSuite {
+	var us inventoryRepositoryTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.inventoryRepository = NewInventoryRepository(mockDB)
+
+	return us
+}
+
+func Test_inventoryRepository_CreateStockMovement(t *testing.T) {
+	receivedDate := time.Date(2024, time.February, 28, 9, 0, 0, 0, time.UTC)
+	expiryDate := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	lotColumns := []string{"id", "product_id", "lot_number", "received_date", "expiry_date", "quantity", "remaining_quantity", "create_date"}
+
+	tests := []struct {
+		name     string
+		movement domain.StockMovement
+		mock     func(ts inventoryRepositoryTestSuite)
+		want     domain.StockMovement
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 입고는 새 로트를 만든다",
+			movement: domain.StockMovement{
+				ProductID: 1,
+				UserID:    1,
+				Type:      domain.StockMovementTypeReceive,
+				Quantity:  10,
+				Note:      "오전 입고",
+				Lot: &domain.StockLot{
+					ProductID:         1,
+					LotNumber:         "L240228-01",
+					ReceivedDate:      receivedDate,
+					ExpiryDate:        expiryDate,
+					Quantity:          10,
+					RemainingQuantity: 10,
+				},
+			},
+			mock: func(ts inventoryRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery("SELECT stock_quantity FROM products WHERE id = \\? AND delete_date IS NULL FOR UPDATE").
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"stock_quantity"}).AddRow(3))
+				ts.sqlMock.ExpectExec("INSERT INTO stock_lots").
+					WithArgs(1, "L240228-01", receivedDate, expiryDate, 10, 10).
+					WillReturnResult(sqlmock.NewResult(8, 1))
+				ts.sqlMock.ExpectExec("UPDATE products SET stock_quantity = \\?, expiry_date = COALESCE\\(.*\\), version = version \\+ 1 WHERE id = \\?").
+					WithArgs(13, 1, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				ts.sqlMock.ExpectExec("INSERT INTO stock_movements").
+					WithArgs(1, 1, domain.StockMovementTypeReceive, 10, 13, "오전 입고", sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(5, 1))
+				ts.sqlMock.ExpectCommit()
+			},
+			want: domain.StockMovement{
+				ID:            5,
+				ProductID:     1,
+				UserID:        1,
+				Type:          domain.StockMovementTypeReceive,
+				Quantity:      10,
+				QuantityAfter: 13,
+				Note:          "오전 입고",
+				Lot: &domain.StockLot{
+					ID:                8,
+					ProductID:         1,
+					LotNumber:         "L240228-01",
+					ReceivedDate:      receivedDate,
+					ExpiryDate:        expiryDate,
+					Quantity:          10,
+					RemainingQuantity: 10,
+				},
+			},
+		},
+		{
+			name: "PASS - 판매는 유통기한이 먼저 끝나는 로트부터 꺼낸다",
+			movement: domain.StockMovement{
+				ProductID: 1,
+				UserID:    1,
+				Type:      domain.StockMovementTypeSale,
+				Quantity:  -4,
+			},
+			mock: func(ts inventoryRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery("SELECT stock_quantity FROM products").
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"stock_quantity"}).AddRow(13))
+				ts.sqlMock.ExpectQuery("SELECT .* FROM stock_lots WHERE product_id = \\? AND remaining_quantity > 0 ORDER BY expiry_date, id FOR UPDATE").
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows(lotColumns).
+						AddRow(7, 1, "", receivedDate, expiryDate.AddDate(0, 0, -5), 3, 3, receivedDate).
+						AddRow(8, 1, "L240228-01", receivedDate, expiryDate, 10, 10, receivedDate))
+				ts.sqlMock.ExpectExec("UPDATE stock_lots SET remaining_quantity").
+					WithArgs(0, 7).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				ts.sqlMock.ExpectExec("UPDATE stock_lots SET remaining_quantity").
+					WithArgs(9, 8).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				ts.sqlMock.ExpectExec("UPDATE products SET stock_quantity").
+					WithArgs(9, 1, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				ts.sqlMock.ExpectExec("INSERT INTO stock_movements").
+					WithArgs(1, 1, domain.StockMovementTypeSale, -4, 9, "", sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(6, 1))
+				ts.sqlMock.ExpectCommit()
+			},
+			want: domain.StockMovement{
+				ID:            6,
+				ProductID:     1,
+				UserID:        1,
+				Type:          domain.StockMovementTypeSale,
+				Quantity:      -4,
+				QuantityAfter: 9,
+			},
+		},
+		{
+			name: "FAIL - 재고보다 많은 판매는 롤백",
+			movement: domain.StockMovement{
+				ProductID: 1,
+				UserID:    1,
+				Type:      domain.StockMovementTypeSale,
+				Quantity:  -4,
+			},
+			mock: func(ts inventoryRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery("SELECT stock_quantity FROM products").
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"stock_quantity"}).AddRow(3))
+				ts.sqlMock.ExpectRollback()
+			},
+			wantKind: cerrors.Invalid,
+		},
+		{
+			name: "FAIL - 삭제된 상품",
+			movement: domain.StockMovement{
+				ProductID: 2,
+				UserID:    1,
+				Type:      domain.StockMovementTypeSale,
+				Quantity:  -1,
+			},
+			mock: func(ts inventoryRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery("SELECT stock_quantity FROM products").
+					WithArgs(2).
+					WillReturnError(sql.ErrNoRows)
+				ts.sqlMock.ExpectRollback()
+			},
+			wantKind: cerrors.NotExist,
+		},
+		{
+			name: "FAIL - 원장 기록 실패 시 롤백",
+			movement: domain.StockMovement{
+				ProductID: 1,
+				UserID:    1,
+				Type:      domain.StockMovementTypeWaste,
+				Quantity:  -1,
+			},
+			mock: func(ts inventoryRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery("SELECT stock_quantity FROM products").
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"stock_quantity"}).AddRow(1))
+				ts.sqlMock.ExpectQuery("SELECT .* FROM stock_lots").
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows(lotColumns))
+				ts.sqlMock.ExpectExec("UPDATE products SET stock_quantity").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				ts.sqlMock.ExpectExec("INSERT INTO stock_movements").
+					WillReturnError(sql.ErrConnDone)
+				ts.sqlMock.ExpectRollback()
+			},
+			wantKind: cerrors.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupInventoryRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.inventoryRepository.CreateStockMovement(context.Background(), tt.movement)
+
+			// then
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.False(t, got.CreateDate.IsZero())
+			got.CreateDate = time.Time{}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_inventoryRepository_CreateDisposal(t *testing.T) {
+	lotColumns := []string{"id", "product_id", "lot_number", "received_date", "expiry_date", "quantity", "remaining_quantity", "create_date"}
+
+	tests := []struct {
+		name     string
+		disposal domain.Disposal
+		mock     func(ts inventoryRepositoryTestSuite)
+		want     domain.Disposal
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 폐기 시점의 원가로 손실액을 계산한다",
+			disposal: domain.Disposal{
+				ProductID: 1,
+				UserID:    1,
+				Quantity:  3,
+				Reason:    domain.DisposalReasonExpired,
+				Note:      "유통기한 경과",
+			},
+			mock: func(ts inventoryRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery("SELECT stock_quantity, cost, category_id FROM products WHERE id = \\? AND delete_date IS NULL FOR UPDATE").
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"stock_quantity", "cost", "category_id"}).AddRow(10, 500.0, 2))
+				ts.sqlMock.ExpectQuery("SELECT .* FROM stock_lots").
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows(lotColumns))
+				ts.sqlMock.ExpectExec("UPDATE products SET stock_quantity").
+					WithArgs(7, 1, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				ts.sqlMock.ExpectExec("INSERT INTO stock_movements").
+					WithArgs(1, 1, domain.StockMovementTypeWaste, -3, 7, "유통기한 경과", sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(12, 1))
+				ts.sqlMock.ExpectExec("INSERT INTO disposals").
+					WithArgs(1, 1, 2, 12, 3, domain.DisposalReasonExpired, "유통기한 경과", 500.0, 1500.0, sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(4, 1))
+				ts.sqlMock.ExpectCommit()
+			},
+			want: domain.Disposal{
+				ID:            4,
+				ProductID:     1,
+				UserID:        1,
+				CategoryID:    2,
+				MovementID:    12,
+				Quantity:      3,
+				Reason:        domain.DisposalReasonExpired,
+				Note:          "유통기한 경과",
+				UnitCost:      500,
+				LossAmount:    1500,
+				QuantityAfter: 7,
+			},
+		},
+		{
+			name: "FAIL - 재고보다 많은 폐기는 롤백",
+			disposal: domain.Disposal{
+				ProductID: 1,
+				UserID:    1,
+				Quantity:  3,
+				Reason:    domain.DisposalReasonDamaged,
+			},
+			mock: func(ts inventoryRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery("SELECT stock_quantity, cost, category_id FROM products").
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"stock_quantity", "cost", "category_id"}).AddRow(2, 500.0, 2))
+				ts.sqlMock.ExpectRollback()
+			},
+			wantKind: cerrors.Invalid,
+		},
+		{
+			name: "FAIL - 삭제된 상품",
+			disposal: domain.Disposal{
+				ProductID: 2,
+				UserID:    1,
+				Quantity:  1,
+				Reason:    domain.DisposalReasonOther,
+			},
+			mock: func(ts inventoryRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectQuery("SELECT stock_quantity, cost, category_id FROM products").
+					WithArgs(2).
+					WillReturnError(sql.ErrNoRows)
+				ts.sqlMock.ExpectRollback()
+			},
+			wantKind: cerrors.NotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupInventoryRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.inventoryRepository.CreateDisposal(context.Background(), tt.disposal)
+
+			// then
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.False(t, got.CreateDate.IsZero())
+			got.CreateDate = time.Time{}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_takeFEFO(t *testing.T) {
+	lots := []domain.StockLot{
+		{ID: 1, RemainingQuantity: 2},
+		{ID: 2, RemainingQuantity: 5},
+		{ID: 3, RemainingQuantity: 4},
+	}
+
+	tests := []struct {
+		name     string
+		quantity int
+		want     []domain.StockLot
+	}{
+		{name: "첫 로트 안에서 꺼냄", quantity: 1, want: []domain.StockLot{{ID: 1, RemainingQuantity: 1}}},
+		{name: "여러 로트에 걸쳐 꺼냄", quantity: 6, want: []domain.StockLot{{ID: 1, RemainingQuantity: 0}, {ID: 2, RemainingQuantity: 1}}},
+		{name: "로트보다 많이 꺼냄", quantity: 20, want: []domain.StockLot{{ID: 1}, {ID: 2}, {ID: 3}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, takeFEFO(lots, tt.quantity))
+		})
+	}
+	assert.Equal(t, 2, lots[0].RemainingQuantity)
+}
+
+func Test_inventoryRepository_ListStockMovements(t *testing.T) {
+	// given
+	ts := setupInventoryRepositoryTestSuite()
+	createDate := time.Now()
+	cursor := 30
+	saleType := domain.StockMovementTypeSale
+	ts.sqlMock.ExpectQuery("SELECT id, product_id, user_id, type, quantity, quantity_after, note, create_date FROM stock_movements WHERE product_id = \\? AND type = 'sale' AND id < 30 ORDER BY id DESC LIMIT \\?").
+		WithArgs(1, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "user_id", "type", "quantity", "quantity_after", "note", "create_date"}).
+			AddRow(29, 1, 1, "sale", -2, 8, "", createDate))
+
+	// when
+	got, err := ts.inventoryRepository.ListStockMovements(context.Background(), domain.ListStockMovementsParams{
+		ProductID: 1,
+		Cursor:    &cursor,
+		Type:      &saleType,
+		Limit:     20,
+	})
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.StockMovement{
+		{ID: 29, ProductID: 1, UserID: 1, Type: domain.StockMovementTypeSale, Quantity: -2, QuantityAfter: 8, CreateDate: createDate},
+	}, got)
+}
+
+func Test_inventoryRepository_ClaimLowStockAlert(t *testing.T) {
+	tests := []struct {
+		name   string
+		result driver.Result
+		want   bool
+	}{
+		{name: "PASS - 처음 보내는 알림", result: sqlmock.NewResult(0, 1), want: true},
+		{name: "PASS - 다른 서버가 이미 보낸 알림", result: sqlmock.NewResult(0, 0), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupInventoryRepositoryTestSuite()
+			createDate := time.Now()
+			ts.sqlMock.ExpectExec("INSERT IGNORE INTO low_stock_alerts").
+				WithArgs(4, 2, createDate).
+				WillReturnResult(tt.result)
+
+			// when
+			got, err := ts.inventoryRepository.ClaimLowStockAlert(context.Background(), domain.LowStockAlert{
+				ProductID:     4,
+				StockQuantity: 2,
+				CreateDate:    createDate,
+			})
+
+			// then
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_inventoryRepository_ListPendingLowStockAlerts(t *testing.T) {
+	// given
+	ts := setupInventoryRepositoryTestSuite()
+	ts.sqlMock.ExpectQuery("SELECT p.user_id, p.id, p.name, p.barcode, p.stock_quantity, p.reorder_point, p.reorder_quantity FROM products p LEFT JOIN low_stock_alerts a .* AND a.product_id IS NULL ORDER BY p.id LIMIT \\?").
+		WithArgs(100).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "id", "name", "barcode", "stock_quantity", "reorder_point", "reorder_quantity"}).
+			AddRow(1, 4, "원두", "8801000000043", 2, 5, 20))
+
+	// when
+	got, err := ts.inventoryRepository.ListPendingLowStockAlerts(context.Background(), 100)
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.LowStockAlert{
+		{UserID: 1, ProductID: 4, ProductName: "원두", Barcode: "8801000000043", StockQuantity: 2, ReorderPoint: 5, ReorderQuantity: 20},
+	}, got)
+}
@@ -0,0 +1,208 @@
+package inventory
+
+import (
+	"context"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"strings"
+	"time"
+)
+
+type inventoryService struct {
+	productRepository   domain.ProductRepository
+	inventoryRepository domain.InventoryRepository
+}
+
+func NewInventoryService(productRepository domain.ProductRepository, inventoryRepository domain.InventoryRepository) *inventoryService {
+	return &inventoryService{
+		productRepository:   productRepository,
+		inventoryRepository: inventoryRepository,
+	}
+}
+
+var _ domain.InventoryService = (*inventoryService)(nil)
+
+func (is inventoryService) CreateStockMovement(ctx context.Context, req domain.CreateStockMovementRequest) (domain.CreateStockMovementResponse, error) {
+	const op cerrors.Op = "inventory/service/CreateStockMovement"
+
+	if _, err := is.getProduct(ctx, req.UserID, req.ProductID); err != nil {
+		return domain.CreateStockMovementResponse{}, err
+	}
+
+	movement, err := is.inventoryRepository.CreateStockMovement(ctx, domain.StockMovement{
+		ProductID: req.ProductID,
+		UserID:    req.UserID,
+		Type:      req.Type,
+		Quantity:  req.Delta(),
+		Note:      strings.TrimSpace(req.Note),
+		Lot:       stockLotFrom(req),
+	})
+	if cerrors.Is(cerrors.Invalid, err) || cerrors.Is(cerrors.NotExist, err) {
+		return domain.CreateStockMovementResponse{}, err
+	}
+	if err != nil {
+		return domain.CreateStockMovementResponse{}, cerrors.E(op, cerrors.Internal, err, "입출고를 기록하는 중에 에러가 발생했습니다.")
+	}
+
+	return domain.CreateStockMovementResponse{
+		Movement: domain.StockMovementDTOFrom(movement),
+	}, nil
+}
+
+// CreateDisposal
+// 폐기한 만큼 재고를 줄이고 폐기 시점의 원가로 손실액을 남긴다.
+func (is inventoryService) CreateDisposal(ctx context.Context, req domain.CreateDisposalRequest) (domain.CreateDisposalResponse, error) {
+	const op cerrors.Op = "inventory/service/CreateDisposal"
+
+	if _, err := is.getProduct(ctx, req.UserID, req.ProductID); err != nil {
+		return domain.CreateDisposalResponse{}, err
+	}
+
+	disposal, err := is.inventoryRepository.CreateDisposal(ctx, domain.Disposal{
+		ProductID: req.ProductID,
+		UserID:    req.UserID,
+		Quantity:  req.Quantity,
+		Reason:    req.Reason,
+		Note:      strings.TrimSpace(req.Note),
+	})
+	if cerrors.Is(cerrors.Invalid, err) || cerrors.Is(cerrors.NotExist, err) {
+		return domain.CreateDisposalResponse{}, err
+	}
+	if err != nil {
+		return domain.CreateDisposalResponse{}, cerrors.E(op, cerrors.Internal, err, "폐기를 기록하는 중에 에러가 발생했습니다.")
+	}
+
+	return domain.CreateDisposalResponse{
+		StockQuantity: disposal.QuantityAfter,
+		Disposal:      domain.DisposalDTOFrom(disposal),
+	}, nil
+}
+
+func (is inventoryService) ListStockMovements(ctx context.Context, req domain.ListStockMovementsRequest) (domain.ListStockMovementsResponse, error) {
+	const op cerrors.Op = "inventory/service/ListStockMovements"
+
+	product, err := is.getProduct(ctx, req.UserID, req.ProductID)
+	if err != nil {
+		return domain.ListStockMovementsResponse{}, err
+	}
+
+	movements, err := is.inventoryRepository.ListStockMovements(ctx, domain.ListStockMovementsParams{
+		ProductID: req.ProductID,
+		Cursor:    req.Cursor,
+		Type:      req.Type,
+		Limit:     domain.ListStockMovementsLimit,
+	})
+	if err != nil {
+		return domain.ListStockMovementsResponse{}, cerrors.E(op, cerrors.Internal, err, "입출고 내역을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	movementDTOs := make([]domain.StockMovementDTO, 0, len(movements))
+	for _, movement := range movements {
+		movementDTOs = append(movementDTOs, domain.StockMovementDTOFrom(movement))
+	}
+
+	var cursor *int
+	if len(movementDTOs) > 0 {
+		cursor = &movementDTOs[len(movementDTOs)-1].ID
+	}
+
+	return domain.ListStockMovementsResponse{
+		StockQuantity: product.StockQuantity,
+		Movements:     movementDTOs,
+		Cursor:        cursor,
+	}, nil
+}
+
+func (is inventoryService) ListStockLots(ctx context.Context, req domain.ListStockLotsRequest) (domain.ListStockLotsResponse, error) {
+	const op cerrors.Op = "inventory/service/ListStockLots"
+
+	product, err := is.getProduct(ctx, req.UserID, req.ProductID)
+	if err != nil {
+		return domain.ListStockLotsResponse{}, err
+	}
+
+	lots, err := is.inventoryRepository.ListStockLots(ctx, domain.ListStockLotsParams{
+		ProductID:    req.ProductID,
+		IncludeEmpty: req.All,
+	})
+	if err != nil {
+		return domain.ListStockLotsResponse{}, cerrors.E(op, cerrors.Internal, err, "로트를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	lotDTOs := make([]domain.StockLotDTO, 0, len(lots))
+	for _, lot := range lots {
+		lotDTOs = append(lotDTOs, domain.StockLotDTOFrom(lot))
+	}
+
+	return domain.ListStockLotsResponse{
+		StockQuantity: product.StockQuantity,
+		Lots:          lotDTOs,
+	}, nil
+}
+
+func (is inventoryService) ListLowStockProducts(ctx context.Context, req domain.ListLowStockProductsRequest) (domain.ListLowStockProductsResponse, error) {
+	const op cerrors.Op = "inventory/service/ListLowStockProducts"
+
+	products, err := is.productRepository.ListLowStockProducts(ctx, domain.ListLowStockProductsParams{
+		UserID: req.UserID,
+		Cursor: req.Cursor,
+		Limit:  domain.ListLowStockProductsLimit,
+	})
+	if err != nil {
+		return domain.ListLowStockProductsResponse{}, cerrors.E(op, cerrors.Internal, err, "재고 부족 상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	productDTOs := make([]domain.ProductDTO, 0, len(products))
+	for _, product := range products {
+		productDTOs = append(productDTOs, domain.ProductDTOFrom(product))
+	}
+
+	var cursor *int
+	if len(productDTOs) > 0 {
+		cursor = &productDTOs[len(productDTOs)-1].ID
+	}
+
+	return domain.ListLowStockProductsResponse{
+		Products: productDTOs,
+		Cursor:   cursor,
+	}, nil
+}
+
+// stockLotFrom
+// 재고를 늘리는 입출고는 요청한 유통기한으로 새 로트를 만든다. 재고를 줄이는 입출고는 nil 이다.
+func stockLotFrom(req domain.CreateStockMovementRequest) *domain.StockLot {
+	if req.Delta() <= 0 {
+		return nil
+	}
+
+	receivedDate := time.Now().UTC()
+	if req.ReceivedDate != nil {
+		receivedDate = req.ReceivedDate.UTC()
+	}
+
+	return &domain.StockLot{
+		ProductID:         req.ProductID,
+		LotNumber:         strings.TrimSpace(req.LotNumber),
+		ReceivedDate:      receivedDate,
+		ExpiryDate:        req.ExpiryDate.UTC(),
+		Quantity:          req.Delta(),
+		RemainingQuantity: req.Delta(),
+	}
+}
+
+func (is inventoryService) getProduct(ctx context.Context, userID, productID int) (*domain.Product, error) {
+	const op cerrors.Op = "inventory/service/getProduct"
+
+	product, err := is.productRepository.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if product == nil {
+		return nil, cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
+	}
+	if product.UserID != userID {
+		return nil, cerrors.E(op, cerrors.Permission, "상품의 재고를 관리할 권한이 없습니다.")
+	}
+
+	return product, nil
+}
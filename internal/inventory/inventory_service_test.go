@@ -0,0 +1,396 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"payhere/domain"
+	"payhere/mocks"
+	cerrors "payhere/pkg/cerrors"
+	"testing"
+	"time"
+)
+
+type inventoryServiceTestSuite struct {
+	productRepository   *mocks.ProductRepository
+	inventoryRepository *mocks.InventoryRepository
+	inventoryService    domain.InventoryService
+}
+
+func setupInventoryServiceTestSuite(t *testing.T) inventoryServiceTestSuite {
+	var us inventoryServiceTestSuite
+
+	us.productRepository = mocks.NewProductRepository(t)
+	us.inventoryRepository = mocks.NewInventoryRepository(t)
+	us.inventoryService = NewInventoryService(us.productRepository, us.inventoryRepository)
+
+	return us
+}
+
+func Test_inventoryService_CreateStockMovement(t *testing.T) {
+	receivedDate := time.Date(2024, time.February, 28, 9, 0, 0, 0, time.UTC)
+	expiryDate := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	type args struct {
+		ctx context.Context
+		req domain.CreateStockMovementRequest
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		mock     func(ts inventoryServiceTestSuite)
+		want     domain.CreateStockMovementResponse
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 판매는 재고를 줄인다",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateStockMovementRequest{
+					UserID:    1,
+					ProductID: 1,
+					Type:      domain.StockMovementTypeSale,
+					Quantity:  2,
+					Note:      " 현장 판매 ",
+				},
+			},
+			mock: func(ts inventoryServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 1).Return(&domain.Product{UserID: 1, StockQuantity: 5}, nil).Once()
+				ts.inventoryRepository.EXPECT().CreateStockMovement(mock.Anything, domain.StockMovement{
+					ProductID: 1,
+					UserID:    1,
+					Type:      domain.StockMovementTypeSale,
+					Quantity:  -2,
+					Note:      "현장 판매",
+				}).Return(domain.StockMovement{
+					ID:            7,
+					ProductID:     1,
+					UserID:        1,
+					Type:          domain.StockMovementTypeSale,
+					Quantity:      -2,
+					QuantityAfter: 3,
+					Note:          "현장 판매",
+				}, nil).Once()
+			},
+			want: domain.CreateStockMovementResponse{
+				Movement: domain.StockMovementDTO{
+					ID:            7,
+					ProductID:     1,
+					Type:          domain.StockMovementTypeSale,
+					Quantity:      -2,
+					QuantityAfter: 3,
+					Note:          "현장 판매",
+				},
+			},
+		},
+		{
+			name: "PASS - 입고는 새 로트로 들어간다",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateStockMovementRequest{
+					UserID:       1,
+					ProductID:    1,
+					Type:         domain.StockMovementTypeReceive,
+					Quantity:     10,
+					LotNumber:    " L240228-01 ",
+					ReceivedDate: &receivedDate,
+					ExpiryDate:   &expiryDate,
+				},
+			},
+			mock: func(ts inventoryServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 1).Return(&domain.Product{UserID: 1}, nil).Once()
+				ts.inventoryRepository.EXPECT().CreateStockMovement(mock.Anything, domain.StockMovement{
+					ProductID: 1,
+					UserID:    1,
+					Type:      domain.StockMovementTypeReceive,
+					Quantity:  10,
+					Lot: &domain.StockLot{
+						ProductID:         1,
+						LotNumber:         "L240228-01",
+						ReceivedDate:      receivedDate,
+						ExpiryDate:        expiryDate,
+						Quantity:          10,
+						RemainingQuantity: 10,
+					},
+				}).Return(domain.StockMovement{ID: 5, ProductID: 1, Type: domain.StockMovementTypeReceive, Quantity: 10, QuantityAfter: 10}, nil).Once()
+			},
+			want: domain.CreateStockMovementResponse{
+				Movement: domain.StockMovementDTO{
+					ID:            5,
+					ProductID:     1,
+					Type:          domain.StockMovementTypeReceive,
+					Quantity:      10,
+					QuantityAfter: 10,
+				},
+			},
+		},
+		{
+			name: "FAIL - 재고 부족",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateStockMovementRequest{
+					UserID:    1,
+					ProductID: 1,
+					Type:      domain.StockMovementTypeSale,
+					Quantity:  10,
+				},
+			},
+			mock: func(ts inventoryServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 1).Return(&domain.Product{UserID: 1, StockQuantity: 5}, nil).Once()
+				ts.inventoryRepository.EXPECT().CreateStockMovement(mock.Anything, mock.Anything).
+					Return(domain.StockMovement{}, cerrors.E(cerrors.Op("test"), cerrors.Invalid, "재고가 부족합니다. (현재 재고: 5개)")).Once()
+			},
+			wantKind: cerrors.Invalid,
+		},
+		{
+			name: "FAIL - 다른 사장님의 상품",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateStockMovementRequest{
+					UserID:    2,
+					ProductID: 1,
+					Type:      domain.StockMovementTypeReceive,
+					Quantity:  10,
+				},
+			},
+			mock: func(ts inventoryServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 1).Return(&domain.Product{UserID: 1}, nil).Once()
+			},
+			wantKind: cerrors.Permission,
+		},
+		{
+			name: "FAIL - 없는 상품",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateStockMovementRequest{
+					UserID:    1,
+					ProductID: 9,
+					Type:      domain.StockMovementTypeReceive,
+					Quantity:  10,
+				},
+			},
+			mock: func(ts inventoryServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 9).Return(nil, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+		{
+			name: "FAIL - 기록 중 에러",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateStockMovementRequest{
+					UserID:     1,
+					ProductID:  1,
+					Type:       domain.StockMovementTypeReceive,
+					Quantity:   10,
+					ExpiryDate: &expiryDate,
+				},
+			},
+			mock: func(ts inventoryServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 1).Return(&domain.Product{UserID: 1}, nil).Once()
+				ts.inventoryRepository.EXPECT().CreateStockMovement(mock.Anything, mock.Anything).Return(domain.StockMovement{}, sql.ErrConnDone).Once()
+			},
+			wantKind: cerrors.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupInventoryServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.inventoryService.CreateStockMovement(tt.args.ctx, tt.args.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_inventoryService_CreateDisposal(t *testing.T) {
+	createDate := time.Date(2024, time.February, 28, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		req      domain.CreateDisposalRequest
+		mock     func(ts inventoryServiceTestSuite)
+		want     domain.CreateDisposalResponse
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 폐기",
+			req:  domain.CreateDisposalRequest{UserID: 1, ProductID: 1, Quantity: 3, Reason: domain.DisposalReasonExpired, Note: " 유통기한 경과 "},
+			mock: func(ts inventoryServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 1).Return(&domain.Product{UserID: 1, StockQuantity: 10}, nil).Once()
+				ts.inventoryRepository.EXPECT().CreateDisposal(mock.Anything, domain.Disposal{
+					ProductID: 1,
+					UserID:    1,
+					Quantity:  3,
+					Reason:    domain.DisposalReasonExpired,
+					Note:      "유통기한 경과",
+				}).Return(domain.Disposal{
+					ID:            4,
+					ProductID:     1,
+					UserID:        1,
+					CategoryID:    2,
+					MovementID:    12,
+					Quantity:      3,
+					Reason:        domain.DisposalReasonExpired,
+					Note:          "유통기한 경과",
+					UnitCost:      500,
+					LossAmount:    1500,
+					QuantityAfter: 7,
+					CreateDate:    createDate,
+				}, nil).Once()
+			},
+			want: domain.CreateDisposalResponse{
+				StockQuantity: 7,
+				Disposal: domain.DisposalDTO{
+					ID:         4,
+					ProductID:  1,
+					CategoryID: 2,
+					MovementID: 12,
+					Quantity:   3,
+					Reason:     domain.DisposalReasonExpired,
+					Note:       "유통기한 경과",
+					UnitCost:   500,
+					LossAmount: 1500,
+					CreateDate: createDate,
+				},
+			},
+		},
+		{
+			name: "FAIL - 다른 사장님의 상품",
+			req:  domain.CreateDisposalRequest{UserID: 2, ProductID: 1, Quantity: 3, Reason: domain.DisposalReasonDamaged},
+			mock: func(ts inventoryServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 1).Return(&domain.Product{UserID: 1}, nil).Once()
+			},
+			wantKind: cerrors.Permission,
+		},
+		{
+			name: "FAIL - 재고 부족",
+			req:  domain.CreateDisposalRequest{UserID: 1, ProductID: 1, Quantity: 30, Reason: domain.DisposalReasonDamaged},
+			mock: func(ts inventoryServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 1).Return(&domain.Product{UserID: 1}, nil).Once()
+				ts.inventoryRepository.EXPECT().CreateDisposal(mock.Anything, mock.Anything).
+					Return(domain.Disposal{}, cerrors.E(cerrors.Op("test"), cerrors.Invalid, "재고가 부족합니다.")).Once()
+			},
+			wantKind: cerrors.Invalid,
+		},
+		{
+			name: "FAIL - 기록 중 에러",
+			req:  domain.CreateDisposalRequest{UserID: 1, ProductID: 1, Quantity: 3, Reason: domain.DisposalReasonOther},
+			mock: func(ts inventoryServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 1).Return(&domain.Product{UserID: 1}, nil).Once()
+				ts.inventoryRepository.EXPECT().CreateDisposal(mock.Anything, mock.Anything).Return(domain.Disposal{}, sql.ErrConnDone).Once()
+			},
+			wantKind: cerrors.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupInventoryServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.inventoryService.CreateDisposal(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_inventoryService_ListStockMovements(t *testing.T) {
+	// given
+	ts := setupInventoryServiceTestSuite(t)
+	cursor := 30
+	ts.productRepository.EXPECT().GetProduct(mock.Anything, 1).Return(&domain.Product{UserID: 1, StockQuantity: 8}, nil).Once()
+	ts.inventoryRepository.EXPECT().ListStockMovements(mock.Anything, domain.ListStockMovementsParams{
+		ProductID: 1,
+		Cursor:    &cursor,
+		Limit:     domain.ListStockMovementsLimit,
+	}).Return([]domain.StockMovement{
+		{ID: 29, ProductID: 1, Type: domain.StockMovementTypeSale, Quantity: -2, QuantityAfter: 8},
+		{ID: 25, ProductID: 1, Type: domain.StockMovementTypeReceive, Quantity: 10, QuantityAfter: 10},
+	}, nil).Once()
+
+	// when
+	got, err := ts.inventoryService.ListStockMovements(context.Background(), domain.ListStockMovementsRequest{
+		UserID:    1,
+		ProductID: 1,
+		Cursor:    &cursor,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, 8, got.StockQuantity)
+	assert.Len(t, got.Movements, 2)
+	assert.Equal(t, 25, *got.Cursor)
+}
+
+func Test_inventoryService_ListLowStockProducts(t *testing.T) {
+	// given
+	ts := setupInventoryServiceTestSuite(t)
+	reorderPoint := 5
+	ts.productRepository.EXPECT().ListLowStockProducts(mock.Anything, domain.ListLowStockProductsParams{
+		UserID: 1,
+		Limit:  domain.ListLowStockProductsLimit,
+	}).Return([]domain.Product{
+		{Base: domain.Base{ID: 4}, UserID: 1, Name: "원두", StockQuantity: 2, ReorderPoint: &reorderPoint, ReorderQuantity: 20},
+	}, nil).Once()
+
+	// when
+	got, err := ts.inventoryService.ListLowStockProducts(context.Background(), domain.ListLowStockProductsRequest{
+		UserID: 1,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, got.Products, 1)
+	assert.Equal(t, 2, got.Products[0].StockQuantity)
+	assert.Equal(t, 4, *got.Cursor)
+}
+
+func Test_inventoryService_ListStockLots(t *testing.T) {
+	// given
+	ts := setupInventoryServiceTestSuite(t)
+	expiryDate := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	ts.productRepository.EXPECT().GetProduct(mock.Anything, 1).Return(&domain.Product{UserID: 1, StockQuantity: 9}, nil).Once()
+	ts.inventoryRepository.EXPECT().ListStockLots(mock.Anything, domain.ListStockLotsParams{
+		ProductID: 1,
+	}).Return([]domain.StockLot{
+		{ID: 8, ProductID: 1, LotNumber: "L240228-01", ExpiryDate: expiryDate, Quantity: 10, RemainingQuantity: 9},
+	}, nil).Once()
+
+	// when
+	got, err := ts.inventoryService.ListStockLots(context.Background(), domain.ListStockLotsRequest{
+		UserID:    1,
+		ProductID: 1,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ListStockLotsResponse{
+		StockQuantity: 9,
+		Lots: []domain.StockLotDTO{
+			{ID: 8, LotNumber: "L240228-01", ExpiryDate: expiryDate, Quantity: 10, RemainingQuantity: 9},
+		},
+	}, got)
+}
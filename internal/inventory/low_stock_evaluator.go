@@ -0,0 +1,88 @@
+package inventory
+
+import (
+	"context"
+	"log"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+const (
+	defaultLowStockInterval = time.Minute
+	lowStockAlertBatchSize  = 100
+)
+
+// LowStockEvaluator
+// 주기적으로 재고가 재고 알림 기준 수량 이하로 떨어진 상품을 찾아 알림을 보낸다.
+// 알림을 보낸 상품은 low_stock_alerts 에 기록해 두고, 재고가 기준 수량 위로 올라가면 기록을 지워 다음에 떨어졌을 때 다시 보낸다.
+type LowStockEvaluator struct {
+	inventoryRepository domain.InventoryRepository
+	notifier            domain.Notifier
+	interval            time.Duration
+}
+
+func NewLowStockEvaluator(inventoryRepository domain.InventoryRepository, notifier domain.Notifier, interval time.Duration) *LowStockEvaluator {
+	if interval <= 0 {
+		interval = defaultLowStockInterval
+	}
+	return &LowStockEvaluator{
+		inventoryRepository: inventoryRepository,
+		notifier:            notifier,
+		interval:            interval,
+	}
+}
+
+// Run
+// ctx 가 끝날 때까지 interval 마다 Evaluate 를 실행한다.
+func (e *LowStockEvaluator) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.Evaluate(ctx); err != nil {
+			log.Println(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *LowStockEvaluator) Evaluate(ctx context.Context) error {
+	const op cerrors.Op = "inventory/LowStockEvaluator/Evaluate"
+
+	if err := e.inventoryRepository.ResetLowStockAlerts(ctx); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "재고 알림 기록을 정리하는 중에 에러가 발생했습니다.")
+	}
+
+	alerts, err := e.inventoryRepository.ListPendingLowStockAlerts(ctx, lowStockAlertBatchSize)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "재고 부족 상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	for _, alert := range alerts {
+		alert.CreateDate = time.Now().UTC()
+
+		// 여러 서버에서 함께 실행해도 먼저 기록한 서버만 알림을 보낸다.
+		claimed, err := e.inventoryRepository.ClaimLowStockAlert(ctx, alert)
+		if err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "재고 알림을 기록하는 중에 에러가 발생했습니다.")
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := e.notifier.NotifyLowStock(ctx, alert); err != nil {
+			if err := e.inventoryRepository.ReleaseLowStockAlert(ctx, alert.ProductID); err != nil {
+				return cerrors.E(op, cerrors.Internal, err, "재고 알림 기록을 되돌리는 중에 에러가 발생했습니다.")
+			}
+			return cerrors.E(op, cerrors.Internal, err, "재고 부족 알림을 보내는 중에 에러가 발생했습니다.")
+		}
+	}
+
+	return nil
+}
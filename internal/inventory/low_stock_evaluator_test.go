@@ -0,0 +1,73 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"payhere/domain"
+	"payhere/mocks"
+	"testing"
+)
+
+func TestLowStockEvaluator_Evaluate(t *testing.T) {
+	alerts := []domain.LowStockAlert{
+		{UserID: 1, ProductID: 4, ProductName: "원두", StockQuantity: 2, ReorderPoint: 5, ReorderQuantity: 20},
+		{UserID: 1, ProductID: 7, ProductName: "우유", StockQuantity: 0, ReorderPoint: 3, ReorderQuantity: 12},
+	}
+
+	tests := []struct {
+		name    string
+		mock    func(repository *mocks.InventoryRepository, notifier *mocks.Notifier)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 새로 재고가 부족해진 상품에 알림",
+			mock: func(repository *mocks.InventoryRepository, notifier *mocks.Notifier) {
+				repository.EXPECT().ResetLowStockAlerts(mock.Anything).Return(nil).Once()
+				repository.EXPECT().ListPendingLowStockAlerts(mock.Anything, lowStockAlertBatchSize).Return(alerts, nil).Once()
+				repository.EXPECT().ClaimLowStockAlert(mock.Anything, mock.MatchedBy(func(alert domain.LowStockAlert) bool { return alert.ProductID == 4 })).Return(true, nil).Once()
+				repository.EXPECT().ClaimLowStockAlert(mock.Anything, mock.MatchedBy(func(alert domain.LowStockAlert) bool { return alert.ProductID == 7 })).Return(true, nil).Once()
+				notifier.EXPECT().NotifyLowStock(mock.Anything, mock.MatchedBy(func(alert domain.LowStockAlert) bool { return alert.ProductID == 4 })).Return(nil).Once()
+				notifier.EXPECT().NotifyLowStock(mock.Anything, mock.MatchedBy(func(alert domain.LowStockAlert) bool { return alert.ProductID == 7 })).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 다른 서버가 먼저 기록한 알림은 보내지 않음",
+			mock: func(repository *mocks.InventoryRepository, notifier *mocks.Notifier) {
+				repository.EXPECT().ResetLowStockAlerts(mock.Anything).Return(nil).Once()
+				repository.EXPECT().ListPendingLowStockAlerts(mock.Anything, lowStockAlertBatchSize).Return(alerts[:1], nil).Once()
+				repository.EXPECT().ClaimLowStockAlert(mock.Anything, mock.Anything).Return(false, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 알림을 보내지 못하면 기록을 되돌림",
+			mock: func(repository *mocks.InventoryRepository, notifier *mocks.Notifier) {
+				repository.EXPECT().ResetLowStockAlerts(mock.Anything).Return(nil).Once()
+				repository.EXPECT().ListPendingLowStockAlerts(mock.Anything, lowStockAlertBatchSize).Return(alerts[:1], nil).Once()
+				repository.EXPECT().ClaimLowStockAlert(mock.Anything, mock.Anything).Return(true, nil).Once()
+				notifier.EXPECT().NotifyLowStock(mock.Anything, mock.Anything).Return(errors.New("notifier unavailable")).Once()
+				repository.EXPECT().ReleaseLowStockAlert(mock.Anything, 4).Return(nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			repository := mocks.NewInventoryRepository(t)
+			notifier := mocks.NewNotifier(t)
+			tt.mock(repository, notifier)
+			evaluator := NewLowStockEvaluator(repository, notifier, 0)
+
+			// when
+			err := evaluator.Evaluate(context.Background())
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
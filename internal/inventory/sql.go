@@ -0,0 +1,148 @@
+package inventory
+
+const lockProductStockQuery = `
+	SELECT 
+		stock_quantity 
+	FROM 
+		products 
+	WHERE 
+		id = ? 
+		AND delete_date IS NULL 
+	FOR UPDATE
+`
+
+// 폐기할 때는 손실액을 계산하려고 원가와 카테고리도 함께 잠근다.
+const lockProductCostQuery = `
+	SELECT 
+		stock_quantity, 
+		cost, 
+		category_id 
+	FROM 
+		products 
+	WHERE 
+		id = ? 
+		AND delete_date IS NULL 
+	FOR UPDATE
+`
+
+// 재고가 남은 로트가 있으면 상품의 유통기한을 가장 먼저 끝나는 로트의 유통기한으로 맞춘다.
+// 재고와 유통기한도 상품 응답에 담기므로 버전을 올려 이전 ETag 로는 수정하지 못하게 한다.
+const updateProductStockQuery = `
+	UPDATE 
+		products 
+	SET 
+		stock_quantity = ?, 
+		expiry_date = COALESCE(
+			(SELECT MIN(l.expiry_date) FROM stock_lots l WHERE l.product_id = ? AND l.remaining_quantity > 0), 
+			expiry_date
+		), 
+		version = version + 1 
+	WHERE 
+		id = ?
+`
+
+const createStockLotQuery = `INSERT INTO stock_lots (product_id, lot_number, received_date, expiry_date, quantity, remaining_quantity) VALUES (?, ?, ?, ?, ?, ?)`
+
+const lockStockLotsQuery = `
+	SELECT 
+		id, 
+		product_id, 
+		lot_number, 
+		received_date, 
+		expiry_date, 
+		quantity, 
+		remaining_quantity, 
+		create_date 
+	FROM 
+		stock_lots 
+	WHERE 
+		product_id = ? 
+		AND remaining_quantity > 0 
+	ORDER BY 
+		expiry_date, 
+		id 
+	FOR UPDATE
+`
+
+const updateStockLotRemainingQuery = `UPDATE stock_lots SET remaining_quantity = ? WHERE id = ?`
+
+const listStockLotsQuery = `
+	SELECT 
+		id, 
+		product_id, 
+		lot_number, 
+		received_date, 
+		expiry_date, 
+		quantity, 
+		remaining_quantity, 
+		create_date 
+	FROM 
+		stock_lots 
+	WHERE 
+		product_id = ? 
+		%s
+	ORDER BY 
+		expiry_date, 
+		id
+`
+
+const createStockMovementQuery = `INSERT INTO stock_movements (product_id, user_id, type, quantity, quantity_after, note, create_date) VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+const createDisposalQuery = `INSERT INTO disposals (product_id, user_id, category_id, movement_id, quantity, reason, note, unit_cost, loss_amount, create_date) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+const listStockMovementsQuery = `
+	SELECT 
+		id, 
+		product_id, 
+		user_id, 
+		type, 
+		quantity, 
+		quantity_after, 
+		note, 
+		create_date 
+	FROM 
+		stock_movements 
+	WHERE 
+		product_id = ? 
+		%s %s
+	ORDER BY 
+		id DESC
+	LIMIT ?
+`
+
+// 재고가 기준 수량 위로 올라갔거나 재고 알림을 끈 상품은 다음에 다시 떨어졌을 때 알림을 보낼 수 있도록 지운다.
+const resetLowStockAlertsQuery = `
+	DELETE a 
+	FROM 
+		low_stock_alerts a 
+		JOIN products p ON p.id = a.product_id 
+	WHERE 
+		p.reorder_point IS NULL 
+		OR p.stock_quantity > p.reorder_point
+`
+
+const listPendingLowStockAlertsQuery = `
+	SELECT 
+		p.user_id, 
+		p.id, 
+		p.name, 
+		p.barcode, 
+		p.stock_quantity, 
+		p.reorder_point, 
+		p.reorder_quantity 
+	FROM 
+		products p 
+		LEFT JOIN low_stock_alerts a ON a.product_id = p.id 
+	WHERE 
+		p.delete_date IS NULL 
+		AND p.reorder_point IS NOT NULL 
+		AND p.stock_quantity <= p.reorder_point 
+		AND a.product_id IS NULL 
+	ORDER BY 
+		p.id 
+	LIMIT ?
+`
+
+const claimLowStockAlertQuery = `INSERT IGNORE INTO low_stock_alerts (product_id, stock_quantity, create_date) VALUES (?, ?, ?)`
+
+const releaseLowStockAlertQuery = `DELETE FROM low_stock_alerts WHERE product_id = ?`
@@ -0,0 +1,144 @@
+package markdown
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"payhere/config"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"payhere/pkg/router"
+	"time"
+)
+
+func RegisterRoutes(e *gin.Engine, controller domain.MarkdownController, authTokenRepository domain.AuthTokenRepository, cfg *config.Config) {
+	rules := e.Group("/markdown-rules")
+	{
+		rules.POST("", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.CreateMarkdownRule)
+		rules.DELETE("/:ruleID", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.DeleteMarkdownRule)
+		rules.GET("", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ListMarkdownRules)
+	}
+}
+
+type markdownController struct {
+	markdownService domain.MarkdownService
+}
+
+func NewMarkdownController(service domain.MarkdownService) *markdownController {
+	return &markdownController{
+		markdownService: service,
+	}
+}
+
+var _ domain.MarkdownController = (*markdownController)(nil)
+
+// CreateMarkdownRule
+// @Summary 유통기한 임박 할인 규칙 생성
+// @Description 유통기한이 withinHours 시간 안으로 남은 상품을 discountPercent 만큼 할인합니다. 할인 대상은 카테고리(categoryID)와 상품(productID) 중 하나만 정합니다. 같은 상품에 상품 규칙과 카테고리 규칙이 모두 걸리면 상품 규칙을, 같은 종류의 규칙이 여러 개 걸리면 할인율이 가장 큰 규칙을 씁니다. 할인가는 서버 작업이 주기적으로 적용하고 되돌립니다.
+// @Tags Markdown
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param CreateMarkdownRuleRequest body domain.CreateMarkdownRuleRequest true "할인 규칙 생성 요청"
+// @Success 204
+// @Router /markdown-rules [post]
+func (mc markdownController) CreateMarkdownRule(c *gin.Context) {
+	var req domain.CreateMarkdownRuleRequest
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := mc.markdownService.CreateMarkdownRule(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DeleteMarkdownRule
+// @Summary 유통기한 임박 할인 규칙 삭제
+// @Description 규칙을 삭제하면 이 규칙으로 할인 중인 상품은 다음 할인 작업 때 정가로 돌아갑니다. (단 자신의 규칙만 삭제 가능)
+// @Tags Markdown
+// @Produce json
+// @Param ruleID path int true "할인 규칙 ID"
+// @Security BearerAuth
+// @Success 204
+// @Router /markdown-rules/{ruleID} [delete]
+func (mc markdownController) DeleteMarkdownRule(c *gin.Context) {
+	var req domain.DeleteMarkdownRuleRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := mc.markdownService.DeleteMarkdownRule(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListMarkdownRules
+// @Summary 유통기한 임박 할인 규칙 목록 조회
+// @Description 사장님의 할인 규칙을 생성 순으로 조회합니다.
+// @Tags Markdown
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} domain.ListMarkdownRulesResponse "할인 규칙 목록"
+// @Router /markdown-rules [get]
+func (mc markdownController) ListMarkdownRules(c *gin.Context) {
+	var req domain.ListMarkdownRulesRequest
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := mc.markdownService.ListMarkdownRules(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
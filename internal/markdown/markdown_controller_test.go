@@ -0,0 +1,198 @@
+package markdown
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/utils/pointer"
+	"net/http"
+	"net/http/httptest"
+	"payhere/config"
+	"payhere/domain"
+	"payhere/internal/auth_token"
+	"payhere/mocks"
+	"testing"
+	"time"
+)
+
+type markdownControllerTestSuite struct {
+	router             *gin.Engine
+	cfg                *config.Config
+	autRepository      *mocks.AuthTokenRepository
+	markdownService    *mocks.MarkdownService
+	markdownController domain.MarkdownController
+}
+
+func setupMarkdownControllerTestSuite(t *testing.T) markdownControllerTestSuite {
+	var us markdownControllerTestSuite
+
+	gin.SetMode(gin.TestMode)
+	us.router = gin.Default()
+	us.autRepository = mocks.NewAuthTokenRepository(t)
+	us.markdownService = mocks.NewMarkdownService(t)
+	us.cfg = &config.Config{
+		Auth: config.Auth{
+			Secret: "payhere_test_secret",
+		},
+	}
+
+	us.markdownController = NewMarkdownController(us.markdownService)
+	RegisterRoutes(
+		us.router, us.markdownController,
+		us.autRepository,
+		us.cfg,
+	)
+
+	return us
+}
+
+func (ts markdownControllerTestSuite) newRequest(method string, path string, body *bytes.Reader) *http.Request {
+	var req *http.Request
+	if body == nil {
+		req, _ = http.NewRequest(method, path, nil)
+	} else {
+		req, _ = http.NewRequest(method, path, body)
+		req.Header.Set("Content-Type", "application/json")
+	}
+	token, _ := auth_token.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return req
+}
+
+func (ts markdownControllerTestSuite) expectAuthToken() {
+	ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+		mock.Anything,
+		mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+	).Return(domain.AuthToken{
+		ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+		Active:         true,
+	}, nil).Once()
+}
+
+func Test_markdownController_CreateMarkdownRule(t *testing.T) {
+	tests := []struct {
+		name string
+		body domain.CreateMarkdownRuleRequest
+		mock func(ts markdownControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 카테고리 규칙 생성",
+			body: domain.CreateMarkdownRuleRequest{CategoryID: pointer.Int(2), WithinHours: 24, DiscountPercent: 30},
+			mock: func(ts markdownControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.markdownService.EXPECT().CreateMarkdownRule(mock.Anything, domain.CreateMarkdownRuleRequest{
+					UserID:          1,
+					CategoryID:      pointer.Int(2),
+					WithinHours:     24,
+					DiscountPercent: 30,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 할인 대상을 둘 다 정함",
+			body: domain.CreateMarkdownRuleRequest{CategoryID: pointer.Int(2), ProductID: pointer.Int(7), WithinHours: 24, DiscountPercent: 30},
+			mock: func(ts markdownControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 할인율 초과",
+			body: domain.CreateMarkdownRuleRequest{ProductID: pointer.Int(7), WithinHours: 24, DiscountPercent: 100},
+			mock: func(ts markdownControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupMarkdownControllerTestSuite(t)
+			tt.mock(ts)
+			body, _ := json.Marshal(tt.body)
+			req := ts.newRequest(http.MethodPost, "/markdown-rules", bytes.NewReader(body))
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.markdownService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_markdownController_DeleteMarkdownRule(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts markdownControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 유효한 규칙 ID",
+			path: "/markdown-rules/3",
+			mock: func(ts markdownControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.markdownService.EXPECT().DeleteMarkdownRule(mock.Anything, domain.DeleteMarkdownRuleRequest{
+					UserID: 1,
+					ID:     3,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 유효하지 않은 규칙 ID",
+			path: "/markdown-rules/0",
+			mock: func(ts markdownControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupMarkdownControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodDelete, tt.path, nil)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.markdownService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_markdownController_ListMarkdownRules(t *testing.T) {
+	// given
+	ts := setupMarkdownControllerTestSuite(t)
+	ts.expectAuthToken()
+	ts.markdownService.EXPECT().ListMarkdownRules(mock.Anything, domain.ListMarkdownRulesRequest{UserID: 1}).
+		Return(domain.ListMarkdownRulesResponse{}, nil).Once()
+	req := ts.newRequest(http.MethodGet, "/markdown-rules", nil)
+
+	// when
+	rec := httptest.NewRecorder()
+	ts.router.ServeHTTP(rec, req)
+
+	// then
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,160 @@
+package markdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+const (
+	defaultMarkdownInterval = time.Minute
+	markdownUserBatchSize   = 100
+)
+
+// MarkdownJob
+// 주기적으로 사장님의 할인 규칙에 맞춰 상품의 할인가를 적용하거나 되돌리고, 바뀔 때마다 가격 변경 기록을 남긴다.
+// 할인가는 이전 값이 그대로일 때만 바꾸므로 여러 서버에서 함께 실행해도 같은 변경은 한 번만 기록된다.
+type MarkdownJob struct {
+	userRepository     domain.UserRepository
+	markdownRepository domain.MarkdownRepository
+	interval           time.Duration
+}
+
+func NewMarkdownJob(userRepository domain.UserRepository, markdownRepository domain.MarkdownRepository, interval time.Duration) *MarkdownJob {
+	if interval <= 0 {
+		interval = defaultMarkdownInterval
+	}
+	return &MarkdownJob{
+		userRepository:     userRepository,
+		markdownRepository: markdownRepository,
+		interval:           interval,
+	}
+}
+
+// Run
+// ctx 가 끝날 때까지 interval 마다 Apply 를 실행한다.
+func (j *MarkdownJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.Apply(ctx, time.Now().UTC()); err != nil {
+			log.Println(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Apply
+// 한 사장님의 할인을 적용하지 못해도 나머지 사장님은 적용하고, 적용하지 못한 할인은 다음 실행 때 다시 적용한다.
+func (j *MarkdownJob) Apply(ctx context.Context, now time.Time) error {
+	const op cerrors.Op = "markdown/MarkdownJob/Apply"
+
+	var failed []error
+	cursor := 0
+	for {
+		users, err := j.userRepository.ListUsersAfter(ctx, cursor, markdownUserBatchSize)
+		if err != nil {
+			return cerrors.E(op, cerrors.Internal, errors.Join(append(failed, err)...), "사장님 목록을 조회하는 중에 에러가 발생했습니다.")
+		}
+
+		for _, user := range users {
+			if err := j.applyUser(ctx, user.ID, now); err != nil {
+				log.Printf("markdown for user %d: %v", user.ID, err)
+				failed = append(failed, err)
+			}
+		}
+
+		if len(users) < markdownUserBatchSize {
+			break
+		}
+		cursor = users[len(users)-1].ID
+	}
+
+	if len(failed) > 0 {
+		return cerrors.E(op, cerrors.Internal, errors.Join(failed...), fmt.Sprintf("사장님 %d명의 할인을 적용하지 못했습니다.", len(failed)))
+	}
+	return nil
+}
+
+func (j *MarkdownJob) applyUser(ctx context.Context, userID int, now time.Time) error {
+	const op cerrors.Op = "markdown/MarkdownJob/applyUser"
+
+	rules, err := j.markdownRepository.ListMarkdownRules(ctx, userID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "할인 규칙을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	var maxWithin time.Duration
+	for _, rule := range rules {
+		maxWithin = max(maxWithin, rule.Within)
+	}
+
+	products, err := j.markdownRepository.ListMarkdownCandidates(ctx, domain.ListMarkdownCandidatesParams{
+		UserID: userID,
+		Since:  now,
+		Until:  now.Add(maxWithin),
+	})
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "할인 대상 상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	for _, product := range products {
+		price, _ := domain.MarkdownPriceFor(product, rules, now)
+		if samePrice(product.MarkdownPrice, price) {
+			continue
+		}
+
+		change := markdownChangeFrom(product, price, now)
+		if _, err := j.markdownRepository.ApplyMarkdown(ctx, change); err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "할인가를 적용하는 중에 에러가 발생했습니다.")
+		}
+	}
+
+	return nil
+}
+
+// markdownChangeFrom
+// 상품의 할인가를 price 로 바꾸는 변경과 가격 변경 기록. 정가와 원가는 그대로이고 실제 판매가만 바뀐다.
+func markdownChangeFrom(product domain.Product, price *float64, now time.Time) domain.MarkdownChange {
+	reason := domain.PriceChangeReasonMarkdown
+	newEffectivePrice := product.Price
+	if price == nil {
+		reason = domain.PriceChangeReasonMarkdownEnd
+	} else {
+		newEffectivePrice = *price
+	}
+
+	return domain.MarkdownChange{
+		ProductID: product.ID,
+		From:      product.MarkdownPrice,
+		To:        price,
+		History: domain.PriceHistory{
+			ProductID:         product.ID,
+			Reason:            reason,
+			OldPrice:          product.Price,
+			NewPrice:          product.Price,
+			OldCost:           product.Cost,
+			NewCost:           product.Cost,
+			OldEffectivePrice: product.EffectivePrice(),
+			NewEffectivePrice: newEffectivePrice,
+			CreateDate:        now,
+		},
+	}
+}
+
+func samePrice(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,123 @@
+package markdown
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/utils/pointer"
+	"payhere/domain"
+	"payhere/mocks"
+	"testing"
+	"time"
+)
+
+func TestMarkdownJob_Apply(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	users := []domain.User{{Base: domain.Base{ID: 1}}}
+	rules := []domain.MarkdownRule{
+		{Base: domain.Base{ID: 1}, UserID: 1, CategoryID: pointer.Int(2), Within: 24 * time.Hour, DiscountPercent: 30},
+	}
+
+	tests := []struct {
+		name    string
+		mock    func(userRepository *mocks.UserRepository, markdownRepository *mocks.MarkdownRepository)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 유통기한이 임박한 상품 할인 시작",
+			mock: func(userRepository *mocks.UserRepository, markdownRepository *mocks.MarkdownRepository) {
+				userRepository.EXPECT().ListUsersAfter(mock.Anything, 0, markdownUserBatchSize).Return(users, nil).Once()
+				markdownRepository.EXPECT().ListMarkdownRules(mock.Anything, 1).Return(rules, nil).Once()
+				markdownRepository.EXPECT().ListMarkdownCandidates(mock.Anything, domain.ListMarkdownCandidatesParams{
+					UserID: 1,
+					Since:  now,
+					Until:  now.Add(24 * time.Hour),
+				}).Return([]domain.Product{
+					{Base: domain.Base{ID: 7}, UserID: 1, CategoryID: 2, Price: 3000, Cost: 1500, ExpiryDate: now.Add(10 * time.Hour)},
+				}, nil).Once()
+				markdownRepository.EXPECT().ApplyMarkdown(mock.Anything, domain.MarkdownChange{
+					ProductID: 7,
+					To:        pointer.Float64(2100),
+					History: domain.PriceHistory{
+						ProductID:         7,
+						Reason:            domain.PriceChangeReasonMarkdown,
+						OldPrice:          3000,
+						NewPrice:          3000,
+						OldCost:           1500,
+						NewCost:           1500,
+						OldEffectivePrice: 3000,
+						NewEffectivePrice: 2100,
+						CreateDate:        now,
+					},
+				}).Return(true, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 이미 같은 할인가면 바꾸지 않음",
+			mock: func(userRepository *mocks.UserRepository, markdownRepository *mocks.MarkdownRepository) {
+				userRepository.EXPECT().ListUsersAfter(mock.Anything, 0, markdownUserBatchSize).Return(users, nil).Once()
+				markdownRepository.EXPECT().ListMarkdownRules(mock.Anything, 1).Return(rules, nil).Once()
+				markdownRepository.EXPECT().ListMarkdownCandidates(mock.Anything, mock.Anything).Return([]domain.Product{
+					{Base: domain.Base{ID: 7}, UserID: 1, CategoryID: 2, Price: 3000, ExpiryDate: now.Add(10 * time.Hour), MarkdownPrice: pointer.Float64(2100)},
+				}, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 규칙이 없어진 상품은 정가로 되돌림",
+			mock: func(userRepository *mocks.UserRepository, markdownRepository *mocks.MarkdownRepository) {
+				userRepository.EXPECT().ListUsersAfter(mock.Anything, 0, markdownUserBatchSize).Return(users, nil).Once()
+				markdownRepository.EXPECT().ListMarkdownRules(mock.Anything, 1).Return(nil, nil).Once()
+				markdownRepository.EXPECT().ListMarkdownCandidates(mock.Anything, domain.ListMarkdownCandidatesParams{
+					UserID: 1,
+					Since:  now,
+					Until:  now,
+				}).Return([]domain.Product{
+					{Base: domain.Base{ID: 7}, UserID: 1, CategoryID: 2, Price: 3000, Cost: 1500, ExpiryDate: now.Add(10 * time.Hour), MarkdownPrice: pointer.Float64(2100)},
+				}, nil).Once()
+				markdownRepository.EXPECT().ApplyMarkdown(mock.Anything, mock.MatchedBy(func(change domain.MarkdownChange) bool {
+					return change.ProductID == 7 &&
+						change.To == nil &&
+						*change.From == 2100 &&
+						change.History.Reason == domain.PriceChangeReasonMarkdownEnd &&
+						change.History.OldEffectivePrice == 2100 &&
+						change.History.NewEffectivePrice == 3000
+				})).Return(true, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 한 사장님의 할인을 적용하지 못해도 다음 사장님은 적용",
+			mock: func(userRepository *mocks.UserRepository, markdownRepository *mocks.MarkdownRepository) {
+				userRepository.EXPECT().ListUsersAfter(mock.Anything, 0, markdownUserBatchSize).Return([]domain.User{
+					users[0],
+					{Base: domain.Base{ID: 2}},
+				}, nil).Once()
+				markdownRepository.EXPECT().ListMarkdownRules(mock.Anything, 1).Return(nil, errors.New("connection refused")).Once()
+				markdownRepository.EXPECT().ListMarkdownRules(mock.Anything, 2).Return(nil, nil).Once()
+				markdownRepository.EXPECT().ListMarkdownCandidates(mock.Anything, mock.MatchedBy(func(params domain.ListMarkdownCandidatesParams) bool {
+					return params.UserID == 2
+				})).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			userRepository := mocks.NewUserRepository(t)
+			markdownRepository := mocks.NewMarkdownRepository(t)
+			tt.mock(userRepository, markdownRepository)
+			job := NewMarkdownJob(userRepository, markdownRepository, 0)
+
+			// when
+			err := job.Apply(context.Background(), now)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
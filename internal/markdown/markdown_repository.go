@@ -0,0 +1,201 @@
+package markdown
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+type markdownRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewMarkdownRepository(sqlDB *sql.DB) *markdownRepository {
+	return &markdownRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.MarkdownRepository = (*markdownRepository)(nil)
+
+func (mr markdownRepository) CreateMarkdownRule(ctx context.Context, rule domain.MarkdownRule) (int, error) {
+	const op cerrors.Op = "markdown/markdownRepository/CreateMarkdownRule"
+
+	result, err := mr.sqlDB.ExecContext(
+		ctx,
+		createMarkdownRuleQuery,
+		rule.UserID,
+		rule.CategoryID,
+		rule.ProductID,
+		int(rule.Within/time.Minute),
+		rule.DiscountPercent,
+	)
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	ruleID, err := result.LastInsertId()
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return int(ruleID), nil
+}
+
+func (mr markdownRepository) GetMarkdownRule(ctx context.Context, ruleID int) (*domain.MarkdownRule, error) {
+	const op cerrors.Op = "markdown/markdownRepository/GetMarkdownRule"
+
+	rule, err := scanMarkdownRule(mr.sqlDB.QueryRowContext(ctx, findMarkdownRuleByIDQuery, ruleID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return &rule, nil
+}
+
+func (mr markdownRepository) DeleteMarkdownRule(ctx context.Context, ruleID int) error {
+	const op cerrors.Op = "markdown/markdownRepository/DeleteMarkdownRule"
+
+	if _, err := mr.sqlDB.ExecContext(ctx, deleteMarkdownRuleQuery, time.Now().UTC(), ruleID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (mr markdownRepository) ListMarkdownRules(ctx context.Context, userID int) ([]domain.MarkdownRule, error) {
+	const op cerrors.Op = "markdown/markdownRepository/ListMarkdownRules"
+
+	var rules []domain.MarkdownRule
+
+	rows, err := mr.sqlDB.QueryContext(ctx, listMarkdownRulesQuery, userID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		rule, err := scanMarkdownRule(rows)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// ListMarkdownCandidates
+// 할인가를 다시 계산해야 하는 상품을 조회한다. 지금 할인 중인 상품은 할인을 끝내야 할 수도 있어서 유통기한과 상관없이 포함한다.
+func (mr markdownRepository) ListMarkdownCandidates(ctx context.Context, params domain.ListMarkdownCandidatesParams) ([]domain.Product, error) {
+	const op cerrors.Op = "markdown/markdownRepository/ListMarkdownCandidates"
+
+	var products []domain.Product
+
+	rows, err := mr.sqlDB.QueryContext(ctx, listMarkdownCandidatesQuery, params.UserID, params.Since.UTC(), params.Until.UTC())
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var product domain.Product
+		err := rows.Scan(
+			&product.ID,
+			&product.UserID,
+			&product.CategoryID,
+			&product.Name,
+			&product.Price,
+			&product.Cost,
+			&product.ExpiryDate,
+			&product.MarkdownPrice,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		products = append(products, product)
+	}
+
+	return products, nil
+}
+
+// ApplyMarkdown
+// 상품의 할인가가 아직 From 이면 To 로 바꾸고 가격 변경 기록을 남긴다.
+// 다른 서버가 먼저 바꿨으면 false 를 반환하므로 같은 변경이 두 번 기록되지 않는다.
+func (mr markdownRepository) ApplyMarkdown(ctx context.Context, change domain.MarkdownChange) (bool, error) {
+	const op cerrors.Op = "markdown/markdownRepository/ApplyMarkdown"
+
+	tx, err := mr.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, updateMarkdownPriceQuery, change.To, change.ProductID, change.From)
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if affected != 1 {
+		return false, nil
+	}
+
+	history := change.History
+	_, err = tx.ExecContext(
+		ctx,
+		createPriceHistoryQuery,
+		history.ProductID,
+		history.UserID,
+		history.Reason,
+		history.OldPrice,
+		history.NewPrice,
+		history.OldCost,
+		history.NewCost,
+		history.OldEffectivePrice,
+		history.NewEffectivePrice,
+		history.CreateDate,
+	)
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return true, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanMarkdownRule(row rowScanner) (domain.MarkdownRule, error) {
+	var rule domain.MarkdownRule
+	var withinMinutes int
+
+	err := row.Scan(
+		&rule.ID,
+		&rule.CreateDate,
+		&rule.UpdateDate,
+		&rule.DeleteDate,
+		&rule.UserID,
+		&rule.CategoryID,
+		&rule.ProductID,
+		&withinMinutes,
+		&rule.DiscountPercent,
+	)
+	rule.Within = time.Duration(withinMinutes) * time.Minute
+
+	return rule, err
+}
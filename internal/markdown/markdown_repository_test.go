@@ -0,0 +1,171 @@
+package markdown
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+	"payhere/domain"
+	"testing"
+	"time"
+)
+
+type markdownRepositoryTestSuite struct {
+	sqlDB              *sql.DB
+	sqlMock            sqlmock.Sqlmock
+	markdownRepository domain.MarkdownRepository
+}
+
+func setupMarkdownRepositoryTestSuite() markdownRepositoryTestSuite {
+	var us markdownRepositoryTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	us.sqlDB = mockDB
+	us.sqlMock = mock
+	us.markdownRepository = NewMarkdownRepository(mockDB)
+
+	return us
+}
+
+func Test_markdownRepository_CreateMarkdownRule(t *testing.T) {
+	// given
+	ts := setupMarkdownRepositoryTestSuite()
+	ts.sqlMock.ExpectExec("INSERT INTO markdown_rules").
+		WithArgs(1, 2, nil, 1440, 30).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+
+	// when
+	got, err := ts.markdownRepository.CreateMarkdownRule(context.Background(), domain.MarkdownRule{
+		UserID:          1,
+		CategoryID:      pointer.Int(2),
+		Within:          24 * time.Hour,
+		DiscountPercent: 30,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, 3, got)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_markdownRepository_ListMarkdownRules(t *testing.T) {
+	createDate := time.Now()
+
+	// given
+	ts := setupMarkdownRepositoryTestSuite()
+	columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "category_id", "product_id", "within_minutes", "discount_percent"}
+	ts.sqlMock.ExpectQuery("SELECT .* FROM markdown_rules WHERE delete_date IS NULL AND user_id = \\? ORDER BY id").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, createDate, createDate, nil, 1, 2, nil, 1440, 30).
+			AddRow(2, createDate, createDate, nil, 1, nil, 7, 360, 50))
+
+	// when
+	got, err := ts.markdownRepository.ListMarkdownRules(context.Background(), 1)
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, 24*time.Hour, got[0].Within)
+	assert.Equal(t, pointer.Int(2), got[0].CategoryID)
+	assert.Equal(t, pointer.Int(7), got[1].ProductID)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_markdownRepository_ListMarkdownCandidates(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	// given
+	ts := setupMarkdownRepositoryTestSuite()
+	columns := []string{"id", "user_id", "category_id", "name", "price", "cost", "expiry_date", "markdown_price"}
+	ts.sqlMock.ExpectQuery("SELECT .* FROM products WHERE user_id = \\? AND delete_date IS NULL AND \\(markdown_price IS NOT NULL OR \\(expiry_date > \\? AND expiry_date < \\?\\)\\)").
+		WithArgs(1, now, now.Add(24*time.Hour)).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(7, 1, 2, "우유", 3000, 1500, now.Add(10*time.Hour), nil).
+			AddRow(9, 1, 2, "생크림", 4000, 2000, now.Add(-time.Hour), 2800))
+
+	// when
+	got, err := ts.markdownRepository.ListMarkdownCandidates(context.Background(), domain.ListMarkdownCandidatesParams{
+		UserID: 1,
+		Since:  now,
+		Until:  now.Add(24 * time.Hour),
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Nil(t, got[0].MarkdownPrice)
+	assert.Equal(t, pointer.Float64(2800), got[1].MarkdownPrice)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_markdownRepository_ApplyMarkdown(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	change := domain.MarkdownChange{
+		ProductID: 7,
+		To:        pointer.Float64(2100),
+		History: domain.PriceHistory{
+			ProductID:         7,
+			Reason:            domain.PriceChangeReasonMarkdown,
+			OldPrice:          3000,
+			NewPrice:          3000,
+			OldCost:           1500,
+			NewCost:           1500,
+			OldEffectivePrice: 3000,
+			NewEffectivePrice: 2100,
+			CreateDate:        now,
+		},
+	}
+
+	tests := []struct {
+		name string
+		mock func(ts markdownRepositoryTestSuite)
+		want bool
+	}{
+		{
+			name: "PASS - 할인가 적용과 가격 변경 기록",
+			mock: func(ts markdownRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec("UPDATE products SET markdown_price = \\?, version = version \\+ 1 WHERE id = \\? AND markdown_price <=> \\?").
+					WithArgs(2100.0, 7, nil).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				ts.sqlMock.ExpectExec("INSERT INTO product_price_history").
+					WithArgs(7, nil, domain.PriceChangeReasonMarkdown, 3000.0, 3000.0, 1500.0, 1500.0, 3000.0, 2100.0, now).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectCommit()
+			},
+			want: true,
+		},
+		{
+			name: "PASS - 다른 서버가 먼저 바꾼 할인가는 기록하지 않음",
+			mock: func(ts markdownRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec("UPDATE products SET markdown_price").
+					WithArgs(2100.0, 7, nil).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				ts.sqlMock.ExpectRollback()
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupMarkdownRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.markdownRepository.ApplyMarkdown(context.Background(), change)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		})
+	}
+}
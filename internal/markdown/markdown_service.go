@@ -0,0 +1,115 @@
+package markdown
+
+import (
+	"context"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+type markdownService struct {
+	productRepository  domain.ProductRepository
+	categoryRepository domain.CategoryRepository
+	markdownRepository domain.MarkdownRepository
+}
+
+func NewMarkdownService(
+	productRepository domain.ProductRepository,
+	categoryRepository domain.CategoryRepository,
+	markdownRepository domain.MarkdownRepository,
+) *markdownService {
+	return &markdownService{
+		productRepository:  productRepository,
+		categoryRepository: categoryRepository,
+		markdownRepository: markdownRepository,
+	}
+}
+
+var _ domain.MarkdownService = (*markdownService)(nil)
+
+func (ms markdownService) CreateMarkdownRule(ctx context.Context, req domain.CreateMarkdownRuleRequest) error {
+	if err := ms.checkTarget(ctx, req); err != nil {
+		return err
+	}
+
+	_, err := ms.markdownRepository.CreateMarkdownRule(ctx, domain.MarkdownRule{
+		UserID:          req.UserID,
+		CategoryID:      req.CategoryID,
+		ProductID:       req.ProductID,
+		Within:          time.Duration(req.WithinHours) * time.Hour,
+		DiscountPercent: req.DiscountPercent,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteMarkdownRule
+// 규칙을 지워도 이미 할인 중인 상품은 다음 할인 작업 때 정가로 돌아간다.
+func (ms markdownService) DeleteMarkdownRule(ctx context.Context, req domain.DeleteMarkdownRuleRequest) error {
+	const op cerrors.Op = "markdown/service/DeleteMarkdownRule"
+
+	rule, err := ms.markdownRepository.GetMarkdownRule(ctx, req.ID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "할인 규칙을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if rule == nil {
+		return cerrors.E(op, cerrors.NotExist, "할인 규칙을 찾을 수 없습니다.")
+	}
+	if rule.UserID != req.UserID {
+		return cerrors.E(op, cerrors.Permission, "할인 규칙을 삭제할 권한이 없습니다.")
+	}
+
+	if err := ms.markdownRepository.DeleteMarkdownRule(ctx, req.ID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "할인 규칙을 삭제하는 중에 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (ms markdownService) ListMarkdownRules(ctx context.Context, req domain.ListMarkdownRulesRequest) (domain.ListMarkdownRulesResponse, error) {
+	const op cerrors.Op = "markdown/service/ListMarkdownRules"
+
+	rules, err := ms.markdownRepository.ListMarkdownRules(ctx, req.UserID)
+	if err != nil {
+		return domain.ListMarkdownRulesResponse{}, cerrors.E(op, cerrors.Internal, err, "할인 규칙을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	ruleDTOs := make([]domain.MarkdownRuleDTO, 0, len(rules))
+	for _, rule := range rules {
+		ruleDTOs = append(ruleDTOs, domain.MarkdownRuleDTOFrom(rule))
+	}
+
+	return domain.ListMarkdownRulesResponse{
+		Rules: ruleDTOs,
+	}, nil
+}
+
+// checkTarget
+// 할인 대상 카테고리나 상품이 존재하고 요청한 사장님의 것인지 확인한다.
+func (ms markdownService) checkTarget(ctx context.Context, req domain.CreateMarkdownRuleRequest) error {
+	const op cerrors.Op = "markdown/service/checkTarget"
+
+	if req.CategoryID != nil {
+		category, err := ms.categoryRepository.GetCategory(ctx, *req.CategoryID)
+		if err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "카테고리를 조회하는 중에 에러가 발생했습니다.")
+		}
+		if category == nil || category.UserID != req.UserID {
+			return cerrors.E(op, cerrors.Invalid, "카테고리를 확인해주세요.")
+		}
+		return nil
+	}
+
+	product, err := ms.productRepository.GetProduct(ctx, *req.ProductID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if product == nil || product.UserID != req.UserID {
+		return cerrors.E(op, cerrors.Invalid, "상품을 확인해주세요.")
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package markdown
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/utils/pointer"
+	"payhere/domain"
+	"payhere/mocks"
+	cerrors "payhere/pkg/cerrors"
+	"testing"
+	"time"
+)
+
+type markdownServiceTestSuite struct {
+	productRepository  *mocks.ProductRepository
+	categoryRepository *mocks.CategoryRepository
+	markdownRepository *mocks.MarkdownRepository
+	markdownService    domain.MarkdownService
+}
+
+func setupMarkdownServiceTestSuite(t *testing.T) markdownServiceTestSuite {
+	var us markdownServiceTestSuite
+
+	us.productRepository = mocks.NewProductRepository(t)
+	us.categoryRepository = mocks.NewCategoryRepository(t)
+	us.markdownRepository = mocks.NewMarkdownRepository(t)
+	us.markdownService = NewMarkdownService(us.productRepository, us.categoryRepository, us.markdownRepository)
+
+	return us
+}
+
+func Test_markdownService_CreateMarkdownRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      domain.CreateMarkdownRuleRequest
+		mock     func(ts markdownServiceTestSuite)
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 카테고리 규칙 생성",
+			req:  domain.CreateMarkdownRuleRequest{UserID: 1, CategoryID: pointer.Int(2), WithinHours: 24, DiscountPercent: 30},
+			mock: func(ts markdownServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 2).Return(&domain.Category{Base: domain.Base{ID: 2}, UserID: 1}, nil).Once()
+				ts.markdownRepository.EXPECT().CreateMarkdownRule(mock.Anything, domain.MarkdownRule{
+					UserID:          1,
+					CategoryID:      pointer.Int(2),
+					Within:          24 * time.Hour,
+					DiscountPercent: 30,
+				}).Return(1, nil).Once()
+			},
+		},
+		{
+			name: "PASS - 상품 규칙 생성",
+			req:  domain.CreateMarkdownRuleRequest{UserID: 1, ProductID: pointer.Int(7), WithinHours: 6, DiscountPercent: 50},
+			mock: func(ts markdownServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 7).Return(&domain.Product{Base: domain.Base{ID: 7}, UserID: 1}, nil).Once()
+				ts.markdownRepository.EXPECT().CreateMarkdownRule(mock.Anything, mock.Anything).Return(2, nil).Once()
+			},
+		},
+		{
+			name: "FAIL - 다른 사장님의 상품",
+			req:  domain.CreateMarkdownRuleRequest{UserID: 1, ProductID: pointer.Int(7), WithinHours: 6, DiscountPercent: 50},
+			mock: func(ts markdownServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 7).Return(&domain.Product{Base: domain.Base{ID: 7}, UserID: 2}, nil).Once()
+			},
+			wantKind: cerrors.Invalid,
+		},
+		{
+			name: "FAIL - 존재하지 않는 카테고리",
+			req:  domain.CreateMarkdownRuleRequest{UserID: 1, CategoryID: pointer.Int(9), WithinHours: 24, DiscountPercent: 30},
+			mock: func(ts markdownServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 9).Return(nil, nil).Once()
+			},
+			wantKind: cerrors.Invalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupMarkdownServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.markdownService.CreateMarkdownRule(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_markdownService_DeleteMarkdownRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      domain.DeleteMarkdownRuleRequest
+		mock     func(ts markdownServiceTestSuite)
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 규칙 삭제",
+			req:  domain.DeleteMarkdownRuleRequest{UserID: 1, ID: 3},
+			mock: func(ts markdownServiceTestSuite) {
+				ts.markdownRepository.EXPECT().GetMarkdownRule(mock.Anything, 3).Return(&domain.MarkdownRule{Base: domain.Base{ID: 3}, UserID: 1}, nil).Once()
+				ts.markdownRepository.EXPECT().DeleteMarkdownRule(mock.Anything, 3).Return(nil).Once()
+			},
+		},
+		{
+			name: "FAIL - 존재하지 않는 규칙",
+			req:  domain.DeleteMarkdownRuleRequest{UserID: 1, ID: 3},
+			mock: func(ts markdownServiceTestSuite) {
+				ts.markdownRepository.EXPECT().GetMarkdownRule(mock.Anything, 3).Return(nil, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+		{
+			name: "FAIL - 다른 사장님의 규칙",
+			req:  domain.DeleteMarkdownRuleRequest{UserID: 1, ID: 3},
+			mock: func(ts markdownServiceTestSuite) {
+				ts.markdownRepository.EXPECT().GetMarkdownRule(mock.Anything, 3).Return(&domain.MarkdownRule{Base: domain.Base{ID: 3}, UserID: 2}, nil).Once()
+			},
+			wantKind: cerrors.Permission,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupMarkdownServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.markdownService.DeleteMarkdownRule(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_markdownService_ListMarkdownRules(t *testing.T) {
+	// given
+	ts := setupMarkdownServiceTestSuite(t)
+	ts.markdownRepository.EXPECT().ListMarkdownRules(mock.Anything, 1).Return([]domain.MarkdownRule{
+		{Base: domain.Base{ID: 1}, UserID: 1, CategoryID: pointer.Int(2), Within: 24 * time.Hour, DiscountPercent: 30},
+	}, nil).Once()
+
+	// when
+	got, err := ts.markdownService.ListMarkdownRules(context.Background(), domain.ListMarkdownRulesRequest{UserID: 1})
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, got.Rules, 1)
+	assert.Equal(t, 24, got.Rules[0].WithinHours)
+	assert.Equal(t, 30, got.Rules[0].DiscountPercent)
+}
@@ -0,0 +1,68 @@
+package markdown
+
+const createMarkdownRuleQuery = `INSERT INTO markdown_rules (user_id, category_id, product_id, within_minutes, discount_percent) VALUES (?, ?, ?, ?, ?)`
+
+const findMarkdownRuleByIDQuery = `
+	SELECT 
+		id, 
+		create_date, 
+		update_date, 
+		delete_date, 
+		user_id, 
+		category_id, 
+		product_id, 
+		within_minutes, 
+		discount_percent 
+	FROM 
+		markdown_rules 
+	WHERE 
+		delete_date IS NULL 
+		AND id = ?
+`
+
+const deleteMarkdownRuleQuery = `UPDATE markdown_rules SET delete_date = ? WHERE id = ?`
+
+const listMarkdownRulesQuery = `
+	SELECT 
+		id, 
+		create_date, 
+		update_date, 
+		delete_date, 
+		user_id, 
+		category_id, 
+		product_id, 
+		within_minutes, 
+		discount_percent 
+	FROM 
+		markdown_rules 
+	WHERE 
+		delete_date IS NULL 
+		AND user_id = ? 
+	ORDER BY 
+		id
+`
+
+const listMarkdownCandidatesQuery = `
+	SELECT 
+		id, 
+		user_id, 
+		category_id, 
+		name, 
+		price, 
+		cost, 
+		expiry_date, 
+		markdown_price 
+	FROM 
+		products 
+	WHERE 
+		user_id = ? 
+		AND delete_date IS NULL 
+		AND (markdown_price IS NOT NULL OR (expiry_date > ? AND expiry_date < ?))
+	ORDER BY 
+		id
+`
+
+// 할인가도 effectivePrice 로 응답하므로 바뀔 때 상품 버전을 올린다.
+const updateMarkdownPriceQuery = `UPDATE products SET markdown_price = ?, version = version + 1 WHERE id = ? AND markdown_price <=> ?`
+
+const createPriceHistoryQuery = `INSERT INTO product_price_history (product_id, user_id, reason, old_price, new_price, old_cost, new_cost, old_effective_price, new_effective_price, create_date) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
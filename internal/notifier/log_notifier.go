@@ -0,0 +1,62 @@
+package notifier
+
+import (
+	"context"
+	"log"
+	"payhere/domain"
+)
+
+// logNotifier
+// 알림을 보내는 대신 로그로 남긴다. 로컬에서 실행할 때 사용한다.
+type logNotifier struct {
+	logger *log.Logger
+}
+
+func NewLogNotifier(logger *log.Logger) *logNotifier {
+	return &logNotifier{
+		logger: logger,
+	}
+}
+
+var _ domain.Notifier = (*logNotifier)(nil)
+
+func (ln logNotifier) NotifyLowStock(ctx context.Context, alert domain.LowStockAlert) error {
+	ln.logger.Printf(
+		"[재고 부족] 사장님 %d, 상품 %d(%s) 재고 %d개 (기준 %d개), 발주 권장 수량 %d개",
+		alert.UserID,
+		alert.ProductID,
+		alert.ProductName,
+		alert.StockQuantity,
+		alert.ReorderPoint,
+		alert.ReorderQuantity,
+	)
+	return nil
+}
+
+func (ln logNotifier) NotifyExpiryDigest(ctx context.Context, digest domain.ExpiryDigest) error {
+	ln.logger.Printf(
+		"[유통기한 요약] 사장님 %d, %s 기준 유통기한 지남 %d개, 임박 %d개",
+		digest.UserID,
+		digest.Date,
+		len(digest.Expired),
+		len(digest.Expiring),
+	)
+	for _, item := range digest.Expired {
+		ln.logItem("지남", item)
+	}
+	for _, item := range digest.Expiring {
+		ln.logItem("임박", item)
+	}
+	return nil
+}
+
+func (ln logNotifier) logItem(label string, item domain.ExpiryDigestItem) {
+	ln.logger.Printf(
+		"  - [%s] 상품 %d(%s) 유통기한 %s, 재고 %d개",
+		label,
+		item.ProductID,
+		item.ProductName,
+		item.ExpiryDate.Format("2006-01-02 15:04"),
+		item.StockQuantity,
+	)
+}
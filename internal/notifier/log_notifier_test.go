@@ -0,0 +1,56 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"log"
+	"payhere/domain"
+	"testing"
+	"time"
+)
+
+func Test_logNotifier_NotifyLowStock(t *testing.T) {
+	// given
+	var buf bytes.Buffer
+	notifier := NewLogNotifier(log.New(&buf, "", 0))
+
+	// when
+	err := notifier.NotifyLowStock(context.Background(), domain.LowStockAlert{
+		UserID:          1,
+		ProductID:       3,
+		ProductName:     "원두",
+		StockQuantity:   2,
+		ReorderPoint:    5,
+		ReorderQuantity: 20,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, "[재고 부족] 사장님 1, 상품 3(원두) 재고 2개 (기준 5개), 발주 권장 수량 20개\n", buf.String())
+}
+
+func Test_logNotifier_NotifyExpiryDigest(t *testing.T) {
+	// given
+	var buf bytes.Buffer
+	notifier := NewLogNotifier(log.New(&buf, "", 0))
+	seoul, _ := time.LoadLocation("Asia/Seoul")
+
+	// when
+	err := notifier.NotifyExpiryDigest(context.Background(), domain.ExpiryDigest{
+		UserID: 1,
+		Date:   "2024-03-01",
+		Expired: []domain.ExpiryDigestItem{
+			{ProductID: 3, ProductName: "우유", ExpiryDate: time.Date(2024, 2, 29, 23, 0, 0, 0, seoul), StockQuantity: 2},
+		},
+		Expiring: []domain.ExpiryDigestItem{
+			{ProductID: 5, ProductName: "생크림", ExpiryDate: time.Date(2024, 3, 2, 9, 30, 0, 0, seoul), StockQuantity: 4},
+		},
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, "[유통기한 요약] 사장님 1, 2024-03-01 기준 유통기한 지남 1개, 임박 1개\n"+
+		"  - [지남] 상품 3(우유) 유통기한 2024-02-29 23:00, 재고 2개\n"+
+		"  - [임박] 상품 5(생크림) 유통기한 2024-03-02 09:30, 재고 4개\n", buf.String())
+}
@@ -0,0 +1,80 @@
+package product
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"payhere/domain"
+	"payhere/pkg/barcode"
+	cerrors "payhere/pkg/cerrors"
+	"strings"
+	"time"
+)
+
+// encodeBarcode
+// auto 는 EAN/UPC 바코드를 EAN-8, EAN-13 으로, 나머지는 Code128 로 인코딩한다.
+func encodeBarcode(code string, symbology domain.BarcodeSymbology) (barcode.Code, error) {
+	const op cerrors.Op = "product/encodeBarcode"
+
+	var (
+		encoded barcode.Code
+		err     error
+	)
+	switch symbology {
+	case domain.BarcodeSymbologyEAN13:
+		content, ok := domain.EAN13Content(code)
+		if !ok {
+			return barcode.Code{}, cerrors.E(op, cerrors.Invalid, "EAN-13 으로 표시할 수 없는 바코드입니다.")
+		}
+		encoded, err = barcode.EAN(content)
+	case domain.BarcodeSymbologyCode128:
+		encoded, err = barcode.Code128(code)
+	default:
+		if content, ok := domain.EAN13Content(code); ok {
+			encoded, err = barcode.EAN(content)
+		} else if domain.IsValidGTIN(code) {
+			encoded, err = barcode.EAN(code)
+		} else {
+			encoded, err = barcode.Code128(code)
+		}
+	}
+	if err != nil {
+		return barcode.Code{}, cerrors.E(op, cerrors.Invalid, err, "이미지로 표시할 수 없는 바코드입니다.")
+	}
+
+	return encoded, nil
+}
+
+// renderBarcodeImage
+// 같은 이미지는 같은 ETag 를 갖도록 이미지 내용의 해시로 ETag 를 만든다.
+func renderBarcodeImage(code barcode.Code, format domain.BarcodeImageFormat, width, height int, updateDate time.Time) (domain.BarcodeImage, error) {
+	const op cerrors.Op = "product/renderBarcodeImage"
+
+	var (
+		data []byte
+		err  error
+	)
+	if format == domain.BarcodeImageFormatSVG {
+		data, err = code.SVG(width, height)
+	} else {
+		data, err = code.PNG(width, height)
+	}
+	if errors.Is(err, barcode.ErrTooSmall) {
+		return domain.BarcodeImage{}, cerrors.E(op, cerrors.Invalid, err, "바코드를 그리기에 이미지 크기가 너무 작습니다.")
+	}
+	if err != nil {
+		return domain.BarcodeImage{}, cerrors.E(op, cerrors.Internal, err, "바코드 이미지를 만드는 중에 에러가 발생했습니다.")
+	}
+
+	sum := sha256.Sum256(data)
+	return domain.BarcodeImage{
+		ContentType:  format.ContentType(),
+		Data:         data,
+		ETag:         fmt.Sprintf(`"%x"`, sum[:16]),
+		LastModified: updateDate,
+	}, nil
+}
+
+func productURL(publicURL string, productID int) string {
+	return fmt.Sprintf("%s/products/%d", strings.TrimRight(publicURL, "/"), productID)
+}
@@ -0,0 +1,36 @@
+package product
+
+import (
+	"github.com/stretchr/testify/assert"
+	"payhere/domain"
+	"testing"
+)
+
+func Test_encodeBarcode(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      string
+		symbology domain.BarcodeSymbology
+		wantErr   bool
+	}{
+		{name: "PASS - EAN-13", code: "8801234567893", symbology: domain.BarcodeSymbologyAuto, wantErr: false},
+		{name: "PASS - UPC-A 를 EAN-13 으로", code: "036000291452", symbology: domain.BarcodeSymbologyEAN13, wantErr: false},
+		{name: "PASS - EAN-8", code: "12345670", symbology: domain.BarcodeSymbologyAuto, wantErr: false},
+		{name: "PASS - EAN-13 을 Code128 로", code: "8801234567893", symbology: domain.BarcodeSymbologyCode128, wantErr: false},
+		{name: "PASS - 매장 자체 바코드", code: "STORE-001", symbology: domain.BarcodeSymbologyAuto, wantErr: false},
+		{name: "FAIL - EAN-8 을 EAN-13 으로", code: "12345670", symbology: domain.BarcodeSymbologyEAN13, wantErr: true},
+		{name: "FAIL - Code128 로 표시할 수 없는 문자", code: "매장001", symbology: domain.BarcodeSymbologyCode128, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := encodeBarcode(tt.code, tt.symbology)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func Test_productURL(t *testing.T) {
+	assert.Equal(t, "https://payhere.in/products/1", productURL("https://payhere.in/", 1))
+	assert.Equal(t, "https://payhere.in/products/1", productURL("https://payhere.in", 1))
+}
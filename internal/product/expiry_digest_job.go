@@ -0,0 +1,167 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+const (
+	defaultExpiryDigestHour     = 9
+	defaultExpiryDigestInterval = 5 * time.Minute
+	expiryDigestUserBatchSize   = 100
+)
+
+// ExpiryDigestJob
+// 사장님마다 매장 시간대로 하루 한 번, hour 시가 지나면 유통기한이 지났거나 within 안에 끝나는 재고 상품 요약을 보낸다.
+// 보낸 날짜는 expiry_digests 에 기록해 두어 여러 서버에서 함께 실행해도 하루 한 번만 나간다.
+type ExpiryDigestJob struct {
+	userRepository    domain.UserRepository
+	productRepository domain.ProductRepository
+	notifier          domain.Notifier
+	hour              int
+	within            time.Duration
+	interval          time.Duration
+}
+
+func NewExpiryDigestJob(
+	userRepository domain.UserRepository,
+	productRepository domain.ProductRepository,
+	notifier domain.Notifier,
+	hour int,
+	within time.Duration,
+	interval time.Duration,
+) *ExpiryDigestJob {
+	if hour < 0 || hour > 23 {
+		hour = defaultExpiryDigestHour
+	}
+	if within <= 0 {
+		within = domain.DefaultExpiringWithin
+	}
+	if interval <= 0 {
+		interval = defaultExpiryDigestInterval
+	}
+	return &ExpiryDigestJob{
+		userRepository:    userRepository,
+		productRepository: productRepository,
+		notifier:          notifier,
+		hour:              hour,
+		within:            within,
+		interval:          interval,
+	}
+}
+
+// Run
+// ctx 가 끝날 때까지 interval 마다 Send 를 실행한다.
+func (j *ExpiryDigestJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.Send(ctx, time.Now()); err != nil {
+			log.Println(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Send
+// now 를 사장님의 매장 시간대로 바꿔 오늘 요약을 보낼 시각이 지났으면 요약을 보낸다.
+// 한 사장님에게 보내지 못해도 나머지 사장님에게는 보내고, 보내지 못한 사장님은 기록을 되돌려 다음 실행 때 다시 보낸다.
+func (j *ExpiryDigestJob) Send(ctx context.Context, now time.Time) error {
+	const op cerrors.Op = "product/ExpiryDigestJob/Send"
+
+	var failed []error
+	cursor := 0
+	for {
+		users, err := j.userRepository.ListUsersAfter(ctx, cursor, expiryDigestUserBatchSize)
+		if err != nil {
+			return cerrors.E(op, cerrors.Internal, errors.Join(append(failed, err)...), "사장님 목록을 조회하는 중에 에러가 발생했습니다.")
+		}
+
+		for _, user := range users {
+			if err := j.sendDigest(ctx, user, now); err != nil {
+				log.Printf("expiry digest for user %d: %v", user.ID, err)
+				failed = append(failed, err)
+			}
+		}
+
+		if len(users) < expiryDigestUserBatchSize {
+			break
+		}
+		cursor = users[len(users)-1].ID
+	}
+
+	if len(failed) > 0 {
+		return cerrors.E(op, cerrors.Internal, errors.Join(failed...), fmt.Sprintf("사장님 %d명에게 유통기한 요약을 보내지 못했습니다.", len(failed)))
+	}
+	return nil
+}
+
+func (j *ExpiryDigestJob) sendDigest(ctx context.Context, user domain.User, now time.Time) error {
+	const op cerrors.Op = "product/ExpiryDigestJob/sendDigest"
+
+	loc := user.Location()
+	local := now.In(loc)
+	if local.Hour() < j.hour {
+		return nil
+	}
+	date := local.Format("2006-01-02")
+
+	claimed, err := j.productRepository.ClaimExpiryDigest(ctx, user.ID, date)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "유통기한 요약 발송을 기록하는 중에 에러가 발생했습니다.")
+	}
+	if !claimed {
+		return nil
+	}
+
+	products, err := j.productRepository.ListExpiringProducts(ctx, domain.ListExpiringProductsParams{
+		UserID:  user.ID,
+		Until:   now.Add(j.within),
+		InStock: true,
+		Limit:   domain.ListExpiringProductsLimit,
+	})
+	if err != nil {
+		if err := j.productRepository.ReleaseExpiryDigest(ctx, user.ID, date); err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "유통기한 요약 기록을 되돌리는 중에 에러가 발생했습니다.")
+		}
+		return cerrors.E(op, cerrors.Internal, err, "유통기한이 임박한 상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	// 알릴 상품이 없는 날은 보내지 않는다.
+	if len(products) == 0 {
+		return nil
+	}
+
+	digest := domain.ExpiryDigest{
+		UserID: user.ID,
+		Date:   date,
+	}
+	for _, product := range products {
+		item := domain.ExpiryDigestItemFrom(product, loc)
+		if product.ExpiryDate.Before(now) {
+			digest.Expired = append(digest.Expired, item)
+		} else {
+			digest.Expiring = append(digest.Expiring, item)
+		}
+	}
+
+	if err := j.notifier.NotifyExpiryDigest(ctx, digest); err != nil {
+		if err := j.productRepository.ReleaseExpiryDigest(ctx, user.ID, date); err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "유통기한 요약 기록을 되돌리는 중에 에러가 발생했습니다.")
+		}
+		return cerrors.E(op, cerrors.Internal, err, "유통기한 요약을 보내는 중에 에러가 발생했습니다.")
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"payhere/domain"
+	"payhere/mocks"
+	"testing"
+	"time"
+)
+
+func TestExpiryDigestJob_Send(t *testing.T) {
+	// 서울 09:30, UTC 00:30
+	now := time.Date(2024, time.March, 1, 0, 30, 0, 0, time.UTC)
+	users := []domain.User{
+		{Base: domain.Base{ID: 1}, TimeZone: "Asia/Seoul"},
+		{Base: domain.Base{ID: 2}, TimeZone: "UTC"},
+	}
+	products := []domain.Product{
+		{Base: domain.Base{ID: 3}, UserID: 1, Name: "우유", ExpiryDate: now.Add(-time.Hour), StockQuantity: 2},
+		{Base: domain.Base{ID: 5}, UserID: 1, Name: "생크림", ExpiryDate: now.Add(24 * time.Hour), StockQuantity: 4},
+	}
+
+	tests := []struct {
+		name    string
+		mock    func(userRepository *mocks.UserRepository, productRepository *mocks.ProductRepository, notifier *mocks.Notifier)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 매장 시간대로 보낼 시각이 지난 사장님에게만 요약",
+			mock: func(userRepository *mocks.UserRepository, productRepository *mocks.ProductRepository, notifier *mocks.Notifier) {
+				userRepository.EXPECT().ListUsersAfter(mock.Anything, 0, expiryDigestUserBatchSize).Return(users, nil).Once()
+				productRepository.EXPECT().ClaimExpiryDigest(mock.Anything, 1, "2024-03-01").Return(true, nil).Once()
+				productRepository.EXPECT().ListExpiringProducts(mock.Anything, domain.ListExpiringProductsParams{
+					UserID:  1,
+					Until:   now.Add(72 * time.Hour),
+					InStock: true,
+					Limit:   domain.ListExpiringProductsLimit,
+				}).Return(products, nil).Once()
+				notifier.EXPECT().NotifyExpiryDigest(mock.Anything, mock.MatchedBy(func(digest domain.ExpiryDigest) bool {
+					return digest.UserID == 1 &&
+						digest.Date == "2024-03-01" &&
+						len(digest.Expired) == 1 && digest.Expired[0].ProductID == 3 &&
+						len(digest.Expiring) == 1 && digest.Expiring[0].ProductID == 5 &&
+						digest.Expiring[0].ExpiryDate.Location().String() == "Asia/Seoul"
+				})).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 다른 서버가 이미 보낸 요약은 보내지 않음",
+			mock: func(userRepository *mocks.UserRepository, productRepository *mocks.ProductRepository, notifier *mocks.Notifier) {
+				userRepository.EXPECT().ListUsersAfter(mock.Anything, 0, expiryDigestUserBatchSize).Return(users[:1], nil).Once()
+				productRepository.EXPECT().ClaimExpiryDigest(mock.Anything, 1, "2024-03-01").Return(false, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 알릴 상품이 없으면 보내지 않음",
+			mock: func(userRepository *mocks.UserRepository, productRepository *mocks.ProductRepository, notifier *mocks.Notifier) {
+				userRepository.EXPECT().ListUsersAfter(mock.Anything, 0, expiryDigestUserBatchSize).Return(users[:1], nil).Once()
+				productRepository.EXPECT().ClaimExpiryDigest(mock.Anything, 1, "2024-03-01").Return(true, nil).Once()
+				productRepository.EXPECT().ListExpiringProducts(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 요약을 보내지 못하면 기록을 되돌림",
+			mock: func(userRepository *mocks.UserRepository, productRepository *mocks.ProductRepository, notifier *mocks.Notifier) {
+				userRepository.EXPECT().ListUsersAfter(mock.Anything, 0, expiryDigestUserBatchSize).Return(users[:1], nil).Once()
+				productRepository.EXPECT().ClaimExpiryDigest(mock.Anything, 1, "2024-03-01").Return(true, nil).Once()
+				productRepository.EXPECT().ListExpiringProducts(mock.Anything, mock.Anything).Return(products, nil).Once()
+				notifier.EXPECT().NotifyExpiryDigest(mock.Anything, mock.Anything).Return(errors.New("notifier unavailable")).Once()
+				productRepository.EXPECT().ReleaseExpiryDigest(mock.Anything, 1, "2024-03-01").Return(nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 한 사장님에게 보내지 못해도 다음 사장님에게는 요약",
+			mock: func(userRepository *mocks.UserRepository, productRepository *mocks.ProductRepository, notifier *mocks.Notifier) {
+				userRepository.EXPECT().ListUsersAfter(mock.Anything, 0, expiryDigestUserBatchSize).Return([]domain.User{
+					users[0],
+					{Base: domain.Base{ID: 3}, TimeZone: "Asia/Seoul"},
+				}, nil).Once()
+				productRepository.EXPECT().ClaimExpiryDigest(mock.Anything, 1, "2024-03-01").Return(true, nil).Once()
+				productRepository.EXPECT().ListExpiringProducts(mock.Anything, mock.MatchedBy(func(params domain.ListExpiringProductsParams) bool {
+					return params.UserID == 1
+				})).Return(nil, errors.New("connection refused")).Once()
+				productRepository.EXPECT().ReleaseExpiryDigest(mock.Anything, 1, "2024-03-01").Return(nil).Once()
+				productRepository.EXPECT().ClaimExpiryDigest(mock.Anything, 3, "2024-03-01").Return(true, nil).Once()
+				productRepository.EXPECT().ListExpiringProducts(mock.Anything, mock.MatchedBy(func(params domain.ListExpiringProductsParams) bool {
+					return params.UserID == 3
+				})).Return(products, nil).Once()
+				notifier.EXPECT().NotifyExpiryDigest(mock.Anything, mock.MatchedBy(func(digest domain.ExpiryDigest) bool {
+					return digest.UserID == 3
+				})).Return(nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			userRepository := mocks.NewUserRepository(t)
+			productRepository := mocks.NewProductRepository(t)
+			notifier := mocks.NewNotifier(t)
+			tt.mock(userRepository, productRepository, notifier)
+			job := NewExpiryDigestJob(userRepository, productRepository, notifier, 9, 72*time.Hour, 0)
+
+			// when
+			err := job.Send(context.Background(), now)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
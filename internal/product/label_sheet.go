@@ -0,0 +1,209 @@
+package product
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/go-pdf/fpdf"
+	"math"
+	"os"
+	"payhere/domain"
+	"payhere/pkg/barcode"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// 옵션 그룹 중 이 이름의 그룹을 라벨의 사이즈로 출력한다.
+const labelSizeOptionGroupName = "사이즈"
+
+const (
+	labelFontFamily = "label"
+	labelPadding    = 2.5 // mm
+	// 기준 라벨(a4-3x8, 높이 37mm)의 글자 크기(pt). 라벨 높이에 비례해 줄인다.
+	labelNameFontSize    = 10
+	labelSizeFontSize    = 7
+	labelPriceFontSize   = 14
+	labelBarcodeFontSize = 6
+	labelBaseHeight      = 37
+	ptToMM               = 25.4 / 72
+)
+
+// productLabel
+// 라벨 한 장에 찍을 내용
+type productLabel struct {
+	Name    string
+	Price   string
+	Size    string
+	Barcode string
+	code    *barcode.Code
+}
+
+func productLabelFrom(product domain.Product) productLabel {
+	label := productLabel{
+		Name:    product.Name,
+		Price:   formatWon(product.Price),
+		Size:    labelSize(product.OptionGroups),
+		Barcode: product.Barcode,
+	}
+	if product.Barcode != "" {
+		if code, err := encodeBarcode(product.Barcode, domain.BarcodeSymbologyAuto); err == nil {
+			label.code = &code
+		}
+	}
+	return label
+}
+
+// formatWon
+// 원 단위로 반올림하고 세 자리마다 쉼표를 찍는다. (예: 3,500원)
+func formatWon(price float64) string {
+	won := strconv.FormatInt(int64(math.Round(price)), 10)
+	sign := ""
+	if strings.HasPrefix(won, "-") {
+		sign, won = "-", won[1:]
+	}
+
+	var b strings.Builder
+	for i, c := range won {
+		if i > 0 && (len(won)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(c)
+	}
+
+	return sign + b.String() + "원"
+}
+
+// labelSize
+// 사이즈 옵션 그룹의 옵션을 표시 순서대로 이어 붙인다. 사이즈 그룹이 없으면 빈 값이다.
+func labelSize(groups []domain.ProductOptionGroup) string {
+	for _, group := range groups {
+		if group.Name != labelSizeOptionGroupName {
+			continue
+		}
+		names := make([]string, 0, len(group.Options))
+		for _, option := range group.Options {
+			names = append(names, option.Name)
+		}
+		return strings.Join(names, " / ")
+	}
+	return ""
+}
+
+// renderLabelSheet
+// 라벨지 규격에 맞춰 왼쪽 위부터 가로 방향으로 라벨을 채우고 페이지가 차면 다음 페이지로 넘긴다.
+func renderLabelSheet(template domain.LabelTemplate, font []byte, labels []productLabel) ([]byte, error) {
+	pdf := fpdf.NewCustom(&fpdf.InitType{
+		UnitStr: "mm",
+		Size:    fpdf.SizeType{Wd: template.PageWidth, Ht: template.PageHeight},
+	})
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetCreator("payhere", true)
+	pdf.AddUTF8FontFromBytes(labelFontFamily, "", font)
+
+	images := make(map[string]string)
+	for i, label := range labels {
+		position := i % template.LabelsPerPage()
+		if position == 0 {
+			pdf.AddPage()
+		}
+		x := template.MarginLeft + float64(position%template.Columns)*(template.LabelWidth+template.GapX)
+		y := template.MarginTop + float64(position/template.Columns)*(template.LabelHeight+template.GapY)
+
+		if err := drawLabel(pdf, template, x, y, label, images); err != nil {
+			return nil, err
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func drawLabel(pdf *fpdf.Fpdf, template domain.LabelTemplate, x, y float64, label productLabel, images map[string]string) error {
+	scale := math.Min(1, template.LabelHeight/labelBaseHeight)
+	width := template.LabelWidth - 2*labelPadding
+	top := y + labelPadding
+	bottom := y + template.LabelHeight - labelPadding
+
+	pdf.SetTextColor(0, 0, 0)
+	top = drawLabelText(pdf, x+labelPadding, top, width, labelNameFontSize*scale, label.Name)
+	if label.Size != "" {
+		pdf.SetTextColor(80, 80, 80)
+		top = drawLabelText(pdf, x+labelPadding, top, width, labelSizeFontSize*scale, label.Size)
+		pdf.SetTextColor(0, 0, 0)
+	}
+	top = drawLabelText(pdf, x+labelPadding, top, width, labelPriceFontSize*scale, label.Price)
+
+	if label.code == nil {
+		return nil
+	}
+
+	// 남은 공간 아래쪽에 바코드와 바코드 숫자를 그린다.
+	textHeight := labelBarcodeFontSize * scale * ptToMM
+	barHeight := bottom - top - textHeight - 0.5
+	if barHeight < 3 {
+		return nil
+	}
+	name, ok := images[label.Barcode]
+	if !ok {
+		data, err := label.code.PNG(800, 200)
+		if err != nil {
+			return err
+		}
+		name = fmt.Sprintf("barcode-%d", len(images))
+		pdf.RegisterImageOptionsReader(name, fpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(data))
+		images[label.Barcode] = name
+	}
+	pdf.ImageOptions(name, x+labelPadding, bottom-textHeight-barHeight, width, barHeight, false, fpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+	pdf.SetFont(labelFontFamily, "", labelBarcodeFontSize*scale)
+	pdf.SetXY(x+labelPadding, bottom-textHeight)
+	pdf.CellFormat(width, textHeight, label.Barcode, "", 0, "C", false, 0, "")
+
+	return pdf.Error()
+}
+
+// drawLabelText
+// 한 줄에 들어가지 않는 글자는 말줄임표로 자르고, 다음 줄을 그릴 y 좌표를 반환한다.
+func drawLabelText(pdf *fpdf.Fpdf, x, y, width, fontSize float64, text string) float64 {
+	pdf.SetFont(labelFontFamily, "", fontSize)
+	height := fontSize * ptToMM * 1.2
+
+	if pdf.GetStringWidth(text) > width {
+		runes := []rune(text)
+		for len(runes) > 0 && pdf.GetStringWidth(string(runes)+"…") > width {
+			runes = runes[:len(runes)-1]
+		}
+		text = string(runes) + "…"
+	}
+
+	pdf.SetXY(x, y)
+	pdf.CellFormat(width, height, text, "", 0, "L", false, 0, "")
+	return y + height
+}
+
+// labelFont
+// 라벨 폰트 파일은 처음 라벨을 만들 때 한 번만 읽는다.
+type labelFont struct {
+	path string
+	once sync.Once
+	data []byte
+	err  error
+}
+
+func newLabelFont(path string) *labelFont {
+	return &labelFont{path: path}
+}
+
+func (f *labelFont) load() ([]byte, error) {
+	f.once.Do(func() {
+		if f.path == "" {
+			f.err = fmt.Errorf("label font path is not configured")
+			return
+		}
+		f.data, f.err = os.ReadFile(f.path)
+	})
+	return f.data, f.err
+}
@@ -0,0 +1,73 @@
+package product
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font/gofont/goregular"
+	"payhere/domain"
+	"testing"
+)
+
+func Test_formatWon(t *testing.T) {
+	tests := []struct {
+		input    float64
+		expected string
+	}{
+		{input: 0, expected: "0원"},
+		{input: 500, expected: "500원"},
+		{input: 3500, expected: "3,500원"},
+		{input: 1234567.6, expected: "1,234,568원"},
+		{input: -1000, expected: "-1,000원"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, formatWon(test.input))
+	}
+}
+
+func Test_labelSize(t *testing.T) {
+	groups := []domain.ProductOptionGroup{
+		{Name: "온도", Options: []domain.ProductOption{{Name: "HOT"}, {Name: "ICE"}}},
+		{Name: "사이즈", Options: []domain.ProductOption{{Name: "레귤러"}, {Name: "라지"}}},
+	}
+
+	assert.Equal(t, "레귤러 / 라지", labelSize(groups))
+	assert.Equal(t, "", labelSize(groups[:1]))
+}
+
+func Test_renderLabelSheet(t *testing.T) {
+	tests := []struct {
+		name      string
+		template  domain.LabelTemplate
+		labels    int
+		wantPages int
+	}{
+		{name: "PASS - 한 페이지", template: domain.LabelTemplates["a4-3x8"], labels: 24, wantPages: 1},
+		{name: "PASS - 다음 페이지로 넘김", template: domain.LabelTemplates["a4-3x8"], labels: 25, wantPages: 2},
+		{name: "PASS - 작은 라벨", template: domain.LabelTemplates["a4-4x10"], labels: 41, wantPages: 2},
+	}
+
+	label := productLabelFrom(domain.Product{
+		Name:    "아주 긴 이름을 가진 슈크림 라떼 아주 긴 이름을 가진 슈크림 라떼",
+		Price:   3500,
+		Barcode: "8801234567893",
+		OptionGroups: []domain.ProductOptionGroup{
+			{Name: "사이즈", Options: []domain.ProductOption{{Name: "레귤러"}, {Name: "라지"}}},
+		},
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labels := make([]productLabel, tt.labels)
+			for i := range labels {
+				labels[i] = label
+			}
+
+			got, err := renderLabelSheet(tt.template, goregular.TTF, labels)
+
+			assert.NoError(t, err)
+			assert.True(t, bytes.HasPrefix(got, []byte("%PDF")))
+			assert.Equal(t, tt.wantPages, bytes.Count(got, []byte("/Type /Page\n")))
+		})
+	}
+}
@@ -2,12 +2,17 @@ package product
 
 import (
 	"context"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"io"
+	"log"
 	"net/http"
+	"path"
 	"payhere/config"
 	"payhere/domain"
 	cerrors "payhere/pkg/cerrors"
 	"payhere/pkg/router"
+	"strings"
 	"time"
 )
 
@@ -17,8 +22,35 @@ func RegisterRoutes(e *gin.Engine, controller domain.ProductController, authToke
 		products.POST("", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.CreateProduct)
 		products.GET("/:productID", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.GetProduct)
 		products.PATCH("", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.PatchProduct)
+		products.PUT("/:productID", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ReplaceProduct)
+		products.PATCH("/:productID", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ApplyProductPatch)
 		products.DELETE("/:productID", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.DeleteProduct)
 		products.GET("", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ListProducts)
+		products.GET("/export", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ExportProducts)
+		products.POST("/batch", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.BatchProducts)
+		products.POST("/import", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ImportProducts)
+		products.POST("/labels", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.CreateProductLabels)
+		products.GET("/expiring", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ListExpiringProducts)
+		products.GET("/suggest", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.SuggestProducts)
+		products.GET("/trash", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ListTrashProducts)
+		products.POST("/:productID/restore", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.RestoreProduct)
+		products.DELETE("/:productID/purge", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.PurgeProduct)
+		products.POST("/:productID/duplicate", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.DuplicateProduct)
+		products.POST("/:productID/template", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.CreateProductTemplate)
+		products.GET("/templates", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ListProductTemplates)
+		products.DELETE("/templates/:templateID", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.DeleteProductTemplate)
+		products.POST("/:productID/scheduled-prices", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.CreateScheduledPriceChange)
+		products.GET("/scheduled-prices", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ListScheduledPriceChanges)
+		products.DELETE("/scheduled-prices/:scheduleID", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.CancelScheduledPriceChange)
+		products.GET("/barcode/:barcode", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.GetProductByBarcode)
+		products.GET("/:productID/barcode.png", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.GetProductBarcodeImage)
+		products.GET("/:productID/barcode.svg", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.GetProductBarcodeImage)
+		products.GET("/:productID/qr.png", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.GetProductQRCode)
+		products.GET("/:productID/qr.svg", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.GetProductQRCode)
+		products.POST("/:productID/images", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.UploadProductImage)
+		products.PATCH("/:productID/images", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.PatchProductImages)
+		products.DELETE("/:productID/images/:imageID", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.DeleteProductImage)
+		products.GET("/:productID/price-history", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.GetProductPriceHistory)
 	}
 }
 
@@ -36,7 +68,7 @@ var _ domain.ProductController = (*productController)(nil)
 
 // CreateProduct
 // @Summary 상품 생성
-// @Description 상품의 필수 정보는 빈 값이 아니면 유효하고 가격과 원가는 0 이상이어야 합니다. 사이즈의 경우 small, large만 가능
+// @Description 상품의 필수 정보는 빈 값이 아니면 유효하고 가격과 원가는 0 이상이어야 합니다. 바코드는 EAN-8, EAN-13, UPC-A 체크 디지트를 검사하며 매장 자체 바코드는 internalBarcode 를 true 로 보냅니다. 같은 사장님의 상품끼리 바코드가 중복될 수 없습니다. 옵션 그룹은 single(하나만 선택), multi(여러 개 선택) 중 하나이고 그룹마다 옵션이 1개 이상 필요합니다. templateID 를 보내면 보내지 않은(0, 빈 값) 항목을 템플릿 값으로 채운 뒤 같은 검사를 합니다.
 // @Tags Product
 // @Accept json
 // @Produce json
@@ -75,23 +107,977 @@ func (pc productController) CreateProduct(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// BatchProducts
+// @Summary 상품 일괄 변경
+// @Description 상품 생성(create), 수정(patch), 삭제(delete) 작업을 보낸 순서대로 한 트랜잭션 안에서 실행합니다. 작업마다 op 에 맞는 create, patch, delete 중 하나만 채우고, 각 요청은 단건 API 의 요청과 같습니다. 작업이 하나라도 실패하면 모든 작업을 되돌리고 422 와 함께 작업별 결과를 응답합니다. 작업은 100개까지 보낼 수 있습니다.
+// @Tags Product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body domain.BatchProductsRequest true "일괄 변경 요청"
+// @Success 200 {object} domain.BatchProductsResponse "작업별 결과"
+// @Failure 422 {object} domain.BatchProductsResponse "실패한 작업과 되돌린 작업"
+// @Router /products/batch [post]
+func (pc productController) BatchProducts(c *gin.Context) {
+	var req domain.BatchProductsRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.BatchProducts(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if !res.Applied {
+		c.JSON(domain.PayhereResponseFrom(http.StatusUnprocessableEntity, res))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// ImportProducts
+// @Summary 상품 일괄 가져오기
+// @Description CSV 또는 XLSX 파일로 상품을 한 번에 만듭니다. 첫 행은 열 이름으로 상품 생성 요청과 같은 categoryID, price, cost, name, description, barcode, internalBarcode, expiryDate, reorderPoint, reorderQuantity, optionGroups(JSON) 를 쓰며 순서는 상관없습니다. 행마다 상품 생성과 같은 검사를 하고 통과한 행만 한 번에 만들며, 실패한 행은 이유와 함께 응답합니다. dryRun 을 true 로 보내면 검사 결과만 응답하고 상품은 만들지 않습니다. 파일은 5MB, 상품은 1000개까지 가져올 수 있습니다.
+// @Tags Product
+// @Accept mpfd
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "상품 파일 (.csv, .xlsx)"
+// @Param dryRun query bool false "검사만 하고 상품은 만들지 않음"
+// @Success 200 {object} domain.ImportProductsResponse "행별 가져오기 결과"
+// @Router /products/import [post]
+func (pc productController) ImportProducts(c *gin.Context) {
+	const op cerrors.Op = "product/controller/ImportProducts"
+	var req domain.ImportProductsRequest
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(cerrors.E(op, cerrors.Invalid, err, "파일을 확인해주세요.")))
+		return
+	}
+	if fileHeader.Size > domain.MaxProductImportFileSize {
+		c.JSON(cerrors.ToSentinelAPIError(cerrors.E(op, cerrors.Invalid, fmt.Sprintf("파일은 %dMB까지 가져올 수 있습니다.", domain.MaxProductImportFileSize>>20))))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(cerrors.E(op, cerrors.Invalid, err, "파일을 확인해주세요.")))
+		return
+	}
+	defer file.Close()
+
+	req.FileName = fileHeader.Filename
+	req.File, err = io.ReadAll(io.LimitReader(file, domain.MaxProductImportFileSize+1))
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(cerrors.E(op, cerrors.Invalid, err, "파일을 확인해주세요.")))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.ImportProducts(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// exportTimeout
+// 상품을 모두 내려받는 요청은 다른 요청보다 오래 걸릴 수 있어 따로 정한다.
+const exportTimeout = 5 * time.Minute
+
+// ExportProducts
+// @Summary 상품 내보내기
+// @Description 삭제되지 않은 자신의 상품을 모두 CSV, XLSX, JSON 파일로 내려받습니다. 상품 목록 조회와 같은 search, categoryID, tagID, tagMatch 조건을 쓸 수 있습니다. CSV 는 엑셀에서 한글이 깨지지 않도록 UTF-8 BOM 을 붙이고, CSV 와 XLSX 는 상품 가져오기와 같은 열 이름을 써서 그대로 다시 가져올 수 있습니다.
+// @Tags Product
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce json
+// @Security BearerAuth
+// @Param format query string false "파일 형식 (csv, xlsx, json)" default(csv)
+// @Param search query string false "검색 키워드"
+// @Param categoryID query int false "카테고리 ID"
+// @Param tagID query []int false "태그 ID (여러 번 보낼 수 있음)" collectionFormat(multi)
+// @Param tagMatch query string false "태그 조건 (any: 하나라도 붙은 상품, all: 모두 붙은 상품, 기본값 any)" Enums(any, all)
+// @Success 200 {file} file "상품 파일"
+// @Router /products/export [get]
+func (pc productController) ExportProducts(c *gin.Context) {
+	var req domain.ExportProductsRequest
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), exportTimeout)
+	defer cancel()
+
+	w := &exportWriter{c: c, req: req}
+	if err := pc.productService.ExportProducts(ctx, req, w); err != nil {
+		if w.written {
+			// 이미 파일을 보내기 시작해서 에러를 응답할 수 없다. 연결을 끊어 클라이언트가 잘린 파일을 알 수 있게 한다.
+			log.Println(err)
+			panic(http.ErrAbortHandler)
+		}
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	if !w.written {
+		c.Status(http.StatusOK)
+	}
+}
+
+// exportWriter
+// 처음 쓸 때 파일 다운로드 헤더와 함께 200 을 보낸다. 쓰기 전에 실패하면 다른 요청처럼 JSON 에러를 응답할 수 있다.
+type exportWriter struct {
+	c       *gin.Context
+	req     domain.ExportProductsRequest
+	written bool
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	if !w.written {
+		w.written = true
+		w.c.Header("Content-Type", w.req.FormatOrDefault().ContentType())
+		w.c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, w.req.FileName()))
+		w.c.Status(http.StatusOK)
+	}
+	return w.c.Writer.Write(p)
+}
+
 // GetProduct
 // @Summary 단일 상품 조회
-// @Description 상품 ID로 상품을 조회합니다. (단 자신의 상품만 조회 가능, 상품 아이디는 1 ~ 32 까지)
+// @Description 상품 ID로 상품을 조회합니다. 상품 버전을 ETag 헤더로 함께 응답하며, 수정과 삭제에 If-Match 로 보내면 그 사이 다른 곳에서 바뀐 상품은 412 로 거절합니다. (단 자신의 상품만 조회 가능, 상품 아이디는 1 ~ 32 까지)
+// @Tags Product
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "상품 ID"
+// @Success 200 {object} domain.GetProductResponse "상품 상세 정보"
+// @Header 200 {string} ETag "상품 버전"
+// @Router /products/{id} [get]
+func (pc productController) GetProduct(c *gin.Context) {
+	var req domain.GetProductRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.GetProduct(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Header("ETag", domain.ProductETag(res.Product.Version))
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// GetProductByBarcode
+// @Summary 바코드로 상품 조회
+// @Description 스캐너로 읽은 바코드로 상품을 조회합니다. (단 자신의 상품만 조회 가능)
+// @Tags Product
+// @Produce json
+// @Security BearerAuth
+// @Param barcode path string true "바코드"
+// @Success 200 {object} domain.GetProductResponse "상품 상세 정보"
+// @Router /products/barcode/{barcode} [get]
+func (pc productController) GetProductByBarcode(c *gin.Context) {
+	var req domain.GetProductByBarcodeRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.GetProductByBarcode(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// GetProductBarcodeImage
+// @Summary 상품 바코드 이미지
+// @Description 상품의 바코드를 PNG 또는 SVG 이미지로 그립니다. symbology 가 auto 면 EAN/UPC 바코드는 EAN 으로, 매장 자체 바코드는 Code128 로 그립니다. 응답의 ETag 로 캐시를 검증할 수 있습니다. (단 자신의 상품만 조회 가능, 크기 기본값 400x120)
+// @Tags Product
+// @Produce png
+// @Produce image/svg+xml
+// @Param productID path int true "상품 ID"
+// @Param symbology query string false "바코드 종류 (auto, code128, ean13)"
+// @Param width query int false "이미지 너비(px)"
+// @Param height query int false "이미지 높이(px)"
+// @Security BearerAuth
+// @Success 200 {file} binary "바코드 이미지"
+// @Success 304
+// @Router /products/{productID}/barcode.png [get]
+// @Router /products/{productID}/barcode.svg [get]
+func (pc productController) GetProductBarcodeImage(c *gin.Context) {
+	var req domain.GetProductBarcodeImageRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.Format = imageFormatFromPath(c)
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.GetProductBarcodeImage(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	writeBarcodeImage(c, res)
+}
+
+// GetProductQRCode
+// @Summary 상품 QR 코드 이미지
+// @Description 상품 URL 을 담은 QR 코드를 PNG 또는 SVG 이미지로 그립니다. 응답의 ETag 로 캐시를 검증할 수 있습니다. (단 자신의 상품만 조회 가능, 크기 기본값 256)
+// @Tags Product
+// @Produce png
+// @Produce image/svg+xml
+// @Param productID path int true "상품 ID"
+// @Param size query int false "이미지 한 변의 길이(px)"
+// @Security BearerAuth
+// @Success 200 {file} binary "QR 코드 이미지"
+// @Success 304
+// @Router /products/{productID}/qr.png [get]
+// @Router /products/{productID}/qr.svg [get]
+func (pc productController) GetProductQRCode(c *gin.Context) {
+	var req domain.GetProductQRCodeRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.Format = imageFormatFromPath(c)
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.GetProductQRCode(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	writeBarcodeImage(c, res)
+}
+
+// CreateProductLabels
+// @Summary 가격표 라벨 PDF 출력
+// @Description 상품명, 가격(원), 사이즈, 바코드가 들어간 가격표 라벨지를 PDF 로 만듭니다. productIDs 와 filter(상품 목록 조회와 같은 검색 조건) 중 하나로 상품을 고릅니다. 라벨지는 a4-2x7, a4-3x8, a4-4x10 중 하나이고 기본값은 a4-3x8 입니다. (단 자신의 상품만 출력 가능, 한 번에 최대 1000장)
+// @Tags Product
+// @Accept json
+// @Produce application/pdf
+// @Security BearerAuth
+// @Param CreateProductLabelsRequest body domain.CreateProductLabelsRequest true "라벨 출력 요청"
+// @Success 200 {file} binary "라벨 PDF"
+// @Router /products/labels [post]
+func (pc productController) CreateProductLabels(c *gin.Context) {
+	var req domain.CreateProductLabelsRequest
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.CreateProductLabels(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="labels.pdf"`)
+	c.Data(http.StatusOK, "application/pdf", res)
+}
+
+// imageFormatFromPath
+// 라우트 경로의 확장자(barcode.png, qr.svg)로 이미지 형식을 정한다.
+func imageFormatFromPath(c *gin.Context) domain.BarcodeImageFormat {
+	return domain.BarcodeImageFormat(strings.TrimPrefix(path.Ext(c.FullPath()), "."))
+}
+
+// writeBarcodeImage
+// 라벨 이미지는 상품을 수정하기 전까지 바뀌지 않으므로 브라우저와 프린터 드라이버가 캐시할 수 있게 한다.
+// 인증이 필요한 응답이라 공유 캐시에는 저장하지 않고, ETag 가 같으면 본문 없이 304 를 응답한다.
+func writeBarcodeImage(c *gin.Context, image domain.BarcodeImage) {
+	c.Header("Cache-Control", "private, max-age=300")
+	c.Header("ETag", image.ETag)
+	if !image.LastModified.IsZero() {
+		c.Header("Last-Modified", image.LastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if c.GetHeader("If-None-Match") == image.ETag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Data(http.StatusOK, image.ContentType, image.Data)
+}
+
+// PatchProduct
+// @Summary 전체 또는 부분 상품 수정
+// @Description 상품의 필수 정보는 빈 값이 아니면 유효하고 가격과 원가는 0 이상이어야 합니다. optionGroups 를 보내면 기존 옵션 그룹을 모두 교체합니다. If-Match 를 보내면 상품 버전이 같을 때만 수정하고, 다르거나 수정하는 사이 다른 곳에서 먼저 수정하면 412 를 응답합니다. 값을 비울 수 없으므로 새 클라이언트는 PUT, PATCH /products/{id} 를 사용해주세요. (단 자신의 상품만 수정 가능)
+// @Tags Product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param If-Match header string false "상품 조회에서 받은 ETag"
+// @Param PatchProductRequest body domain.PatchProductRequest true "상품 수정 요청"
+// @Success 204
+// @Failure 412 {object} cerrors.SentinelAPIError "상품 버전이 다름"
+// @Deprecated
+// @Router /products [patch]
+func (pc productController) PatchProduct(c *gin.Context) {
+	var req domain.PatchProductRequest
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	version, err := domain.ProductVersionFromIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.Version = version
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := pc.productService.PatchProduct(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ReplaceProduct
+// @Summary 상품 전체 수정
+// @Description 상품을 보낸 값으로 모두 바꿉니다. 상품 생성과 같은 검사를 하며, 보내지 않은 값은 비우므로 reorderPoint 가 없으면 재고 부족 알림을 끄고 optionGroups 가 없으면 옵션을 모두 지웁니다. 정가나 원가가 바뀌면 가격 변경 기록을 남깁니다. If-Match 를 보내면 상품 버전이 같을 때만 수정하고, 다르면 412 를 응답합니다. (단 자신의 상품만 수정 가능)
+// @Tags Product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "상품 ID"
+// @Param If-Match header string false "상품 조회에서 받은 ETag"
+// @Param ReplaceProductRequest body domain.ReplaceProductRequest true "상품 전체 수정 요청"
+// @Success 204
+// @Failure 412 {object} cerrors.SentinelAPIError "상품 버전이 다름"
+// @Router /products/{id} [put]
+func (pc productController) ReplaceProduct(c *gin.Context) {
+	var req domain.ReplaceProductRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	version, err := domain.ProductVersionFromIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.Version = version
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := pc.productService.ReplaceProduct(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ApplyProductPatch
+// @Summary 상품 부분 수정 (merge patch, JSON patch)
+// @Description Content-Type 이 application/merge-patch+json 이면 RFC 7396 에 따라 보낸 값만 바꾸고 null 로 보낸 값은 비웁니다. application/json-patch+json 이면 RFC 6902 의 test, replace, remove 작업을 순서대로 적용하고, test 가 실패하면 412 를 응답합니다. 두 형식 모두 상품 전체 수정 요청(PUT)과 같은 모양의 문서에 적용하고, 적용한 결과에 상품 생성과 같은 검사를 다시 합니다. If-Match 를 보내면 상품 버전이 같을 때만 수정하고, 다르거나 수정하는 사이 다른 곳에서 먼저 수정하면 412 를 응답합니다. (단 자신의 상품만 수정 가능)
+// @Tags Product
+// @Accept application/merge-patch+json,application/json-patch+json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "상품 ID"
+// @Param If-Match header string false "상품 조회에서 받은 ETag"
+// @Param patch body object true "merge patch 객체 또는 JSON patch 작업 배열"
+// @Success 204
+// @Failure 412 {object} cerrors.SentinelAPIError "상품 버전이 다르거나 test 작업이 실패함"
+// @Router /products/{id} [patch]
+func (pc productController) ApplyProductPatch(c *gin.Context) {
+	const op cerrors.Op = "product/controller/ApplyProductPatch"
+	var req domain.ApplyProductPatchRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	patch, err := io.ReadAll(io.LimitReader(c.Request.Body, domain.MaxProductPatchSize+1))
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(cerrors.E(op, cerrors.Invalid, err, "수정할 내용을 확인해주세요.")))
+		return
+	}
+	req.ContentType = c.ContentType()
+	req.Patch = patch
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	version, err := domain.ProductVersionFromIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.Version = version
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := pc.productService.ApplyProductPatch(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DeleteProduct
+// @Summary 상품 삭제
+// @Description 상품 ID로 상품을 삭제합니다. If-Match 를 보내면 상품 버전이 같을 때만 삭제하고, 다르면 412 를 응답합니다. (단 자신의 상품만 삭제 가능)
+// @Tags Product
+// @Produce json
+// @Param id path int true "제품 ID"
+// @Param If-Match header string false "상품 조회에서 받은 ETag"
+// @Security BearerAuth
+// @Success 204
+// @Failure 412 {object} cerrors.SentinelAPIError "상품 버전이 다름"
+// @Router /products/{id} [delete]
+func (pc productController) DeleteProduct(c *gin.Context) {
+	var req domain.DeleteProductRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	version, err := domain.ProductVersionFromIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.Version = version
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := pc.productService.DeleteProduct(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListTrashProducts
+// @Summary 휴지통 상품 목록 조회
+// @Description 삭제한 상품을 조회합니다. 삭제한 상품은 보관 기간 동안 휴지통에 있고 purgeDate 가 지나면 완전히 삭제됩니다. (단 자신의 상품만 조회 가능)
+// @Tags Product
+// @Produce json
+// @Param cursor query int false "커서"
+// @Security BearerAuth
+// @Success 200 {object} domain.ListTrashProductsResponse "휴지통 상품 목록"
+// @Router /products/trash [get]
+func (pc productController) ListTrashProducts(c *gin.Context) {
+	var req domain.ListTrashProductsRequest
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.ListTrashProducts(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// RestoreProduct
+// @Summary 휴지통 상품 복원
+// @Description 휴지통에 있는 상품을 복원하고 복원한 상품을 응답합니다. 그 사이 같은 바코드의 상품을 만들었으면 복원할 수 없습니다. (단 자신의 상품만 복원 가능)
+// @Tags Product
+// @Produce json
+// @Param productID path int true "상품 ID"
+// @Security BearerAuth
+// @Success 200 {object} domain.GetProductResponse "복원한 상품"
+// @Router /products/{productID}/restore [post]
+func (pc productController) RestoreProduct(c *gin.Context) {
+	var req domain.RestoreProductRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.RestoreProduct(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// PurgeProduct
+// @Summary 휴지통 상품 완전 삭제
+// @Description 휴지통에 있는 상품을 보관 기간을 기다리지 않고 완전히 삭제합니다. 완전히 삭제한 상품은 복원할 수 없고 폐기 기록만 남습니다. (단 자신의 상품만 삭제 가능)
+// @Tags Product
+// @Produce json
+// @Param productID path int true "상품 ID"
+// @Security BearerAuth
+// @Success 204
+// @Router /products/{productID}/purge [delete]
+func (pc productController) PurgeProduct(c *gin.Context) {
+	var req domain.PurgeProductRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := pc.productService.PurgeProduct(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DuplicateProduct
+// @Summary 상품 복제
+// @Description 상품을 복제해 새 상품을 만들고 만든 상품을 응답합니다. 보낸 값만 원본과 다르게 만들고, 바코드는 상품마다 달라야 하므로 반드시 보냅니다. 옵션은 복제하고 재고, 이미지, 가격 변경 기록은 복제하지 않습니다. 상품명을 바꾸면 초성과 로마자 검색어도 새 이름으로 만듭니다. (단 자신의 상품만 복제 가능)
+// @Tags Product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param productID path int true "상품 ID"
+// @Param DuplicateProductRequest body domain.DuplicateProductRequest true "바꿀 값"
+// @Success 200 {object} domain.GetProductResponse "만든 상품"
+// @Router /products/{productID}/duplicate [post]
+func (pc productController) DuplicateProduct(c *gin.Context) {
+	var req domain.DuplicateProductRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.DuplicateProduct(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// CreateProductTemplate
+// @Summary 상품을 템플릿으로 저장
+// @Description 상품의 카테고리, 가격, 원가, 이름, 설명, 재고 알림, 옵션을 템플릿으로 저장합니다. 상품 생성에 templateID 를 보내면 보내지 않은 값을 템플릿 값으로 채웁니다. 바코드와 유통기한은 저장하지 않습니다. (단 자신의 상품만 저장 가능)
+// @Tags Product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param productID path int true "상품 ID"
+// @Param CreateProductTemplateRequest body domain.CreateProductTemplateRequest false "템플릿 이름 (보내지 않으면 상품명)"
+// @Success 200 {object} domain.CreateProductTemplateResponse "저장한 템플릿"
+// @Router /products/{productID}/template [post]
+func (pc productController) CreateProductTemplate(c *gin.Context) {
+	var req domain.CreateProductTemplateRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(cerrors.ToSentinelAPIError(err))
+			return
+		}
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.CreateProductTemplate(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// ListProductTemplates
+// @Summary 상품 템플릿 목록 조회
+// @Description 저장한 상품 템플릿을 이름 순서로 조회합니다.
+// @Tags Product
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} domain.ListProductTemplatesResponse "템플릿 목록"
+// @Router /products/templates [get]
+func (pc productController) ListProductTemplates(c *gin.Context) {
+	var req domain.ListProductTemplatesRequest
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.ListProductTemplates(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// DeleteProductTemplate
+// @Summary 상품 템플릿 삭제
+// @Description 템플릿을 삭제합니다. 템플릿으로 이미 만든 상품은 그대로 남습니다. (단 자신의 템플릿만 삭제 가능)
+// @Tags Product
+// @Produce json
+// @Security BearerAuth
+// @Param templateID path int true "템플릿 ID"
+// @Success 204
+// @Router /products/templates/{templateID} [delete]
+func (pc productController) DeleteProductTemplate(c *gin.Context) {
+	var req domain.DeleteProductTemplateRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := pc.productService.DeleteProductTemplate(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateScheduledPriceChange
+// @Summary 상품 가격 예약
+// @Description effectiveDate 가 지나면 정가나 원가를 바꾸도록 예약합니다. price 와 cost 중 바꿀 값만 보냅니다. 서버 작업이 1분마다 확인해서 한 번만 적용하고, 가격 변경 기록에는 예약한 사장님을 남깁니다. 휴지통에 있는 상품의 예약은 복구할 때까지 적용하지 않습니다. (단 자신의 상품만 예약 가능)
 // @Tags Product
+// @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param id path int true "상품 ID"
-// @Success 200 {object} domain.GetProductResponse "상품 상세 정보"
-// @Router /products/{id} [get]
-func (pc productController) GetProduct(c *gin.Context) {
-	var req domain.GetProductRequest
+// @Param productID path int true "상품 ID"
+// @Param CreateScheduledPriceChangeRequest body domain.CreateScheduledPriceChangeRequest true "바꿀 가격, 원가와 시각"
+// @Success 200 {object} domain.CreateScheduledPriceChangeResponse "예약한 가격"
+// @Router /products/{productID}/scheduled-prices [post]
+func (pc productController) CreateScheduledPriceChange(c *gin.Context) {
+	var req domain.CreateScheduledPriceChangeRequest
 
 	if err := c.ShouldBindUri(&req); err != nil {
 		c.JSON(cerrors.ToSentinelAPIError(err))
 		return
 	}
 
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
 	if err := req.Validate(); err != nil {
 		c.JSON(cerrors.ToSentinelAPIError(err))
 		return
@@ -107,7 +1093,7 @@ func (pc productController) GetProduct(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
 	defer cancel()
 
-	res, err := pc.productService.GetProduct(ctx, req)
+	res, err := pc.productService.CreateScheduledPriceChange(ctx, req)
 	if err != nil {
 		c.JSON(cerrors.ToSentinelAPIError(err))
 		return
@@ -116,20 +1102,60 @@ func (pc productController) GetProduct(c *gin.Context) {
 	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
 }
 
-// PatchProduct
-// @Summary 전체 또는 부분 상품 수정
-// @Description 상품의 필수 정보는 빈 값이 아니면 유효하고 가격과 원가는 0 이상이어야 합니다. 사이즈의 경우 small, large만 가능 (단 자신의 상품만 수정 가능)
+// ListScheduledPriceChanges
+// @Summary 상품 가격 예약 목록 조회
+// @Description 아직 적용하지 않은 가격 예약을 적용할 시각 순서로 조회합니다. productID 를 보내면 그 상품의 예약만 조회합니다.
 // @Tags Product
-// @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param PatchProductRequest body domain.PatchProductRequest true "상품 수정 요청"
+// @Param productID query int false "상품 ID"
+// @Success 200 {object} domain.ListScheduledPriceChangesResponse "가격 예약 목록"
+// @Router /products/scheduled-prices [get]
+func (pc productController) ListScheduledPriceChanges(c *gin.Context) {
+	var req domain.ListScheduledPriceChangesRequest
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.ListScheduledPriceChanges(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// CancelScheduledPriceChange
+// @Summary 상품 가격 예약 취소
+// @Description 아직 적용하지 않은 가격 예약을 취소합니다. 이미 적용했거나 취소한 예약이면 409 를 응답합니다. (단 자신의 예약만 취소 가능)
+// @Tags Product
+// @Produce json
+// @Security BearerAuth
+// @Param scheduleID path int true "가격 예약 ID"
 // @Success 204
-// @Router /products [patch]
-func (pc productController) PatchProduct(c *gin.Context) {
-	var req domain.PatchProductRequest
+// @Router /products/scheduled-prices/{scheduleID} [delete]
+func (pc productController) CancelScheduledPriceChange(c *gin.Context) {
+	var req domain.CancelScheduledPriceChangeRequest
 
-	if err := c.ShouldBind(&req); err != nil {
+	if err := c.ShouldBindUri(&req); err != nil {
 		c.JSON(cerrors.ToSentinelAPIError(err))
 		return
 	}
@@ -149,7 +1175,7 @@ func (pc productController) PatchProduct(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
 	defer cancel()
 
-	if err := pc.productService.PatchProduct(ctx, req); err != nil {
+	if err := pc.productService.CancelScheduledPriceChange(ctx, req); err != nil {
 		c.JSON(cerrors.ToSentinelAPIError(err))
 		return
 	}
@@ -157,17 +1183,133 @@ func (pc productController) PatchProduct(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
-// DeleteProduct
-// @Summary 상품 삭제
-// @Description 상품 ID로 상품을 삭제합니다. (단 자신의 상품만 삭제 가능)
+// UploadProductImage
+// @Summary 상품 이미지 올리기
+// @Description JPEG, PNG, WebP 이미지를 올리면 원본(2048px), 중간(640px), 썸네일(160px) 크기로 줄여 저장합니다. 촬영 정보(EXIF)는 지우고 회전 정보만 반영합니다. PNG 는 PNG 로, 나머지는 JPEG 로 저장합니다. 이미지는 10MB, 상품마다 10개까지 올릴 수 있고 처음 올린 이미지가 대표 이미지가 됩니다. (단 자신의 상품만 가능)
+// @Tags Product
+// @Accept mpfd
+// @Produce json
+// @Security BearerAuth
+// @Param productID path int true "상품 ID"
+// @Param image formData file true "상품 이미지 (.jpg, .png, .webp)"
+// @Success 200 {object} domain.UploadProductImageResponse "올린 이미지"
+// @Router /products/{productID}/images [post]
+func (pc productController) UploadProductImage(c *gin.Context) {
+	const op cerrors.Op = "product/controller/UploadProductImage"
+	var req domain.UploadProductImageRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(cerrors.E(op, cerrors.Invalid, err, "이미지를 확인해주세요.")))
+		return
+	}
+	if fileHeader.Size > domain.MaxProductImageFileSize {
+		c.JSON(cerrors.ToSentinelAPIError(cerrors.E(op, cerrors.Invalid, fmt.Sprintf("이미지는 %dMB까지 올릴 수 있습니다.", domain.MaxProductImageFileSize>>20))))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(cerrors.E(op, cerrors.Invalid, err, "이미지를 확인해주세요.")))
+		return
+	}
+	defer file.Close()
+
+	req.File, err = io.ReadAll(io.LimitReader(file, domain.MaxProductImageFileSize+1))
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(cerrors.E(op, cerrors.Invalid, err, "이미지를 확인해주세요.")))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.UploadProductImage(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// PatchProductImages
+// @Summary 상품 이미지 순서, 대표 이미지 변경
+// @Description imageIDs 에 상품의 이미지 ID 를 모두 원하는 순서대로 보내면 그 순서로 바뀝니다. primaryImageID 를 보내면 대표 이미지가 바뀝니다. 둘 중 하나는 보내야 합니다. (단 자신의 상품만 가능)
+// @Tags Product
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param productID path int true "상품 ID"
+// @Param PatchProductImagesRequest body domain.PatchProductImagesRequest true "이미지 순서, 대표 이미지 변경 요청"
+// @Success 200 {object} domain.PatchProductImagesResponse "바뀐 이미지 목록"
+// @Router /products/{productID}/images [patch]
+func (pc productController) PatchProductImages(c *gin.Context) {
+	var req domain.PatchProductImagesRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.PatchProductImages(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// DeleteProductImage
+// @Summary 상품 이미지 삭제
+// @Description 상품 이미지를 삭제합니다. 대표 이미지를 삭제하면 가장 앞의 이미지가 대표 이미지가 됩니다. (단 자신의 상품만 가능)
 // @Tags Product
 // @Produce json
-// @Param id path int true "제품 ID"
 // @Security BearerAuth
+// @Param productID path int true "상품 ID"
+// @Param imageID path int true "이미지 ID"
 // @Success 204
-// @Router /products/{id} [delete]
-func (pc productController) DeleteProduct(c *gin.Context) {
-	var req domain.DeleteProductRequest
+// @Router /products/{productID}/images/{imageID} [delete]
+func (pc productController) DeleteProductImage(c *gin.Context) {
+	var req domain.DeleteProductImageRequest
 
 	if err := c.ShouldBindUri(&req); err != nil {
 		c.JSON(cerrors.ToSentinelAPIError(err))
@@ -189,7 +1331,7 @@ func (pc productController) DeleteProduct(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
 	defer cancel()
 
-	if err := pc.productService.DeleteProduct(ctx, req); err != nil {
+	if err := pc.productService.DeleteProductImage(ctx, req); err != nil {
 		c.JSON(cerrors.ToSentinelAPIError(err))
 		return
 	}
@@ -197,13 +1339,64 @@ func (pc productController) DeleteProduct(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// GetProductPriceHistory
+// @Summary 상품 가격 변경 기록
+// @Description 정가, 원가, 실제 판매가가 바뀐 기록을 바꾼 사람(actorID, 서버 작업이면 없음)과 이유와 함께 응답합니다. series 에는 기간의 날짜마다 그날이 끝날 때의 가격을 담아 그래프를 그릴 수 있습니다. 날짜는 매장 시간대 기준이고 from, to 를 보내지 않으면 오늘까지 최근 30일, 최대 366일까지 조회할 수 있습니다. (단 자신의 상품만 조회 가능)
+// @Tags Product
+// @Produce json
+// @Security BearerAuth
+// @Param productID path int true "상품 ID"
+// @Param from query string false "시작일 (2024-02-01)"
+// @Param to query string false "종료일, 포함 (2024-02-29)"
+// @Success 200 {object} domain.GetProductPriceHistoryResponse "가격 변경 기록과 날짜별 가격"
+// @Router /products/{productID}/price-history [get]
+func (pc productController) GetProductPriceHistory(c *gin.Context) {
+	var req domain.GetProductPriceHistoryRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.GetProductPriceHistory(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
 // ListProducts
 // @Summary 상품 목록 조회
 // @Description 상품 목록을 조회합니다. (단 자신의 상품만 조회 가능)
 // @Tags Product
 // @Produce json
 // @Param cursor query int false "커서"
-// @Param search query string false "검색어"
+// @Param search query string false "검색어 (상품명, 초성 또는 영문 로마자 표기)"
+// @Param categoryID query int false "카테고리 ID"
+// @Param tagID query []int false "태그 ID (여러 번 보낼 수 있음)" collectionFormat(multi)
+// @Param tagMatch query string false "태그 조건 (any: 하나라도 붙은 상품, all: 모두 붙은 상품, 기본값 any)" Enums(any, all)
 // @Security BearerAuth
 // @Success 200 {object} domain.ListProductsResponse "상품 목록"
 // @Router /products [get]
@@ -215,6 +1408,11 @@ func (pc productController) ListProducts(c *gin.Context) {
 		return
 	}
 
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
 	userID, err := router.GetUserIDFromContext(c)
 	if err != nil {
 		c.JSON(cerrors.ToSentinelAPIError(err))
@@ -233,3 +1431,88 @@ func (pc productController) ListProducts(c *gin.Context) {
 
 	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
 }
+
+// SuggestProducts
+// @Summary 상품명 자동완성
+// @Description 검색어로 시작하는 상품명을 최대 limit 개 조회합니다. 상품명과 초성 모두 검색 가능 (단 자신의 상품만 조회 가능, limit 기본값 10 최대 20)
+// @Tags Product
+// @Produce json
+// @Param q query string true "검색어"
+// @Param limit query int false "자동완성 개수"
+// @Security BearerAuth
+// @Success 200 {object} domain.SuggestProductsResponse "자동완성 상품명 목록"
+// @Router /products/suggest [get]
+func (pc productController) SuggestProducts(c *gin.Context) {
+	var req domain.SuggestProductsRequest
+
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.SuggestProducts(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// ListExpiringProducts
+// @Summary 유통기한 임박 상품 조회
+// @Description 지금부터 within 안에 유통기한이 끝나는 상품을 유통기한이 빠른 순으로 최대 500개 조회합니다. within 은 72h, 90m 같은 형식이고 기본값 72h 최대 2160h 입니다. includeExpired 가 true 면 이미 유통기한이 지난 상품도 함께 조회합니다. (단 자신의 상품만 조회 가능)
+// @Tags Product
+// @Produce json
+// @Param within query string false "조회 기간 (예: 72h)"
+// @Param categoryID query int false "카테고리 ID"
+// @Param includeExpired query bool false "유통기한이 지난 상품 포함 여부"
+// @Security BearerAuth
+// @Success 200 {object} domain.ListExpiringProductsResponse "유통기한 임박 상품 목록"
+// @Router /products/expiring [get]
+func (pc productController) ListExpiringProducts(c *gin.Context) {
+	var req domain.ListExpiringProductsRequest
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := pc.productService.ListExpiringProducts(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
@@ -2,11 +2,15 @@ package product
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"io"
 	"k8s.io/utils/pointer"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -14,6 +18,8 @@ import (
 	"payhere/domain"
 	"payhere/internal/auth_token"
 	"payhere/mocks"
+	cerrors "payhere/pkg/cerrors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -51,6 +57,17 @@ func setupProductControllerTestSuite(t *testing.T) productControllerTestSuite {
 
 func Test_productController_CreateProduct(t *testing.T) {
 	expiryDate := time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC)
+	sizeOptionGroups := []domain.ProductOptionGroupRequest{
+		{
+			Name:       "사이즈",
+			SelectType: domain.ProductOptionSelectTypeSingle,
+			Required:   true,
+			Options: []domain.ProductOptionRequest{
+				{Name: "레귤러"},
+				{Name: "라지", PriceDelta: 500, CostDelta: 200},
+			},
+		},
+	}
 
 	tests := []struct {
 		name string
@@ -62,15 +79,15 @@ func Test_productController_CreateProduct(t *testing.T) {
 			name: "PASS - 상품 생성 성공",
 			body: func() *bytes.Reader {
 				req := domain.CreateProductRequest{
-					UserID:      1,
-					Category:    "payhere",
-					Price:       1000,
-					Cost:        500,
-					Name:        "test_product",
-					Description: "test_description",
-					Barcode:     "1234567890",
-					ExpiryDate:  expiryDate,
-					Size:        domain.ProductSizeTypeSmall,
+					UserID:       1,
+					CategoryID:   1,
+					Price:        1000,
+					Cost:         500,
+					Name:         "test_product",
+					Description:  "test_description",
+					Barcode:      "8801234567893",
+					ExpiryDate:   expiryDate,
+					OptionGroups: sizeOptionGroups,
 				}
 				jsonData, _ := json.Marshal(req)
 
@@ -85,15 +102,38 @@ func Test_productController_CreateProduct(t *testing.T) {
 					Active:         true,
 				}, nil).Once()
 				ts.productService.EXPECT().CreateProduct(mock.Anything, domain.CreateProductRequest{
-					UserID:      1,
-					Category:    "payhere",
-					Price:       1000,
-					Cost:        500,
-					Name:        "test_product",
-					Description: "test_description",
-					Barcode:     "1234567890",
-					ExpiryDate:  expiryDate,
-					Size:        domain.ProductSizeTypeSmall,
+					UserID:       1,
+					CategoryID:   1,
+					Price:        1000,
+					Cost:         500,
+					Name:         "test_product",
+					Description:  "test_description",
+					Barcode:      "8801234567893",
+					ExpiryDate:   expiryDate,
+					OptionGroups: sizeOptionGroups,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "PASS - 템플릿으로 시작하면 0 으로 보낸 항목도 보낸 값으로 넘긴다",
+			body: func() *bytes.Reader {
+				return bytes.NewReader([]byte(`{"templateID":3,"price":0,"barcode":"8801234567893","expiryDate":"2025-06-10T00:00:00Z"}`))
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().CreateProduct(mock.Anything, domain.CreateProductRequest{
+					UserID:     1,
+					Barcode:    "8801234567893",
+					ExpiryDate: expiryDate,
+					TemplateID: pointer.Int(3),
+					SentFields: map[string]bool{"templateID": true, "price": true, "barcode": true, "expiryDate": true},
 				}).Return(nil).Once()
 			},
 			code: http.StatusNoContent,
@@ -102,15 +142,15 @@ func Test_productController_CreateProduct(t *testing.T) {
 			name: "FAIL - 음수의 가격",
 			body: func() *bytes.Reader {
 				req := domain.CreateProductRequest{
-					UserID:      1,
-					Category:    "payhere",
-					Price:       -1000,
-					Cost:        500,
-					Name:        "test_product",
-					Description: "test_description",
-					Barcode:     "1234567890",
-					ExpiryDate:  expiryDate,
-					Size:        domain.ProductSizeTypeSmall,
+					UserID:       1,
+					CategoryID:   1,
+					Price:        -1000,
+					Cost:         500,
+					Name:         "test_product",
+					Description:  "test_description",
+					Barcode:      "8801234567893",
+					ExpiryDate:   expiryDate,
+					OptionGroups: sizeOptionGroups,
 				}
 				jsonData, _ := json.Marshal(req)
 
@@ -131,15 +171,15 @@ func Test_productController_CreateProduct(t *testing.T) {
 			name: "FAIL - 음수의 원가",
 			body: func() *bytes.Reader {
 				req := domain.CreateProductRequest{
-					UserID:      1,
-					Category:    "payhere",
-					Price:       1000,
-					Cost:        -500,
-					Name:        "test_product",
-					Description: "test_description",
-					Barcode:     "1234567890",
-					ExpiryDate:  expiryDate,
-					Size:        domain.ProductSizeTypeSmall,
+					UserID:       1,
+					CategoryID:   1,
+					Price:        1000,
+					Cost:         -500,
+					Name:         "test_product",
+					Description:  "test_description",
+					Barcode:      "8801234567893",
+					ExpiryDate:   expiryDate,
+					OptionGroups: sizeOptionGroups,
 				}
 				jsonData, _ := json.Marshal(req)
 
@@ -160,15 +200,15 @@ func Test_productController_CreateProduct(t *testing.T) {
 			name: "FAIL - 비어있는 이름",
 			body: func() *bytes.Reader {
 				req := domain.CreateProductRequest{
-					UserID:      1,
-					Category:    "payhere",
-					Price:       1000,
-					Cost:        500,
-					Name:        "",
-					Description: "test_description",
-					Barcode:     "1234567890",
-					ExpiryDate:  expiryDate,
-					Size:        domain.ProductSizeTypeSmall,
+					UserID:       1,
+					CategoryID:   1,
+					Price:        1000,
+					Cost:         500,
+					Name:         "",
+					Description:  "test_description",
+					Barcode:      "8801234567893",
+					ExpiryDate:   expiryDate,
+					OptionGroups: sizeOptionGroups,
 				}
 				jsonData, _ := json.Marshal(req)
 
@@ -189,15 +229,15 @@ func Test_productController_CreateProduct(t *testing.T) {
 			name: "FAIL - 비어있는 설명",
 			body: func() *bytes.Reader {
 				req := domain.CreateProductRequest{
-					UserID:      1,
-					Category:    "category",
-					Price:       1000,
-					Cost:        500,
-					Name:        "test_product",
-					Description: "",
-					Barcode:     "1234567890",
-					ExpiryDate:  expiryDate,
-					Size:        domain.ProductSizeTypeSmall,
+					UserID:       1,
+					CategoryID:   1,
+					Price:        1000,
+					Cost:         500,
+					Name:         "test_product",
+					Description:  "",
+					Barcode:      "8801234567893",
+					ExpiryDate:   expiryDate,
+					OptionGroups: sizeOptionGroups,
 				}
 				jsonData, _ := json.Marshal(req)
 
@@ -218,15 +258,44 @@ func Test_productController_CreateProduct(t *testing.T) {
 			name: "FAIL - 비어있는 바코드",
 			body: func() *bytes.Reader {
 				req := domain.CreateProductRequest{
-					UserID:      1,
-					Category:    "payhere",
-					Price:       1000,
-					Cost:        500,
-					Name:        "test_product",
-					Description: "test_description",
-					Barcode:     "",
-					ExpiryDate:  expiryDate,
-					Size:        domain.ProductSizeTypeSmall,
+					UserID:       1,
+					CategoryID:   1,
+					Price:        1000,
+					Cost:         500,
+					Name:         "test_product",
+					Description:  "test_description",
+					Barcode:      "",
+					ExpiryDate:   expiryDate,
+					OptionGroups: sizeOptionGroups,
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 체크 디지트가 틀린 바코드",
+			body: func() *bytes.Reader {
+				req := domain.CreateProductRequest{
+					UserID:       1,
+					CategoryID:   1,
+					Price:        1000,
+					Cost:         500,
+					Name:         "test_product",
+					Description:  "test_description",
+					Barcode:      "8801234567890",
+					ExpiryDate:   expiryDate,
+					OptionGroups: sizeOptionGroups,
 				}
 				jsonData, _ := json.Marshal(req)
 
@@ -243,19 +312,59 @@ func Test_productController_CreateProduct(t *testing.T) {
 			},
 			code: http.StatusBadRequest,
 		},
+		{
+			name: "PASS - 매장 자체 바코드",
+			body: func() *bytes.Reader {
+				req := domain.CreateProductRequest{
+					UserID:          1,
+					CategoryID:      1,
+					Price:           1000,
+					Cost:            500,
+					Name:            "test_product",
+					Description:     "test_description",
+					Barcode:         "STORE-001",
+					InternalBarcode: true,
+					ExpiryDate:      expiryDate,
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().CreateProduct(mock.Anything, domain.CreateProductRequest{
+					UserID:          1,
+					CategoryID:      1,
+					Price:           1000,
+					Cost:            500,
+					Name:            "test_product",
+					Description:     "test_description",
+					Barcode:         "STORE-001",
+					InternalBarcode: true,
+					ExpiryDate:      expiryDate,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
 		{
 			name: "FAIL - 비어있는 유효기간",
 			body: func() *bytes.Reader {
 				req := domain.CreateProductRequest{
-					UserID:      1,
-					Category:    "",
-					Price:       1000,
-					Cost:        500,
-					Name:        "test_product",
-					Description: "test_description",
-					Barcode:     "1234567890",
-					ExpiryDate:  time.Time{},
-					Size:        domain.ProductSizeTypeSmall,
+					UserID:       1,
+					CategoryID:   0,
+					Price:        1000,
+					Cost:         500,
+					Name:         "test_product",
+					Description:  "test_description",
+					Barcode:      "8801234567893",
+					ExpiryDate:   time.Time{},
+					OptionGroups: sizeOptionGroups,
 				}
 				jsonData, _ := json.Marshal(req)
 
@@ -273,18 +382,20 @@ func Test_productController_CreateProduct(t *testing.T) {
 			code: http.StatusBadRequest,
 		},
 		{
-			name: "FAIL - 비어있는 사이즈 입력",
+			name: "FAIL - 옵션이 없는 옵션 그룹 입력",
 			body: func() *bytes.Reader {
 				req := domain.CreateProductRequest{
 					UserID:      1,
-					Category:    "",
+					CategoryID:  0,
 					Price:       1000,
 					Cost:        500,
 					Name:        "test_product",
 					Description: "test_description",
-					Barcode:     "1234567890",
+					Barcode:     "8801234567893",
 					ExpiryDate:  expiryDate,
-					Size:        "",
+					OptionGroups: []domain.ProductOptionGroupRequest{
+						{Name: "사이즈", SelectType: domain.ProductOptionSelectTypeSingle},
+					},
 				}
 				jsonData, _ := json.Marshal(req)
 
@@ -303,18 +414,20 @@ func Test_productController_CreateProduct(t *testing.T) {
 			code: http.StatusBadRequest,
 		},
 		{
-			name: "FAIL - 잘못 된 사이즈 입력",
+			name: "FAIL - 잘못 된 옵션 선택 방식 입력",
 			body: func() *bytes.Reader {
 				req := domain.CreateProductRequest{
 					UserID:      1,
-					Category:    "",
+					CategoryID:  0,
 					Price:       1000,
 					Cost:        500,
 					Name:        "test_product",
 					Description: "test_description",
-					Barcode:     "1234567890",
+					Barcode:     "8801234567893",
 					ExpiryDate:  expiryDate,
-					Size:        "payhere",
+					OptionGroups: []domain.ProductOptionGroupRequest{
+						{Name: "사이즈", SelectType: "payhere", Options: []domain.ProductOptionRequest{{Name: "벤티"}}},
+					},
 				}
 				jsonData, _ := json.Marshal(req)
 
@@ -364,6 +477,7 @@ func Test_productController_GetProduct(t *testing.T) {
 		path func() string
 		mock func(ts productControllerTestSuite)
 		code int
+		etag string
 	}{
 		{
 			name: "PASS - 유효한 상품 ID",
@@ -382,9 +496,10 @@ func Test_productController_GetProduct(t *testing.T) {
 				ts.productService.EXPECT().GetProduct(mock.Anything, domain.GetProductRequest{
 					UserID:    1,
 					ProductID: 100,
-				}).Return(domain.GetProductResponse{}, nil).Once()
+				}).Return(domain.GetProductResponse{Product: domain.ProductDTO{Version: 3}}, nil).Once()
 			},
 			code: http.StatusOK,
+			etag: `"3"`,
 		},
 		{
 			name: "FAIL - 유효하지 않은 상품ID",
@@ -425,36 +540,23 @@ func Test_productController_GetProduct(t *testing.T) {
 			// then
 			ts.productService.AssertExpectations(t)
 			assert.Equal(t, tt.code, rec.Code)
+			assert.Equal(t, tt.etag, rec.Header().Get("ETag"))
 		})
 	}
 }
 
-func Test_productController_PatchProduct(t *testing.T) {
-	expiryDate := time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC)
-
+func Test_productController_GetProductByBarcode(t *testing.T) {
 	tests := []struct {
 		name string
-		body func() *bytes.Reader
+		path func() string
 		mock func(ts productControllerTestSuite)
 		code int
 	}{
 		{
-			name: "PASS - 상품 전체 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:          1,
-					Category:    pointer.String("payhere"),
-					Price:       pointer.Float64(1000),
-					Cost:        pointer.Float64(500),
-					Name:        pointer.String("이름을 수정"),
-					Description: pointer.String("modify description"),
-					Barcode:     pointer.String("barcode"),
-					ExpiryDate:  &expiryDate,
-					Size:        domain.ProductSizeTypeLarge.ToPointer(),
-				}
-				jsonData, _ := json.Marshal(req)
-
-				return bytes.NewReader(jsonData)
+			name: "PASS - 바코드로 상품 조회",
+			path: func() string {
+				path, _ := url.JoinPath("/products/barcode", "8801234567893")
+				return path
 			},
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
@@ -464,31 +566,18 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
-				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
-					UserID:      1,
-					ID:          1,
-					Category:    pointer.String("payhere"),
-					Price:       pointer.Float64(1000),
-					Cost:        pointer.Float64(500),
-					Name:        pointer.String("이름을 수정"),
-					Description: pointer.String("modify description"),
-					Barcode:     pointer.String("barcode"),
-					ExpiryDate:  &expiryDate,
-					Size:        domain.ProductSizeTypeLarge.ToPointer(),
-				}).Return(nil).Once()
+				ts.productService.EXPECT().GetProductByBarcode(mock.Anything, domain.GetProductByBarcodeRequest{
+					UserID:  1,
+					Barcode: "8801234567893",
+				}).Return(domain.GetProductResponse{}, nil).Once()
 			},
-			code: http.StatusNoContent,
+			code: http.StatusOK,
 		},
 		{
-			name: "PASS - Category 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:       1,
-					Category: pointer.String("payhere"),
-				}
-				jsonData, _ := json.Marshal(req)
-
-				return bytes.NewReader(jsonData)
+			name: "FAIL - 너무 긴 바코드",
+			path: func() string {
+				path, _ := url.JoinPath("/products/barcode", strings.Repeat("1", domain.MaxBarcodeLength+1))
+				return path
 			},
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
@@ -498,25 +587,53 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
-				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
-					UserID:   1,
-					ID:       1,
-					Category: pointer.String("payhere"),
-				}).Return(nil).Once()
 			},
-			code: http.StatusNoContent,
+			code: http.StatusBadRequest,
 		},
-		{
-			name: "PASS - 가격 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:    1,
-					Price: pointer.Float64(1000),
-				}
-				jsonData, _ := json.Marshal(req)
+	}
 
-				return bytes.NewReader(jsonData)
-			},
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, tt.path(), nil)
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.productService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_productController_GetProductBarcodeImage(t *testing.T) {
+	image := domain.BarcodeImage{
+		ContentType:  "image/png",
+		Data:         []byte("png"),
+		ETag:         `"etag"`,
+		LastModified: time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name        string
+		path        string
+		ifNoneMatch string
+		mock        func(ts productControllerTestSuite)
+		code        int
+	}{
+		{
+			name: "PASS - PNG 바코드",
+			path: "/products/100/barcode.png?symbology=ean13&width=300&height=100",
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -525,25 +642,21 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
-				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
-					UserID: 1,
-					ID:     1,
-					Price:  pointer.Float64(1000),
-				}).Return(nil).Once()
+				ts.productService.EXPECT().GetProductBarcodeImage(mock.Anything, domain.GetProductBarcodeImageRequest{
+					UserID:    1,
+					ProductID: 100,
+					Format:    domain.BarcodeImageFormatPNG,
+					Symbology: domain.BarcodeSymbologyEAN13,
+					Width:     300,
+					Height:    100,
+				}).Return(image, nil).Once()
 			},
-			code: http.StatusNoContent,
+			code: http.StatusOK,
 		},
 		{
-			name: "PASS - 원가 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:   1,
-					Cost: pointer.Float64(500),
-				}
-				jsonData, _ := json.Marshal(req)
-
-				return bytes.NewReader(jsonData)
-			},
+			name:        "PASS - ETag 가 같으면 304",
+			path:        "/products/100/barcode.svg",
+			ifNoneMatch: `"etag"`,
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -552,25 +665,17 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
-				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
-					UserID: 1,
-					ID:     1,
-					Cost:   pointer.Float64(500),
-				}).Return(nil).Once()
+				ts.productService.EXPECT().GetProductBarcodeImage(mock.Anything, domain.GetProductBarcodeImageRequest{
+					UserID:    1,
+					ProductID: 100,
+					Format:    domain.BarcodeImageFormatSVG,
+				}).Return(image, nil).Once()
 			},
-			code: http.StatusNoContent,
+			code: http.StatusNotModified,
 		},
 		{
-			name: "PASS - 이름 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:   1,
-					Name: pointer.String("이름을 수정"),
-				}
-				jsonData, _ := json.Marshal(req)
-
-				return bytes.NewReader(jsonData)
-			},
+			name: "FAIL - 지원하지 않는 바코드 종류",
+			path: "/products/100/barcode.png?symbology=qr",
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -579,25 +684,12 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
-				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
-					UserID: 1,
-					ID:     1,
-					Name:   pointer.String("이름을 수정"),
-				}).Return(nil).Once()
 			},
-			code: http.StatusNoContent,
+			code: http.StatusBadRequest,
 		},
 		{
-			name: "PASS - 설명 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:          1,
-					Description: pointer.String("modify description"),
-				}
-				jsonData, _ := json.Marshal(req)
-
-				return bytes.NewReader(jsonData)
-			},
+			name: "FAIL - 너무 큰 이미지",
+			path: "/products/100/barcode.png?width=5000",
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -606,26 +698,1840 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
-				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
-					UserID:      1,
-					ID:          1,
-					Description: pointer.String("modify description"),
-				}).Return(nil).Once()
 			},
-			code: http.StatusNoContent,
+			code: http.StatusBadRequest,
 		},
-		{
-			name: "PASS - 바코드 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:      1,
-					Barcode: pointer.String("barcode"),
-				}
-				jsonData, _ := json.Marshal(req)
+	}
 
-				return bytes.NewReader(jsonData)
-			},
-			mock: func(ts productControllerTestSuite) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.productService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+			if tt.code == http.StatusOK {
+				assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+				assert.Equal(t, `"etag"`, rec.Header().Get("ETag"))
+				assert.Equal(t, "private, max-age=300", rec.Header().Get("Cache-Control"))
+				assert.Equal(t, "Fri, 01 Mar 2024 09:00:00 GMT", rec.Header().Get("Last-Modified"))
+				assert.Equal(t, "png", rec.Body.String())
+			}
+		})
+	}
+}
+
+func Test_productController_GetProductQRCode(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - SVG QR 코드",
+			path: "/products/100/qr.svg?size=512",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().GetProductQRCode(mock.Anything, domain.GetProductQRCodeRequest{
+					UserID:    1,
+					ProductID: 100,
+					Format:    domain.BarcodeImageFormatSVG,
+					Size:      512,
+				}).Return(domain.BarcodeImage{ContentType: "image/svg+xml", Data: []byte("<svg/>"), ETag: `"etag"`}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 너무 작은 이미지",
+			path: "/products/100/qr.png?size=10",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.productService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_productController_ImportProducts(t *testing.T) {
+	file := []byte("categoryID,price,cost,name,description,barcode,expiryDate\n1,1000,500,슈크림 라떼,설명,8801234567893,2025-06-10\n")
+
+	tests := []struct {
+		name     string
+		path     string
+		fileName string
+		mock     func(ts productControllerTestSuite)
+		code     int
+	}{
+		{
+			name:     "PASS - CSV 검사만 하기",
+			path:     "/products/import?dryRun=true",
+			fileName: "menu.csv",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().ImportProducts(mock.Anything, domain.ImportProductsRequest{
+					UserID:   1,
+					DryRun:   true,
+					FileName: "menu.csv",
+					File:     file,
+				}).Return(domain.ImportProductsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:     "FAIL - 지원하지 않는 파일 형식",
+			path:     "/products/import",
+			fileName: "menu.txt",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			part, _ := writer.CreateFormFile("file", tt.fileName)
+			_, _ = part.Write(file)
+			_ = writer.Close()
+
+			req, _ := http.NewRequest(http.MethodPost, tt.path, body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.productService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_productController_BatchProducts(t *testing.T) {
+	body := `{"operations":[{"op":"patch","patch":{"id":100,"price":2000}},{"op":"delete","delete":{"id":101}}]}`
+	operations := []domain.BatchProductOperation{
+		{Op: domain.BatchProductOperationPatch, Patch: &domain.PatchProductRequest{ID: 100, Price: pointer.Float64(2000)}},
+		{Op: domain.BatchProductOperationDelete, Delete: &domain.DeleteProductRequest{ID: 101}},
+	}
+
+	tests := []struct {
+		name string
+		body string
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 모든 작업 반영",
+			body: body,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().BatchProducts(mock.Anything, domain.BatchProductsRequest{UserID: 1, Operations: operations}).
+					Return(domain.BatchProductsResponse{Applied: true}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 실패한 작업이 있어 모두 되돌림",
+			body: body,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().BatchProducts(mock.Anything, domain.BatchProductsRequest{UserID: 1, Operations: operations}).
+					Return(domain.BatchProductsResponse{Applied: false}, nil).Once()
+			},
+			code: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "FAIL - op 와 다른 요청",
+			body: `{"operations":[{"op":"create","delete":{"id":101}}]}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+
+			req, _ := http.NewRequest(http.MethodPost, "/products/batch", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.productService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_productController_ExportProducts(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		mock        func(ts productControllerTestSuite)
+		code        int
+		contentType string
+	}{
+		{
+			name: "PASS - XLSX 파일 다운로드",
+			path: "/products/export?format=xlsx&categoryID=2",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().ExportProducts(mock.Anything, domain.ExportProductsRequest{
+					UserID:     1,
+					Format:     domain.ProductExportFormatXLSX,
+					CategoryID: pointer.Int(2),
+				}, mock.Anything).RunAndReturn(func(ctx context.Context, req domain.ExportProductsRequest, w io.Writer) error {
+					_, err := w.Write([]byte("xlsx"))
+					return err
+				}).Once()
+			},
+			code:        http.StatusOK,
+			contentType: domain.ProductExportFormatXLSX.ContentType(),
+		},
+		{
+			name: "FAIL - 파일을 쓰기 전 에러는 JSON 으로 응답",
+			path: "/products/export",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().ExportProducts(mock.Anything, domain.ExportProductsRequest{UserID: 1}, mock.Anything).
+					Return(cerrors.E(cerrors.Op("test"), cerrors.Internal, errors.New("db"), "서버 에러가 발생했습니다.")).Once()
+			},
+			code:        http.StatusInternalServerError,
+			contentType: "application/json; charset=utf-8",
+		},
+		{
+			name: "FAIL - 지원하지 않는 형식",
+			path: "/products/export?format=pdf",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code:        http.StatusBadRequest,
+			contentType: "application/json; charset=utf-8",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.productService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+			assert.Equal(t, tt.contentType, rec.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func Test_productController_CreateProductLabels(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 상품 ID 로 라벨 출력",
+			body: `{"productIDs":[1,2],"template":"a4-2x7","copies":3}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().CreateProductLabels(mock.Anything, domain.CreateProductLabelsRequest{
+					UserID:     1,
+					ProductIDs: []int{1, 2},
+					Template:   "a4-2x7",
+					Copies:     3,
+				}).Return([]byte("%PDF-1.3"), nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "PASS - 검색 조건으로 라벨 출력",
+			body: `{"filter":{"search":"라떼"}}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().CreateProductLabels(mock.Anything, domain.CreateProductLabelsRequest{
+					UserID: 1,
+					Filter: &domain.ProductLabelFilter{Search: pointer.String("라떼")},
+				}).Return([]byte("%PDF-1.3"), nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 상품 ID 와 검색 조건을 함께 보냄",
+			body: `{"productIDs":[1],"filter":{"search":"라떼"}}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 없는 라벨지 규격",
+			body: `{"productIDs":[1],"template":"a4-9x9"}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPost, "/products/labels", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.productService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+			if tt.code == http.StatusOK {
+				assert.Equal(t, "application/pdf", rec.Header().Get("Content-Type"))
+				assert.Equal(t, `attachment; filename="labels.pdf"`, rec.Header().Get("Content-Disposition"))
+			}
+		})
+	}
+}
+
+func Test_productController_PatchProduct(t *testing.T) {
+	sizeOptionGroups := []domain.ProductOptionGroupRequest{
+		{
+			Name:       "사이즈",
+			SelectType: domain.ProductOptionSelectTypeSingle,
+			Required:   true,
+			Options: []domain.ProductOptionRequest{
+				{Name: "레귤러"},
+				{Name: "라지", PriceDelta: 500, CostDelta: 200},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		body func() *bytes.Reader
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 상품 전체 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:           1,
+					CategoryID:   pointer.Int(1),
+					Price:        pointer.Float64(1000),
+					Cost:         pointer.Float64(500),
+					Name:         pointer.String("이름을 수정"),
+					Description:  pointer.String("modify description"),
+					Barcode:      pointer.String("8801234567893"),
+					OptionGroups: &sizeOptionGroups,
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
+					UserID:       1,
+					ID:           1,
+					CategoryID:   pointer.Int(1),
+					Price:        pointer.Float64(1000),
+					Cost:         pointer.Float64(500),
+					Name:         pointer.String("이름을 수정"),
+					Description:  pointer.String("modify description"),
+					Barcode:      pointer.String("8801234567893"),
+					OptionGroups: &sizeOptionGroups,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "PASS - Category 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:         1,
+					CategoryID: pointer.Int(1),
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
+					UserID:     1,
+					ID:         1,
+					CategoryID: pointer.Int(1),
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "PASS - 가격 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:    1,
+					Price: pointer.Float64(1000),
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
+					UserID: 1,
+					ID:     1,
+					Price:  pointer.Float64(1000),
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "PASS - 원가 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:   1,
+					Cost: pointer.Float64(500),
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
+					UserID: 1,
+					ID:     1,
+					Cost:   pointer.Float64(500),
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "PASS - 이름 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:   1,
+					Name: pointer.String("이름을 수정"),
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
+					UserID: 1,
+					ID:     1,
+					Name:   pointer.String("이름을 수정"),
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "PASS - 설명 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:          1,
+					Description: pointer.String("modify description"),
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
+					UserID:      1,
+					ID:          1,
+					Description: pointer.String("modify description"),
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "PASS - 바코드 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:      1,
+					Barcode: pointer.String("8801234567893"),
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
+					UserID:  1,
+					ID:      1,
+					Barcode: pointer.String("8801234567893"),
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "PASS - 옵션 그룹 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:           1,
+					OptionGroups: &sizeOptionGroups,
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
+					UserID:       1,
+					ID:           1,
+					OptionGroups: &sizeOptionGroups,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 비어있는 카테고리 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:         1,
+					CategoryID: pointer.Int(0),
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 음수의 가격 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:    1,
+					Price: pointer.Float64(-1000),
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 음수의 원가 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:   1,
+					Cost: pointer.Float64(-1000),
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 비어있는 이름 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:   1,
+					Name: pointer.String(""),
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 비어있는 설명 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:          1,
+					Description: pointer.String(""),
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 비어있는 바코드 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID:      1,
+					Barcode: pointer.String(""),
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 빈 옵션 그룹명 수정",
+			body: func() *bytes.Reader {
+				req := domain.PatchProductRequest{
+					ID: 1,
+					OptionGroups: &[]domain.ProductOptionGroupRequest{
+						{Name: "", SelectType: domain.ProductOptionSelectTypeSingle, Options: []domain.ProductOptionRequest{{Name: "벤티"}}},
+					},
+				}
+				jsonData, _ := json.Marshal(req)
+
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPatch, "/products", tt.body())
+			req.Header.Set("Content-Type", "application/json")
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.productService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_productController_ReplaceProduct(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		body    string
+		ifMatch string
+		mock    func(ts productControllerTestSuite)
+		code    int
+	}{
+		{
+			name:    "PASS - 상품 전체 수정",
+			path:    "/products/100",
+			body:    `{"categoryID":1,"price":1000,"cost":500,"name":"슈크림 라떼","description":"description","barcode":"8801234567893"}`,
+			ifMatch: `"3"`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().ReplaceProduct(mock.Anything, domain.ReplaceProductRequest{
+					UserID:      1,
+					ID:          100,
+					CategoryID:  1,
+					Price:       1000,
+					Cost:        500,
+					Name:        "슈크림 라떼",
+					Description: "description",
+					Barcode:     "8801234567893",
+					Version:     pointer.Int(3),
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 필수 값이 빠진 경우",
+			path: "/products/100",
+			body: `{"categoryID":1,"price":1000,"cost":500,"barcode":"8801234567893"}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPut, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.productService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_productController_ApplyProductPatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		mock        func(ts productControllerTestSuite)
+		code        int
+	}{
+		{
+			name:        "PASS - merge patch",
+			contentType: "application/merge-patch+json; charset=utf-8",
+			body:        `{"reorderPoint":null}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().ApplyProductPatch(mock.Anything, domain.ApplyProductPatchRequest{
+					UserID:      1,
+					ID:          100,
+					ContentType: domain.MergePatchContentType,
+					Patch:       []byte(`{"reorderPoint":null}`),
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name:        "FAIL - test 작업 실패",
+			contentType: domain.JSONPatchContentType,
+			body:        `[{"op":"test","path":"/price","value":1000}]`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().ApplyProductPatch(mock.Anything, mock.Anything).
+					Return(cerrors.E(cerrors.Op("test"), cerrors.PreconditionFailed, "1번째 test 작업의 값이 현재 상품과 다릅니다.")).Once()
+			},
+			code: http.StatusPreconditionFailed,
+		},
+		{
+			name:        "FAIL - 지원하지 않는 Content-Type",
+			contentType: "application/json",
+			body:        `{"price":1000}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPatch, "/products/100", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.productService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_productController_DeleteProduct(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    func() string
+		ifMatch string
+		mock    func(ts productControllerTestSuite)
+		code    int
+	}{
+		{
+			name: "PASS - 상품 삭제 성공",
+			path: func() string {
+				path, _ := url.JoinPath("/products", "100")
+				return path
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().DeleteProduct(mock.Anything, domain.DeleteProductRequest{
+					UserID: 1,
+					ID:     100,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 잘못된 상품 ID",
+			path: func() string {
+				path, _ := url.JoinPath("/products", "-1")
+				return path
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 다른 곳에서 먼저 수정된 상품",
+			path: func() string {
+				path, _ := url.JoinPath("/products", "100")
+				return path
+			},
+			ifMatch: `"2"`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().DeleteProduct(mock.Anything, domain.DeleteProductRequest{
+					UserID:  1,
+					ID:      100,
+					Version: pointer.Int(2),
+				}).Return(cerrors.E(cerrors.Op("test"), cerrors.PreconditionFailed, "다른 곳에서 상품이 먼저 수정되었습니다.")).Once()
+			},
+			code: http.StatusPreconditionFailed,
+		},
+		{
+			name: "FAIL - 잘못된 If-Match",
+			path: func() string {
+				path, _ := url.JoinPath("/products", "100")
+				return path
+			},
+			ifMatch: "W/abc",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodDelete, tt.path(), nil)
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.productService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_productController_ListProducts(t *testing.T) {
+	tests := []struct {
+		name  string
+		query func() string
+		mock  func(ts productControllerTestSuite)
+		code  int
+	}{
+		{
+			name: "PASS - 유효한 커서와 검색어",
+			query: func() string {
+				params := url.Values{}
+				params.Add("cursor", "1")
+				params.Add("search", "슈크림 라떼")
+				return params.Encode()
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().ListProducts(mock.Anything, domain.ListProductsRequest{
+					UserID: 1,
+					Cursor: pointer.Int(1),
+					Search: pointer.String("슈크림 라떼"),
+				}).Return(domain.ListProductsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "PASS - 커서만 입력",
+			query: func() string {
+				params := url.Values{}
+				params.Add("cursor", "1")
+				return params.Encode()
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().ListProducts(mock.Anything, domain.ListProductsRequest{
+					UserID: 1,
+					Cursor: pointer.Int(1),
+				}).Return(domain.ListProductsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "PASS - 검색어만 입력",
+			query: func() string {
+				params := url.Values{}
+				params.Add("search", "슈크림 라떼")
+				return params.Encode()
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().ListProducts(mock.Anything, domain.ListProductsRequest{
+					UserID: 1,
+					Search: pointer.String("슈크림 라떼"),
+				}).Return(domain.ListProductsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "PASS - 태그를 여러 개 고름",
+			query: func() string {
+				params := url.Values{}
+				params.Add("tagID", "1")
+				params.Add("tagID", "2")
+				params.Add("tagMatch", "all")
+				return params.Encode()
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().ListProducts(mock.Anything, domain.ListProductsRequest{
+					UserID:   1,
+					TagIDs:   []int{1, 2},
+					TagMatch: domain.TagMatchAll,
+				}).Return(domain.ListProductsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 태그 조건이 any, all 이 아님",
+			query: func() string {
+				params := url.Values{}
+				params.Add("tagID", "1")
+				params.Add("tagMatch", "some")
+				return params.Encode()
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, "/products", nil)
+			req.URL.RawQuery = tt.query()
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			t.Logf("Request URL: %s", req.URL.String())
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.productService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_productController_SuggestProducts(t *testing.T) {
+	tests := []struct {
+		name  string
+		query func() string
+		mock  func(ts productControllerTestSuite)
+		code  int
+	}{
+		{
+			name: "PASS - 검색어만 입력",
+			query: func() string {
+				params := url.Values{}
+				params.Add("q", "슈크")
+				return params.Encode()
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().SuggestProducts(mock.Anything, domain.SuggestProductsRequest{
+					UserID: 1,
+					Query:  "슈크",
+				}).Return(domain.SuggestProductsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "PASS - 검색어와 개수 입력",
+			query: func() string {
+				params := url.Values{}
+				params.Add("q", "ㅅㅋ")
+				params.Add("limit", "5")
+				return params.Encode()
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().SuggestProducts(mock.Anything, domain.SuggestProductsRequest{
+					UserID: 1,
+					Query:  "ㅅㅋ",
+					Limit:  pointer.Int(5),
+				}).Return(domain.SuggestProductsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 검색어 없음",
+			query: func() string {
+				return ""
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 최대 개수 초과",
+			query: func() string {
+				params := url.Values{}
+				params.Add("q", "슈크")
+				params.Add("limit", "100")
+				return params.Encode()
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, "/products/suggest", nil)
+			req.URL.RawQuery = tt.query()
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.productService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_productController_ListExpiringProducts(t *testing.T) {
+	tests := []struct {
+		name  string
+		query func() string
+		mock  func(ts productControllerTestSuite)
+		code  int
+	}{
+		{
+			name: "PASS - 기본 조회 기간",
+			query: func() string {
+				return ""
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().ListExpiringProducts(mock.Anything, domain.ListExpiringProductsRequest{
+					UserID: 1,
+				}).Return(domain.ListExpiringProductsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "PASS - 조회 기간, 카테고리, 지난 상품 포함",
+			query: func() string {
+				params := url.Values{}
+				params.Add("within", "48h")
+				params.Add("categoryID", "2")
+				params.Add("includeExpired", "true")
+				return params.Encode()
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().ListExpiringProducts(mock.Anything, domain.ListExpiringProductsRequest{
+					UserID:         1,
+					Within:         "48h",
+					CategoryID:     pointer.Int(2),
+					IncludeExpired: true,
+				}).Return(domain.ListExpiringProductsResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 잘못된 조회 기간",
+			query: func() string {
+				params := url.Values{}
+				params.Add("within", "3days")
+				return params.Encode()
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 최대 조회 기간 초과",
+			query: func() string {
+				params := url.Values{}
+				params.Add("within", "2161h")
+				return params.Encode()
+			},
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, "/products/expiring", nil)
+			req.URL.RawQuery = tt.query()
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.productService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_productController_UploadProductImage(t *testing.T) {
+	file := []byte("\x89PNG\r\n\x1a\nimage")
+
+	tests := []struct {
+		name string
+		path string
+		file []byte
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 이미지 올리기",
+			path: "/products/100/images",
+			file: file,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().UploadProductImage(mock.Anything, domain.UploadProductImageRequest{
+					UserID:    1,
+					ProductID: 100,
+					File:      file,
+				}).Return(domain.UploadProductImageResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 빈 파일",
+			path: "/products/100/images",
+			file: nil,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 상품 ID 가 숫자가 아닌 경우",
+			path: "/products/payhere/images",
+			file: file,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			part, _ := writer.CreateFormFile("image", "latte.png")
+			_, _ = part.Write(tt.file)
+			_ = writer.Close()
+
+			req, _ := http.NewRequest(http.MethodPost, tt.path, body)
+			req.Header.Set("Content-Type", writer.FormDataContentType())
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.productService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_productController_PatchProductImages(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 대표 이미지 변경",
+			body: `{"primaryImageID": 2}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().PatchProductImages(mock.Anything, domain.PatchProductImagesRequest{
+					UserID:         1,
+					ProductID:      100,
+					PrimaryImageID: pointer.Int(2),
+				}).Return(domain.PatchProductImagesResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 바꿀 내용이 없는 경우",
+			body: `{}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+
+			req, _ := http.NewRequest(http.MethodPatch, "/products/100/images", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.productService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_productController_DeleteProductImage(t *testing.T) {
+	// given
+	ts := setupProductControllerTestSuite(t)
+	ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+		mock.Anything,
+		mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+	).Return(domain.AuthToken{
+		ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+		Active:         true,
+	}, nil).Once()
+	ts.productService.EXPECT().DeleteProductImage(mock.Anything, domain.DeleteProductImageRequest{
+		UserID:    1,
+		ProductID: 100,
+		ImageID:   3,
+	}).Return(nil).Once()
+
+	req, _ := http.NewRequest(http.MethodDelete, "/products/100/images/3", nil)
+	token, _ := auth_token.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	// when
+	rec := httptest.NewRecorder()
+	ts.router.ServeHTTP(rec, req)
+
+	// then
+	ts.productService.AssertExpectations(t)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func Test_productController_GetProductPriceHistory(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 기간으로 조회",
+			path: "/products/100/price-history?from=2024-02-01&to=2024-02-29",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().GetProductPriceHistory(mock.Anything, domain.GetProductPriceHistoryRequest{
+					UserID:    1,
+					ProductID: 100,
+					From:      pointer.String("2024-02-01"),
+					To:        pointer.String("2024-02-29"),
+				}).Return(domain.GetProductPriceHistoryResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 날짜 형식",
+			path: "/products/100/price-history?from=2024.02.01",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.productService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_productController_ListTrashProducts(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 커서로 조회",
+			path: "/products/trash?cursor=10",
+			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
 					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
@@ -633,25 +2539,82 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
-				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
-					UserID:  1,
-					ID:      1,
-					Barcode: pointer.String("barcode"),
-				}).Return(nil).Once()
+				ts.productService.EXPECT().ListTrashProducts(mock.Anything, domain.ListTrashProductsRequest{
+					UserID: 1,
+					Cursor: pointer.Int(10),
+				}).Return(domain.ListTrashProductsResponse{}, nil).Once()
 			},
-			code: http.StatusNoContent,
+			code: http.StatusOK,
 		},
 		{
-			name: "PASS - 유통기한 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:         1,
-					ExpiryDate: &expiryDate,
-				}
-				jsonData, _ := json.Marshal(req)
+			name: "FAIL - 잘못된 커서",
+			path: "/products/trash?cursor=abc",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
 
-				return bytes.NewReader(jsonData)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.productService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_productController_RestoreProduct(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 상품 복원",
+			path: "/products/100/restore",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().RestoreProduct(mock.Anything, domain.RestoreProductRequest{
+					UserID: 1,
+					ID:     100,
+				}).Return(domain.GetProductResponse{}, nil).Once()
 			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 같은 바코드의 상품이 있음",
+			path: "/products/100/restore",
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -660,25 +2623,62 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
-				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
-					UserID:     1,
-					ID:         1,
-					ExpiryDate: &expiryDate,
-				}).Return(nil).Once()
+				ts.productService.EXPECT().RestoreProduct(mock.Anything, mock.Anything).
+					Return(domain.GetProductResponse{}, cerrors.E(cerrors.Op("test"), cerrors.Exist, "이미 같은 바코드의 상품이 있어 복원할 수 없습니다.")).Once()
 			},
-			code: http.StatusNoContent,
+			code: http.StatusConflict,
 		},
 		{
-			name: "PASS - 사이즈 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:   1,
-					Size: domain.ProductSizeTypeLarge.ToPointer(),
-				}
-				jsonData, _ := json.Marshal(req)
-
-				return bytes.NewReader(jsonData)
+			name: "FAIL - 잘못된 상품 ID",
+			path: "/products/-1/restore",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
 			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+
+			req, _ := http.NewRequest(http.MethodPost, tt.path, nil)
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.productService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_productController_PurgeProduct(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 상품 완전 삭제",
+			path: "/products/100/purge",
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -687,25 +2687,176 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
-				ts.productService.EXPECT().PatchProduct(mock.Anything, domain.PatchProductRequest{
+				ts.productService.EXPECT().PurgeProduct(mock.Anything, domain.PurgeProductRequest{
 					UserID: 1,
-					ID:     1,
-					Size:   domain.ProductSizeTypeLarge.ToPointer(),
+					ID:     100,
 				}).Return(nil).Once()
 			},
 			code: http.StatusNoContent,
 		},
 		{
-			name: "FAIL - 비어있는 카테고리 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:       1,
-					Category: pointer.String(""),
-				}
-				jsonData, _ := json.Marshal(req)
+			name: "FAIL - 휴지통에 없는 상품",
+			path: "/products/100/purge",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().PurgeProduct(mock.Anything, mock.Anything).
+					Return(cerrors.E(cerrors.Op("test"), cerrors.NotExist, "휴지통에서 상품을 찾을 수 없습니다.")).Once()
+			},
+			code: http.StatusNotFound,
+		},
+	}
 
-				return bytes.NewReader(jsonData)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+
+			req, _ := http.NewRequest(http.MethodDelete, tt.path, nil)
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.productService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_productController_DuplicateProduct(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		body string
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 이름과 바코드만 바꿔 복제",
+			path: "/products/100/duplicate",
+			body: `{"name":"슈크림 라떼 라지","barcode":"LATTE-L","internalBarcode":true}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().DuplicateProduct(mock.Anything, domain.DuplicateProductRequest{
+					UserID:          1,
+					ID:              100,
+					Name:            pointer.String("슈크림 라떼 라지"),
+					Barcode:         "LATTE-L",
+					InternalBarcode: true,
+				}).Return(domain.GetProductResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 바코드 없음",
+			path: "/products/100/duplicate",
+			body: `{"name":"슈크림 라떼 라지"}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 이미 같은 바코드의 상품이 있음",
+			path: "/products/100/duplicate",
+			body: `{"barcode":"8801234567893"}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().DuplicateProduct(mock.Anything, mock.Anything).
+					Return(domain.GetProductResponse{}, cerrors.E(cerrors.Op("test"), cerrors.Exist, "이미 같은 바코드의 상품이 있습니다.")).Once()
+			},
+			code: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.productService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_productController_CreateProductTemplate(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		body string
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 이름을 붙여 템플릿으로 저장",
+			path: "/products/100/template",
+			body: `{"name":"라떼 기본"}`,
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().CreateProductTemplate(mock.Anything, domain.CreateProductTemplateRequest{
+					UserID:    1,
+					ProductID: 100,
+					Name:      "라떼 기본",
+				}).Return(domain.CreateProductTemplateResponse{}, nil).Once()
 			},
+			code: http.StatusOK,
+		},
+		{
+			name: "PASS - 본문 없이 저장",
+			path: "/products/100/template",
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -714,20 +2865,17 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
+				ts.productService.EXPECT().CreateProductTemplate(mock.Anything, domain.CreateProductTemplateRequest{
+					UserID:    1,
+					ProductID: 100,
+				}).Return(domain.CreateProductTemplateResponse{}, nil).Once()
 			},
-			code: http.StatusBadRequest,
+			code: http.StatusOK,
 		},
 		{
-			name: "FAIL - 음수의 가격 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:    1,
-					Price: pointer.Float64(-1000),
-				}
-				jsonData, _ := json.Marshal(req)
-
-				return bytes.NewReader(jsonData)
-			},
+			name: "FAIL - 너무 긴 템플릿 이름",
+			path: "/products/100/template",
+			body: `{"name":"` + strings.Repeat("라", domain.MaxProductTemplateNameLength+1) + `"}`,
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -739,17 +2887,43 @@ func Test_productController_PatchProduct(t *testing.T) {
 			},
 			code: http.StatusBadRequest,
 		},
-		{
-			name: "FAIL - 음수의 원가 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:   1,
-					Cost: pointer.Float64(-1000),
-				}
-				jsonData, _ := json.Marshal(req)
+	}
 
-				return bytes.NewReader(jsonData)
-			},
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.productService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_productController_ListProductTemplates(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 템플릿 목록",
+			path: "/products/templates",
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -758,20 +2932,47 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
+				ts.productService.EXPECT().ListProductTemplates(mock.Anything, domain.ListProductTemplatesRequest{UserID: 1}).
+					Return(domain.ListProductTemplatesResponse{Templates: []domain.ProductTemplateDTO{}}, nil).Once()
 			},
-			code: http.StatusBadRequest,
+			code: http.StatusOK,
 		},
-		{
-			name: "FAIL - 비어있는 이름 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:   1,
-					Name: pointer.String(""),
-				}
-				jsonData, _ := json.Marshal(req)
+	}
 
-				return bytes.NewReader(jsonData)
-			},
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.productService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_productController_DeleteProductTemplate(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 템플릿 삭제",
+			path: "/products/templates/3",
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -780,20 +2981,13 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
+				ts.productService.EXPECT().DeleteProductTemplate(mock.Anything, domain.DeleteProductTemplateRequest{UserID: 1, ID: 3}).Return(nil).Once()
 			},
-			code: http.StatusBadRequest,
+			code: http.StatusNoContent,
 		},
 		{
-			name: "FAIL - 비어있는 설명 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:          1,
-					Description: pointer.String(""),
-				}
-				jsonData, _ := json.Marshal(req)
-
-				return bytes.NewReader(jsonData)
-			},
+			name: "FAIL - 없는 템플릿",
+			path: "/products/templates/3",
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -802,20 +2996,52 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
+				ts.productService.EXPECT().DeleteProductTemplate(mock.Anything, mock.Anything).
+					Return(cerrors.E(cerrors.Op("test"), cerrors.NotExist, "템플릿을 찾을 수 없습니다.")).Once()
 			},
-			code: http.StatusBadRequest,
+			code: http.StatusNotFound,
 		},
-		{
-			name: "FAIL - 비어있는 바코드 수정",
-			body: func() *bytes.Reader {
-				req := domain.PatchProductRequest{
-					ID:      1,
-					Barcode: pointer.String(""),
-				}
-				jsonData, _ := json.Marshal(req)
+	}
 
-				return bytes.NewReader(jsonData)
-			},
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupProductControllerTestSuite(t)
+			tt.mock(ts)
+			req, _ := http.NewRequest(http.MethodDelete, tt.path, nil)
+			token, _ := auth_token.CreateAccessToken(domain.User{
+				Base: domain.Base{
+					ID: 1,
+				},
+			}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.code, rec.Code)
+			ts.productService.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_productController_CreateScheduledPriceChange(t *testing.T) {
+	price := float64(5000)
+	effectiveDate := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		path string
+		body string
+		mock func(ts productControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 가격 예약",
+			path: "/products/100/scheduled-prices",
+			body: `{"price":5000,"effectiveDate":"2024-03-01T09:00:00+09:00"}`,
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -824,21 +3050,16 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
+				ts.productService.EXPECT().CreateScheduledPriceChange(mock.Anything, mock.MatchedBy(func(req domain.CreateScheduledPriceChangeRequest) bool {
+					return req.UserID == 1 && req.ProductID == 100 && req.Price != nil && *req.Price == price && req.Cost == nil && req.EffectiveDate.Equal(effectiveDate)
+				})).Return(domain.CreateScheduledPriceChangeResponse{}, nil).Once()
 			},
-			code: http.StatusBadRequest,
+			code: http.StatusOK,
 		},
 		{
-			name: "FAIL - 비어있는 유통기한 수정",
-			body: func() *bytes.Reader {
-				emptyTime := time.Time{}
-				req := domain.PatchProductRequest{
-					ID:         1,
-					ExpiryDate: &emptyTime,
-				}
-				jsonData, _ := json.Marshal(req)
-
-				return bytes.NewReader(jsonData)
-			},
+			name: "FAIL - 바꿀 값이 없음",
+			path: "/products/100/scheduled-prices",
+			body: `{"effectiveDate":"2024-03-01T09:00:00+09:00"}`,
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -851,17 +3072,9 @@ func Test_productController_PatchProduct(t *testing.T) {
 			code: http.StatusBadRequest,
 		},
 		{
-			name: "FAIL - 빈 문자열 사이즈 수정",
-			body: func() *bytes.Reader {
-				emptySizeType := domain.ProductSizeType("")
-				req := domain.PatchProductRequest{
-					ID:   1,
-					Size: &emptySizeType,
-				}
-				jsonData, _ := json.Marshal(req)
-
-				return bytes.NewReader(jsonData)
-			},
+			name: "FAIL - 다른 사장님의 상품",
+			path: "/products/100/scheduled-prices",
+			body: `{"cost":1800,"effectiveDate":"2024-03-01T09:00:00+09:00"}`,
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -870,6 +3083,8 @@ func Test_productController_PatchProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
+				ts.productService.EXPECT().CreateScheduledPriceChange(mock.Anything, mock.Anything).
+					Return(domain.CreateScheduledPriceChangeResponse{}, cerrors.E(cerrors.Op("test"), cerrors.Permission, "상품 가격을 예약할 권한이 없습니다.")).Once()
 			},
 			code: http.StatusBadRequest,
 		},
@@ -880,7 +3095,7 @@ func Test_productController_PatchProduct(t *testing.T) {
 			// given
 			ts := setupProductControllerTestSuite(t)
 			tt.mock(ts)
-			req, _ := http.NewRequest(http.MethodPatch, "/products", tt.body())
+			req, _ := http.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
 			req.Header.Set("Content-Type", "application/json")
 			token, _ := auth_token.CreateAccessToken(domain.User{
 				Base: domain.Base{
@@ -900,19 +3115,16 @@ func Test_productController_PatchProduct(t *testing.T) {
 	}
 }
 
-func Test_productController_DeleteProduct(t *testing.T) {
+func Test_productController_ListScheduledPriceChanges(t *testing.T) {
 	tests := []struct {
 		name string
-		path func() string
+		path string
 		mock func(ts productControllerTestSuite)
 		code int
 	}{
 		{
-			name: "PASS - 상품 삭제 성공",
-			path: func() string {
-				path, _ := url.JoinPath("/products", "100")
-				return path
-			},
+			name: "PASS - 모든 상품의 가격 예약",
+			path: "/products/scheduled-prices",
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -921,19 +3133,30 @@ func Test_productController_DeleteProduct(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
-				ts.productService.EXPECT().DeleteProduct(mock.Anything, domain.DeleteProductRequest{
-					UserID: 1,
-					ID:     100,
-				}).Return(nil).Once()
+				ts.productService.EXPECT().ListScheduledPriceChanges(mock.Anything, domain.ListScheduledPriceChangesRequest{UserID: 1}).
+					Return(domain.ListScheduledPriceChangesResponse{ScheduledPrices: []domain.ScheduledPriceChangeDTO{}}, nil).Once()
 			},
-			code: http.StatusNoContent,
+			code: http.StatusOK,
 		},
 		{
-			name: "FAIL - 잘못된 상품 ID",
-			path: func() string {
-				path, _ := url.JoinPath("/products", "-1")
-				return path
+			name: "PASS - 상품 하나의 가격 예약",
+			path: "/products/scheduled-prices?productID=3",
+			mock: func(ts productControllerTestSuite) {
+				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+					mock.Anything,
+					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+				).Return(domain.AuthToken{
+					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+					Active:         true,
+				}, nil).Once()
+				ts.productService.EXPECT().ListScheduledPriceChanges(mock.Anything, domain.ListScheduledPriceChangesRequest{UserID: 1, ProductID: pointer.Int(3)}).
+					Return(domain.ListScheduledPriceChangesResponse{ScheduledPrices: []domain.ScheduledPriceChangeDTO{}}, nil).Once()
 			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 유효하지 않은 상품 ID",
+			path: "/products/scheduled-prices?productID=0",
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -952,7 +3175,7 @@ func Test_productController_DeleteProduct(t *testing.T) {
 			// given
 			ts := setupProductControllerTestSuite(t)
 			tt.mock(ts)
-			req, _ := http.NewRequest(http.MethodDelete, tt.path(), nil)
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
 			token, _ := auth_token.CreateAccessToken(domain.User{
 				Base: domain.Base{
 					ID: 1,
@@ -971,44 +3194,16 @@ func Test_productController_DeleteProduct(t *testing.T) {
 	}
 }
 
-func Test_productController_ListProducts(t *testing.T) {
+func Test_productController_CancelScheduledPriceChange(t *testing.T) {
 	tests := []struct {
-		name  string
-		query func() string
-		mock  func(ts productControllerTestSuite)
-		code  int
+		name string
+		path string
+		mock func(ts productControllerTestSuite)
+		code int
 	}{
 		{
-			name: "PASS - 유효한 커서와 검색어",
-			query: func() string {
-				params := url.Values{}
-				params.Add("cursor", "1")
-				params.Add("search", "슈크림 라떼")
-				return params.Encode()
-			},
-			mock: func(ts productControllerTestSuite) {
-				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
-					mock.Anything,
-					mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
-				).Return(domain.AuthToken{
-					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
-					Active:         true,
-				}, nil).Once()
-				ts.productService.EXPECT().ListProducts(mock.Anything, domain.ListProductsRequest{
-					UserID: 1,
-					Cursor: pointer.Int(1),
-					Search: pointer.String("슈크림 라떼"),
-				}).Return(domain.ListProductsResponse{}, nil).Once()
-			},
-			code: http.StatusOK,
-		},
-		{
-			name: "PASS - 커서만 입력",
-			query: func() string {
-				params := url.Values{}
-				params.Add("cursor", "1")
-				return params.Encode()
-			},
+			name: "PASS - 가격 예약 취소",
+			path: "/products/scheduled-prices/7",
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -1017,20 +3212,13 @@ func Test_productController_ListProducts(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
-				ts.productService.EXPECT().ListProducts(mock.Anything, domain.ListProductsRequest{
-					UserID: 1,
-					Cursor: pointer.Int(1),
-				}).Return(domain.ListProductsResponse{}, nil).Once()
+				ts.productService.EXPECT().CancelScheduledPriceChange(mock.Anything, domain.CancelScheduledPriceChangeRequest{UserID: 1, ID: 7}).Return(nil).Once()
 			},
-			code: http.StatusOK,
+			code: http.StatusNoContent,
 		},
 		{
-			name: "PASS - 검색어만 입력",
-			query: func() string {
-				params := url.Values{}
-				params.Add("search", "슈크림 라떼")
-				return params.Encode()
-			},
+			name: "FAIL - 이미 적용한 예약",
+			path: "/products/scheduled-prices/7",
 			mock: func(ts productControllerTestSuite) {
 				ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
 					mock.Anything,
@@ -1039,12 +3227,10 @@ func Test_productController_ListProducts(t *testing.T) {
 					ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
 					Active:         true,
 				}, nil).Once()
-				ts.productService.EXPECT().ListProducts(mock.Anything, domain.ListProductsRequest{
-					UserID: 1,
-					Search: pointer.String("슈크림 라떼"),
-				}).Return(domain.ListProductsResponse{}, nil).Once()
+				ts.productService.EXPECT().CancelScheduledPriceChange(mock.Anything, mock.Anything).
+					Return(cerrors.E(cerrors.Op("test"), cerrors.Exist, "이미 적용했거나 취소한 가격 예약입니다.")).Once()
 			},
-			code: http.StatusOK,
+			code: http.StatusConflict,
 		},
 	}
 
@@ -1053,8 +3239,7 @@ func Test_productController_ListProducts(t *testing.T) {
 			// given
 			ts := setupProductControllerTestSuite(t)
 			tt.mock(ts)
-			req, _ := http.NewRequest(http.MethodGet, "/products", nil)
-			req.URL.RawQuery = tt.query()
+			req, _ := http.NewRequest(http.MethodDelete, tt.path, nil)
 			token, _ := auth_token.CreateAccessToken(domain.User{
 				Base: domain.Base{
 					ID: 1,
@@ -1064,7 +3249,6 @@ func Test_productController_ListProducts(t *testing.T) {
 
 			// when
 			rec := httptest.NewRecorder()
-			t.Logf("Request URL: %s", req.URL.String())
 			ts.router.ServeHTTP(rec, req)
 
 			// then
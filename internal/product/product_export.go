@@ -0,0 +1,266 @@
+package product
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"github.com/xuri/excelize/v2"
+	"io"
+	"payhere/domain"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const exportSheetName = "products"
+
+// productExporter
+// 상품을 batch 단위로 받아 바로 w 에 쓴다. 열 이름 행은 처음 쓸 때 함께 쓰므로 상품이 하나도 없어도 close 를 호출해야 한다.
+type productExporter interface {
+	write(products []domain.Product) error
+	close() error
+}
+
+func newProductExporter(format domain.ProductExportFormat, w io.Writer) productExporter {
+	switch format {
+	case domain.ProductExportFormatXLSX:
+		return &xlsxProductExporter{w: w}
+	case domain.ProductExportFormatJSON:
+		return &jsonProductExporter{w: w}
+	default:
+		return &csvProductExporter{w: w}
+	}
+}
+
+type csvProductExporter struct {
+	w      io.Writer
+	writer *csv.Writer
+}
+
+func (e *csvProductExporter) start() error {
+	if e.writer != nil {
+		return nil
+	}
+	if _, err := io.WriteString(e.w, utf8BOM); err != nil {
+		return err
+	}
+	e.writer = csv.NewWriter(e.w)
+	return e.writer.Write(domain.ProductExportColumns)
+}
+
+func (e *csvProductExporter) write(products []domain.Product) error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	for _, product := range products {
+		values, err := exportRecord(product)
+		if err != nil {
+			return err
+		}
+		record := make([]string, 0, len(values))
+		for _, value := range values {
+			record = append(record, formatExportValue(value))
+		}
+		if err := e.writer.Write(record); err != nil {
+			return err
+		}
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *csvProductExporter) close() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+// xlsxProductExporter
+// XLSX 는 zip 이라 끝까지 쓴 다음에야 내보낼 수 있다. 행은 excelize 의 StreamWriter 로 임시 파일에 쌓아 메모리에 모두 올리지 않는다.
+type xlsxProductExporter struct {
+	w      io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	row    int
+}
+
+func (e *xlsxProductExporter) start() error {
+	if e.stream != nil {
+		return nil
+	}
+
+	e.file = excelize.NewFile()
+	if err := e.file.SetSheetName(e.file.GetSheetName(0), exportSheetName); err != nil {
+		return err
+	}
+	stream, err := e.file.NewStreamWriter(exportSheetName)
+	if err != nil {
+		return err
+	}
+	e.stream = stream
+
+	header := make([]any, 0, len(domain.ProductExportColumns))
+	for _, column := range domain.ProductExportColumns {
+		header = append(header, column)
+	}
+	return e.writeRow(header)
+}
+
+func (e *xlsxProductExporter) writeRow(values []any) error {
+	e.row++
+	cell, err := excelize.CoordinatesToCellName(1, e.row)
+	if err != nil {
+		return err
+	}
+	return e.stream.SetRow(cell, values)
+}
+
+func (e *xlsxProductExporter) write(products []domain.Product) error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	for _, product := range products {
+		values, err := exportRecord(product)
+		if err != nil {
+			return err
+		}
+		if err := e.writeRow(values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *xlsxProductExporter) close() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	defer e.file.Close()
+
+	if err := e.stream.Flush(); err != nil {
+		return err
+	}
+	return e.file.Write(e.w)
+}
+
+// jsonProductExporter
+// 상품 조회 응답과 같은 형태의 상품을 JSON 배열로 내보낸다.
+type jsonProductExporter struct {
+	w       io.Writer
+	started bool
+	count   int
+}
+
+func (e *jsonProductExporter) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+	_, err := io.WriteString(e.w, "[")
+	return err
+}
+
+func (e *jsonProductExporter) write(products []domain.Product) error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	for _, product := range products {
+		data, err := json.Marshal(domain.ProductDTOFrom(product))
+		if err != nil {
+			return err
+		}
+		if e.count > 0 {
+			if _, err := io.WriteString(e.w, ","); err != nil {
+				return err
+			}
+		}
+		if _, err := e.w.Write(data); err != nil {
+			return err
+		}
+		e.count++
+	}
+	return nil
+}
+
+func (e *jsonProductExporter) close() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(e.w, "]")
+	return err
+}
+
+// exportRecord
+// ProductExportColumns 순서의 셀 값. XLSX 에서 숫자 셀로 쓰도록 숫자는 숫자 그대로 담고, 바코드는 앞자리 0 이 지워지지 않게 문자열로 담는다.
+// 옵션 그룹은 상품 생성 요청의 optionGroups 와 같은 JSON 으로 쓴다. 사장님이 입력한 글자는 엑셀이 수식으로 실행하지 않도록 escapeFormula 를 거친다.
+func exportRecord(product domain.Product) ([]any, error) {
+	var reorderPoint any
+	if product.ReorderPoint != nil {
+		reorderPoint = *product.ReorderPoint
+	}
+
+	var optionGroups string
+	if len(product.OptionGroups) > 0 {
+		data, err := json.Marshal(domain.ProductOptionGroupRequestsFrom(product.OptionGroups))
+		if err != nil {
+			return nil, err
+		}
+		optionGroups = string(data)
+	}
+
+	return []any{
+		product.ID,
+		product.CategoryID,
+		escapeFormula(product.Category),
+		product.Price,
+		product.EffectivePrice(),
+		product.Cost,
+		escapeFormula(product.Name),
+		escapeFormula(product.Description),
+		escapeFormula(product.Barcode),
+		!domain.IsValidGTIN(product.Barcode),
+		product.ExpiryDate.UTC().Format(time.RFC3339),
+		product.StockQuantity,
+		reorderPoint,
+		product.ReorderQuantity,
+		optionGroups,
+	}, nil
+}
+
+func formatExportValue(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case int:
+		return strconv.Itoa(value)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	default:
+		return value.(string)
+	}
+}
+
+// formulaPrefixes
+// 엑셀은 이 글자로 시작하는 셀을 수식으로 읽는다.
+const formulaPrefixes = "=+-@\t\r"
+
+// escapeFormula
+// 수식으로 읽히는 글자로 시작하면 앞에 ' 를 붙여 글자로 보이게 한다. 다시 가져올 때는 unescapeFormula 로 ' 를 뗀다.
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune(formulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// unescapeFormula
+// escapeFormula 가 붙인 ' 만 뗀다. ' 뒤가 수식 글자가 아니면 사장님이 입력한 ' 이므로 그대로 둔다.
+func unescapeFormula(value string) string {
+	if len(value) > 1 && value[0] == '\'' && strings.ContainsRune(formulaPrefixes, rune(value[1])) {
+		return value[1:]
+	}
+	return value
+}
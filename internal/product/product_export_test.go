@@ -0,0 +1,162 @@
+package product
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+	"payhere/domain"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_productExporter(t *testing.T) {
+	products := []domain.Product{
+		{
+			Base:        domain.Base{ID: 1},
+			CategoryID:  2,
+			Category:    "음료",
+			Price:       3000,
+			Cost:        1500,
+			Name:        "아메리카노",
+			Description: "원두 2샷",
+			Barcode:     "0801234567893",
+			ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	t.Run("PASS - CSV 는 BOM 과 열 이름 행으로 시작한다", func(t *testing.T) {
+		// given
+		var buf bytes.Buffer
+		exporter := newProductExporter(domain.ProductExportFormatCSV, &buf)
+
+		// when
+		err := exporter.write(products)
+		assert.NoError(t, err)
+		err = exporter.close()
+
+		// then
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		assert.Len(t, lines, 2)
+		assert.Equal(t, utf8BOM+strings.Join(domain.ProductExportColumns, ","), lines[0])
+		assert.Equal(t, "1,2,음료,3000,3000,1500,아메리카노,원두 2샷,0801234567893,true,2025-06-10T00:00:00Z,0,,0,", lines[1])
+	})
+
+	t.Run("PASS - 상품이 없어도 CSV 열 이름 행은 쓴다", func(t *testing.T) {
+		// given
+		var buf bytes.Buffer
+		exporter := newProductExporter(domain.ProductExportFormatCSV, &buf)
+
+		// when
+		err := exporter.close()
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, utf8BOM+strings.Join(domain.ProductExportColumns, ",")+"\n", buf.String())
+	})
+
+	t.Run("PASS - 내보낸 CSV 를 다시 가져올 수 있다", func(t *testing.T) {
+		// given
+		var buf bytes.Buffer
+		exporter := newProductExporter(domain.ProductExportFormatCSV, &buf)
+		assert.NoError(t, exporter.write(products))
+		assert.NoError(t, exporter.close())
+
+		// when
+		rows, err := parseProductImport(domain.ProductImportFormatCSV, buf.Bytes())
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, rows, 1)
+		assert.NoError(t, rows[0].Err)
+		assert.Equal(t, "아메리카노", rows[0].Req.Name)
+		assert.Equal(t, "0801234567893", rows[0].Req.Barcode)
+		assert.True(t, rows[0].Req.InternalBarcode)
+		assert.NoError(t, rows[0].Req.Validate())
+	})
+
+	t.Run("PASS - 수식으로 읽히는 글자는 ' 를 붙여 쓰고 다시 가져올 때 뗀다", func(t *testing.T) {
+		// given
+		formula := products[0]
+		formula.Name = `=HYPERLINK("http://example.com","클릭")`
+		formula.Description = "-10% 할인"
+		formula.Category = "@음료"
+		var buf bytes.Buffer
+		exporter := newProductExporter(domain.ProductExportFormatCSV, &buf)
+
+		// when
+		assert.NoError(t, exporter.write([]domain.Product{formula}))
+		assert.NoError(t, exporter.close())
+		rows, err := parseProductImport(domain.ProductImportFormatCSV, buf.Bytes())
+
+		// then
+		assert.Contains(t, buf.String(), `'@음료`)
+		assert.Contains(t, buf.String(), `"'=HYPERLINK(""http://example.com"",""클릭"")"`)
+		assert.Contains(t, buf.String(), `'-10% 할인`)
+		assert.NoError(t, err)
+		assert.Len(t, rows, 1)
+		assert.Equal(t, formula.Name, rows[0].Req.Name)
+		assert.Equal(t, formula.Description, rows[0].Req.Description)
+	})
+
+	t.Run("PASS - XLSX 는 숫자 셀과 문자열 바코드로 쓴다", func(t *testing.T) {
+		// given
+		var buf bytes.Buffer
+		exporter := newProductExporter(domain.ProductExportFormatXLSX, &buf)
+
+		// when
+		err := exporter.write(products)
+		assert.NoError(t, err)
+		err = exporter.close()
+
+		// then
+		assert.NoError(t, err)
+		file, err := excelize.OpenReader(&buf)
+		assert.NoError(t, err)
+		rows, err := file.GetRows(exportSheetName)
+		assert.NoError(t, err)
+		assert.Len(t, rows, 2)
+		assert.Equal(t, domain.ProductExportColumns[0], rows[0][0])
+		assert.Equal(t, "아메리카노", rows[1][6])
+		assert.Equal(t, "0801234567893", rows[1][8])
+	})
+
+	t.Run("PASS - XLSX 도 수식으로 읽히는 글자에 ' 를 붙인다", func(t *testing.T) {
+		// given
+		formula := products[0]
+		formula.Name = "+82 아메리카노"
+		var buf bytes.Buffer
+		exporter := newProductExporter(domain.ProductExportFormatXLSX, &buf)
+
+		// when
+		assert.NoError(t, exporter.write([]domain.Product{formula}))
+		assert.NoError(t, exporter.close())
+
+		// then
+		file, err := excelize.OpenReader(&buf)
+		assert.NoError(t, err)
+		rows, err := file.GetRows(exportSheetName)
+		assert.NoError(t, err)
+		assert.Equal(t, "'+82 아메리카노", rows[1][6])
+	})
+
+	t.Run("PASS - JSON 은 상품 배열로 쓴다", func(t *testing.T) {
+		// given
+		var buf bytes.Buffer
+		exporter := newProductExporter(domain.ProductExportFormatJSON, &buf)
+
+		// when
+		err := exporter.write(append(products, products...))
+		assert.NoError(t, err)
+		err = exporter.close()
+
+		// then
+		assert.NoError(t, err)
+		var got []domain.ProductDTO
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		assert.Len(t, got, 2)
+		assert.Equal(t, "아메리카노", got[1].Name)
+	})
+}
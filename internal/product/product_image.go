@@ -0,0 +1,235 @@
+package product
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"slices"
+)
+
+const productImageJPEGQuality = 85
+
+// processedImage
+// 크기별로 다시 인코딩한 이미지. Width, Height 는 original 크기다.
+type processedImage struct {
+	ContentType string
+	Width       int
+	Height      int
+	Variants    map[string][]byte
+}
+
+// processProductImage
+// 파일 내용으로 형식을 확인하고 크기별 이미지를 만든다. 픽셀만 남기고 다시 인코딩하므로 EXIF(촬영 위치, 기기 정보 등)는 모두 지워진다.
+// 휴대폰 사진은 EXIF 의 방향(Orientation) 값으로 회전하므로, EXIF 를 지우기 전에 그 방향대로 돌려 둔다.
+// 투명한 배경을 지키도록 PNG 는 PNG 로, 나머지는 JPEG 로 저장한다.
+func processProductImage(data []byte) (processedImage, error) {
+	const op cerrors.Op = "product/processProductImage"
+
+	contentType := http.DetectContentType(data)
+	if !slices.Contains(domain.ProductImageContentTypes, contentType) {
+		return processedImage{}, cerrors.E(op, cerrors.Invalid, "jpg, png, webp 이미지만 올릴 수 있습니다.")
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return processedImage{}, cerrors.E(op, cerrors.Invalid, err, "이미지 파일을 확인해주세요.")
+	}
+	if config.Width*config.Height > domain.MaxProductImagePixels {
+		return processedImage{}, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("이미지는 %d만 화소까지 올릴 수 있습니다.", domain.MaxProductImagePixels/10000))
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return processedImage{}, cerrors.E(op, cerrors.Invalid, err, "이미지 파일을 확인해주세요.")
+	}
+
+	orientation := 1
+	if contentType == "image/jpeg" {
+		orientation = jpegOrientation(data)
+	}
+
+	res := processedImage{
+		ContentType: "image/jpeg",
+		Variants:    make(map[string][]byte, len(domain.ProductImageVariants)),
+	}
+	if contentType == "image/png" {
+		res.ContentType = "image/png"
+	}
+
+	for _, variant := range domain.ProductImageVariants {
+		img := orient(resizeToFit(src, variant.MaxSize, res.ContentType == "image/png"), orientation)
+
+		var buf bytes.Buffer
+		if res.ContentType == "image/png" {
+			err = png.Encode(&buf, img)
+		} else {
+			err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: productImageJPEGQuality})
+		}
+		if err != nil {
+			return processedImage{}, cerrors.E(op, cerrors.Internal, err, "이미지를 변환하는 중에 에러가 발생했습니다.")
+		}
+		res.Variants[variant.Name] = buf.Bytes()
+
+		if variant == domain.ProductImageVariantOriginal {
+			res.Width, res.Height = img.Bounds().Dx(), img.Bounds().Dy()
+		}
+	}
+
+	return res, nil
+}
+
+// resizeToFit
+// 긴 변이 maxSize 를 넘지 않게 비율을 유지한 채 줄인다. JPEG 로 저장할 이미지는 투명한 부분을 흰 배경으로 채운다.
+func resizeToFit(src image.Image, maxSize int, keepAlpha bool) *image.NRGBA {
+	width, height := src.Bounds().Dx(), src.Bounds().Dy()
+	if width > maxSize || height > maxSize {
+		if width >= height {
+			width, height = maxSize, max(1, height*maxSize/width)
+		} else {
+			width, height = max(1, width*maxSize/height), maxSize
+		}
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	if !keepAlpha {
+		draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	}
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Over, nil)
+
+	return dst
+}
+
+// orient
+// EXIF 방향 값(1 ~ 8)대로 이미지를 돌리거나 뒤집어 똑바로 세운다.
+func orient(src *image.NRGBA, orientation int) *image.NRGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	width, height := src.Bounds().Dx(), src.Bounds().Dy()
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		for x := 0; x < dstWidth; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = width-1-x, y
+			case 3:
+				sx, sy = width-1-x, height-1-y
+			case 4:
+				sx, sy = x, height-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, height-1-x
+			case 7:
+				sx, sy = width-1-y, height-1-x
+			case 8:
+				sx, sy = width-1-y, x
+			}
+			dst.SetNRGBA(x, y, src.NRGBAAt(sx, sy))
+		}
+	}
+
+	return dst
+}
+
+// jpegOrientation
+// JPEG 의 EXIF(APP1) 에서 방향(Orientation) 값을 읽는다. 없거나 읽을 수 없으면 1(회전 없음)이다.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		// 이미지 데이터(SOS)가 시작되면 더는 메타데이터가 없다.
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+
+		size := int(binary.BigEndian.Uint16(data[i+2:]))
+		if size < 2 || i+2+size > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+size]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + size
+	}
+
+	return 1
+}
+
+// exifOrientation
+// EXIF 의 TIFF 헤더와 첫 번째 IFD 에서 방향 태그(0x0112)를 찾는다.
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+
+	count := int(order.Uint16(tiff[offset:]))
+	for n := 0; n < count; n++ {
+		entry := offset + 2 + n*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) != 0x0112 {
+			continue
+		}
+		orientation := int(order.Uint16(tiff[entry+8:]))
+		if orientation < 1 || orientation > 8 {
+			return 1
+		}
+		return orientation
+	}
+
+	return 1
+}
+
+// newProductImageKey
+// 이미지마다 추측할 수 없는 경로를 써서, 다른 이미지의 주소를 알아내거나 지운 이미지가 캐시로 남아 다시 보이는 일을 막는다.
+func newProductImageKey(productID int) (string, error) {
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("products/%d/%s", productID, hex.EncodeToString(token)), nil
+}
@@ -0,0 +1,166 @@
+package product
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/stretchr/testify/assert"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"regexp"
+	"testing"
+)
+
+func encodeTestJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 100, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// withEXIFOrientation
+// SOI 바로 뒤에 방향 태그 하나만 있는 EXIF(APP1) 를 넣는다.
+func withEXIFOrientation(data []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, 0x0112)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1}
+	app1 = binary.BigEndian.AppendUint16(app1, uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	return append(append([]byte{0xFF, 0xD8}, app1...), data[2:]...)
+}
+
+func Test_processProductImage(t *testing.T) {
+	t.Run("PASS - JPEG 를 크기별로 줄이고 EXIF 는 방향만 반영한 채 지운다", func(t *testing.T) {
+		// given
+		data := withEXIFOrientation(encodeTestJPEG(t, 3000, 1000), 6)
+
+		// when
+		got, err := processProductImage(data)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "image/jpeg", got.ContentType)
+		assert.Equal(t, 682, got.Width)
+		assert.Equal(t, 2048, got.Height)
+		for _, variant := range domain.ProductImageVariants {
+			config, format, err := image.DecodeConfig(bytes.NewReader(got.Variants[variant.Name]))
+			assert.NoError(t, err)
+			assert.Equal(t, "jpeg", format)
+			assert.Equal(t, variant.MaxSize, config.Height)
+			assert.False(t, bytes.Contains(got.Variants[variant.Name], []byte("Exif")))
+		}
+	})
+
+	t.Run("PASS - 작은 PNG 는 크기를 그대로 두고 PNG 로 저장한다", func(t *testing.T) {
+		// given
+		img := image.NewNRGBA(image.Rect(0, 0, 100, 50))
+		img.SetNRGBA(0, 0, color.NRGBA{R: 255, A: 128})
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, img); err != nil {
+			t.Fatal(err)
+		}
+
+		// when
+		got, err := processProductImage(buf.Bytes())
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "image/png", got.ContentType)
+		assert.Equal(t, 100, got.Width)
+		assert.Equal(t, 50, got.Height)
+		thumbnail, err := png.Decode(bytes.NewReader(got.Variants[domain.ProductImageVariantThumbnail.Name]))
+		assert.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 100, 50), thumbnail.Bounds())
+		assert.Equal(t, color.NRGBA{R: 255, A: 128}, thumbnail.At(0, 0))
+	})
+
+	t.Run("FAIL - 이미지가 아닌 파일", func(t *testing.T) {
+		// when
+		_, err := processProductImage([]byte("%PDF-1.4"))
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Invalid, err))
+	})
+
+	t.Run("FAIL - 형식만 맞고 내용이 깨진 이미지", func(t *testing.T) {
+		// when
+		_, err := processProductImage(encodeTestJPEG(t, 10, 10)[:100])
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Invalid, err))
+	})
+}
+
+func Test_orient(t *testing.T) {
+	// 2x1 이미지의 왼쪽은 빨강, 오른쪽은 파랑
+	red, blue := color.NRGBA{R: 255, A: 255}, color.NRGBA{B: 255, A: 255}
+	src := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	src.SetNRGBA(0, 0, red)
+	src.SetNRGBA(1, 0, blue)
+
+	tests := []struct {
+		name        string
+		orientation int
+		want        []color.NRGBA
+		wantBounds  image.Rectangle
+	}{
+		{name: "PASS - 회전 없음", orientation: 1, want: []color.NRGBA{red, blue}, wantBounds: image.Rect(0, 0, 2, 1)},
+		{name: "PASS - 좌우 반전", orientation: 2, want: []color.NRGBA{blue, red}, wantBounds: image.Rect(0, 0, 2, 1)},
+		{name: "PASS - 시계 방향 90도", orientation: 6, want: []color.NRGBA{red, blue}, wantBounds: image.Rect(0, 0, 1, 2)},
+		{name: "PASS - 반시계 방향 90도", orientation: 8, want: []color.NRGBA{blue, red}, wantBounds: image.Rect(0, 0, 1, 2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := orient(src, tt.orientation)
+			assert.Equal(t, tt.wantBounds, got.Bounds())
+			var pixels []color.NRGBA
+			for y := 0; y < got.Bounds().Dy(); y++ {
+				for x := 0; x < got.Bounds().Dx(); x++ {
+					pixels = append(pixels, got.NRGBAAt(x, y))
+				}
+			}
+			assert.Equal(t, tt.want, pixels)
+		})
+	}
+}
+
+func Test_jpegOrientation(t *testing.T) {
+	data := encodeTestJPEG(t, 4, 4)
+
+	assert.Equal(t, 1, jpegOrientation(data))
+	assert.Equal(t, 6, jpegOrientation(withEXIFOrientation(data, 6)))
+	assert.Equal(t, 1, jpegOrientation(withEXIFOrientation(data, 9)))
+	assert.Equal(t, 1, jpegOrientation([]byte("not a jpeg")))
+}
+
+func Test_newProductImageKey(t *testing.T) {
+	key, err := newProductImageKey(1)
+	assert.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^products/1/[0-9a-f]{32}$`), key)
+
+	other, err := newProductImageKey(1)
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, other)
+}
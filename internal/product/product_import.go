@@ -0,0 +1,213 @@
+package product
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/xuri/excelize/v2"
+	"io"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// utf8BOM
+// 엑셀에서 UTF-8 로 저장한 CSV 는 앞에 BOM 이 붙는다.
+const utf8BOM = "\xef\xbb\xbf"
+
+var importDateLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"}
+
+// importRow
+// 파일의 한 행을 CreateProductRequest 로 바꾼 결과. 값을 읽지 못한 행은 Err 에 이유를 담는다.
+type importRow struct {
+	Line int
+	Req  domain.CreateProductRequest
+	Err  error
+}
+
+// parseProductImport
+// 첫 행은 열 이름이고 빈 행은 건너뛴다. 파일을 읽을 수 없거나 필수 열이 없으면 전체를 거절한다.
+func parseProductImport(format domain.ProductImportFormat, data []byte) ([]importRow, error) {
+	const op cerrors.Op = "product/parseProductImport"
+
+	var records [][]string
+	var err error
+	switch format {
+	case domain.ProductImportFormatXLSX:
+		records, err = readXLSXRecords(data)
+	default:
+		records, err = readCSVRecords(data)
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Invalid, err, "파일을 읽을 수 없습니다. 형식을 확인해주세요.")
+	}
+	if len(records) == 0 {
+		return nil, cerrors.E(op, cerrors.Invalid, "열 이름 행이 없습니다.")
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range domain.RequiredProductImportColumns {
+		if _, ok := columns[strings.ToLower(name)]; !ok {
+			return nil, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("%s 열이 없습니다.", name))
+		}
+	}
+
+	var rows []importRow
+	for i, record := range records[1:] {
+		if isEmptyRecord(record) {
+			continue
+		}
+		cell := func(name string) string {
+			index, ok := columns[strings.ToLower(name)]
+			if !ok || index >= len(record) {
+				return ""
+			}
+			return unescapeFormula(strings.TrimSpace(record[index]))
+		}
+
+		req, err := createProductRequestFrom(cell)
+		rows = append(rows, importRow{Line: i + 2, Req: req, Err: err})
+	}
+
+	return rows, nil
+}
+
+func readCSVRecords(data []byte) ([][]string, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte(utf8BOM))))
+	reader.FieldsPerRecord = -1
+
+	var records [][]string
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+}
+
+// readXLSXRecords
+// 첫 번째 시트를 읽는다. 날짜 셀은 표시 형식과 상관없이 읽도록 서식을 적용하지 않은 값을 읽는다.
+func readXLSXRecords(data []byte) ([][]string, error) {
+	file, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	sheets := file.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, nil
+	}
+
+	return file.GetRows(sheets[0], excelize.Options{RawCellValue: true})
+}
+
+func isEmptyRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// createProductRequestFrom
+// 셀 값을 열 타입에 맞게 읽는다. Validate 는 호출하는 쪽에서 한다.
+func createProductRequestFrom(cell func(name string) string) (domain.CreateProductRequest, error) {
+	const op cerrors.Op = "product/createProductRequestFrom"
+
+	req := domain.CreateProductRequest{
+		Name:        cell("name"),
+		Description: cell("description"),
+		Barcode:     cell("barcode"),
+	}
+
+	var err error
+	if req.CategoryID, err = parseImportInt(cell("categoryID")); err != nil {
+		return req, cerrors.E(op, cerrors.Invalid, err, "categoryID 열을 확인해주세요.")
+	}
+	if req.Price, err = parseImportFloat(cell("price")); err != nil {
+		return req, cerrors.E(op, cerrors.Invalid, err, "price 열을 확인해주세요.")
+	}
+	if req.Cost, err = parseImportFloat(cell("cost")); err != nil {
+		return req, cerrors.E(op, cerrors.Invalid, err, "cost 열을 확인해주세요.")
+	}
+	if value := cell("internalBarcode"); value != "" {
+		if req.InternalBarcode, err = strconv.ParseBool(value); err != nil {
+			return req, cerrors.E(op, cerrors.Invalid, err, "internalBarcode 열은 true 또는 false 로 입력해주세요.")
+		}
+	}
+	if req.ExpiryDate, err = parseImportDate(cell("expiryDate")); err != nil {
+		return req, cerrors.E(op, cerrors.Invalid, err, "expiryDate 열은 2024-02-28 형식으로 입력해주세요.")
+	}
+	if value := cell("reorderPoint"); value != "" {
+		reorderPoint, err := parseImportInt(value)
+		if err != nil {
+			return req, cerrors.E(op, cerrors.Invalid, err, "reorderPoint 열을 확인해주세요.")
+		}
+		req.ReorderPoint = &reorderPoint
+	}
+	if req.ReorderQuantity, err = parseImportInt(cell("reorderQuantity")); err != nil {
+		return req, cerrors.E(op, cerrors.Invalid, err, "reorderQuantity 열을 확인해주세요.")
+	}
+	if value := cell("optionGroups"); value != "" {
+		if err := json.Unmarshal([]byte(value), &req.OptionGroups); err != nil {
+			return req, cerrors.E(op, cerrors.Invalid, err, "optionGroups 열을 확인해주세요.")
+		}
+	}
+
+	return req, nil
+}
+
+// parseImportInt
+// 엑셀에서 숫자 셀은 20.0 처럼 읽힐 수 있어 소수점 아래가 0 이면 정수로 받는다.
+func parseImportInt(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+	if err != nil {
+		return 0, err
+	}
+	if number != float64(int(number)) {
+		return 0, fmt.Errorf("not an integer: %s", value)
+	}
+	return int(number), nil
+}
+
+func parseImportFloat(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+}
+
+// parseImportDate
+// 날짜만 적으면 UTC 0시로 읽고, 엑셀 날짜 셀은 일련번호로 읽힌다.
+func parseImportDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range importDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date.UTC(), nil
+		}
+	}
+
+	serial, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return excelize.ExcelDateToTime(serial, false)
+}
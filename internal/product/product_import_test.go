@@ -0,0 +1,80 @@
+package product
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"testing"
+	"time"
+)
+
+func Test_parseProductImport(t *testing.T) {
+	expiryDate := time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC)
+
+	t.Run("PASS - BOM 이 붙은 CSV 는 열 순서와 상관없이 읽고 빈 행은 건너뛴다", func(t *testing.T) {
+		// given
+		data := []byte(utf8BOM + "name,categoryID,price,cost,description,barcode,expiryDate,reorderPoint,optionGroups\n" +
+			"슈크림 라떼,1,\"1,000\",500,설명,8801234567893,2025-06-10,5,\"[{\"\"name\"\":\"\"사이즈\"\",\"\"selectType\"\":\"\"single\"\",\"\"options\"\":[{\"\"name\"\":\"\"레귤러\"\"}]}]\"\n" +
+			",,,,,,,,\n" +
+			"아메리카노,1,abc,500,설명,8801234567893,2025-06-10,,\n")
+
+		// when
+		rows, err := parseProductImport(domain.ProductImportFormatCSV, data)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, rows, 2)
+		assert.Equal(t, 2, rows[0].Line)
+		assert.NoError(t, rows[0].Err)
+		assert.Equal(t, "슈크림 라떼", rows[0].Req.Name)
+		assert.Equal(t, float64(1000), rows[0].Req.Price)
+		assert.Equal(t, expiryDate, rows[0].Req.ExpiryDate)
+		assert.Equal(t, 5, *rows[0].Req.ReorderPoint)
+		assert.Equal(t, "레귤러", rows[0].Req.OptionGroups[0].Options[0].Name)
+		assert.Equal(t, 4, rows[1].Line)
+		assert.True(t, cerrors.Is(cerrors.Invalid, rows[1].Err))
+	})
+
+	t.Run("PASS - XLSX 의 날짜 셀을 읽는다", func(t *testing.T) {
+		// given
+		file := excelize.NewFile()
+		sheet := file.GetSheetName(0)
+		_ = file.SetSheetRow(sheet, "A1", &[]any{"categoryID", "price", "cost", "name", "description", "barcode", "expiryDate", "internalBarcode"})
+		_ = file.SetSheetRow(sheet, "A2", &[]any{1, 3000, 1500, "아메리카노", "설명", "2000001", expiryDate, true})
+		buf, err := file.WriteToBuffer()
+		assert.NoError(t, err)
+
+		// when
+		rows, err := parseProductImport(domain.ProductImportFormatXLSX, buf.Bytes())
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, rows, 1)
+		assert.NoError(t, rows[0].Err)
+		assert.Equal(t, 1, rows[0].Req.CategoryID)
+		assert.Equal(t, float64(3000), rows[0].Req.Price)
+		assert.Equal(t, "2000001", rows[0].Req.Barcode)
+		assert.True(t, rows[0].Req.InternalBarcode)
+		assert.Equal(t, expiryDate, rows[0].Req.ExpiryDate)
+	})
+
+	t.Run("FAIL - 필수 열이 없음", func(t *testing.T) {
+		// given
+		data := []byte("name,price\n라떼,1000\n")
+
+		// when
+		_, err := parseProductImport(domain.ProductImportFormatCSV, data)
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Invalid, err))
+	})
+
+	t.Run("FAIL - 읽을 수 없는 XLSX", func(t *testing.T) {
+		// when
+		_, err := parseProductImport(domain.ProductImportFormatXLSX, []byte("name,price"))
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Invalid, err))
+	})
+}
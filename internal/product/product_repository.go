@@ -3,15 +3,20 @@ package product
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/go-sql-driver/mysql"
 	"payhere/domain"
 	cerrors "payhere/pkg/cerrors"
+	"strings"
 	"time"
 )
 
 type productRepository struct {
 	sqlDB *sql.DB
+	// WithTx 안에서 만든 repository 는 모든 쿼리를 이 트랜잭션으로 실행한다.
+	tx *sql.Tx
 }
 
 func NewProductRepository(sqlDB *sql.DB) *productRepository {
@@ -20,32 +25,165 @@ func NewProductRepository(sqlDB *sql.DB) *productRepository {
 	}
 }
 
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func (pr productRepository) db() dbtx {
+	if pr.tx != nil {
+		return pr.tx
+	}
+	return pr.sqlDB
+}
+
+// productTx
+// WithTx 안에서는 바깥 트랜잭션을 그대로 쓰고, commit 과 rollback 은 WithTx 가 한다.
+type productTx struct {
+	*sql.Tx
+	nested bool
+}
+
+func (tx productTx) Commit() error {
+	if tx.nested {
+		return nil
+	}
+	return tx.Tx.Commit()
+}
+
+func (tx productTx) Rollback() error {
+	if tx.nested {
+		return nil
+	}
+	return tx.Tx.Rollback()
+}
+
+func (pr productRepository) beginTx(ctx context.Context) (productTx, error) {
+	if pr.tx != nil {
+		return productTx{Tx: pr.tx, nested: true}, nil
+	}
+
+	tx, err := pr.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return productTx{}, err
+	}
+	return productTx{Tx: tx}, nil
+}
+
+// WithTx
+// fn 에 넘긴 repository 로 실행한 쿼리는 모두 한 트랜잭션으로 묶인다. fn 이 에러를 반환하면 모두 롤백하고 그 에러를 그대로 반환한다.
+func (pr productRepository) WithTx(ctx context.Context, fn func(repository domain.ProductRepository) error) error {
+	const op cerrors.Op = "product/productRepository/WithTx"
+
+	tx, err := pr.beginTx(ctx)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer tx.Rollback()
+
+	if err := fn(productRepository{sqlDB: pr.sqlDB, tx: tx.Tx}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
 var _ domain.ProductRepository = (*productRepository)(nil)
 
+// CreateProduct
+// 상품과 옵션 그룹을 하나의 트랜잭션으로 저장한다.
 func (pr productRepository) CreateProduct(ctx context.Context, product domain.Product) (int, error) {
 	const op cerrors.Op = "product/productRepository/CreateProduct"
 
-	result, err := pr.sqlDB.ExecContext(
+	tx, err := pr.beginTx(ctx)
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer tx.Rollback()
+
+	productID, err := createProduct(ctx, tx.Tx, product)
+	if isDuplicateEntry(err) {
+		return 0, cerrors.E(op, cerrors.Exist, err, "이미 같은 바코드의 상품이 있습니다.")
+	}
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return productID, nil
+}
+
+// CreateProducts
+// 상품을 한 트랜잭션으로 만들고 만든 순서대로 상품 ID 를 반환한다. 하나라도 실패하면 아무 상품도 만들지 않는다.
+func (pr productRepository) CreateProducts(ctx context.Context, products []domain.Product) ([]int, error) {
+	const op cerrors.Op = "product/productRepository/CreateProducts"
+
+	tx, err := pr.beginTx(ctx)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer tx.Rollback()
+
+	productIDs := make([]int, 0, len(products))
+	for _, product := range products {
+		productID, err := createProduct(ctx, tx.Tx, product)
+		if isDuplicateEntry(err) {
+			return nil, cerrors.E(op, cerrors.Exist, err, fmt.Sprintf("이미 같은 바코드의 상품이 있습니다. (바코드: %s)", product.Barcode))
+		}
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		productIDs = append(productIDs, productID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return productIDs, nil
+}
+
+// createProduct
+// 상품과 옵션 그룹, 태그를 tx 안에서 만든다.
+func createProduct(ctx context.Context, tx *sql.Tx, product domain.Product) (int, error) {
+	result, err := tx.ExecContext(
 		ctx,
 		createProductQuery,
 		product.UserID,
 		product.Initial,
-		product.Category,
+		product.Romanized,
+		product.CategoryID,
 		product.Price,
 		product.Cost,
 		product.Name,
 		product.Description,
 		product.Barcode,
 		product.ExpiryDate,
-		product.Size,
+		product.ReorderPoint,
+		product.ReorderQuantity,
 	)
 	if err != nil {
-		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		return 0, err
 	}
 
 	productID, err := result.LastInsertId()
 	if err != nil {
-		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		return 0, err
+	}
+
+	if err := createProductOptionGroups(ctx, tx, int(productID), product.OptionGroups); err != nil {
+		return 0, err
+	}
+	if err := createProductTags(ctx, tx, product.UserID, int(productID), domain.TagNamesFrom(product.Tags)); err != nil {
+		return 0, err
 	}
 
 	return int(productID), nil
@@ -53,25 +191,22 @@ func (pr productRepository) CreateProduct(ctx context.Context, product domain.Pr
 
 func (pr productRepository) GetProduct(ctx context.Context, productID int) (*domain.Product, error) {
 	const op cerrors.Op = "product/productRepository/GetProduct"
-	var product domain.Product
 
-	err := pr.sqlDB.QueryRowContext(ctx, findProductByIDQuery, productID).
-		Scan(
-			&product.ID,
-			&product.CreateDate,
-			&product.UpdateDate,
-			&product.DeleteDate,
-			&product.UserID,
-			&product.Initial,
-			&product.Category,
-			&product.Price,
-			&product.Cost,
-			&product.Name,
-			&product.Description,
-			&product.Barcode,
-			&product.ExpiryDate,
-			&product.Size,
-		)
+	product, err := scanProduct(pr.db().QueryRowContext(ctx, findProductByIDQuery, productID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return &product, nil
+}
+
+func (pr productRepository) GetProductByBarcode(ctx context.Context, userID int, barcode string) (*domain.Product, error) {
+	const op cerrors.Op = "product/productRepository/GetProductByBarcode"
+
+	product, err := scanProduct(pr.db().QueryRowContext(ctx, findProductByBarcodeQuery, userID, barcode))
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -82,37 +217,61 @@ func (pr productRepository) GetProduct(ctx context.Context, productID int) (*dom
 	return &product, nil
 }
 
+// UpdateProduct
+// product.Version 이 DB 의 버전과 같을 때만 수정하고 버전을 올린다. 그 사이 다른 요청이 먼저 수정했으면 PreconditionFailed 를 반환한다.
 func (pr productRepository) UpdateProduct(ctx context.Context, product domain.Product) error {
 	const op cerrors.Op = "product/productRepository/UpdateProduct"
 
-	_, err := pr.sqlDB.ExecContext(
+	result, err := pr.db().ExecContext(
 		ctx,
 		updateProductQuery,
 		product.Initial,
-		product.Category,
+		product.Romanized,
+		product.CategoryID,
 		product.Price,
 		product.Cost,
 		product.Name,
 		product.Description,
 		product.Barcode,
-		product.ExpiryDate,
-		product.Size,
+		product.ReorderPoint,
+		product.ReorderQuantity,
+		product.MarkdownPrice,
 		product.ID,
+		product.Version,
 	)
+	if isDuplicateEntry(err) {
+		return cerrors.E(op, cerrors.Exist, err, "이미 같은 바코드의 상품이 있습니다.")
+	}
 	if err != nil {
 		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
 
-	return nil
+	return checkVersionUpdated(op, result)
 }
 
-func (pr productRepository) DeleteProduct(ctx context.Context, productID int) error {
+// DeleteProduct
+// version 이 DB 의 버전과 같을 때만 휴지통으로 옮긴다.
+func (pr productRepository) DeleteProduct(ctx context.Context, productID int, version int) error {
 	const op cerrors.Op = "product/productRepository/DeleteProduct"
 
-	_, err := pr.sqlDB.ExecContext(ctx, deleteProductQuery, time.Now().UTC(), productID)
+	result, err := pr.db().ExecContext(ctx, deleteProductQuery, time.Now().UTC(), productID, version)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return checkVersionUpdated(op, result)
+}
+
+// checkVersionUpdated
+// 버전을 조건으로 건 UPDATE 가 한 행도 바꾸지 못했으면 그 사이 다른 요청이 상품을 먼저 바꾼 것이다.
+func checkVersionUpdated(op cerrors.Op, result sql.Result) error {
+	affected, err := result.RowsAffected()
 	if err != nil {
 		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
+	if affected == 0 {
+		return cerrors.E(op, cerrors.PreconditionFailed, "다른 곳에서 상품이 먼저 수정되었습니다. 상품을 다시 조회한 뒤 수정해주세요.")
+	}
 
 	return nil
 }
@@ -122,36 +281,165 @@ func (pr productRepository) ListProducts(ctx context.Context, params domain.List
 
 	var products []domain.Product
 
-	query := fmt.Sprintf(listProductsQuery,
-		params.LikeInitial(),
-		params.LikeName(),
-		params.AfterCursor(),
-	)
+	filter, args := params.Filter()
+	query := fmt.Sprintf(listProductsQuery, filter, params.AfterCursor())
 
-	rows, err := pr.sqlDB.QueryContext(ctx, query, params.UserID)
+	rows, err := pr.db().QueryContext(ctx, query, append([]any{params.UserID}, args...)...)
 	if err != nil {
 		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var product domain.Product
-		err := rows.Scan(
-			&product.ID,
-			&product.CreateDate,
-			&product.UpdateDate,
-			&product.DeleteDate,
-			&product.UserID,
-			&product.Initial,
-			&product.Category,
-			&product.Price,
-			&product.Cost,
-			&product.Name,
-			&product.Description,
-			&product.Barcode,
-			&product.ExpiryDate,
-			&product.Size,
-		)
+		product, err := scanProduct(rows)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		products = append(products, product)
+	}
+
+	return products, nil
+}
+
+// EachProduct
+// 조건에 맞는 상품을 ID 순으로 한 행씩 읽어 fn 에 넘긴다. 목록을 메모리에 모으지 않으므로 상품이 많아도 내보낼 수 있다.
+// fn 이 에러를 반환하면 읽기를 멈추고 그 에러를 그대로 반환한다.
+func (pr productRepository) EachProduct(ctx context.Context, params domain.ListProductsParams, fn func(product domain.Product) error) error {
+	const op cerrors.Op = "product/productRepository/EachProduct"
+
+	filter, args := params.Filter()
+	query := fmt.Sprintf(exportProductsQuery, filter)
+
+	rows, err := pr.db().QueryContext(ctx, query, append([]any{params.UserID}, args...)...)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		if err := fn(product); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// ListLowStockProducts
+// 재고 알림 기준 수량을 정한 상품 중 재고가 기준 수량 이하인 상품을 조회한다.
+func (pr productRepository) ListLowStockProducts(ctx context.Context, params domain.ListLowStockProductsParams) ([]domain.Product, error) {
+	const op cerrors.Op = "product/productRepository/ListLowStockProducts"
+
+	var products []domain.Product
+
+	query := fmt.Sprintf(listLowStockProductsQuery, params.AfterCursor())
+
+	rows, err := pr.db().QueryContext(ctx, query, params.UserID, params.Limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		products = append(products, product)
+	}
+
+	return products, nil
+}
+
+// ListExpiringProducts
+// 유통기한이 Until 이전인 상품을 유통기한이 빠른 순으로 조회한다. Since 가 있으면 그 이후에 만료되는 상품만 조회한다.
+func (pr productRepository) ListExpiringProducts(ctx context.Context, params domain.ListExpiringProductsParams) ([]domain.Product, error) {
+	const op cerrors.Op = "product/productRepository/ListExpiringProducts"
+
+	var products []domain.Product
+
+	query := fmt.Sprintf(listExpiringProductsQuery, params.ExpiryAfter(), params.EqualCategory(), params.HasStock())
+
+	rows, err := pr.db().QueryContext(ctx, query, params.UserID, params.Until.UTC(), params.Limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		products = append(products, product)
+	}
+
+	return products, nil
+}
+
+// ClaimExpiryDigest
+// 사장님의 date 날짜 유통기한 요약을 보냈다고 기록한다. 다른 서버가 먼저 기록했으면 false 를 반환하므로 요약은 하루 한 번만 나간다.
+func (pr productRepository) ClaimExpiryDigest(ctx context.Context, userID int, date string) (bool, error) {
+	const op cerrors.Op = "product/productRepository/ClaimExpiryDigest"
+
+	result, err := pr.db().ExecContext(ctx, claimExpiryDigestQuery, userID, date)
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return affected == 1, nil
+}
+
+// ReleaseExpiryDigest
+// 요약을 보내지 못한 경우 기록을 지워 다음 실행 때 다시 보낸다.
+func (pr productRepository) ReleaseExpiryDigest(ctx context.Context, userID int, date string) error {
+	const op cerrors.Op = "product/productRepository/ReleaseExpiryDigest"
+
+	if _, err := pr.db().ExecContext(ctx, releaseExpiryDigestQuery, userID, date); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// ListProductsByIDs
+// 사장님의 삭제되지 않은 상품 중 productIDs 에 해당하는 상품을 조회한다. 없는 상품은 결과에서 빠진다.
+func (pr productRepository) ListProductsByIDs(ctx context.Context, userID int, productIDs []int) ([]domain.Product, error) {
+	const op cerrors.Op = "product/productRepository/ListProductsByIDs"
+
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders := make([]string, len(productIDs))
+	args := make([]any, 0, len(productIDs)+1)
+	args = append(args, userID)
+	for i, productID := range productIDs {
+		placeholders[i] = "?"
+		args = append(args, productID)
+	}
+
+	rows, err := pr.db().QueryContext(ctx, fmt.Sprintf(listProductsByIDsQuery, strings.Join(placeholders, ", ")), args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var products []domain.Product
+	for rows.Next() {
+		product, err := scanProduct(rows)
 		if err != nil {
 			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 		}
@@ -160,3 +448,929 @@ func (pr productRepository) ListProducts(ctx context.Context, params domain.List
 
 	return products, nil
 }
+
+func (pr productRepository) ListProductNames(ctx context.Context, userID int) ([]domain.ProductName, error) {
+	const op cerrors.Op = "product/productRepository/ListProductNames"
+
+	var names []domain.ProductName
+
+	rows, err := pr.db().QueryContext(ctx, listProductNamesQuery, userID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name domain.ProductName
+		if err := rows.Scan(&name.ID, &name.Name, &name.Initial, &name.Romanized); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// ListAllProductNamesAfter
+// 사장님과 삭제 여부에 관계없이 cursor 이후의 상품명을 ID 순으로 조회한다. (일괄 재계산용)
+func (pr productRepository) ListAllProductNamesAfter(ctx context.Context, cursor int, limit int) ([]domain.ProductName, error) {
+	const op cerrors.Op = "product/productRepository/ListAllProductNamesAfter"
+
+	var names []domain.ProductName
+
+	rows, err := pr.db().QueryContext(ctx, listAllProductNamesAfterQuery, cursor, limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name domain.ProductName
+		if err := rows.Scan(&name.ID, &name.Name, &name.Initial, &name.Romanized); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+func (pr productRepository) UpdateProductRomanized(ctx context.Context, productID int, romanized string) error {
+	const op cerrors.Op = "product/productRepository/UpdateProductRomanized"
+
+	_, err := pr.db().ExecContext(ctx, updateProductRomanizedQuery, romanized, productID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// ListProductOptionGroups
+// 여러 상품의 옵션 그룹을 한 번에 조회한다. 그룹과 옵션은 노출 순서대로 정렬된다.
+func (pr productRepository) ListProductOptionGroups(ctx context.Context, productIDs []int) ([]domain.ProductOptionGroup, error) {
+	const op cerrors.Op = "product/productRepository/ListProductOptionGroups"
+
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders := make([]string, len(productIDs))
+	args := make([]any, len(productIDs))
+	for i, productID := range productIDs {
+		placeholders[i] = "?"
+		args[i] = productID
+	}
+
+	rows, err := pr.db().QueryContext(ctx, fmt.Sprintf(listProductOptionGroupsQuery, strings.Join(placeholders, ", ")), args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var groups []domain.ProductOptionGroup
+	for rows.Next() {
+		var group domain.ProductOptionGroup
+		var option domain.ProductOption
+		err := rows.Scan(
+			&group.ID,
+			&group.ProductID,
+			&group.Name,
+			&group.SelectType,
+			&group.Required,
+			&group.MinSelect,
+			&group.MaxSelect,
+			&group.DisplayOrder,
+			&option.ID,
+			&option.Name,
+			&option.PriceDelta,
+			&option.CostDelta,
+			&option.DisplayOrder,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		option.OptionGroupID = group.ID
+
+		if len(groups) == 0 || groups[len(groups)-1].ID != group.ID {
+			groups = append(groups, group)
+		}
+		last := &groups[len(groups)-1]
+		last.Options = append(last.Options, option)
+	}
+
+	return groups, nil
+}
+
+// ReplaceProductOptionGroups
+// 상품의 옵션 그룹을 모두 지우고 주어진 옵션 그룹으로 바꾼다.
+func (pr productRepository) ReplaceProductOptionGroups(ctx context.Context, productID int, groups []domain.ProductOptionGroup) error {
+	const op cerrors.Op = "product/productRepository/ReplaceProductOptionGroups"
+
+	tx, err := pr.beginTx(ctx)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, deleteProductOptionsQuery, productID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if _, err := tx.ExecContext(ctx, deleteProductOptionGroupsQuery, productID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if err := createProductOptionGroups(ctx, tx.Tx, productID, groups); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func createProductOptionGroups(ctx context.Context, tx *sql.Tx, productID int, groups []domain.ProductOptionGroup) error {
+	for _, group := range groups {
+		result, err := tx.ExecContext(
+			ctx,
+			createProductOptionGroupQuery,
+			productID,
+			group.Name,
+			group.SelectType,
+			group.Required,
+			group.MinSelect,
+			group.MaxSelect,
+			group.DisplayOrder,
+		)
+		if err != nil {
+			return err
+		}
+
+		groupID, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		for _, option := range group.Options {
+			_, err := tx.ExecContext(
+				ctx,
+				createProductOptionQuery,
+				groupID,
+				option.Name,
+				option.PriceDelta,
+				option.CostDelta,
+				option.DisplayOrder,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReplaceProductTags
+// 상품의 태그를 names 로 모두 교체한다. 사장님의 태그 중 없는 이름은 새로 만든다.
+func (pr productRepository) ReplaceProductTags(ctx context.Context, userID int, productID int, names []string) error {
+	const op cerrors.Op = "product/productRepository/ReplaceProductTags"
+
+	tx, err := pr.beginTx(ctx)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, deleteProductTagsQuery, productID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if err := createProductTags(ctx, tx.Tx, userID, productID, names); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// ListProductTags
+// 상품 목록의 태그를 한 번에 조회한다. 상품마다 태그 이름 순서로 정렬한다.
+func (pr productRepository) ListProductTags(ctx context.Context, productIDs []int) ([]domain.ProductTag, error) {
+	const op cerrors.Op = "product/productRepository/ListProductTags"
+
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders := make([]string, len(productIDs))
+	args := make([]any, len(productIDs))
+	for i, productID := range productIDs {
+		placeholders[i] = "?"
+		args[i] = productID
+	}
+
+	rows, err := pr.db().QueryContext(ctx, fmt.Sprintf(listProductTagsQuery, strings.Join(placeholders, ", ")), args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var productTags []domain.ProductTag
+	for rows.Next() {
+		var productTag domain.ProductTag
+		err := rows.Scan(
+			&productTag.ProductID,
+			&productTag.Tag.ID,
+			&productTag.Tag.UserID,
+			&productTag.Tag.Name,
+			&productTag.Tag.CreateDate,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		productTags = append(productTags, productTag)
+	}
+
+	return productTags, nil
+}
+
+// createProductTags
+// 없는 태그를 만든 뒤 상품에 붙인다. names 는 정리해서 겹치지 않는 이름만 쓴다.
+func createProductTags(ctx context.Context, tx *sql.Tx, userID int, productID int, names []string) error {
+	names = domain.NormalizeTagNames(names)
+	if len(names) == 0 {
+		return nil
+	}
+
+	values := make([]string, len(names))
+	placeholders := make([]string, len(names))
+	createArgs := make([]any, 0, len(names)*2)
+	findArgs := make([]any, 0, len(names)+1)
+	findArgs = append(findArgs, userID)
+	for i, name := range names {
+		values[i] = "(?, ?)"
+		placeholders[i] = "?"
+		createArgs = append(createArgs, userID, name)
+		findArgs = append(findArgs, name)
+	}
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(createTagsQuery, strings.Join(values, ", ")), createArgs...); err != nil {
+		return err
+	}
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(findTagIDsByNamesQuery, strings.Join(placeholders, ", ")), findArgs...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var tagValues []string
+	var tagArgs []any
+	for rows.Next() {
+		var tagID int
+		if err := rows.Scan(&tagID); err != nil {
+			return err
+		}
+		tagValues = append(tagValues, "(?, ?)")
+		tagArgs = append(tagArgs, productID, tagID)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(createProductTagsQuery, strings.Join(tagValues, ", ")), tagArgs...)
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanProduct(row rowScanner) (domain.Product, error) {
+	var product domain.Product
+
+	err := row.Scan(
+		&product.ID,
+		&product.CreateDate,
+		&product.UpdateDate,
+		&product.DeleteDate,
+		&product.UserID,
+		&product.Initial,
+		&product.Romanized,
+		&product.CategoryID,
+		&product.Category,
+		&product.Price,
+		&product.Cost,
+		&product.Name,
+		&product.Description,
+		&product.Barcode,
+		&product.ExpiryDate,
+		&product.StockQuantity,
+		&product.ReorderPoint,
+		&product.ReorderQuantity,
+		&product.MarkdownPrice,
+		&product.Version,
+	)
+
+	return product, err
+}
+
+// isDuplicateEntry
+// 유니크 인덱스 위반(MySQL 1062)인지 확인한다. 상품은 사장님별로 삭제되지 않은 상품의 바코드가 유일하다.
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
+
+// CreateProductImage
+// 이미지를 상품의 맨 뒤에 추가한다. 상품의 첫 이미지는 대표 이미지가 된다.
+// 같은 상품에 동시에 올린 이미지가 같은 순서를 받지 않도록 상품 행을 잠근 채 순서를 정한다.
+func (pr productRepository) CreateProductImage(ctx context.Context, image domain.ProductImage) (domain.ProductImage, error) {
+	const op cerrors.Op = "product/productRepository/CreateProductImage"
+
+	tx, err := pr.beginTx(ctx)
+	if err != nil {
+		return domain.ProductImage{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer tx.Rollback()
+
+	var productID int
+	if err := tx.QueryRowContext(ctx, lockProductQuery, image.ProductID).Scan(&productID); err != nil {
+		return domain.ProductImage{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	var count int
+	if err := tx.QueryRowContext(ctx, nextProductImageQuery, image.ProductID).Scan(&count, &image.DisplayOrder); err != nil {
+		return domain.ProductImage{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	image.Primary = count == 0
+
+	result, err := tx.ExecContext(
+		ctx,
+		createProductImageQuery,
+		image.ProductID,
+		image.StorageKey,
+		image.ContentType,
+		image.Width,
+		image.Height,
+		image.DisplayOrder,
+		image.Primary,
+	)
+	if err != nil {
+		return domain.ProductImage{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	imageID, err := result.LastInsertId()
+	if err != nil {
+		return domain.ProductImage{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	image.ID = int(imageID)
+
+	if err := tx.Commit(); err != nil {
+		return domain.ProductImage{}, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return image, nil
+}
+
+// ListProductImages
+// 상품별로 보여줄 순서대로 조회한다.
+func (pr productRepository) ListProductImages(ctx context.Context, productIDs []int) ([]domain.ProductImage, error) {
+	const op cerrors.Op = "product/productRepository/ListProductImages"
+
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders := make([]string, len(productIDs))
+	args := make([]any, len(productIDs))
+	for i, productID := range productIDs {
+		placeholders[i] = "?"
+		args[i] = productID
+	}
+
+	rows, err := pr.db().QueryContext(ctx, fmt.Sprintf(listProductImagesQuery, strings.Join(placeholders, ", ")), args...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var images []domain.ProductImage
+	for rows.Next() {
+		var image domain.ProductImage
+		err := rows.Scan(
+			&image.ID,
+			&image.ProductID,
+			&image.StorageKey,
+			&image.ContentType,
+			&image.Width,
+			&image.Height,
+			&image.DisplayOrder,
+			&image.Primary,
+			&image.CreateDate,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		images = append(images, image)
+	}
+
+	return images, nil
+}
+
+func (pr productRepository) CreatePriceHistory(ctx context.Context, history domain.PriceHistory) error {
+	const op cerrors.Op = "product/productRepository/CreatePriceHistory"
+
+	_, err := pr.db().ExecContext(
+		ctx,
+		createPriceHistoryQuery,
+		history.ProductID,
+		history.UserID,
+		history.Reason,
+		history.OldPrice,
+		history.NewPrice,
+		history.OldCost,
+		history.NewCost,
+		history.OldEffectivePrice,
+		history.NewEffectivePrice,
+		history.CreateDate,
+	)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// ListPriceHistory
+// since 이후의 가격 변경 기록을 오래된 순서로 조회한다.
+func (pr productRepository) ListPriceHistory(ctx context.Context, productID int, since time.Time) ([]domain.PriceHistory, error) {
+	const op cerrors.Op = "product/productRepository/ListPriceHistory"
+
+	rows, err := pr.db().QueryContext(ctx, listPriceHistoryQuery, productID, since.UTC())
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var histories []domain.PriceHistory
+	for rows.Next() {
+		var history domain.PriceHistory
+		err := rows.Scan(
+			&history.ID,
+			&history.ProductID,
+			&history.UserID,
+			&history.Reason,
+			&history.OldPrice,
+			&history.NewPrice,
+			&history.OldCost,
+			&history.NewCost,
+			&history.OldEffectivePrice,
+			&history.NewEffectivePrice,
+			&history.CreateDate,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		histories = append(histories, history)
+	}
+
+	return histories, nil
+}
+
+// UpdateProductImages
+// imageIDs 가 있으면 그 순서대로 보여줄 순서를 다시 매기고, primaryImageID 가 있으면 그 이미지만 대표 이미지로 둔다.
+func (pr productRepository) UpdateProductImages(ctx context.Context, productID int, imageIDs []int, primaryImageID *int) error {
+	const op cerrors.Op = "product/productRepository/UpdateProductImages"
+
+	tx, err := pr.beginTx(ctx)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer tx.Rollback()
+
+	for i, imageID := range imageIDs {
+		if _, err := tx.ExecContext(ctx, updateProductImageOrderQuery, i, imageID, productID); err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+	}
+	if primaryImageID != nil {
+		if _, err := tx.ExecContext(ctx, updateProductPrimaryImageQuery, *primaryImageID, productID); err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// DeleteProductImage
+// 대표 이미지를 지우면 남은 이미지 중 맨 앞의 이미지를 대표 이미지로 바꾼다.
+func (pr productRepository) DeleteProductImage(ctx context.Context, image domain.ProductImage) error {
+	const op cerrors.Op = "product/productRepository/DeleteProductImage"
+
+	tx, err := pr.beginTx(ctx)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, deleteProductImageQuery, image.ID, image.ProductID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if image.Primary {
+		if _, err := tx.ExecContext(ctx, promoteProductImageQuery, image.ProductID); err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// GetDeletedProduct
+// 휴지통에 있는 상품을 조회한다.
+func (pr productRepository) GetDeletedProduct(ctx context.Context, productID int) (*domain.Product, error) {
+	const op cerrors.Op = "product/productRepository/GetDeletedProduct"
+
+	product, err := scanProduct(pr.db().QueryRowContext(ctx, findDeletedProductByIDQuery, productID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return &product, nil
+}
+
+func (pr productRepository) ListDeletedProducts(ctx context.Context, userID int, cursor *int) ([]domain.Product, error) {
+	const op cerrors.Op = "product/productRepository/ListDeletedProducts"
+
+	query := fmt.Sprintf(listDeletedProductsQuery, domain.ListProductsParams{Cursor: cursor}.AfterCursor())
+
+	rows, err := pr.db().QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var products []domain.Product
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		products = append(products, product)
+	}
+
+	return products, nil
+}
+
+// ListPurgeableProductIDs
+// 모든 사장님의 상품 중 deletedBefore 전에 휴지통에 들어간 상품을 먼저 들어간 순서로 limit 개 조회한다.
+func (pr productRepository) ListPurgeableProductIDs(ctx context.Context, deletedBefore time.Time, limit int) ([]int, error) {
+	const op cerrors.Op = "product/productRepository/ListPurgeableProductIDs"
+
+	rows, err := pr.db().QueryContext(ctx, listPurgeableProductIDsQuery, deletedBefore, limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var productIDs []int
+	for rows.Next() {
+		var productID int
+		if err := rows.Scan(&productID); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		productIDs = append(productIDs, productID)
+	}
+
+	return productIDs, nil
+}
+
+// RestoreProduct
+// 휴지통에 있는 상품을 되살린다. 그 사이 완전히 지워졌거나 이미 복원되었으면 false 를 반환한다.
+func (pr productRepository) RestoreProduct(ctx context.Context, productID int) (bool, error) {
+	const op cerrors.Op = "product/productRepository/RestoreProduct"
+
+	result, err := pr.db().ExecContext(ctx, restoreProductQuery, productID)
+	if isDuplicateEntry(err) {
+		return false, cerrors.E(op, cerrors.Exist, err, "이미 같은 바코드의 상품이 있어 복원할 수 없습니다.")
+	}
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return affected == 1, nil
+}
+
+// PurgeProduct
+// 휴지통에 있는 상품과 상품을 참조하는 행을 한 트랜잭션으로 지운다. 상품이 휴지통에 없으면 아무것도 지우지 않고 false 를 반환한다.
+// 여러 서버가 같은 상품을 함께 지우려 해도 상품 행을 잠근 쪽만 지운다.
+func (pr productRepository) PurgeProduct(ctx context.Context, productID int) (bool, error) {
+	const op cerrors.Op = "product/productRepository/PurgeProduct"
+
+	tx, err := pr.beginTx(ctx)
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer tx.Rollback()
+
+	var lockedID int
+	err = tx.QueryRowContext(ctx, lockDeletedProductQuery, productID).Scan(&lockedID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	for _, query := range purgeProductReferencesQueries {
+		if _, err := tx.ExecContext(ctx, query, productID); err != nil {
+			return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+	}
+	if _, err := tx.ExecContext(ctx, purgeProductQuery, productID); err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return true, nil
+}
+
+// CreateProductTemplate
+// 옵션 그룹은 상품 생성 요청의 optionGroups 와 같은 JSON 으로 저장한다.
+func (pr productRepository) CreateProductTemplate(ctx context.Context, template domain.ProductTemplate) (int, error) {
+	const op cerrors.Op = "product/productRepository/CreateProductTemplate"
+
+	optionGroups, err := json.Marshal(template.OptionGroups)
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	result, err := pr.db().ExecContext(
+		ctx,
+		createProductTemplateQuery,
+		template.UserID,
+		template.Name,
+		template.CategoryID,
+		template.Price,
+		template.Cost,
+		template.ProductName,
+		template.Description,
+		template.ReorderPoint,
+		template.ReorderQuantity,
+		string(optionGroups),
+	)
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	templateID, err := result.LastInsertId()
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return int(templateID), nil
+}
+
+func (pr productRepository) GetProductTemplate(ctx context.Context, templateID int) (*domain.ProductTemplate, error) {
+	const op cerrors.Op = "product/productRepository/GetProductTemplate"
+
+	template, err := scanProductTemplate(pr.db().QueryRowContext(ctx, findProductTemplateByIDQuery, templateID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return &template, nil
+}
+
+// ListProductTemplates
+// 사장님의 템플릿을 이름 순서로 조회한다.
+func (pr productRepository) ListProductTemplates(ctx context.Context, userID int) ([]domain.ProductTemplate, error) {
+	const op cerrors.Op = "product/productRepository/ListProductTemplates"
+
+	rows, err := pr.db().QueryContext(ctx, listProductTemplatesQuery, userID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var templates []domain.ProductTemplate
+	for rows.Next() {
+		template, err := scanProductTemplate(rows)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, nil
+}
+
+func (pr productRepository) DeleteProductTemplate(ctx context.Context, templateID int) error {
+	const op cerrors.Op = "product/productRepository/DeleteProductTemplate"
+
+	if _, err := pr.db().ExecContext(ctx, deleteProductTemplateQuery, templateID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (pr productRepository) CreateScheduledPriceChange(ctx context.Context, change domain.ScheduledPriceChange) (int, error) {
+	const op cerrors.Op = "product/productRepository/CreateScheduledPriceChange"
+
+	result, err := pr.db().ExecContext(
+		ctx,
+		createScheduledPriceChangeQuery,
+		change.ProductID,
+		change.UserID,
+		change.Price,
+		change.Cost,
+		change.EffectiveDate,
+	)
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	scheduleID, err := result.LastInsertId()
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return int(scheduleID), nil
+}
+
+// GetScheduledPriceChange
+// 이미 적용했거나 취소한 예약도 조회한다.
+func (pr productRepository) GetScheduledPriceChange(ctx context.Context, scheduleID int) (*domain.ScheduledPriceChange, error) {
+	const op cerrors.Op = "product/productRepository/GetScheduledPriceChange"
+
+	change, err := scanScheduledPriceChange(pr.db().QueryRowContext(ctx, findScheduledPriceChangeByIDQuery, scheduleID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return &change, nil
+}
+
+// ListScheduledPriceChanges
+// 아직 적용하지 않은 예약을 적용할 시각 순서로 조회한다.
+func (pr productRepository) ListScheduledPriceChanges(ctx context.Context, params domain.ListScheduledPriceChangesParams) ([]domain.ScheduledPriceChange, error) {
+	const op cerrors.Op = "product/productRepository/ListScheduledPriceChanges"
+
+	rows, err := pr.db().QueryContext(ctx, fmt.Sprintf(listScheduledPriceChangesQuery, params.EqualProduct()), params.UserID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var changes []domain.ScheduledPriceChange
+	for rows.Next() {
+		change, err := scanScheduledPriceChange(rows)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// ListDueScheduledPriceChanges
+// 모든 사장님의 예약 중 적용할 시각이 now 이전인 예약을 먼저 적용할 순서로 limit 개까지 조회한다.
+func (pr productRepository) ListDueScheduledPriceChanges(ctx context.Context, now time.Time, limit int) ([]domain.ScheduledPriceChange, error) {
+	const op cerrors.Op = "product/productRepository/ListDueScheduledPriceChanges"
+
+	rows, err := pr.db().QueryContext(ctx, listDueScheduledPriceChangesQuery, now, limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var changes []domain.ScheduledPriceChange
+	for rows.Next() {
+		change, err := scanScheduledPriceChange(rows)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// ClaimScheduledPriceChange
+// 예약을 적용했다고 기록한다. 다른 서버가 먼저 적용했거나 사장님이 취소했으면 false 를 반환한다.
+// 가격을 바꾸는 트랜잭션 안에서 부르면 커밋할 때까지 다른 서버는 같은 예약을 기록하지 못하고 기다린다.
+func (pr productRepository) ClaimScheduledPriceChange(ctx context.Context, scheduleID int, now time.Time) (bool, error) {
+	const op cerrors.Op = "product/productRepository/ClaimScheduledPriceChange"
+
+	result, err := pr.db().ExecContext(ctx, claimScheduledPriceChangeQuery, now, scheduleID)
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return affected == 1, nil
+}
+
+// CancelScheduledPriceChange
+// 아직 적용하지 않은 예약만 취소한다. 이미 적용했거나 취소했으면 false 를 반환한다.
+func (pr productRepository) CancelScheduledPriceChange(ctx context.Context, scheduleID int, now time.Time) (bool, error) {
+	const op cerrors.Op = "product/productRepository/CancelScheduledPriceChange"
+
+	result, err := pr.db().ExecContext(ctx, cancelScheduledPriceChangeQuery, now, scheduleID)
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return affected == 1, nil
+}
+
+func scanScheduledPriceChange(row rowScanner) (domain.ScheduledPriceChange, error) {
+	var change domain.ScheduledPriceChange
+
+	err := row.Scan(
+		&change.ID,
+		&change.ProductID,
+		&change.ProductName,
+		&change.UserID,
+		&change.Price,
+		&change.Cost,
+		&change.EffectiveDate,
+		&change.ApplyDate,
+		&change.CancelDate,
+		&change.CreateDate,
+	)
+	if err != nil {
+		return domain.ScheduledPriceChange{}, err
+	}
+
+	return change, nil
+}
+
+func scanProductTemplate(row rowScanner) (domain.ProductTemplate, error) {
+	var template domain.ProductTemplate
+	var optionGroups string
+
+	err := row.Scan(
+		&template.ID,
+		&template.UserID,
+		&template.Name,
+		&template.CategoryID,
+		&template.Price,
+		&template.Cost,
+		&template.ProductName,
+		&template.Description,
+		&template.ReorderPoint,
+		&template.ReorderQuantity,
+		&optionGroups,
+		&template.CreateDate,
+	)
+	if err != nil {
+		return domain.ProductTemplate{}, err
+	}
+
+	if err := json.Unmarshal([]byte(optionGroups), &template.OptionGroups); err != nil {
+		return domain.ProductTemplate{}, err
+	}
+
+	return template, nil
+}
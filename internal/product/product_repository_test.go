@@ -3,9 +3,13 @@ package product
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
 	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
 	"testing"
 	"time"
 )
@@ -57,35 +61,136 @@ func Test_productRepository_CreateProduct(t *testing.T) {
 				product: domain.Product{
 					UserID:      1,
 					Initial:     "ㅅㅋㄹ ㄹㄸ",
-					Category:    "payhere",
+					Romanized:   "syukeurim ratte",
+					CategoryID:  1,
 					Price:       1000,
 					Cost:        500,
 					Name:        "슈크림 라떼",
 					Description: "description",
 					Barcode:     "barcode",
 					ExpiryDate:  expiryDate,
-					Size:        domain.ProductSizeTypeSmall,
 				},
 			},
 			mock: func(ts productRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
 				ts.sqlMock.ExpectExec("INSERT INTO products").
 					WithArgs(
 						1,
 						"ㅅㅋㄹ ㄹㄸ",
-						"payhere",
+						"syukeurim ratte",
+						1,
 						float64(1000),
 						float64(500),
 						"슈크림 라떼",
 						"description",
 						"barcode",
 						expiryDate,
-						domain.ProductSizeTypeSmall,
+						nil,
+						0,
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectCommit()
 			},
 			want:    1,
 			wantErr: false,
 		},
+		{
+			name: "PASS - 옵션 그룹을 포함한 상품 생성",
+			args: args{
+				ctx: context.Background(),
+				product: domain.Product{
+					UserID:      1,
+					Initial:     "ㅇㅁㄹㅋㄴ",
+					Romanized:   "amerikano",
+					CategoryID:  1,
+					Price:       3000,
+					Cost:        1500,
+					Name:        "아메리카노",
+					Description: "description",
+					Barcode:     "barcode",
+					ExpiryDate:  expiryDate,
+					OptionGroups: []domain.ProductOptionGroup{
+						{
+							Name:       "사이즈",
+							SelectType: domain.ProductOptionSelectTypeSingle,
+							Required:   true,
+							MinSelect:  1,
+							MaxSelect:  1,
+							Options: []domain.ProductOption{
+								{Name: "레귤러"},
+								{Name: "라지", PriceDelta: 500, CostDelta: 200, DisplayOrder: 1},
+							},
+						},
+					},
+				},
+			},
+			mock: func(ts productRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec("INSERT INTO products").
+					WillReturnResult(sqlmock.NewResult(2, 1))
+				ts.sqlMock.ExpectExec("INSERT INTO product_option_groups").
+					WithArgs(2, "사이즈", domain.ProductOptionSelectTypeSingle, true, 1, 1, 0).
+					WillReturnResult(sqlmock.NewResult(10, 1))
+				ts.sqlMock.ExpectExec("INSERT INTO product_options").
+					WithArgs(10, "레귤러", float64(0), float64(0), 0).
+					WillReturnResult(sqlmock.NewResult(20, 1))
+				ts.sqlMock.ExpectExec("INSERT INTO product_options").
+					WithArgs(10, "라지", float64(500), float64(200), 1).
+					WillReturnResult(sqlmock.NewResult(21, 1))
+				ts.sqlMock.ExpectCommit()
+			},
+			want:    2,
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 옵션 저장 실패 시 롤백",
+			args: args{
+				ctx: context.Background(),
+				product: domain.Product{
+					UserID: 1,
+					Name:   "아메리카노",
+					OptionGroups: []domain.ProductOptionGroup{
+						{Name: "사이즈", SelectType: domain.ProductOptionSelectTypeSingle, Options: []domain.ProductOption{{Name: "레귤러"}}},
+					},
+				},
+			},
+			mock: func(ts productRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec("INSERT INTO products").
+					WillReturnResult(sqlmock.NewResult(2, 1))
+				ts.sqlMock.ExpectExec("INSERT INTO product_option_groups").
+					WillReturnError(sql.ErrConnDone)
+				ts.sqlMock.ExpectRollback()
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 같은 바코드의 상품이 이미 있음",
+			args: args{
+				ctx: context.Background(),
+				product: domain.Product{
+					UserID:      1,
+					Initial:     "ㅅㅋㄹ ㄹㄸ",
+					Romanized:   "syukeurim ratte",
+					CategoryID:  1,
+					Price:       1000,
+					Cost:        500,
+					Name:        "슈크림 라떼",
+					Description: "description",
+					Barcode:     "8801234567893",
+					ExpiryDate:  expiryDate,
+				},
+			},
+			mock: func(ts productRepositoryTestSuite) {
+				ts.sqlMock.ExpectBegin()
+				ts.sqlMock.ExpectExec("INSERT INTO products").
+					WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+				ts.sqlMock.ExpectRollback()
+			},
+			want:    0,
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -109,6 +214,96 @@ func Test_productRepository_CreateProduct(t *testing.T) {
 	}
 }
 
+func Test_productRepository_CreateProducts(t *testing.T) {
+	expiryDate := time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC)
+	products := []domain.Product{
+		{UserID: 1, CategoryID: 1, Price: 1000, Cost: 500, Name: "슈크림 라떼", Barcode: "8801234567893", ExpiryDate: expiryDate},
+		{UserID: 1, CategoryID: 1, Price: 3000, Cost: 1500, Name: "아메리카노", Barcode: "96385074", ExpiryDate: expiryDate},
+	}
+
+	t.Run("PASS - 한 트랜잭션으로 만든다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectExec("INSERT INTO products").WillReturnResult(sqlmock.NewResult(10, 1))
+		ts.sqlMock.ExpectExec("INSERT INTO products").WillReturnResult(sqlmock.NewResult(11, 1))
+		ts.sqlMock.ExpectCommit()
+
+		// when
+		got, err := ts.productRepository.CreateProducts(context.Background(), products)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []int{10, 11}, got)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("FAIL - 하나라도 실패하면 롤백", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectExec("INSERT INTO products").WillReturnResult(sqlmock.NewResult(10, 1))
+		ts.sqlMock.ExpectExec("INSERT INTO products").WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+		ts.sqlMock.ExpectRollback()
+
+		// when
+		got, err := ts.productRepository.CreateProducts(context.Background(), products)
+
+		// then
+		assert.Error(t, err)
+		assert.Nil(t, got)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func Test_productRepository_WithTx(t *testing.T) {
+	product := domain.Product{UserID: 1, CategoryID: 1, Price: 1000, Cost: 500, Name: "슈크림 라떼", Barcode: "8801234567893"}
+
+	t.Run("PASS - 트랜잭션을 여는 메서드도 바깥 트랜잭션으로 실행하고 한 번만 커밋한다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectExec("INSERT INTO products").WillReturnResult(sqlmock.NewResult(10, 1))
+		ts.sqlMock.ExpectExec("UPDATE products").WithArgs(sqlmock.AnyArg(), 10, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		ts.sqlMock.ExpectCommit()
+
+		// when
+		err := ts.productRepository.WithTx(context.Background(), func(repository domain.ProductRepository) error {
+			productID, err := repository.CreateProduct(context.Background(), product)
+			if err != nil {
+				return err
+			}
+			return repository.DeleteProduct(context.Background(), productID, 1)
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("FAIL - fn 이 실패하면 롤백하고 에러를 그대로 반환한다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectExec("INSERT INTO products").WillReturnResult(sqlmock.NewResult(10, 1))
+		ts.sqlMock.ExpectExec("INSERT INTO products").WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+		ts.sqlMock.ExpectRollback()
+
+		// when
+		err := ts.productRepository.WithTx(context.Background(), func(repository domain.ProductRepository) error {
+			if _, err := repository.CreateProduct(context.Background(), product); err != nil {
+				return err
+			}
+			_, err := repository.CreateProduct(context.Background(), product)
+			return err
+		})
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Exist, err))
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+}
+
 func Test_productRepository_GetProduct(t *testing.T) {
 	type args struct {
 		ctx       context.Context
@@ -133,9 +328,9 @@ func Test_productRepository_GetProduct(t *testing.T) {
 				productID: 100,
 			},
 			mock: func(ts productRepositoryTestSuite) {
-				query := `SELECT id, create_date, update_date, delete_date, user_id, initial, category, price, cost, name, description, barcode, expiry_date, size FROM products`
-				columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "size"}
-				rows := sqlmock.NewRows(columns).AddRow(100, createDate, updateDate, nil, 1, "ㅅㅋㄹ ㄹㄸ", "payhere", 1000, 500, "슈크림 라떼", "description", "barcode", expiryDate, domain.ProductSizeTypeSmall)
+				query := `SELECT p.id, p.create_date, p.update_date, p.delete_date, p.user_id, p.initial, p.romanized, p.category_id, COALESCE\(c.name, ''\), p.price, p.cost, p.name, p.description, p.barcode, p.expiry_date, p.stock_quantity, p.reorder_point, p.reorder_quantity, p.markdown_price, p.version FROM products p`
+				columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "romanized", "category_id", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "stock_quantity", "reorder_point", "reorder_quantity", "markdown_price", "version"}
+				rows := sqlmock.NewRows(columns).AddRow(100, createDate, updateDate, nil, 1, "ㅅㅋㄹ ㄹㄸ", "syukeurim ratte", 1, "payhere", 1000, 500, "슈크림 라떼", "description", "barcode", expiryDate, 0, nil, 0, nil, 1)
 				ts.sqlMock.ExpectQuery(query).WithArgs(100).WillReturnRows(rows)
 			},
 			want: &domain.Product{
@@ -150,6 +345,8 @@ func Test_productRepository_GetProduct(t *testing.T) {
 				},
 				UserID:      1,
 				Initial:     "ㅅㅋㄹ ㄹㄸ",
+				Romanized:   "syukeurim ratte",
+				CategoryID:  1,
 				Category:    "payhere",
 				Price:       1000,
 				Cost:        500,
@@ -157,7 +354,7 @@ func Test_productRepository_GetProduct(t *testing.T) {
 				Description: "description",
 				Barcode:     "barcode",
 				ExpiryDate:  expiryDate,
-				Size:        domain.ProductSizeTypeSmall,
+				Version:     1,
 			},
 			wantErr: false,
 		},
@@ -168,7 +365,7 @@ func Test_productRepository_GetProduct(t *testing.T) {
 				productID: 100,
 			},
 			mock: func(ts productRepositoryTestSuite) {
-				query := `SELECT id, create_date, update_date, delete_date, user_id, initial, category, price, cost, name, description, barcode, expiry_date, size FROM products`
+				query := `SELECT p.id, p.create_date, p.update_date, p.delete_date, p.user_id, p.initial, p.romanized, p.category_id, COALESCE\(c.name, ''\), p.price, p.cost, p.name, p.description, p.barcode, p.expiry_date, p.stock_quantity, p.reorder_point, p.reorder_quantity, p.markdown_price, p.version FROM products p`
 				ts.sqlMock.ExpectQuery(query).WithArgs(100).WillReturnError(sql.ErrNoRows)
 			},
 			want:    nil,
@@ -197,6 +394,93 @@ func Test_productRepository_GetProduct(t *testing.T) {
 	}
 }
 
+func Test_productRepository_GetProductByBarcode(t *testing.T) {
+	type args struct {
+		ctx     context.Context
+		userID  int
+		barcode string
+	}
+
+	createDate := time.Now()
+	updateDate := time.Now()
+	expiryDate := time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts productRepositoryTestSuite)
+		want    *domain.Product
+		wantErr bool
+	}{
+		{
+			name: "PASS - 바코드로 상품 조회 성공",
+			args: args{
+				ctx:     context.Background(),
+				userID:  1,
+				barcode: "8801234567893",
+			},
+			mock: func(ts productRepositoryTestSuite) {
+				query := `SELECT p.id, p.create_date, p.update_date, p.delete_date, p.user_id, p.initial, p.romanized, p.category_id, COALESCE\(c.name, ''\), p.price, p.cost, p.name, p.description, p.barcode, p.expiry_date, p.stock_quantity, p.reorder_point, p.reorder_quantity, p.markdown_price, p.version FROM products p`
+				columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "romanized", "category_id", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "stock_quantity", "reorder_point", "reorder_quantity", "markdown_price", "version"}
+				rows := sqlmock.NewRows(columns).AddRow(100, createDate, updateDate, nil, 1, "ㅅㅋㄹ ㄹㄸ", "syukeurim ratte", 1, "payhere", 1000, 500, "슈크림 라떼", "description", "8801234567893", expiryDate, 0, nil, 0, nil, 1)
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, "8801234567893").WillReturnRows(rows)
+			},
+			want: &domain.Product{
+				Base: domain.Base{
+					ID:         100,
+					CreateDate: createDate,
+					UpdateDate: updateDate,
+				},
+				UserID:      1,
+				Initial:     "ㅅㅋㄹ ㄹㄸ",
+				Romanized:   "syukeurim ratte",
+				CategoryID:  1,
+				Category:    "payhere",
+				Price:       1000,
+				Cost:        500,
+				Name:        "슈크림 라떼",
+				Description: "description",
+				Barcode:     "8801234567893",
+				ExpiryDate:  expiryDate,
+				Version:     1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 바코드에 해당하는 상품이 없음",
+			args: args{
+				ctx:     context.Background(),
+				userID:  1,
+				barcode: "8801234567893",
+			},
+			mock: func(ts productRepositoryTestSuite) {
+				query := `SELECT p.id, p.create_date, p.update_date, p.delete_date, p.user_id, p.initial, p.romanized, p.category_id, COALESCE\(c.name, ''\), p.price, p.cost, p.name, p.description, p.barcode, p.expiry_date, p.stock_quantity, p.reorder_point, p.reorder_quantity, p.markdown_price, p.version FROM products p`
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, "8801234567893").WillReturnError(sql.ErrNoRows)
+			},
+			want:    nil,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.productRepository.GetProductByBarcode(tt.args.ctx, tt.args.userID, tt.args.barcode)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+		})
+	}
+}
+
 func Test_productRepository_UpdateProduct(t *testing.T) {
 	type args struct {
 		ctx     context.Context
@@ -221,34 +505,52 @@ func Test_productRepository_UpdateProduct(t *testing.T) {
 					},
 					UserID:      1,
 					Initial:     "ㅅㅈ ㄹㄸ",
-					Category:    "modified category",
+					Romanized:   "sujeong ratte",
+					CategoryID:  2,
 					Price:       1000,
 					Cost:        2000,
 					Name:        "수정 라떼",
 					Description: "modified description",
 					Barcode:     "modified barcode",
 					ExpiryDate:  expiryDate,
-					Size:        domain.ProductSizeTypeSmall,
+					Version:     3,
 				},
 			},
 			mock: func(ts productRepositoryTestSuite) {
-				ts.sqlMock.ExpectExec("UPDATE products").
+				ts.sqlMock.ExpectExec(`UPDATE products SET .*, version = version \+ 1 WHERE id = \? AND version = \?`).
 					WithArgs(
 						"ㅅㅈ ㄹㄸ",
-						"modified category",
+						"sujeong ratte",
+						2,
 						float64(1000),
 						float64(2000),
 						"수정 라떼",
 						"modified description",
 						"modified barcode",
-						expiryDate,
-						domain.ProductSizeTypeSmall,
+						nil,
+						0,
+						nil,
 						100,
+						3,
 					).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
 		},
+		{
+			name: "FAIL - 그 사이 다른 요청이 먼저 수정함",
+			args: args{
+				ctx: context.Background(),
+				product: domain.Product{
+					Base:    domain.Base{ID: 100},
+					Version: 3,
+				},
+			},
+			mock: func(ts productRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec("UPDATE products").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -264,8 +566,9 @@ func Test_productRepository_UpdateProduct(t *testing.T) {
 			if ts.sqlMock.ExpectationsWereMet() != nil {
 				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
 			}
-			if err != nil {
-				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			assert.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				assert.True(t, cerrors.Is(cerrors.PreconditionFailed, err))
 			}
 		})
 	}
@@ -290,10 +593,23 @@ func Test_productRepository_DeleteProduct(t *testing.T) {
 				productID: 1,
 			},
 			mock: func(ts productRepositoryTestSuite) {
-				ts.sqlMock.ExpectExec("UPDATE products").WillReturnResult(sqlmock.NewResult(1, 1))
+				ts.sqlMock.ExpectExec(`UPDATE products SET delete_date = \?, version = version \+ 1 WHERE id = \? AND version = \?`).
+					WithArgs(sqlmock.AnyArg(), 1, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
 		},
+		{
+			name: "FAIL - 그 사이 다른 요청이 먼저 수정함",
+			args: args{
+				ctx:       context.Background(),
+				productID: 1,
+			},
+			mock: func(ts productRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec("UPDATE products").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -303,14 +619,15 @@ func Test_productRepository_DeleteProduct(t *testing.T) {
 			tt.mock(ts)
 
 			// when
-			err := ts.productRepository.DeleteProduct(tt.args.ctx, tt.args.productID)
+			err := ts.productRepository.DeleteProduct(tt.args.ctx, tt.args.productID, 1)
 
 			// then
 			if ts.sqlMock.ExpectationsWereMet() != nil {
 				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
 			}
-			if err != nil {
-				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			assert.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				assert.True(t, cerrors.Is(cerrors.PreconditionFailed, err))
 			}
 		})
 	}
@@ -342,9 +659,9 @@ func Test_productRepository_ListProducts(t *testing.T) {
 				},
 			},
 			mock: func(ts productRepositoryTestSuite) {
-				query := `SELECT id, create_date, update_date, delete_date, user_id, initial, category, price, cost, name, description, barcode, expiry_date, size FROM products`
-				columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "size"}
-				rows := sqlmock.NewRows(columns).AddRow(100, createDate, updateDate, nil, 1, "ㅅㅋㄹ ㄹㄸ", "payhere", 1000, 500, "슈크림 라떼", "description", "barcode", expiryDate, domain.ProductSizeTypeSmall)
+				query := `SELECT p.id, p.create_date, p.update_date, p.delete_date, p.user_id, p.initial, p.romanized, p.category_id, COALESCE\(c.name, ''\), p.price, p.cost, p.name, p.description, p.barcode, p.expiry_date, p.stock_quantity, p.reorder_point, p.reorder_quantity, p.markdown_price, p.version FROM products p`
+				columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "romanized", "category_id", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "stock_quantity", "reorder_point", "reorder_quantity", "markdown_price", "version"}
+				rows := sqlmock.NewRows(columns).AddRow(100, createDate, updateDate, nil, 1, "ㅅㅋㄹ ㄹㄸ", "syukeurim ratte", 1, "payhere", 1000, 500, "슈크림 라떼", "description", "barcode", expiryDate, 0, nil, 0, nil, 1)
 				ts.sqlMock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
 			},
 			want: []domain.Product{
@@ -360,6 +677,8 @@ func Test_productRepository_ListProducts(t *testing.T) {
 					},
 					UserID:      1,
 					Initial:     "ㅅㅋㄹ ㄹㄸ",
+					Romanized:   "syukeurim ratte",
+					CategoryID:  1,
 					Category:    "payhere",
 					Price:       1000,
 					Cost:        500,
@@ -367,9 +686,45 @@ func Test_productRepository_ListProducts(t *testing.T) {
 					Description: "description",
 					Barcode:     "barcode",
 					ExpiryDate:  expiryDate,
-					Size:        domain.ProductSizeTypeSmall,
+					Version:     1,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 태그가 모두 붙은 상품만 조회",
+			args: args{
+				ctx: context.Background(),
+				params: domain.ListProductsParams{
+					UserID:   1,
+					TagIDs:   []int{1, 2},
+					TagMatch: domain.TagMatchAll,
+				},
+			},
+			mock: func(ts productRepositoryTestSuite) {
+				query := `FROM products p (.+) AND p.id IN \(SELECT pt.product_id FROM product_tags pt WHERE pt.tag_id IN \(1, 2\) GROUP BY pt.product_id HAVING COUNT\(\*\) = 2\)`
+				columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "romanized", "category_id", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "stock_quantity", "reorder_point", "reorder_quantity", "markdown_price", "version"}
+				ts.sqlMock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "PASS - 검색어는 SQL 에 넣지 않고 ? 로 넘김",
+			args: args{
+				ctx: context.Background(),
+				params: domain.ListProductsParams{
+					UserID:    1,
+					Name:      pointer.String("라떼' OR '1'='1"),
+					Romanized: pointer.String("50%_off"),
 				},
 			},
+			mock: func(ts productRepositoryTestSuite) {
+				query := `FROM products p (.+) AND \(p.name LIKE \? OR REPLACE\(p.romanized, ' ', ''\) LIKE \?\) ORDER BY`
+				columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "romanized", "category_id", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "stock_quantity", "reorder_point", "reorder_quantity", "markdown_price", "version"}
+				ts.sqlMock.ExpectQuery(query).WithArgs(1, "%라떼' OR '1'='1%", `%50\%\_off%`).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			want:    nil,
 			wantErr: false,
 		},
 	}
@@ -394,3 +749,1155 @@ func Test_productRepository_ListProducts(t *testing.T) {
 		})
 	}
 }
+
+func Test_productRepository_EachProduct(t *testing.T) {
+	createDate := time.Now()
+	updateDate := time.Now()
+	expiryDate := time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC)
+
+	t.Run("PASS - LIMIT 없이 조건에 맞는 상품을 하나씩 넘긴다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		query := `SELECT p.id, .* FROM products p .* WHERE p.user_id = \? AND p.delete_date IS NULL AND p.category_id = 1 ORDER BY p.id$`
+		columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "romanized", "category_id", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "stock_quantity", "reorder_point", "reorder_quantity", "markdown_price", "version"}
+		rows := sqlmock.NewRows(columns).
+			AddRow(100, createDate, updateDate, nil, 1, "ㅅㅋㄹ ㄹㄸ", "syukeurim ratte", 1, "payhere", 1000, 500, "슈크림 라떼", "description", "barcode", expiryDate, 0, nil, 0, nil, 1).
+			AddRow(101, createDate, updateDate, nil, 1, "ㅇㅁㄹㅋㄴ", "amerikano", 1, "payhere", 3000, 1500, "아메리카노", "description", "barcode2", expiryDate, 0, nil, 0, nil, 1)
+		ts.sqlMock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+
+		// when
+		var got []int
+		err := ts.productRepository.EachProduct(context.Background(), domain.ListProductsParams{UserID: 1, CategoryID: pointer.Int(1)}, func(product domain.Product) error {
+			got = append(got, product.ID)
+			return nil
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []int{100, 101}, got)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("PASS - 목록 조회와 같은 태그 조건으로 내보낸다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		query := `FROM products p .* WHERE p.user_id = \? AND p.delete_date IS NULL AND p.name LIKE \? AND p.id IN \(SELECT pt.product_id FROM product_tags pt WHERE pt.tag_id IN \(3, 4\) GROUP BY pt.product_id HAVING COUNT\(\*\) = 2\) ORDER BY p.id$`
+		columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "romanized", "category_id", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "stock_quantity", "reorder_point", "reorder_quantity", "markdown_price", "version"}
+		ts.sqlMock.ExpectQuery(query).WithArgs(1, "%라떼%").WillReturnRows(sqlmock.NewRows(columns))
+
+		// when
+		err := ts.productRepository.EachProduct(context.Background(), domain.ListProductsParams{
+			UserID:   1,
+			Name:     pointer.String("라떼"),
+			TagIDs:   []int{3, 4},
+			TagMatch: domain.TagMatchAll,
+		}, func(product domain.Product) error {
+			return nil
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func Test_productRepository_ListProductsByIDs(t *testing.T) {
+	createDate := time.Now()
+	updateDate := time.Now()
+	expiryDate := time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC)
+
+	// given
+	ts := setupUserRepositoryTestSuite()
+	query := `SELECT p.id, .* FROM products p .* WHERE p.user_id = \? AND p.delete_date IS NULL AND p.id IN \(\?, \?\)`
+	columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "romanized", "category_id", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "stock_quantity", "reorder_point", "reorder_quantity", "markdown_price", "version"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(1, createDate, updateDate, nil, 1, "ㅇㅁㄹㅋㄴ", "amerikano", 1, "payhere", 3000, 1500, "아메리카노", "description", "8801000000012", expiryDate, 0, nil, 0, nil, 1).
+		AddRow(2, createDate, updateDate, nil, 1, "ㅋㅍㄹㄸ", "kaperatte", 1, "payhere", 3500, 1800, "카페라떼", "description", "8801000000029", expiryDate, 0, nil, 0, nil, 1)
+	ts.sqlMock.ExpectQuery(query).WithArgs(1, 2, 1).WillReturnRows(rows)
+
+	// when
+	got, err := ts.productRepository.ListProductsByIDs(context.Background(), 1, []int{2, 1})
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, "아메리카노", got[0].Name)
+	assert.Equal(t, "8801000000029", got[1].Barcode)
+	if ts.sqlMock.ExpectationsWereMet() != nil {
+		t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+	}
+}
+
+func Test_productRepository_ListLowStockProducts(t *testing.T) {
+	createDate := time.Now()
+	updateDate := time.Now()
+	expiryDate := time.Now()
+
+	// given
+	ts := setupUserRepositoryTestSuite()
+	query := `SELECT p.id, .* FROM products p .* WHERE p.user_id = \? AND p.delete_date IS NULL AND p.reorder_point IS NOT NULL AND p.stock_quantity <= p.reorder_point AND p.id > 3 ORDER BY p.id LIMIT \?`
+	columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "romanized", "category_id", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "stock_quantity", "reorder_point", "reorder_quantity", "markdown_price", "version"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(4, createDate, updateDate, nil, 1, "ㅇㄷ", "wondu", 1, "payhere", 15000, 9000, "원두", "description", "8801000000043", expiryDate, 2, 5, 20, nil, 1)
+	ts.sqlMock.ExpectQuery(query).WithArgs(1, 20).WillReturnRows(rows)
+
+	// when
+	got, err := ts.productRepository.ListLowStockProducts(context.Background(), domain.ListLowStockProductsParams{
+		UserID: 1,
+		Cursor: pointer.Int(3),
+		Limit:  20,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, 2, got[0].StockQuantity)
+	assert.Equal(t, pointer.Int(5), got[0].ReorderPoint)
+	assert.Equal(t, 20, got[0].ReorderQuantity)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_productRepository_ListExpiringProducts(t *testing.T) {
+	createDate := time.Now()
+	updateDate := time.Now()
+	since := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	until := since.Add(72 * time.Hour)
+
+	// given
+	ts := setupUserRepositoryTestSuite()
+	query := `SELECT p.id, .* FROM products p .* WHERE p.user_id = \? AND p.delete_date IS NULL AND p.expiry_date < \? AND p.expiry_date >= '2024-03-01 00:00:00' AND p.category_id = 2 AND p.stock_quantity > 0 ORDER BY p.expiry_date, p.id LIMIT \?`
+	columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "romanized", "category_id", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "stock_quantity", "reorder_point", "reorder_quantity", "markdown_price", "version"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(7, createDate, updateDate, nil, 1, "ㅇㅇ", "uyu", 2, "payhere", 3000, 1500, "우유", "description", "8801000000074", since.Add(12*time.Hour), 3, nil, 0, nil, 1)
+	ts.sqlMock.ExpectQuery(query).WithArgs(1, until, 500).WillReturnRows(rows)
+
+	// when
+	got, err := ts.productRepository.ListExpiringProducts(context.Background(), domain.ListExpiringProductsParams{
+		UserID:     1,
+		CategoryID: pointer.Int(2),
+		Since:      &since,
+		Until:      until,
+		InStock:    true,
+		Limit:      500,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "우유", got[0].Name)
+	assert.Equal(t, 3, got[0].StockQuantity)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_productRepository_ClaimExpiryDigest(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		want     bool
+	}{
+		{name: "PASS - 오늘 처음 보내는 요약", affected: 1, want: true},
+		{name: "PASS - 다른 서버가 이미 보낸 요약", affected: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserRepositoryTestSuite()
+			ts.sqlMock.ExpectExec("INSERT IGNORE INTO expiry_digests").
+				WithArgs(1, "2024-03-01").
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			// when
+			got, err := ts.productRepository.ClaimExpiryDigest(context.Background(), 1, "2024-03-01")
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_productRepository_ListProductNames(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		userID int
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts productRepositoryTestSuite)
+		want    []domain.ProductName
+		wantErr bool
+	}{
+		{
+			name: "PASS - 상품명 목록 조회 성공",
+			args: args{
+				ctx:    context.Background(),
+				userID: 1,
+			},
+			mock: func(ts productRepositoryTestSuite) {
+				query := `SELECT id, name, initial, romanized FROM products`
+				columns := []string{"id", "name", "initial", "romanized"}
+				rows := sqlmock.NewRows(columns).AddRow(100, "슈크림 라떼", "ㅅㅋㄹ ㄹㄸ", "syukeurim ratte")
+				ts.sqlMock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+			},
+			want: []domain.ProductName{
+				{
+					ID:        100,
+					Name:      "슈크림 라떼",
+					Initial:   "ㅅㅋㄹ ㄹㄸ",
+					Romanized: "syukeurim ratte",
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.productRepository.ListProductNames(tt.args.ctx, tt.args.userID)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			}
+		})
+	}
+}
+
+func Test_productRepository_ListAllProductNamesAfter(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		cursor int
+		limit  int
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts productRepositoryTestSuite)
+		want    []domain.ProductName
+		wantErr bool
+	}{
+		{
+			name: "PASS - 커서 이후 상품명 조회 성공",
+			args: args{
+				ctx:    context.Background(),
+				cursor: 10,
+				limit:  100,
+			},
+			mock: func(ts productRepositoryTestSuite) {
+				query := `SELECT id, name, initial, romanized FROM products WHERE id > \? ORDER BY id LIMIT \?`
+				columns := []string{"id", "name", "initial", "romanized"}
+				rows := sqlmock.NewRows(columns).AddRow(11, "슈크림 라떼", "ㅅㅋㄹ ㄹㄸ", "")
+				ts.sqlMock.ExpectQuery(query).WithArgs(10, 100).WillReturnRows(rows)
+			},
+			want: []domain.ProductName{
+				{
+					ID:        11,
+					Name:      "슈크림 라떼",
+					Initial:   "ㅅㅋㄹ ㄹㄸ",
+					Romanized: "",
+				},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.productRepository.ListAllProductNamesAfter(tt.args.ctx, tt.args.cursor, tt.args.limit)
+
+			// then
+			assert.Equal(t, tt.want, got)
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			}
+		})
+	}
+}
+
+func Test_productRepository_UpdateProductRomanized(t *testing.T) {
+	type args struct {
+		ctx       context.Context
+		productID int
+		romanized string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts productRepositoryTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 로마자 표기 수정 성공",
+			args: args{
+				ctx:       context.Background(),
+				productID: 100,
+				romanized: "syukeurim ratte",
+			},
+			mock: func(ts productRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec("UPDATE products SET romanized").
+					WithArgs("syukeurim ratte", 100).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			err := ts.productRepository.UpdateProductRomanized(tt.args.ctx, tt.args.productID, tt.args.romanized)
+
+			// then
+			if ts.sqlMock.ExpectationsWereMet() != nil {
+				t.Errorf("there were unfulfilled expectations: %s", ts.sqlMock.ExpectationsWereMet())
+			}
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			}
+		})
+	}
+}
+
+func Test_productRepository_ListProductOptionGroups(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	columns := []string{"g.id", "g.product_id", "g.name", "g.select_type", "g.required", "g.min_select", "g.max_select", "g.display_order", "o.id", "o.name", "o.price_delta", "o.cost_delta", "o.display_order"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(1, 1, "사이즈", "single", true, 1, 1, 0, 1, "레귤러", 0, 0, 0).
+		AddRow(1, 1, "사이즈", "single", true, 1, 1, 0, 2, "라지", 500, 200, 1).
+		AddRow(2, 1, "샷 추가", "multi", false, 0, 2, 1, 3, "에스프레소 샷", 500, 300, 0).
+		AddRow(3, 2, "사이즈", "single", true, 1, 1, 0, 4, "레귤러", 0, 0, 0)
+	ts.sqlMock.ExpectQuery(`SELECT (.+) FROM product_option_groups g JOIN product_options o ON o.option_group_id = g.id WHERE g.product_id IN \(\?, \?\)`).
+		WithArgs(1, 2).
+		WillReturnRows(rows)
+
+	// when
+	got, err := ts.productRepository.ListProductOptionGroups(context.Background(), []int{1, 2})
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.ProductOptionGroup{
+		{
+			ID: 1, ProductID: 1, Name: "사이즈", SelectType: domain.ProductOptionSelectTypeSingle, Required: true, MinSelect: 1, MaxSelect: 1,
+			Options: []domain.ProductOption{
+				{ID: 1, OptionGroupID: 1, Name: "레귤러"},
+				{ID: 2, OptionGroupID: 1, Name: "라지", PriceDelta: 500, CostDelta: 200, DisplayOrder: 1},
+			},
+		},
+		{
+			ID: 2, ProductID: 1, Name: "샷 추가", SelectType: domain.ProductOptionSelectTypeMulti, MinSelect: 0, MaxSelect: 2, DisplayOrder: 1,
+			Options: []domain.ProductOption{
+				{ID: 3, OptionGroupID: 2, Name: "에스프레소 샷", PriceDelta: 500, CostDelta: 300},
+			},
+		},
+		{
+			ID: 3, ProductID: 2, Name: "사이즈", SelectType: domain.ProductOptionSelectTypeSingle, Required: true, MinSelect: 1, MaxSelect: 1,
+			Options: []domain.ProductOption{
+				{ID: 4, OptionGroupID: 3, Name: "레귤러"},
+			},
+		},
+	}, got)
+}
+
+func Test_productRepository_ReplaceProductOptionGroups(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	ts.sqlMock.ExpectBegin()
+	ts.sqlMock.ExpectExec("DELETE o FROM product_options o").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	ts.sqlMock.ExpectExec("DELETE FROM product_option_groups").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	ts.sqlMock.ExpectExec("INSERT INTO product_option_groups").
+		WithArgs(1, "온도", domain.ProductOptionSelectTypeSingle, true, 1, 1, 0).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	ts.sqlMock.ExpectExec("INSERT INTO product_options").
+		WithArgs(5, "ICE", float64(0), float64(0), 0).
+		WillReturnResult(sqlmock.NewResult(9, 1))
+	ts.sqlMock.ExpectCommit()
+
+	// when
+	err := ts.productRepository.ReplaceProductOptionGroups(context.Background(), 1, []domain.ProductOptionGroup{
+		{
+			Name:       "온도",
+			SelectType: domain.ProductOptionSelectTypeSingle,
+			Required:   true,
+			MinSelect:  1,
+			MaxSelect:  1,
+			Options:    []domain.ProductOption{{Name: "ICE"}},
+		},
+	})
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func Test_productRepository_ReplaceProductTags(t *testing.T) {
+	t.Run("PASS - 없는 태그는 만들고 겹치는 이름은 한 번만 붙인다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectExec(`DELETE FROM product_tags WHERE product_id = \?`).
+			WithArgs(100).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		ts.sqlMock.ExpectExec(`INSERT INTO tags \(user_id, name\) VALUES \(\?, \?\), \(\?, \?\) ON DUPLICATE KEY UPDATE`).
+			WithArgs(1, "비건", 1, "신메뉴").
+			WillReturnResult(sqlmock.NewResult(2, 1))
+		ts.sqlMock.ExpectQuery(`SELECT id FROM tags WHERE user_id = \? AND name IN \(\?, \?\)`).
+			WithArgs(1, "비건", "신메뉴").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+		ts.sqlMock.ExpectExec(`INSERT INTO product_tags \(product_id, tag_id\) VALUES \(\?, \?\), \(\?, \?\)`).
+			WithArgs(100, 1, 100, 2).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		ts.sqlMock.ExpectCommit()
+
+		// when
+		err := ts.productRepository.ReplaceProductTags(context.Background(), 1, 100, []string{"#비건", "신메뉴", "비건"})
+
+		// then
+		assert.NoError(t, err)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("PASS - 빈 목록이면 태그를 모두 뗀다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectExec(`DELETE FROM product_tags WHERE product_id = \?`).
+			WithArgs(100).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		ts.sqlMock.ExpectCommit()
+
+		// when
+		err := ts.productRepository.ReplaceProductTags(context.Background(), 1, 100, []string{})
+
+		// then
+		assert.NoError(t, err)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func Test_productRepository_ListProductTags(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	createDate := time.Now()
+	rows := sqlmock.NewRows([]string{"product_id", "id", "user_id", "name", "create_date"}).
+		AddRow(1, 2, 1, "비건", createDate).
+		AddRow(1, 1, 1, "신메뉴", createDate).
+		AddRow(2, 1, 1, "신메뉴", createDate)
+	ts.sqlMock.ExpectQuery(`SELECT (.+) FROM product_tags pt JOIN tags t (.+) WHERE pt.product_id IN \(\?, \?\)`).
+		WithArgs(1, 2).
+		WillReturnRows(rows)
+
+	// when
+	got, err := ts.productRepository.ListProductTags(context.Background(), []int{1, 2})
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.ProductTag{
+		{ProductID: 1, Tag: domain.Tag{ID: 2, UserID: 1, Name: "비건", CreateDate: createDate}},
+		{ProductID: 1, Tag: domain.Tag{ID: 1, UserID: 1, Name: "신메뉴", CreateDate: createDate}},
+		{ProductID: 2, Tag: domain.Tag{ID: 1, UserID: 1, Name: "신메뉴", CreateDate: createDate}},
+	}, got)
+}
+
+func Test_productRepository_CreateProductImage(t *testing.T) {
+	image := domain.ProductImage{ProductID: 1, StorageKey: "products/1/abc", ContentType: "image/jpeg", Width: 800, Height: 600}
+
+	t.Run("PASS - 첫 이미지는 대표 이미지가 된다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectQuery(`SELECT id FROM products WHERE id = \? FOR UPDATE`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		ts.sqlMock.ExpectQuery(`SELECT COUNT\(\*\), (.+) FROM product_images WHERE product_id = \?`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count", "next"}).AddRow(0, 0))
+		ts.sqlMock.ExpectExec("INSERT INTO product_images").
+			WithArgs(1, "products/1/abc", "image/jpeg", 800, 600, 0, true).
+			WillReturnResult(sqlmock.NewResult(10, 1))
+		ts.sqlMock.ExpectCommit()
+
+		// when
+		got, err := ts.productRepository.CreateProductImage(context.Background(), image)
+
+		// then
+		assert.NoError(t, err)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		assert.Equal(t, 10, got.ID)
+		assert.True(t, got.Primary)
+		assert.Equal(t, 0, got.DisplayOrder)
+	})
+
+	t.Run("PASS - 이미 이미지가 있으면 맨 뒤에 붙인다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectQuery(`SELECT id FROM products WHERE id = \? FOR UPDATE`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		ts.sqlMock.ExpectQuery(`SELECT COUNT\(\*\), (.+) FROM product_images WHERE product_id = \?`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count", "next"}).AddRow(2, 3))
+		ts.sqlMock.ExpectExec("INSERT INTO product_images").
+			WithArgs(1, "products/1/abc", "image/jpeg", 800, 600, 3, false).
+			WillReturnResult(sqlmock.NewResult(11, 1))
+		ts.sqlMock.ExpectCommit()
+
+		// when
+		got, err := ts.productRepository.CreateProductImage(context.Background(), image)
+
+		// then
+		assert.NoError(t, err)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+		assert.False(t, got.Primary)
+		assert.Equal(t, 3, got.DisplayOrder)
+	})
+
+	t.Run("FAIL - 저장 에러면 롤백한다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectQuery(`SELECT id FROM products WHERE id = \? FOR UPDATE`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		ts.sqlMock.ExpectQuery(`SELECT COUNT\(\*\), (.+) FROM product_images WHERE product_id = \?`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"count", "next"}).AddRow(0, 0))
+		ts.sqlMock.ExpectExec("INSERT INTO product_images").WillReturnError(errors.New("db"))
+		ts.sqlMock.ExpectRollback()
+
+		// when
+		_, err := ts.productRepository.CreateProductImage(context.Background(), image)
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Internal, err))
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func Test_productRepository_ListProductImages(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	createDate := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "product_id", "storage_key", "content_type", "width", "height", "display_order", "is_primary", "create_date"}).
+		AddRow(1, 1, "products/1/abc", "image/jpeg", 800, 600, 0, true, createDate).
+		AddRow(2, 2, "products/2/def", "image/png", 100, 100, 0, true, createDate)
+	ts.sqlMock.ExpectQuery(`SELECT (.+) FROM product_images WHERE product_id IN \(\?, \?\)`).
+		WithArgs(1, 2).
+		WillReturnRows(rows)
+
+	// when
+	got, err := ts.productRepository.ListProductImages(context.Background(), []int{1, 2})
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.ProductImage{
+		{ID: 1, ProductID: 1, StorageKey: "products/1/abc", ContentType: "image/jpeg", Width: 800, Height: 600, Primary: true, CreateDate: createDate},
+		{ID: 2, ProductID: 2, StorageKey: "products/2/def", ContentType: "image/png", Width: 100, Height: 100, Primary: true, CreateDate: createDate},
+	}, got)
+}
+
+func Test_productRepository_UpdateProductImages(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	ts.sqlMock.ExpectBegin()
+	ts.sqlMock.ExpectExec(`UPDATE product_images SET display_order = \?`).WithArgs(0, 2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	ts.sqlMock.ExpectExec(`UPDATE product_images SET display_order = \?`).WithArgs(1, 1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	ts.sqlMock.ExpectExec(`UPDATE product_images SET is_primary = \(id = \?\)`).WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 2))
+	ts.sqlMock.ExpectCommit()
+
+	// when
+	err := ts.productRepository.UpdateProductImages(context.Background(), 1, []int{2, 1}, pointer.Int(2))
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_productRepository_DeleteProductImage(t *testing.T) {
+	t.Run("PASS - 대표 이미지를 지우면 맨 앞의 이미지를 대표 이미지로 바꾼다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectExec("DELETE FROM product_images").WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		ts.sqlMock.ExpectExec(`UPDATE product_images SET is_primary = TRUE`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		ts.sqlMock.ExpectCommit()
+
+		// when
+		err := ts.productRepository.DeleteProductImage(context.Background(), domain.ProductImage{ID: 1, ProductID: 1, Primary: true})
+
+		// then
+		assert.NoError(t, err)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("PASS - 대표 이미지가 아니면 지우기만 한다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectExec("DELETE FROM product_images").WithArgs(2, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		ts.sqlMock.ExpectCommit()
+
+		// when
+		err := ts.productRepository.DeleteProductImage(context.Background(), domain.ProductImage{ID: 2, ProductID: 1})
+
+		// then
+		assert.NoError(t, err)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func Test_productRepository_CreatePriceHistory(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	createDate := time.Date(2024, time.February, 28, 9, 0, 0, 0, time.UTC)
+	ts.sqlMock.ExpectExec("INSERT INTO product_price_history").
+		WithArgs(100, 1, domain.PriceChangeReasonManual, 4500.0, 5000.0, 1500.0, 1800.0, 4500.0, 5000.0, createDate).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// when
+	err := ts.productRepository.CreatePriceHistory(context.Background(), domain.PriceHistory{
+		ProductID:         100,
+		UserID:            pointer.Int(1),
+		Reason:            domain.PriceChangeReasonManual,
+		OldPrice:          4500,
+		NewPrice:          5000,
+		OldCost:           1500,
+		NewCost:           1800,
+		OldEffectivePrice: 4500,
+		NewEffectivePrice: 5000,
+		CreateDate:        createDate,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_productRepository_ListPriceHistory(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	since := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	createDate := since.Add(time.Hour)
+	rows := sqlmock.NewRows([]string{"id", "product_id", "user_id", "reason", "old_price", "new_price", "old_cost", "new_cost", "old_effective_price", "new_effective_price", "create_date"}).
+		AddRow(1, 100, 1, "manual", 4500, 5000, 1500, 1800, 4500, 5000, createDate).
+		AddRow(2, 100, nil, "markdown", 5000, 5000, 1800, 1800, 5000, 4000, createDate)
+	ts.sqlMock.ExpectQuery(`SELECT (.+) FROM product_price_history WHERE product_id = \? AND create_date >= \? ORDER BY create_date, id`).
+		WithArgs(100, since).
+		WillReturnRows(rows)
+
+	// when
+	got, err := ts.productRepository.ListPriceHistory(context.Background(), 100, since)
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PriceHistory{
+		{ID: 1, ProductID: 100, UserID: pointer.Int(1), Reason: domain.PriceChangeReasonManual, OldPrice: 4500, NewPrice: 5000, OldCost: 1500, NewCost: 1800, OldEffectivePrice: 4500, NewEffectivePrice: 5000, CreateDate: createDate},
+		{ID: 2, ProductID: 100, Reason: domain.PriceChangeReasonMarkdown, OldPrice: 5000, NewPrice: 5000, OldCost: 1800, NewCost: 1800, OldEffectivePrice: 5000, NewEffectivePrice: 4000, CreateDate: createDate},
+	}, got)
+}
+
+func Test_productRepository_GetDeletedProduct(t *testing.T) {
+	createDate := time.Now()
+	deleteDate := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	expiryDate := time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC)
+	columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "romanized", "category_id", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "stock_quantity", "reorder_point", "reorder_quantity", "markdown_price", "version"}
+
+	t.Run("PASS - 휴지통에 있는 상품 조회", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		rows := sqlmock.NewRows(columns).AddRow(100, createDate, deleteDate, deleteDate, 1, "ㅅㅋㄹ ㄹㄸ", "syukeurim ratte", 1, "payhere", 1000, 500, "슈크림 라떼", "description", "barcode", expiryDate, 0, nil, 0, nil, 1)
+		ts.sqlMock.ExpectQuery(`SELECT p.id, .* WHERE p.delete_date IS NOT NULL AND p.id = \?`).WithArgs(100).WillReturnRows(rows)
+
+		// when
+		got, err := ts.productRepository.GetDeletedProduct(context.Background(), 100)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 100, got.ID)
+		assert.Equal(t, sql.NullTime{Time: deleteDate, Valid: true}, got.DeleteDate)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("PASS - 휴지통에 없으면 nil", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectQuery(`SELECT p.id, .* WHERE p.delete_date IS NOT NULL AND p.id = \?`).WithArgs(100).WillReturnRows(sqlmock.NewRows(columns))
+
+		// when
+		got, err := ts.productRepository.GetDeletedProduct(context.Background(), 100)
+
+		// then
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func Test_productRepository_ListDeletedProducts(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	createDate := time.Now()
+	deleteDate := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	expiryDate := time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC)
+	columns := []string{"id", "create_date", "update_date", "delete_date", "user_id", "initial", "romanized", "category_id", "category", "price", "cost", "name", "description", "barcode", "expiry_date", "stock_quantity", "reorder_point", "reorder_quantity", "markdown_price", "version"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(11, createDate, deleteDate, deleteDate, 1, "ㅇㅁㄹㅋㄴ", "amerikano", 1, "payhere", 3000, 1500, "아메리카노", "description", "8801000000012", expiryDate, 0, nil, 0, nil, 1)
+	ts.sqlMock.ExpectQuery(`SELECT p.id, .* WHERE p.user_id = \? AND p.delete_date IS NOT NULL AND p.id > 10 ORDER BY p.id LIMIT 10`).WithArgs(1).WillReturnRows(rows)
+
+	// when
+	got, err := ts.productRepository.ListDeletedProducts(context.Background(), 1, pointer.Int(10))
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "아메리카노", got[0].Name)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_productRepository_ListPurgeableProductIDs(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	deletedBefore := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	ts.sqlMock.ExpectQuery(`SELECT id FROM products WHERE delete_date IS NOT NULL AND delete_date < \? ORDER BY delete_date, id LIMIT \?`).
+		WithArgs(deletedBefore, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(1))
+
+	// when
+	got, err := ts.productRepository.ListPurgeableProductIDs(context.Background(), deletedBefore, 100)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 1}, got)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_productRepository_RestoreProduct(t *testing.T) {
+	tests := []struct {
+		name     string
+		mock     func(ts productRepositoryTestSuite)
+		want     bool
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 상품 복원",
+			mock: func(ts productRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE products SET delete_date = NULL, version = version \+ 1 WHERE id = \? AND delete_date IS NOT NULL`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			want: true,
+		},
+		{
+			name: "PASS - 이미 복원했거나 완전히 지운 상품",
+			mock: func(ts productRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE products SET delete_date = NULL`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			want: false,
+		},
+		{
+			name: "FAIL - 같은 바코드의 상품이 있음",
+			mock: func(ts productRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec(`UPDATE products SET delete_date = NULL`).WithArgs(1).WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+			},
+			wantKind: cerrors.Exist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.productRepository.RestoreProduct(context.Background(), 1)
+
+			// then
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+			assert.Equal(t, tt.want, got)
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_productRepository_PurgeProduct(t *testing.T) {
+	t.Run("PASS - 상품을 참조하는 행을 먼저 지우고 폐기 기록은 연결만 끊는다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectQuery(`SELECT id FROM products WHERE id = \? AND delete_date IS NOT NULL FOR UPDATE`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		ts.sqlMock.ExpectExec(`DELETE o FROM product_options o`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+		ts.sqlMock.ExpectExec(`DELETE FROM product_option_groups`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		ts.sqlMock.ExpectExec(`DELETE FROM product_images`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		ts.sqlMock.ExpectExec(`UPDATE disposals SET product_id = NULL, movement_id = NULL WHERE product_id = \?`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		ts.sqlMock.ExpectExec(`DELETE FROM stock_movements`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 3))
+		ts.sqlMock.ExpectExec(`DELETE FROM stock_lots`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		ts.sqlMock.ExpectExec(`DELETE FROM low_stock_alerts`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+		ts.sqlMock.ExpectExec(`DELETE FROM markdown_rules`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+		ts.sqlMock.ExpectExec(`DELETE FROM product_price_history`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+		ts.sqlMock.ExpectExec(`DELETE FROM product_tags`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		ts.sqlMock.ExpectExec(`DELETE FROM scheduled_price_changes`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+		ts.sqlMock.ExpectExec(`DELETE FROM products WHERE id = \?`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+		ts.sqlMock.ExpectCommit()
+
+		// when
+		got, err := ts.productRepository.PurgeProduct(context.Background(), 1)
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, got)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("PASS - 휴지통에 없으면 아무것도 지우지 않는다", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectQuery(`SELECT id FROM products WHERE id = \? AND delete_date IS NOT NULL FOR UPDATE`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		ts.sqlMock.ExpectRollback()
+
+		// when
+		got, err := ts.productRepository.PurgeProduct(context.Background(), 1)
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, got)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("FAIL - 중간에 실패하면 롤백", func(t *testing.T) {
+		// given
+		ts := setupUserRepositoryTestSuite()
+		ts.sqlMock.ExpectBegin()
+		ts.sqlMock.ExpectQuery(`SELECT id FROM products`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		ts.sqlMock.ExpectExec(`DELETE o FROM product_options o`).WithArgs(1).WillReturnError(errors.New("lock wait timeout"))
+		ts.sqlMock.ExpectRollback()
+
+		// when
+		got, err := ts.productRepository.PurgeProduct(context.Background(), 1)
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Internal, err))
+		assert.False(t, got)
+		assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	})
+}
+
+func Test_productRepository_CreateProductTemplate(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	ts.sqlMock.ExpectExec("INSERT INTO product_templates").
+		WithArgs(1, "라떼 기본", 1, 4500.0, 1500.0, "슈크림 라떼", "description", 5, 20, `[{"name":"온도","selectType":"single","required":true,"minSelect":0,"maxSelect":0,"options":[{"name":"HOT","priceDelta":0,"costDelta":0}]}]`).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+
+	// when
+	got, err := ts.productRepository.CreateProductTemplate(context.Background(), domain.ProductTemplate{
+		UserID:          1,
+		Name:            "라떼 기본",
+		CategoryID:      1,
+		Price:           4500,
+		Cost:            1500,
+		ProductName:     "슈크림 라떼",
+		Description:     "description",
+		ReorderPoint:    pointer.Int(5),
+		ReorderQuantity: 20,
+		OptionGroups: []domain.ProductOptionGroupRequest{
+			{Name: "온도", SelectType: domain.ProductOptionSelectTypeSingle, Required: true, Options: []domain.ProductOptionRequest{{Name: "HOT"}}},
+		},
+	})
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, got)
+}
+
+func Test_productRepository_GetProductTemplate(t *testing.T) {
+	createDate := time.Date(2024, time.February, 28, 9, 0, 0, 0, time.UTC)
+	columns := []string{"id", "user_id", "name", "category_id", "price", "cost", "product_name", "description", "reorder_point", "reorder_quantity", "option_groups", "create_date"}
+
+	tests := []struct {
+		name string
+		rows *sqlmock.Rows
+		want *domain.ProductTemplate
+	}{
+		{
+			name: "PASS - 템플릿 조회",
+			rows: sqlmock.NewRows(columns).AddRow(3, 1, "라떼 기본", 1, 4500, 1500, "슈크림 라떼", "description", nil, 0, `[{"name":"온도","selectType":"single","required":true,"options":[{"name":"HOT","priceDelta":0}]}]`, createDate),
+			want: &domain.ProductTemplate{
+				ID:          3,
+				UserID:      1,
+				Name:        "라떼 기본",
+				CategoryID:  1,
+				Price:       4500,
+				Cost:        1500,
+				ProductName: "슈크림 라떼",
+				Description: "description",
+				OptionGroups: []domain.ProductOptionGroupRequest{
+					{Name: "온도", SelectType: domain.ProductOptionSelectTypeSingle, Required: true, Options: []domain.ProductOptionRequest{{Name: "HOT"}}},
+				},
+				CreateDate: createDate,
+			},
+		},
+		{
+			name: "PASS - 없는 템플릿",
+			rows: sqlmock.NewRows(columns),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// given
+			ts := setupUserRepositoryTestSuite()
+			ts.sqlMock.ExpectQuery(`SELECT (.+) FROM product_templates WHERE id = \?`).WithArgs(3).WillReturnRows(test.rows)
+
+			// when
+			got, err := ts.productRepository.GetProductTemplate(context.Background(), 3)
+
+			// then
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func Test_productRepository_ListProductTemplates(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	createDate := time.Date(2024, time.February, 28, 9, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "user_id", "name", "category_id", "price", "cost", "product_name", "description", "reorder_point", "reorder_quantity", "option_groups", "create_date"}).
+		AddRow(4, 1, "아메리카노", 1, 3000, 800, "아메리카노", "description", 5, 20, `[]`, createDate).
+		AddRow(3, 1, "라떼 기본", 1, 4500, 1500, "슈크림 라떼", "description", nil, 0, `null`, createDate)
+	ts.sqlMock.ExpectQuery(`SELECT (.+) FROM product_templates WHERE user_id = \? ORDER BY name, id`).WithArgs(1).WillReturnRows(rows)
+
+	// when
+	got, err := ts.productRepository.ListProductTemplates(context.Background(), 1)
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.ProductTemplate{
+		{ID: 4, UserID: 1, Name: "아메리카노", CategoryID: 1, Price: 3000, Cost: 800, ProductName: "아메리카노", Description: "description", ReorderPoint: pointer.Int(5), ReorderQuantity: 20, OptionGroups: []domain.ProductOptionGroupRequest{}, CreateDate: createDate},
+		{ID: 3, UserID: 1, Name: "라떼 기본", CategoryID: 1, Price: 4500, Cost: 1500, ProductName: "슈크림 라떼", Description: "description", CreateDate: createDate},
+	}, got)
+}
+
+func Test_productRepository_DeleteProductTemplate(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	ts.sqlMock.ExpectExec(`DELETE FROM product_templates WHERE id = \?`).WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// when
+	err := ts.productRepository.DeleteProductTemplate(context.Background(), 3)
+
+	// then
+	assert.NoError(t, err)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_productRepository_CreateScheduledPriceChange(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	effectiveDate := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	ts.sqlMock.ExpectExec(`INSERT INTO scheduled_price_changes \(product_id, user_id, price, cost, effective_date\)`).
+		WithArgs(3, 1, 5000.0, nil, effectiveDate).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+
+	// when
+	price := float64(5000)
+	got, err := ts.productRepository.CreateScheduledPriceChange(context.Background(), domain.ScheduledPriceChange{
+		ProductID:     3,
+		UserID:        1,
+		Price:         &price,
+		EffectiveDate: effectiveDate,
+	})
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, 7, got)
+}
+
+func Test_productRepository_GetScheduledPriceChange(t *testing.T) {
+	effectiveDate := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	createDate := time.Date(2024, time.February, 28, 9, 0, 0, 0, time.UTC)
+	columns := []string{"id", "product_id", "name", "user_id", "price", "cost", "effective_date", "apply_date", "cancel_date", "create_date"}
+	price := float64(5000)
+
+	tests := []struct {
+		name string
+		rows *sqlmock.Rows
+		want *domain.ScheduledPriceChange
+	}{
+		{
+			name: "PASS - 적용한 예약도 조회",
+			rows: sqlmock.NewRows(columns).AddRow(7, 3, "슈크림 라떼", 1, 5000, nil, effectiveDate, effectiveDate, nil, createDate),
+			want: &domain.ScheduledPriceChange{
+				ID:            7,
+				ProductID:     3,
+				ProductName:   "슈크림 라떼",
+				UserID:        1,
+				Price:         &price,
+				EffectiveDate: effectiveDate,
+				ApplyDate:     sql.NullTime{Time: effectiveDate, Valid: true},
+				CreateDate:    createDate,
+			},
+		},
+		{
+			name: "PASS - 없는 예약",
+			rows: sqlmock.NewRows(columns),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// given
+			ts := setupUserRepositoryTestSuite()
+			ts.sqlMock.ExpectQuery(`SELECT (.+) FROM scheduled_price_changes s JOIN products p (.+) WHERE s.id = \?`).WithArgs(7).WillReturnRows(test.rows)
+
+			// when
+			got, err := ts.productRepository.GetScheduledPriceChange(context.Background(), 7)
+
+			// then
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func Test_productRepository_ListScheduledPriceChanges(t *testing.T) {
+	effectiveDate := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	createDate := time.Date(2024, time.February, 28, 9, 0, 0, 0, time.UTC)
+	columns := []string{"id", "product_id", "name", "user_id", "price", "cost", "effective_date", "apply_date", "cancel_date", "create_date"}
+
+	tests := []struct {
+		name   string
+		params domain.ListScheduledPriceChangesParams
+		query  string
+	}{
+		{
+			name:   "PASS - 모든 상품의 예약",
+			params: domain.ListScheduledPriceChangesParams{UserID: 1},
+			query:  `WHERE p.user_id = \? AND s.apply_date IS NULL AND s.cancel_date IS NULL\s+ORDER BY s.effective_date, s.id`,
+		},
+		{
+			name:   "PASS - 상품 하나의 예약",
+			params: domain.ListScheduledPriceChangesParams{UserID: 1, ProductID: pointer.Int(3)},
+			query:  `WHERE p.user_id = \? AND s.apply_date IS NULL AND s.cancel_date IS NULL AND s.product_id = 3\s+ORDER BY s.effective_date, s.id`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// given
+			ts := setupUserRepositoryTestSuite()
+			rows := sqlmock.NewRows(columns).AddRow(7, 3, "슈크림 라떼", 1, nil, 1800, effectiveDate, nil, nil, createDate)
+			ts.sqlMock.ExpectQuery(test.query).WithArgs(1).WillReturnRows(rows)
+
+			// when
+			got, err := ts.productRepository.ListScheduledPriceChanges(context.Background(), test.params)
+
+			// then
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+			assert.NoError(t, err)
+			cost := float64(1800)
+			assert.Equal(t, []domain.ScheduledPriceChange{
+				{ID: 7, ProductID: 3, ProductName: "슈크림 라떼", UserID: 1, Cost: &cost, EffectiveDate: effectiveDate, CreateDate: createDate},
+			}, got)
+		})
+	}
+}
+
+func Test_productRepository_ListDueScheduledPriceChanges(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	now := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "product_id", "name", "user_id", "price", "cost", "effective_date", "apply_date", "cancel_date", "create_date"})
+	ts.sqlMock.ExpectQuery(`WHERE s.effective_date <= \? AND s.apply_date IS NULL AND s.cancel_date IS NULL\s+ORDER BY s.effective_date, s.id\s+LIMIT \?`).
+		WithArgs(now, 100).
+		WillReturnRows(rows)
+
+	// when
+	got, err := ts.productRepository.ListDueScheduledPriceChanges(context.Background(), now, 100)
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func Test_productRepository_ClaimScheduledPriceChange(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		affected int64
+		want     bool
+	}{
+		{name: "PASS - 적용 시각을 기록", affected: 1, want: true},
+		{name: "PASS - 다른 서버가 먼저 적용함", affected: 0, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// given
+			ts := setupUserRepositoryTestSuite()
+			ts.sqlMock.ExpectExec(`UPDATE scheduled_price_changes SET apply_date = \? WHERE id = \? AND apply_date IS NULL AND cancel_date IS NULL`).
+				WithArgs(now, 7).
+				WillReturnResult(sqlmock.NewResult(0, test.affected))
+
+			// when
+			got, err := ts.productRepository.ClaimScheduledPriceChange(context.Background(), 7, now)
+
+			// then
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func Test_productRepository_CancelScheduledPriceChange(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	now := time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)
+	ts.sqlMock.ExpectExec(`UPDATE scheduled_price_changes SET cancel_date = \? WHERE id = \? AND apply_date IS NULL AND cancel_date IS NULL`).
+		WithArgs(now, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// when
+	got, err := ts.productRepository.CancelScheduledPriceChange(context.Background(), 7, now)
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.True(t, got)
+}
@@ -3,22 +3,42 @@ package product
 import (
 	"context"
 	"fmt"
+	"io"
+	"log"
+	"payhere/config"
 	"payhere/domain"
+	"payhere/pkg/barcode"
 	cerrors "payhere/pkg/cerrors"
+	"reflect"
+	"slices"
+	"time"
 )
 
 type productService struct {
-	userRepository    domain.UserRepository
-	productRepository domain.ProductRepository
+	userRepository     domain.UserRepository
+	productRepository  domain.ProductRepository
+	categoryRepository domain.CategoryRepository
+	imageStorage       domain.ImageStorage
+	suggester          *productSuggester
+	labelFont          *labelFont
+	cfg                *config.Config
 }
 
 func NewProductService(
 	userRepository domain.UserRepository,
 	productRepository domain.ProductRepository,
+	categoryRepository domain.CategoryRepository,
+	imageStorage domain.ImageStorage,
+	cfg *config.Config,
 ) *productService {
 	return &productService{
-		userRepository:    userRepository,
-		productRepository: productRepository,
+		userRepository:     userRepository,
+		productRepository:  productRepository,
+		categoryRepository: categoryRepository,
+		imageStorage:       imageStorage,
+		suggester:          newProductSuggester(),
+		labelFont:          newLabelFont(cfg.Label.FontPath),
+		cfg:                cfg,
 	}
 }
 
@@ -32,27 +52,241 @@ const (
 )
 
 func (ps productService) CreateProduct(ctx context.Context, req domain.CreateProductRequest) error {
+	product, err := ps.createProduct(ctx, ps.productRepository, req)
+	if err != nil {
+		return err
+	}
+
+	ps.suggester.upsert(product.UserID, productNameFrom(product))
+
+	return nil
+}
+
+// createProduct
+// 자동완성 인덱스는 바꾸지 않는다. 트랜잭션 안에서 부르면 커밋한 다음에 호출한 쪽에서 바꾼다.
+// 템플릿으로 시작하면 템플릿 값을 채운 뒤 상품 생성 검사를 다시 한다.
+func (ps productService) createProduct(ctx context.Context, repository domain.ProductRepository, req domain.CreateProductRequest) (domain.Product, error) {
 	const op cerrors.Op = "product/service/CreateProduct"
 
-	_, err := ps.productRepository.CreateProduct(ctx, domain.Product{
-		UserID:      req.UserID,
-		Initial:     extractChosung(req.Name),
-		Category:    req.Category,
-		Price:       req.Price,
-		Cost:        req.Cost,
-		Name:        req.Name,
-		Description: req.Description,
-		Barcode:     req.Barcode,
-		ExpiryDate:  req.ExpiryDate,
-		Size:        req.Size,
+	if req.TemplateID != nil {
+		template, err := getOwnProductTemplate(ctx, repository, req.UserID, *req.TemplateID)
+		if err != nil {
+			return domain.Product{}, err
+		}
+		req = template.Apply(req)
+		if err := req.Validate(); err != nil {
+			return domain.Product{}, err
+		}
+	}
+
+	if err := ps.checkCategory(ctx, req.UserID, req.CategoryID); err != nil {
+		return domain.Product{}, err
+	}
+	if err := checkDuplicateBarcode(ctx, repository, req.UserID, req.Barcode, 0); err != nil {
+		return domain.Product{}, err
+	}
+
+	product := productFrom(req)
+	productID, err := repository.CreateProduct(ctx, product)
+	if cerrors.Is(cerrors.Exist, err) {
+		return domain.Product{}, err
+	}
+	if err != nil {
+		return domain.Product{}, cerrors.E(op, cerrors.Internal, err, "상품을 생성하는 중에 에러가 발생했습니다.")
+	}
+	product.ID = productID
+
+	return product, nil
+}
+
+// ImportProducts
+// 행마다 CreateProduct 와 같은 검사를 하고 통과한 행만 한 트랜잭션으로 만든다. 실패한 행은 이유와 함께 응답에 담는다.
+// 파일 안에서 바코드가 겹치면 먼저 나온 행만 받는다.
+func (ps productService) ImportProducts(ctx context.Context, req domain.ImportProductsRequest) (domain.ImportProductsResponse, error) {
+	const op cerrors.Op = "product/service/ImportProducts"
+
+	rows, err := parseProductImport(req.Format(), req.File)
+	if err != nil {
+		return domain.ImportProductsResponse{}, err
+	}
+	if len(rows) == 0 {
+		return domain.ImportProductsResponse{}, cerrors.E(op, cerrors.Invalid, "가져올 상품이 없습니다.")
+	}
+	if len(rows) > domain.MaxProductImportRows {
+		return domain.ImportProductsResponse{}, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("상품은 한 번에 %d개까지 가져올 수 있습니다.", domain.MaxProductImportRows))
+	}
+
+	res := domain.ImportProductsResponse{
+		DryRun: req.DryRun,
+		Total:  len(rows),
+		Rows:   make([]domain.ImportProductRowDTO, 0, len(rows)),
+	}
+	checkedCategories := make(map[int]error)
+	barcodeRows := make(map[string]int)
+
+	var products []domain.Product
+	var accepted []int
+	for _, row := range rows {
+		row.Req.UserID = req.UserID
+		rowDTO := domain.ImportProductRowDTO{
+			Row:     row.Line,
+			Name:    row.Req.Name,
+			Barcode: row.Req.Barcode,
+			Status:  domain.ImportProductRowStatusAccepted,
+		}
+
+		if err := ps.checkImportRow(ctx, row, checkedCategories, barcodeRows); err != nil {
+			if cerrors.Is(cerrors.Internal, err) {
+				return domain.ImportProductsResponse{}, err
+			}
+			_, apiErr := cerrors.ToSentinelAPIError(err)
+			rowDTO.Status = domain.ImportProductRowStatusFailed
+			rowDTO.Error = apiErr.Meta.Message
+			res.Failed++
+		} else {
+			barcodeRows[row.Req.Barcode] = row.Line
+			products = append(products, productFrom(row.Req))
+			accepted = append(accepted, len(res.Rows))
+			res.Accepted++
+		}
+
+		res.Rows = append(res.Rows, rowDTO)
+	}
+
+	if req.DryRun || len(products) == 0 {
+		return res, nil
+	}
+
+	productIDs, err := ps.productRepository.CreateProducts(ctx, products)
+	if cerrors.Is(cerrors.Exist, err) {
+		return domain.ImportProductsResponse{}, err
+	}
+	if err != nil {
+		return domain.ImportProductsResponse{}, cerrors.E(op, cerrors.Internal, err, "상품을 가져오는 중에 에러가 발생했습니다.")
+	}
+
+	for i, productID := range productIDs {
+		productID := productID
+		res.Rows[accepted[i]].ProductID = &productID
+		products[i].ID = productID
+		ps.suggester.upsert(req.UserID, productNameFrom(products[i]))
+	}
+
+	return res, nil
+}
+
+const exportBatchSize = 100
+
+// ExportProducts
+// 상품을 exportBatchSize 개씩 옵션 그룹을 붙여 w 에 바로 쓴다. 처음 쓰기 전에 실패하면 w 에는 아무것도 쓰지 않는다.
+func (ps productService) ExportProducts(ctx context.Context, req domain.ExportProductsRequest, w io.Writer) error {
+	const op cerrors.Op = "product/service/ExportProducts"
+
+	exporter := newProductExporter(req.FormatOrDefault(), w)
+	params := ListProductsParamsFrom(domain.ListProductsRequest{
+		UserID:     req.UserID,
+		Search:     req.Search,
+		CategoryID: req.CategoryID,
+		TagIDs:     req.TagIDs,
+		TagMatch:   req.TagMatch,
+	})
+
+	batch := make([]domain.Product, 0, exportBatchSize)
+	flush := func() error {
+		if err := ps.attachOptionGroups(ctx, batch); err != nil {
+			return err
+		}
+		if err := exporter.write(batch); err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "상품을 내보내는 중에 에러가 발생했습니다.")
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	err := ps.productRepository.EachProduct(ctx, params, func(product domain.Product) error {
+		batch = append(batch, product)
+		if len(batch) < exportBatchSize {
+			return nil
+		}
+		return flush()
 	})
 	if err != nil {
-		return cerrors.E(op, cerrors.Internal, err, "상품을 생성하는 중에 에러가 발생했습니다.")
+		if cerrors.Is(cerrors.Internal, err) {
+			return err
+		}
+		return cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	if len(batch) > 0 {
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+	if err := exporter.close(); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "상품을 내보내는 중에 에러가 발생했습니다.")
 	}
 
 	return nil
 }
 
+// checkImportRow
+// 카테고리 확인 결과는 같은 파일 안에서 다시 조회하지 않도록 checkedCategories 에 담아둔다.
+func (ps productService) checkImportRow(ctx context.Context, row importRow, checkedCategories map[int]error, barcodeRows map[string]int) error {
+	const op cerrors.Op = "product/service/checkImportRow"
+
+	if row.Err != nil {
+		return row.Err
+	}
+	if err := row.Req.Validate(); err != nil {
+		return err
+	}
+
+	err, ok := checkedCategories[row.Req.CategoryID]
+	if !ok {
+		err = ps.checkCategory(ctx, row.Req.UserID, row.Req.CategoryID)
+		checkedCategories[row.Req.CategoryID] = err
+	}
+	if err != nil {
+		return err
+	}
+
+	if line, ok := barcodeRows[row.Req.Barcode]; ok {
+		return cerrors.E(op, cerrors.Exist, fmt.Sprintf("%d행과 바코드가 같습니다.", line))
+	}
+
+	return checkDuplicateBarcode(ctx, ps.productRepository, row.Req.UserID, row.Req.Barcode, 0)
+}
+
+// productFrom
+// 상품 생성 요청을 검색용 초성, 로마자와 함께 상품으로 변환한다.
+func productFrom(req domain.CreateProductRequest) domain.Product {
+	return domain.Product{
+		UserID:          req.UserID,
+		Initial:         extractChosung(req.Name),
+		Romanized:       romanize(req.Name),
+		CategoryID:      req.CategoryID,
+		Price:           req.Price,
+		Cost:            req.Cost,
+		Name:            req.Name,
+		Description:     req.Description,
+		Barcode:         req.Barcode,
+		ExpiryDate:      req.ExpiryDate,
+		ReorderPoint:    req.ReorderPoint,
+		ReorderQuantity: req.ReorderQuantity,
+		OptionGroups:    domain.ProductOptionGroupsFrom(req.OptionGroups),
+		Tags:            domain.TagsFrom(req.Tags),
+	}
+}
+
+func productNameFrom(product domain.Product) domain.ProductName {
+	return domain.ProductName{
+		ID:        product.ID,
+		Name:      product.Name,
+		Initial:   product.Initial,
+		Romanized: product.Romanized,
+	}
+}
+
 func (ps productService) GetProduct(ctx context.Context, req domain.GetProductRequest) (domain.GetProductResponse, error) {
 	const op cerrors.Op = "product/service/GetProduct"
 
@@ -68,30 +302,150 @@ func (ps productService) GetProduct(ctx context.Context, req domain.GetProductRe
 		return domain.GetProductResponse{}, cerrors.E(op, cerrors.Permission, "상품을 조회할 권한이 없습니다.")
 	}
 
+	products := []domain.Product{*product}
+	if err := ps.attachOptionGroups(ctx, products); err != nil {
+		return domain.GetProductResponse{}, err
+	}
+	if err := ps.attachImages(ctx, products); err != nil {
+		return domain.GetProductResponse{}, err
+	}
+	if err := ps.attachTags(ctx, products); err != nil {
+		return domain.GetProductResponse{}, err
+	}
+
+	return domain.GetProductResponse{
+		Product: domain.ProductDTOFrom(products[0]),
+	}, nil
+}
+
+// GetProductByBarcode
+// 스캐너로 읽은 바코드로 삭제되지 않은 자신의 상품을 조회한다.
+func (ps productService) GetProductByBarcode(ctx context.Context, req domain.GetProductByBarcodeRequest) (domain.GetProductResponse, error) {
+	const op cerrors.Op = "product/service/GetProductByBarcode"
+
+	product, err := ps.productRepository.GetProductByBarcode(ctx, req.UserID, req.Barcode)
+	if err != nil {
+		return domain.GetProductResponse{}, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if product == nil {
+		return domain.GetProductResponse{}, cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
+	}
+
+	products := []domain.Product{*product}
+	if err := ps.attachOptionGroups(ctx, products); err != nil {
+		return domain.GetProductResponse{}, err
+	}
+	if err := ps.attachImages(ctx, products); err != nil {
+		return domain.GetProductResponse{}, err
+	}
+	if err := ps.attachTags(ctx, products); err != nil {
+		return domain.GetProductResponse{}, err
+	}
+
 	return domain.GetProductResponse{
-		Product: domain.ProductDTOFrom(*product),
+		Product: domain.ProductDTOFrom(products[0]),
 	}, nil
 }
 
+// GetProductBarcodeImage
+// 상품에 저장된 바코드를 선반 라벨에 붙일 수 있는 이미지로 그린다.
+func (ps productService) GetProductBarcodeImage(ctx context.Context, req domain.GetProductBarcodeImageRequest) (domain.BarcodeImage, error) {
+	const op cerrors.Op = "product/service/GetProductBarcodeImage"
+
+	product, err := ps.productRepository.GetProduct(ctx, req.ProductID)
+	if err != nil {
+		return domain.BarcodeImage{}, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if product == nil {
+		return domain.BarcodeImage{}, cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
+	}
+	if product.UserID != req.UserID {
+		return domain.BarcodeImage{}, cerrors.E(op, cerrors.Permission, "상품을 조회할 권한이 없습니다.")
+	}
+	if product.Barcode == "" {
+		return domain.BarcodeImage{}, cerrors.E(op, cerrors.NotExist, "상품에 등록된 바코드가 없습니다.")
+	}
+
+	code, err := encodeBarcode(product.Barcode, req.SymbologyOrDefault())
+	if err != nil {
+		return domain.BarcodeImage{}, err
+	}
+
+	return renderBarcodeImage(code, req.Format, req.WidthOrDefault(), req.HeightOrDefault(), product.UpdateDate)
+}
+
+// GetProductQRCode
+// 상품 URL 을 담은 QR 코드를 그린다. 휴대폰으로 찍으면 바로 상품 정보로 이동할 수 있다.
+func (ps productService) GetProductQRCode(ctx context.Context, req domain.GetProductQRCodeRequest) (domain.BarcodeImage, error) {
+	const op cerrors.Op = "product/service/GetProductQRCode"
+
+	product, err := ps.productRepository.GetProduct(ctx, req.ProductID)
+	if err != nil {
+		return domain.BarcodeImage{}, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if product == nil {
+		return domain.BarcodeImage{}, cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
+	}
+	if product.UserID != req.UserID {
+		return domain.BarcodeImage{}, cerrors.E(op, cerrors.Permission, "상품을 조회할 권한이 없습니다.")
+	}
+
+	code, err := barcode.QR(productURL(ps.cfg.App.PublicURL, product.ID))
+	if err != nil {
+		return domain.BarcodeImage{}, cerrors.E(op, cerrors.Internal, err, "QR 코드를 만드는 중에 에러가 발생했습니다.")
+	}
+
+	return renderBarcodeImage(code, req.Format, req.SizeOrDefault(), req.SizeOrDefault(), product.UpdateDate)
+}
+
+// PatchProduct
+// 상품 수정과 가격 변경 기록을 한 트랜잭션으로 저장한다.
 func (ps productService) PatchProduct(ctx context.Context, req domain.PatchProductRequest) error {
+	var product *domain.Product
+	err := ps.productRepository.WithTx(ctx, func(repository domain.ProductRepository) error {
+		var err error
+		product, err = ps.patchProduct(ctx, repository, req)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if req.Name != nil {
+		ps.suggester.upsert(product.UserID, productNameFrom(*product))
+	}
+
+	return nil
+}
+
+// patchProduct
+// 자동완성 인덱스는 바꾸지 않는다. 트랜잭션 안에서 부르면 커밋한 다음에 호출한 쪽에서 바꾼다.
+func (ps productService) patchProduct(ctx context.Context, repository domain.ProductRepository, req domain.PatchProductRequest) (*domain.Product, error) {
 	const op cerrors.Op = "product/service/PatchProduct"
 
-	product, err := ps.productRepository.GetProduct(ctx, req.ID)
+	product, err := repository.GetProduct(ctx, req.ID)
 	if err != nil {
-		return cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+		return nil, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
 	}
 	if product == nil {
-		return cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
+		return nil, cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
 	}
 	if product.UserID != req.UserID {
-		return cerrors.E(op, cerrors.Permission, "상품을 수정할 권한이 없습니다.")
+		return nil, cerrors.E(op, cerrors.Permission, "상품을 수정할 권한이 없습니다.")
 	}
+	if err := checkProductVersion(product, req.Version); err != nil {
+		return nil, err
+	}
+	before := *product
 
-	if req.Category != nil {
-		product.Category = *req.Category
+	if req.CategoryID != nil {
+		if err := ps.checkCategory(ctx, req.UserID, *req.CategoryID); err != nil {
+			return nil, err
+		}
+		product.CategoryID = *req.CategoryID
 	}
 	if req.Price != nil {
-		product.Price = *req.Price
+		product.SetPrice(*req.Price)
 	}
 	if req.Cost != nil {
 		product.Cost = *req.Cost
@@ -99,69 +453,196 @@ func (ps productService) PatchProduct(ctx context.Context, req domain.PatchProdu
 	if req.Name != nil {
 		product.Name = *req.Name
 		product.Initial = extractChosung(*req.Name)
+		product.Romanized = romanize(*req.Name)
 	}
 	if req.Description != nil {
 		product.Description = *req.Description
 	}
-	if req.Barcode != nil {
+	if req.Barcode != nil && *req.Barcode != product.Barcode {
+		if err := checkDuplicateBarcode(ctx, repository, req.UserID, *req.Barcode, product.ID); err != nil {
+			return nil, err
+		}
 		product.Barcode = *req.Barcode
 	}
-	if req.ExpiryDate != nil {
-		product.ExpiryDate = *req.ExpiryDate
+	if req.ReorderPoint != nil {
+		product.ReorderPoint = req.ReorderPoint
+	}
+	if req.ClearReorderPoint {
+		product.ReorderPoint = nil
+	}
+	if req.ReorderQuantity != nil {
+		product.ReorderQuantity = *req.ReorderQuantity
+	}
+
+	if err := repository.UpdateProduct(ctx, *product); err != nil {
+		if cerrors.Is(cerrors.Exist, err) || cerrors.Is(cerrors.PreconditionFailed, err) {
+			return nil, err
+		}
+		return nil, cerrors.E(op, cerrors.Internal, err, "상품을 수정하는 중에 에러가 발생했습니다.")
+	}
+
+	if history := domain.PriceHistoryFrom(before, *product, &req.UserID, domain.PriceChangeReasonManual, time.Now().UTC()); history != nil {
+		if err := repository.CreatePriceHistory(ctx, *history); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "가격 변경 기록을 저장하는 중에 에러가 발생했습니다.")
+		}
+	}
+
+	// 옵션 그룹은 부분 수정하지 않고 요청한 옵션 그룹으로 모두 교체한다.
+	if req.OptionGroups != nil {
+		if err := repository.ReplaceProductOptionGroups(ctx, product.ID, domain.ProductOptionGroupsFrom(*req.OptionGroups)); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "상품 옵션을 수정하는 중에 에러가 발생했습니다.")
+		}
 	}
-	if req.Size != nil {
-		product.Size = *req.Size
+	if req.Tags != nil {
+		if err := repository.ReplaceProductTags(ctx, product.UserID, product.ID, *req.Tags); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "상품 태그를 수정하는 중에 에러가 발생했습니다.")
+		}
 	}
 
-	if err := ps.productRepository.UpdateProduct(ctx, *product); err != nil {
-		return cerrors.E(op, cerrors.Internal, err, "상품을 수정하는 중에 에러가 발생했습니다.")
+	return product, nil
+}
+
+// ReplaceProduct
+// 보내지 않은 값은 비우고 상품 전체를 요청한 값으로 바꾼다. 검사와 가격 변경 기록은 부분 수정과 같다.
+func (ps productService) ReplaceProduct(ctx context.Context, req domain.ReplaceProductRequest) error {
+	return ps.PatchProduct(ctx, req.PatchRequest())
+}
+
+// ApplyProductPatch
+// 현재 상품에 patch 를 적용한 결과를 전체 수정과 같이 검사해 저장한다.
+// patch 를 적용한 버전일 때만 저장하므로 그 사이 다른 곳에서 수정하면 PreconditionFailed 를 반환한다.
+func (ps productService) ApplyProductPatch(ctx context.Context, req domain.ApplyProductPatchRequest) error {
+	const op cerrors.Op = "product/service/ApplyProductPatch"
+
+	var product *domain.Product
+	err := ps.productRepository.WithTx(ctx, func(repository domain.ProductRepository) error {
+		current, err := repository.GetProduct(ctx, req.ID)
+		if err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+		}
+		if current == nil {
+			return cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
+		}
+		if current.UserID != req.UserID {
+			return cerrors.E(op, cerrors.Permission, "상품을 수정할 권한이 없습니다.")
+		}
+		if err := checkProductVersion(current, req.Version); err != nil {
+			return err
+		}
+
+		current.OptionGroups, err = repository.ListProductOptionGroups(ctx, []int{current.ID})
+		if err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "상품 옵션을 조회하는 중에 에러가 발생했습니다.")
+		}
+		productTags, err := repository.ListProductTags(ctx, []int{current.ID})
+		if err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "상품 태그를 조회하는 중에 에러가 발생했습니다.")
+		}
+		for _, productTag := range productTags {
+			current.Tags = append(current.Tags, productTag.Tag)
+		}
+
+		document := domain.ReplaceProductRequestFrom(*current)
+		replaced, err := req.Apply(document)
+		if err != nil {
+			return err
+		}
+
+		patch := replaced.PatchRequest()
+		patch.Version = &current.Version
+		// 옵션을 건드리지 않았으면 옵션 ID 가 바뀌지 않도록 교체하지 않는다.
+		if reflect.DeepEqual(replaced.OptionGroups, document.OptionGroups) {
+			patch.OptionGroups = nil
+		}
+		if reflect.DeepEqual(replaced.Tags, document.Tags) {
+			patch.Tags = nil
+		}
+		product, err = ps.patchProduct(ctx, repository, patch)
+		return err
+	})
+	if err != nil {
+		return err
 	}
 
+	ps.suggester.upsert(product.UserID, productNameFrom(*product))
+
 	return nil
 }
 
 func (ps productService) DeleteProduct(ctx context.Context, req domain.DeleteProductRequest) error {
+	product, err := deleteProduct(ctx, ps.productRepository, req)
+	if err != nil {
+		return err
+	}
+
+	ps.suggester.remove(product.UserID, product.ID)
+
+	return nil
+}
+
+func deleteProduct(ctx context.Context, repository domain.ProductRepository, req domain.DeleteProductRequest) (*domain.Product, error) {
 	const op cerrors.Op = "product/service/DeleteProduct"
 
-	product, err := ps.productRepository.GetProduct(ctx, req.ID)
+	product, err := repository.GetProduct(ctx, req.ID)
 	if err != nil {
-		return cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+		return nil, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
 	}
 	if product == nil {
-		return cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
+		return nil, cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
 	}
 	if product.UserID != req.UserID {
-		return cerrors.E(op, cerrors.Permission, "상품을 삭제할 권한이 없습니다.")
+		return nil, cerrors.E(op, cerrors.Permission, "상품을 삭제할 권한이 없습니다.")
+	}
+	if err := checkProductVersion(product, req.Version); err != nil {
+		return nil, err
 	}
 
-	if err := ps.productRepository.DeleteProduct(ctx, req.ID); err != nil {
-		return cerrors.E(op, cerrors.Internal, err, "상품을 삭제하는 중에 에러가 발생했습니다.")
+	if err := repository.DeleteProduct(ctx, req.ID, product.Version); err != nil {
+		if cerrors.Is(cerrors.PreconditionFailed, err) {
+			return nil, err
+		}
+		return nil, cerrors.E(op, cerrors.Internal, err, "상품을 삭제하는 중에 에러가 발생했습니다.")
 	}
 
-	return nil
+	return product, nil
 }
 
-func (ps productService) ListProducts(ctx context.Context, req domain.ListProductsRequest) (domain.ListProductsResponse, error) {
-	const op cerrors.Op = "product/service/ListProducts"
-	params := domain.ListProductsParams{
-		UserID: req.UserID,
-		Cursor: req.Cursor,
-	}
+// checkProductVersion
+// If-Match 로 받은 버전이 있으면 조회한 상품의 버전과 같은지 확인한다.
+func checkProductVersion(product *domain.Product, version *int) error {
+	const op cerrors.Op = "product/service/checkProductVersion"
 
-	if req.Search != nil && isKoreanChosung(*req.Search) {
-		params.Initial = req.Search
-	} else {
-		params.Name = req.Search
+	if version != nil && *version != product.Version {
+		return cerrors.E(op, cerrors.PreconditionFailed, "다른 곳에서 상품이 먼저 수정되었습니다. 상품을 다시 조회한 뒤 수정해주세요.")
 	}
 
-	products, err := ps.productRepository.ListProducts(ctx, params)
+	return nil
+}
+
+// ListTrashProducts
+// 휴지통에 있는 자신의 상품을 조회한다. 상품마다 완전히 지워지는 시각을 함께 응답한다.
+func (ps productService) ListTrashProducts(ctx context.Context, req domain.ListTrashProductsRequest) (domain.ListTrashProductsResponse, error) {
+	const op cerrors.Op = "product/service/ListTrashProducts"
+
+	products, err := ps.productRepository.ListDeletedProducts(ctx, req.UserID, req.Cursor)
 	if err != nil {
-		return domain.ListProductsResponse{}, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+		return domain.ListTrashProductsResponse{}, cerrors.E(op, cerrors.Internal, err, "휴지통을 조회하는 중에 에러가 발생했습니다.")
 	}
 
-	var productDTOs []domain.ProductDTO
+	if err := ps.attachOptionGroups(ctx, products); err != nil {
+		return domain.ListTrashProductsResponse{}, err
+	}
+	if err := ps.attachImages(ctx, products); err != nil {
+		return domain.ListTrashProductsResponse{}, err
+	}
+	if err := ps.attachTags(ctx, products); err != nil {
+		return domain.ListTrashProductsResponse{}, err
+	}
+
+	retention := domain.TrashRetentionFrom(ps.cfg.Trash.RetentionDays)
+	productDTOs := make([]domain.TrashProductDTO, 0, len(products))
 	for _, product := range products {
-		productDTOs = append(productDTOs, domain.ProductDTOFrom(product))
+		productDTOs = append(productDTOs, domain.TrashProductDTOFrom(product, retention))
 	}
 
 	var cursor *int
@@ -169,12 +650,1070 @@ func (ps productService) ListProducts(ctx context.Context, req domain.ListProduc
 		cursor = &productDTOs[len(productDTOs)-1].ID
 	}
 
-	return domain.ListProductsResponse{
+	return domain.ListTrashProductsResponse{
 		Products: productDTOs,
 		Cursor:   cursor,
 	}, nil
 }
 
+// RestoreProduct
+// 휴지통에 있는 상품을 되살린다. 그 사이 같은 바코드로 만든 상품이 있으면 복원하지 않는다.
+func (ps productService) RestoreProduct(ctx context.Context, req domain.RestoreProductRequest) (domain.GetProductResponse, error) {
+	const op cerrors.Op = "product/service/RestoreProduct"
+
+	product, err := ps.productRepository.GetDeletedProduct(ctx, req.ID)
+	if err != nil {
+		return domain.GetProductResponse{}, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if product == nil {
+		return domain.GetProductResponse{}, cerrors.E(op, cerrors.NotExist, "휴지통에서 상품을 찾을 수 없습니다.")
+	}
+	if product.UserID != req.UserID {
+		return domain.GetProductResponse{}, cerrors.E(op, cerrors.Permission, "상품을 복원할 권한이 없습니다.")
+	}
+
+	if err := checkDuplicateBarcode(ctx, ps.productRepository, req.UserID, product.Barcode, product.ID); err != nil {
+		if cerrors.Is(cerrors.Exist, err) {
+			return domain.GetProductResponse{}, cerrors.E(op, cerrors.Exist, err, "이미 같은 바코드의 상품이 있어 복원할 수 없습니다.")
+		}
+		return domain.GetProductResponse{}, err
+	}
+
+	restored, err := ps.productRepository.RestoreProduct(ctx, product.ID)
+	if err != nil {
+		if cerrors.Is(cerrors.Exist, err) {
+			return domain.GetProductResponse{}, err
+		}
+		return domain.GetProductResponse{}, cerrors.E(op, cerrors.Internal, err, "상품을 복원하는 중에 에러가 발생했습니다.")
+	}
+	if !restored {
+		return domain.GetProductResponse{}, cerrors.E(op, cerrors.NotExist, "휴지통에서 상품을 찾을 수 없습니다.")
+	}
+
+	ps.suggester.upsert(product.UserID, productNameFrom(*product))
+
+	return ps.GetProduct(ctx, domain.GetProductRequest{UserID: req.UserID, ProductID: product.ID})
+}
+
+// PurgeProduct
+// 휴지통에 있는 상품을 보관 기간을 기다리지 않고 완전히 지운다. 지운 상품은 복원할 수 없다.
+func (ps productService) PurgeProduct(ctx context.Context, req domain.PurgeProductRequest) error {
+	const op cerrors.Op = "product/service/PurgeProduct"
+
+	product, err := ps.productRepository.GetDeletedProduct(ctx, req.ID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if product == nil {
+		return cerrors.E(op, cerrors.NotExist, "휴지통에서 상품을 찾을 수 없습니다.")
+	}
+	if product.UserID != req.UserID {
+		return cerrors.E(op, cerrors.Permission, "상품을 삭제할 권한이 없습니다.")
+	}
+
+	purged, err := purgeProduct(ctx, ps.productRepository, ps.imageStorage, product.ID)
+	if err != nil {
+		return err
+	}
+	if !purged {
+		return cerrors.E(op, cerrors.NotExist, "휴지통에서 상품을 찾을 수 없습니다.")
+	}
+
+	return nil
+}
+
+// purgeProduct
+// 상품을 DB 에서 완전히 지운 다음 이미지 파일을 지운다. 휴지통에 없어 지우지 않았으면 false 를 반환한다.
+func purgeProduct(ctx context.Context, repository domain.ProductRepository, imageStorage domain.ImageStorage, productID int) (bool, error) {
+	const op cerrors.Op = "product/purgeProduct"
+
+	images, err := repository.ListProductImages(ctx, []int{productID})
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "상품 이미지를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	purged, err := repository.PurgeProduct(ctx, productID)
+	if err != nil {
+		return false, cerrors.E(op, cerrors.Internal, err, "상품을 완전히 삭제하는 중에 에러가 발생했습니다.")
+	}
+	if !purged {
+		return false, nil
+	}
+
+	for _, image := range images {
+		deleteImageFiles(ctx, imageStorage, image.Keys())
+	}
+
+	return true, nil
+}
+
+// UploadProductImage
+// 이미지를 크기별로 만들어 스토리지에 모두 저장한 다음 DB 에 기록한다. 중간에 실패하면 이미 저장한 파일을 지운다.
+func (ps productService) UploadProductImage(ctx context.Context, req domain.UploadProductImageRequest) (domain.UploadProductImageResponse, error) {
+	const op cerrors.Op = "product/service/UploadProductImage"
+
+	product, err := ps.getOwnProduct(ctx, req.UserID, req.ProductID)
+	if err != nil {
+		return domain.UploadProductImageResponse{}, err
+	}
+
+	images, err := ps.productRepository.ListProductImages(ctx, []int{product.ID})
+	if err != nil {
+		return domain.UploadProductImageResponse{}, cerrors.E(op, cerrors.Internal, err, "상품 이미지를 조회하는 중에 에러가 발생했습니다.")
+	}
+	if len(images) >= domain.MaxProductImages {
+		return domain.UploadProductImageResponse{}, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("이미지는 상품마다 %d개까지 올릴 수 있습니다.", domain.MaxProductImages))
+	}
+
+	processed, err := processProductImage(req.File)
+	if err != nil {
+		return domain.UploadProductImageResponse{}, err
+	}
+
+	storageKey, err := newProductImageKey(product.ID)
+	if err != nil {
+		return domain.UploadProductImageResponse{}, cerrors.E(op, cerrors.Internal, err, "이미지를 저장하는 중에 에러가 발생했습니다.")
+	}
+	image := domain.ProductImage{
+		ProductID:   product.ID,
+		StorageKey:  storageKey,
+		ContentType: processed.ContentType,
+		Width:       processed.Width,
+		Height:      processed.Height,
+	}
+
+	var stored []string
+	for _, variant := range domain.ProductImageVariants {
+		key := image.Key(variant)
+		if err := ps.imageStorage.Put(ctx, key, image.ContentType, processed.Variants[variant.Name]); err != nil {
+			deleteImageFiles(ctx, ps.imageStorage, stored)
+			return domain.UploadProductImageResponse{}, cerrors.E(op, cerrors.Internal, err, "이미지를 저장하는 중에 에러가 발생했습니다.")
+		}
+		stored = append(stored, key)
+	}
+
+	image, err = ps.productRepository.CreateProductImage(ctx, image)
+	if err != nil {
+		deleteImageFiles(ctx, ps.imageStorage, stored)
+		return domain.UploadProductImageResponse{}, cerrors.E(op, cerrors.Internal, err, "이미지를 저장하는 중에 에러가 발생했습니다.")
+	}
+
+	return domain.UploadProductImageResponse{
+		Image: domain.ProductImageDTOFrom(ps.withImageURLs(image)),
+	}, nil
+}
+
+// PatchProductImages
+// 이미지 순서와 대표 이미지를 바꾸고 바뀐 이미지 목록을 응답한다.
+func (ps productService) PatchProductImages(ctx context.Context, req domain.PatchProductImagesRequest) (domain.PatchProductImagesResponse, error) {
+	const op cerrors.Op = "product/service/PatchProductImages"
+
+	product, err := ps.getOwnProduct(ctx, req.UserID, req.ProductID)
+	if err != nil {
+		return domain.PatchProductImagesResponse{}, err
+	}
+
+	images, err := ps.productRepository.ListProductImages(ctx, []int{product.ID})
+	if err != nil {
+		return domain.PatchProductImagesResponse{}, cerrors.E(op, cerrors.Internal, err, "상품 이미지를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	imageIDs := make(map[int]bool, len(images))
+	for _, image := range images {
+		imageIDs[image.ID] = true
+	}
+	if req.ImageIDs != nil {
+		if len(req.ImageIDs) != len(images) {
+			return domain.PatchProductImagesResponse{}, cerrors.E(op, cerrors.Invalid, "상품의 이미지를 모두 순서대로 보내주세요.")
+		}
+		for _, imageID := range req.ImageIDs {
+			if !imageIDs[imageID] {
+				return domain.PatchProductImagesResponse{}, cerrors.E(op, cerrors.Invalid, "상품의 이미지를 모두 순서대로 보내주세요.")
+			}
+		}
+	}
+	if req.PrimaryImageID != nil && !imageIDs[*req.PrimaryImageID] {
+		return domain.PatchProductImagesResponse{}, cerrors.E(op, cerrors.NotExist, "이미지를 찾을 수 없습니다.")
+	}
+
+	if err := ps.productRepository.UpdateProductImages(ctx, product.ID, req.ImageIDs, req.PrimaryImageID); err != nil {
+		return domain.PatchProductImagesResponse{}, cerrors.E(op, cerrors.Internal, err, "상품 이미지를 수정하는 중에 에러가 발생했습니다.")
+	}
+
+	products := []domain.Product{*product}
+	if err := ps.attachImages(ctx, products); err != nil {
+		return domain.PatchProductImagesResponse{}, err
+	}
+
+	return domain.PatchProductImagesResponse{
+		Images: domain.ProductImageDTOsFrom(products[0].Images),
+	}, nil
+}
+
+// DeleteProductImage
+// DB 에서 먼저 지운 다음 파일을 지운다. 파일을 지우지 못해도 이미지는 더 보이지 않으므로 로그만 남긴다.
+func (ps productService) DeleteProductImage(ctx context.Context, req domain.DeleteProductImageRequest) error {
+	const op cerrors.Op = "product/service/DeleteProductImage"
+
+	product, err := ps.getOwnProduct(ctx, req.UserID, req.ProductID)
+	if err != nil {
+		return err
+	}
+
+	images, err := ps.productRepository.ListProductImages(ctx, []int{product.ID})
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "상품 이미지를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	index := slices.IndexFunc(images, func(image domain.ProductImage) bool { return image.ID == req.ImageID })
+	if index < 0 {
+		return cerrors.E(op, cerrors.NotExist, "이미지를 찾을 수 없습니다.")
+	}
+
+	if err := ps.productRepository.DeleteProductImage(ctx, images[index]); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "상품 이미지를 삭제하는 중에 에러가 발생했습니다.")
+	}
+
+	deleteImageFiles(ctx, ps.imageStorage, images[index].Keys())
+
+	return nil
+}
+
+// GetProductPriceHistory
+// 기간은 사장님의 매장 시간대로 나눈다. 기간이 시작할 때의 값을 알기 위해 기간이 끝난 뒤의 변경까지 함께 조회한다.
+func (ps productService) GetProductPriceHistory(ctx context.Context, req domain.GetProductPriceHistoryRequest) (domain.GetProductPriceHistoryResponse, error) {
+	const op cerrors.Op = "product/service/GetProductPriceHistory"
+
+	product, err := ps.productRepository.GetProduct(ctx, req.ProductID)
+	if err != nil {
+		return domain.GetProductPriceHistoryResponse{}, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if product == nil {
+		return domain.GetProductPriceHistoryResponse{}, cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
+	}
+	if product.UserID != req.UserID {
+		return domain.GetProductPriceHistoryResponse{}, cerrors.E(op, cerrors.Permission, "상품을 조회할 권한이 없습니다.")
+	}
+
+	user, err := ps.userRepository.GetUser(ctx, req.UserID)
+	if err != nil {
+		return domain.GetProductPriceHistoryResponse{}, cerrors.E(op, cerrors.Internal, err, "사장님 정보를 조회하는 중에 에러가 발생했습니다.")
+	}
+	if user == nil {
+		return domain.GetProductPriceHistoryResponse{}, cerrors.E(op, cerrors.NotExist, "사장님을 찾을 수 없습니다.")
+	}
+
+	now := time.Now()
+	loc := user.Location()
+	since, until, err := req.DateRange(now, loc)
+	if err != nil {
+		return domain.GetProductPriceHistoryResponse{}, err
+	}
+
+	histories, err := ps.productRepository.ListPriceHistory(ctx, product.ID, since)
+	if err != nil {
+		return domain.GetProductPriceHistoryResponse{}, cerrors.E(op, cerrors.Internal, err, "가격 변경 기록을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	changes := make([]domain.PriceChangeDTO, 0, len(histories))
+	for _, history := range histories {
+		if !history.CreateDate.Before(until) {
+			break
+		}
+		changes = append(changes, domain.PriceChangeDTOFrom(history))
+	}
+
+	return domain.GetProductPriceHistoryResponse{
+		From:     since.Format(domain.ReportDateLayout),
+		To:       until.AddDate(0, 0, -1).Format(domain.ReportDateLayout),
+		TimeZone: loc.String(),
+		Changes:  changes,
+		Series:   priceSeriesFrom(*product, histories, since, until, now),
+	}, nil
+}
+
+// priceSeriesFrom
+// since 부터 until 전까지 now 가 지나지 않은 날짜마다 그날이 끝날 때의 값을 담는다.
+// histories 는 since 이후의 변경을 오래된 순서로 담고 있어야 한다. since 의 값은 첫 변경 전 값이고, 변경이 없었으면 지금 상품의 값이다.
+func priceSeriesFrom(product domain.Product, histories []domain.PriceHistory, since, until, now time.Time) []domain.PricePointDTO {
+	point := domain.PricePointDTO{
+		Price:          product.Price,
+		Cost:           product.Cost,
+		EffectivePrice: product.EffectivePrice(),
+	}
+	if len(histories) > 0 {
+		point.Price = histories[0].OldPrice
+		point.Cost = histories[0].OldCost
+		point.EffectivePrice = histories[0].OldEffectivePrice
+	}
+
+	series := []domain.PricePointDTO{}
+	i := 0
+	for day := since; day.Before(until) && !day.After(now); day = day.AddDate(0, 0, 1) {
+		end := day.AddDate(0, 0, 1)
+		for ; i < len(histories) && histories[i].CreateDate.Before(end); i++ {
+			point.Price = histories[i].NewPrice
+			point.Cost = histories[i].NewCost
+			point.EffectivePrice = histories[i].NewEffectivePrice
+		}
+		point.Date = day.Format(domain.ReportDateLayout)
+		series = append(series, point)
+	}
+
+	return series
+}
+
+// DuplicateProduct
+// 원본 상품에 바꾸는 값을 덮어써 새 상품을 만들고, 만든 상품을 응답한다.
+// 초성과 로마자 표기는 새 상품명으로 다시 만든다.
+func (ps productService) DuplicateProduct(ctx context.Context, req domain.DuplicateProductRequest) (domain.GetProductResponse, error) {
+	const op cerrors.Op = "product/service/DuplicateProduct"
+
+	source, err := ps.productRepository.GetProduct(ctx, req.ID)
+	if err != nil {
+		return domain.GetProductResponse{}, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if source == nil {
+		return domain.GetProductResponse{}, cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
+	}
+	if source.UserID != req.UserID {
+		return domain.GetProductResponse{}, cerrors.E(op, cerrors.Permission, "상품을 복제할 권한이 없습니다.")
+	}
+
+	products := []domain.Product{*source}
+	if err := ps.attachOptionGroups(ctx, products); err != nil {
+		return domain.GetProductResponse{}, err
+	}
+	if err := ps.attachTags(ctx, products); err != nil {
+		return domain.GetProductResponse{}, err
+	}
+
+	create := req.Apply(domain.CreateProductRequestFrom(products[0]))
+	if err := create.Validate(); err != nil {
+		return domain.GetProductResponse{}, err
+	}
+
+	product, err := ps.createProduct(ctx, ps.productRepository, create)
+	if err != nil {
+		return domain.GetProductResponse{}, err
+	}
+
+	ps.suggester.upsert(product.UserID, productNameFrom(product))
+
+	return ps.GetProduct(ctx, domain.GetProductRequest{UserID: req.UserID, ProductID: product.ID})
+}
+
+// CreateProductTemplate
+// 상품의 현재 정보(옵션 포함)를 템플릿으로 저장한다. 바코드와 유통기한은 저장하지 않는다.
+func (ps productService) CreateProductTemplate(ctx context.Context, req domain.CreateProductTemplateRequest) (domain.CreateProductTemplateResponse, error) {
+	const op cerrors.Op = "product/service/CreateProductTemplate"
+
+	product, err := ps.productRepository.GetProduct(ctx, req.ProductID)
+	if err != nil {
+		return domain.CreateProductTemplateResponse{}, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if product == nil {
+		return domain.CreateProductTemplateResponse{}, cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
+	}
+	if product.UserID != req.UserID {
+		return domain.CreateProductTemplateResponse{}, cerrors.E(op, cerrors.Permission, "상품을 템플릿으로 저장할 권한이 없습니다.")
+	}
+
+	products := []domain.Product{*product}
+	if err := ps.attachOptionGroups(ctx, products); err != nil {
+		return domain.CreateProductTemplateResponse{}, err
+	}
+
+	template := domain.ProductTemplateFrom(products[0], req.Name)
+	template.ID, err = ps.productRepository.CreateProductTemplate(ctx, template)
+	if err != nil {
+		return domain.CreateProductTemplateResponse{}, cerrors.E(op, cerrors.Internal, err, "템플릿을 저장하는 중에 에러가 발생했습니다.")
+	}
+	template.CreateDate = time.Now().UTC()
+
+	return domain.CreateProductTemplateResponse{
+		Template: domain.ProductTemplateDTOFrom(template),
+	}, nil
+}
+
+func (ps productService) ListProductTemplates(ctx context.Context, req domain.ListProductTemplatesRequest) (domain.ListProductTemplatesResponse, error) {
+	const op cerrors.Op = "product/service/ListProductTemplates"
+
+	templates, err := ps.productRepository.ListProductTemplates(ctx, req.UserID)
+	if err != nil {
+		return domain.ListProductTemplatesResponse{}, cerrors.E(op, cerrors.Internal, err, "템플릿을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	dtos := make([]domain.ProductTemplateDTO, 0, len(templates))
+	for _, template := range templates {
+		dtos = append(dtos, domain.ProductTemplateDTOFrom(template))
+	}
+
+	return domain.ListProductTemplatesResponse{
+		Templates: dtos,
+	}, nil
+}
+
+// DeleteProductTemplate
+// 템플릿은 상품과 연결되어 있지 않으므로 지워도 이미 만든 상품은 그대로다.
+func (ps productService) DeleteProductTemplate(ctx context.Context, req domain.DeleteProductTemplateRequest) error {
+	const op cerrors.Op = "product/service/DeleteProductTemplate"
+
+	if _, err := getOwnProductTemplate(ctx, ps.productRepository, req.UserID, req.ID); err != nil {
+		return err
+	}
+
+	if err := ps.productRepository.DeleteProductTemplate(ctx, req.ID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "템플릿을 삭제하는 중에 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func getOwnProductTemplate(ctx context.Context, repository domain.ProductRepository, userID int, templateID int) (*domain.ProductTemplate, error) {
+	const op cerrors.Op = "product/service/getOwnProductTemplate"
+
+	template, err := repository.GetProductTemplate(ctx, templateID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "템플릿을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if template == nil || template.UserID != userID {
+		return nil, cerrors.E(op, cerrors.NotExist, "템플릿을 찾을 수 없습니다.")
+	}
+
+	return template, nil
+}
+
+// CreateScheduledPriceChange
+// 정가나 원가를 effectiveDate 에 바꾸도록 예약한다. 예약은 서버 작업이 적용하고, 예약한 사장님을 가격 변경 기록에 남긴다.
+func (ps productService) CreateScheduledPriceChange(ctx context.Context, req domain.CreateScheduledPriceChangeRequest) (domain.CreateScheduledPriceChangeResponse, error) {
+	const op cerrors.Op = "product/service/CreateScheduledPriceChange"
+
+	now := time.Now().UTC()
+	if !req.EffectiveDate.After(now) {
+		return domain.CreateScheduledPriceChangeResponse{}, cerrors.E(op, cerrors.Invalid, "가격을 바꿀 시각은 지금 이후로 입력해주세요.")
+	}
+
+	product, err := ps.productRepository.GetProduct(ctx, req.ProductID)
+	if err != nil {
+		return domain.CreateScheduledPriceChangeResponse{}, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if product == nil {
+		return domain.CreateScheduledPriceChangeResponse{}, cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
+	}
+	if product.UserID != req.UserID {
+		return domain.CreateScheduledPriceChangeResponse{}, cerrors.E(op, cerrors.Permission, "상품 가격을 예약할 권한이 없습니다.")
+	}
+
+	change := domain.ScheduledPriceChange{
+		ProductID:     product.ID,
+		ProductName:   product.Name,
+		UserID:        req.UserID,
+		Price:         req.Price,
+		Cost:          req.Cost,
+		EffectiveDate: req.EffectiveDate.UTC(),
+	}
+	change.ID, err = ps.productRepository.CreateScheduledPriceChange(ctx, change)
+	if err != nil {
+		return domain.CreateScheduledPriceChangeResponse{}, cerrors.E(op, cerrors.Internal, err, "가격을 예약하는 중에 에러가 발생했습니다.")
+	}
+	change.CreateDate = now
+
+	return domain.CreateScheduledPriceChangeResponse{
+		ScheduledPrice: domain.ScheduledPriceChangeDTOFrom(change),
+	}, nil
+}
+
+// ListScheduledPriceChanges
+// 아직 적용하지 않은 예약만 조회한다. 휴지통에 있는 상품의 예약은 조회하지 않는다.
+func (ps productService) ListScheduledPriceChanges(ctx context.Context, req domain.ListScheduledPriceChangesRequest) (domain.ListScheduledPriceChangesResponse, error) {
+	const op cerrors.Op = "product/service/ListScheduledPriceChanges"
+
+	changes, err := ps.productRepository.ListScheduledPriceChanges(ctx, domain.ListScheduledPriceChangesParams{
+		UserID:    req.UserID,
+		ProductID: req.ProductID,
+	})
+	if err != nil {
+		return domain.ListScheduledPriceChangesResponse{}, cerrors.E(op, cerrors.Internal, err, "가격 예약을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	dtos := make([]domain.ScheduledPriceChangeDTO, 0, len(changes))
+	for _, change := range changes {
+		dtos = append(dtos, domain.ScheduledPriceChangeDTOFrom(change))
+	}
+
+	return domain.ListScheduledPriceChangesResponse{
+		ScheduledPrices: dtos,
+	}, nil
+}
+
+// CancelScheduledPriceChange
+// 서버 작업이 먼저 적용한 예약은 취소할 수 없다.
+func (ps productService) CancelScheduledPriceChange(ctx context.Context, req domain.CancelScheduledPriceChangeRequest) error {
+	const op cerrors.Op = "product/service/CancelScheduledPriceChange"
+
+	change, err := getOwnScheduledPriceChange(ctx, ps.productRepository, req.UserID, req.ID)
+	if err != nil {
+		return err
+	}
+	if !change.Pending() {
+		return cerrors.E(op, cerrors.Exist, "이미 적용했거나 취소한 가격 예약입니다.")
+	}
+
+	canceled, err := ps.productRepository.CancelScheduledPriceChange(ctx, req.ID, time.Now().UTC())
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "가격 예약을 취소하는 중에 에러가 발생했습니다.")
+	}
+	if !canceled {
+		return cerrors.E(op, cerrors.Exist, "이미 적용했거나 취소한 가격 예약입니다.")
+	}
+
+	return nil
+}
+
+func getOwnScheduledPriceChange(ctx context.Context, repository domain.ProductRepository, userID int, scheduleID int) (*domain.ScheduledPriceChange, error) {
+	const op cerrors.Op = "product/service/getOwnScheduledPriceChange"
+
+	change, err := repository.GetScheduledPriceChange(ctx, scheduleID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "가격 예약을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if change == nil {
+		return nil, cerrors.E(op, cerrors.NotExist, "가격 예약을 찾을 수 없습니다.")
+	}
+
+	product, err := repository.GetProduct(ctx, change.ProductID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if product == nil || product.UserID != userID {
+		return nil, cerrors.E(op, cerrors.NotExist, "가격 예약을 찾을 수 없습니다.")
+	}
+
+	return change, nil
+}
+
+// getOwnProduct
+// 상품 이미지를 바꿀 수 있는지 확인한다.
+func (ps productService) getOwnProduct(ctx context.Context, userID int, productID int) (*domain.Product, error) {
+	const op cerrors.Op = "product/service/getOwnProduct"
+
+	product, err := ps.productRepository.GetProduct(ctx, productID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if product == nil {
+		return nil, cerrors.E(op, cerrors.NotExist, "상품을 찾을 수 없습니다.")
+	}
+	if product.UserID != userID {
+		return nil, cerrors.E(op, cerrors.Permission, "상품을 수정할 권한이 없습니다.")
+	}
+
+	return product, nil
+}
+
+// deleteImageFiles
+// 파일을 지우지 못해도 DB 에서는 이미 지웠으므로 로그만 남긴다.
+func deleteImageFiles(ctx context.Context, imageStorage domain.ImageStorage, keys []string) {
+	for _, key := range keys {
+		if err := imageStorage.Delete(ctx, key); err != nil {
+			log.Printf("delete product image %s: %v", key, err)
+		}
+	}
+}
+
+// withImageURLs
+// 크기별 파일의 주소를 채운다.
+func (ps productService) withImageURLs(image domain.ProductImage) domain.ProductImage {
+	image.URLs = make(map[string]string, len(domain.ProductImageVariants))
+	for _, variant := range domain.ProductImageVariants {
+		image.URLs[variant.Name] = ps.imageStorage.URL(image.Key(variant))
+	}
+	return image
+}
+
+// BatchProducts
+// 작업을 순서대로 한 트랜잭션 안에서 실행한다. 작업이 하나라도 실패하면 모두 되돌리고 applied 가 false 인 결과를 응답한다.
+// 뒤의 작업은 앞의 작업이 반영된 상태를 보므로, 같은 요청에서 만든 바코드나 지운 상품도 검사에 반영된다.
+func (ps productService) BatchProducts(ctx context.Context, req domain.BatchProductsRequest) (domain.BatchProductsResponse, error) {
+	const op cerrors.Op = "product/service/BatchProducts"
+
+	res := domain.BatchProductsResponse{
+		Results: make([]domain.BatchProductResultDTO, len(req.Operations)),
+	}
+	for i, operation := range req.Operations {
+		res.Results[i] = domain.BatchProductResultDTO{
+			Index:  i,
+			Op:     operation.Op,
+			Status: domain.BatchProductResultStatusSkipped,
+		}
+	}
+
+	products := make([]domain.Product, len(req.Operations))
+	failed := -1
+	var failedErr error
+	err := ps.productRepository.WithTx(ctx, func(repository domain.ProductRepository) error {
+		for i, operation := range req.Operations {
+			product, err := ps.applyBatchOperation(ctx, repository, req.UserID, operation)
+			if err != nil {
+				if !cerrors.Is(cerrors.Internal, err) {
+					failed, failedErr = i, err
+				}
+				return err
+			}
+			products[i] = product
+		}
+		return nil
+	})
+
+	if failed >= 0 {
+		for i := 0; i < failed; i++ {
+			res.Results[i].Status = domain.BatchProductResultStatusRolledBack
+		}
+		_, apiErr := cerrors.ToSentinelAPIError(failedErr)
+		res.Results[failed].Status = domain.BatchProductResultStatusFailed
+		res.Results[failed].Error = apiErr.Meta.Message
+		return res, nil
+	}
+	if err != nil {
+		if cerrors.Is(cerrors.Internal, err) {
+			return domain.BatchProductsResponse{}, err
+		}
+		return domain.BatchProductsResponse{}, cerrors.E(op, cerrors.Internal, err, "상품을 일괄 변경하는 중에 에러가 발생했습니다.")
+	}
+
+	res.Applied = true
+	for i, operation := range req.Operations {
+		product := products[i]
+		res.Results[i].Status = domain.BatchProductResultStatusApplied
+		res.Results[i].ProductID = &product.ID
+
+		switch operation.Op {
+		case domain.BatchProductOperationCreate:
+			ps.suggester.upsert(product.UserID, productNameFrom(product))
+		case domain.BatchProductOperationPatch:
+			if operation.Patch.Name != nil {
+				ps.suggester.upsert(product.UserID, productNameFrom(product))
+			}
+		case domain.BatchProductOperationDelete:
+			ps.suggester.remove(product.UserID, product.ID)
+		}
+	}
+
+	return res, nil
+}
+
+// applyBatchOperation
+// 요청의 사장님으로 작업을 실행한다. 작업마다 단건 API 와 같은 Validate() 와 검사를 한다.
+func (ps productService) applyBatchOperation(ctx context.Context, repository domain.ProductRepository, userID int, operation domain.BatchProductOperation) (domain.Product, error) {
+	const op cerrors.Op = "product/service/applyBatchOperation"
+
+	switch operation.Op {
+	case domain.BatchProductOperationCreate:
+		req := *operation.Create
+		req.UserID = userID
+		if err := req.Validate(); err != nil {
+			return domain.Product{}, err
+		}
+		return ps.createProduct(ctx, repository, req)
+	case domain.BatchProductOperationPatch:
+		req := *operation.Patch
+		req.UserID = userID
+		if err := req.Validate(); err != nil {
+			return domain.Product{}, err
+		}
+		product, err := ps.patchProduct(ctx, repository, req)
+		if err != nil {
+			return domain.Product{}, err
+		}
+		return *product, nil
+	case domain.BatchProductOperationDelete:
+		req := *operation.Delete
+		req.UserID = userID
+		if err := req.Validate(); err != nil {
+			return domain.Product{}, err
+		}
+		product, err := deleteProduct(ctx, repository, req)
+		if err != nil {
+			return domain.Product{}, err
+		}
+		return *product, nil
+	default:
+		return domain.Product{}, cerrors.E(op, cerrors.Invalid, "작업을 확인해주세요.")
+	}
+}
+
+func (ps productService) ListProducts(ctx context.Context, req domain.ListProductsRequest) (domain.ListProductsResponse, error) {
+	const op cerrors.Op = "product/service/ListProducts"
+
+	products, err := ps.productRepository.ListProducts(ctx, ListProductsParamsFrom(req))
+	if err != nil {
+		return domain.ListProductsResponse{}, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	if err := ps.attachOptionGroups(ctx, products); err != nil {
+		return domain.ListProductsResponse{}, err
+	}
+	if err := ps.attachImages(ctx, products); err != nil {
+		return domain.ListProductsResponse{}, err
+	}
+	if err := ps.attachTags(ctx, products); err != nil {
+		return domain.ListProductsResponse{}, err
+	}
+
+	var productDTOs []domain.ProductDTO
+	for _, product := range products {
+		productDTOs = append(productDTOs, domain.ProductDTOFrom(product))
+	}
+
+	var cursor *int
+	if len(productDTOs) > 0 {
+		cursor = &productDTOs[len(productDTOs)-1].ID
+	}
+
+	return domain.ListProductsResponse{
+		Products: productDTOs,
+		Cursor:   cursor,
+	}, nil
+}
+
+// ListExpiringProducts
+// 지금부터 within 안에 유통기한이 끝나는 상품을 조회한다. includeExpired 이면 이미 지난 상품도 함께 조회한다.
+func (ps productService) ListExpiringProducts(ctx context.Context, req domain.ListExpiringProductsRequest) (domain.ListExpiringProductsResponse, error) {
+	const op cerrors.Op = "product/service/ListExpiringProducts"
+
+	now := time.Now().UTC()
+	params := domain.ListExpiringProductsParams{
+		UserID:     req.UserID,
+		CategoryID: req.CategoryID,
+		Until:      now.Add(req.WithinOrDefault()),
+		Limit:      domain.ListExpiringProductsLimit,
+	}
+	if !req.IncludeExpired {
+		params.Since = &now
+	}
+
+	products, err := ps.productRepository.ListExpiringProducts(ctx, params)
+	if err != nil {
+		return domain.ListExpiringProductsResponse{}, cerrors.E(op, cerrors.Internal, err, "유통기한이 임박한 상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	if err := ps.attachOptionGroups(ctx, products); err != nil {
+		return domain.ListExpiringProductsResponse{}, err
+	}
+	if err := ps.attachImages(ctx, products); err != nil {
+		return domain.ListExpiringProductsResponse{}, err
+	}
+	if err := ps.attachTags(ctx, products); err != nil {
+		return domain.ListExpiringProductsResponse{}, err
+	}
+
+	productDTOs := make([]domain.ProductDTO, 0, len(products))
+	for _, product := range products {
+		productDTOs = append(productDTOs, domain.ProductDTOFrom(product))
+	}
+
+	return domain.ListExpiringProductsResponse{
+		Products: productDTOs,
+		Until:    params.Until,
+	}, nil
+}
+
+// ListProductsParamsFrom
+// 검색어가 초성이면 초성으로, 로마자로 읽을 수 있으면 상품명과 로마자 표기로 검색한다. 리포트도 상품 목록과 같은 조건으로 조회하도록 이 함수를 쓴다.
+func ListProductsParamsFrom(req domain.ListProductsRequest) domain.ListProductsParams {
+	params := domain.ListProductsParams{
+		UserID:     req.UserID,
+		Cursor:     req.Cursor,
+		CategoryID: req.CategoryID,
+		TagIDs:     req.TagIDs,
+		TagMatch:   req.TagMatch,
+	}
+
+	if req.Search != nil && isKoreanChosung(*req.Search) {
+		params.Initial = req.Search
+	} else {
+		params.Name = req.Search
+	}
+
+	if req.Search != nil && isRomanizedQuery(*req.Search) {
+		romanized := normalizeRomanizedQuery(*req.Search)
+		params.Romanized = &romanized
+	}
+
+	return params
+}
+
+// CreateProductLabels
+// 가격표 라벨지 PDF 를 만든다. 상품마다 copies 장씩 연달아 출력한다.
+func (ps productService) CreateProductLabels(ctx context.Context, req domain.CreateProductLabelsRequest) ([]byte, error) {
+	const op cerrors.Op = "product/service/CreateProductLabels"
+
+	products, err := ps.labelProducts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(products) == 0 {
+		return nil, cerrors.E(op, cerrors.NotExist, "라벨을 출력할 상품이 없습니다.")
+	}
+	if len(products)*req.CopiesOrDefault() > domain.MaxLabelsPerRequest {
+		return nil, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("라벨은 한 번에 %d장까지 출력할 수 있습니다.", domain.MaxLabelsPerRequest))
+	}
+
+	if err := ps.attachOptionGroups(ctx, products); err != nil {
+		return nil, err
+	}
+
+	font, err := ps.labelFont.load()
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "라벨 폰트를 불러오는 중에 에러가 발생했습니다.")
+	}
+
+	labels := make([]productLabel, 0, len(products)*req.CopiesOrDefault())
+	for _, product := range products {
+		label := productLabelFrom(product)
+		for i := 0; i < req.CopiesOrDefault(); i++ {
+			labels = append(labels, label)
+		}
+	}
+
+	pdf, err := renderLabelSheet(req.TemplateOrDefault(), font, labels)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "라벨을 만드는 중에 에러가 발생했습니다.")
+	}
+
+	return pdf, nil
+}
+
+// labelProducts
+// productIDs 로 고른 상품은 요청한 순서대로, 검색 조건으로 고른 상품은 목록 조회 순서대로 반환한다.
+func (ps productService) labelProducts(ctx context.Context, req domain.CreateProductLabelsRequest) ([]domain.Product, error) {
+	const op cerrors.Op = "product/service/labelProducts"
+
+	if len(req.ProductIDs) > 0 {
+		found, err := ps.productRepository.ListProductsByIDs(ctx, req.UserID, req.ProductIDs)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+		}
+
+		byID := make(map[int]domain.Product, len(found))
+		for _, product := range found {
+			byID[product.ID] = product
+		}
+
+		products := make([]domain.Product, 0, len(req.ProductIDs))
+		for _, productID := range req.ProductIDs {
+			product, ok := byID[productID]
+			if !ok {
+				return nil, cerrors.E(op, cerrors.NotExist, fmt.Sprintf("상품을 찾을 수 없습니다. (상품 ID: %d)", productID))
+			}
+			products = append(products, product)
+		}
+		return products, nil
+	}
+
+	var products []domain.Product
+	params := ListProductsParamsFrom(domain.ListProductsRequest{
+		UserID:     req.UserID,
+		Search:     req.Filter.Search,
+		CategoryID: req.Filter.CategoryID,
+		TagIDs:     req.Filter.TagIDs,
+		TagMatch:   req.Filter.TagMatch,
+	})
+	for {
+		page, err := ps.productRepository.ListProducts(ctx, params)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+		}
+		if len(page) == 0 {
+			return products, nil
+		}
+		products = append(products, page...)
+		if len(products) > domain.MaxLabelsPerRequest {
+			return nil, cerrors.E(op, cerrors.Invalid, fmt.Sprintf("라벨은 한 번에 %d장까지 출력할 수 있습니다.", domain.MaxLabelsPerRequest))
+		}
+		params.Cursor = &page[len(page)-1].ID
+	}
+}
+
+func (ps productService) SuggestProducts(ctx context.Context, req domain.SuggestProductsRequest) (domain.SuggestProductsResponse, error) {
+	const op cerrors.Op = "product/service/SuggestProducts"
+
+	if load := ps.suggester.beginLoad(req.UserID); load != nil {
+		names, err := ps.productRepository.ListProductNames(ctx, req.UserID)
+		if err != nil {
+			ps.suggester.abortLoad(req.UserID, load)
+			return domain.SuggestProductsResponse{}, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+		}
+		ps.suggester.finishLoad(req.UserID, load, names)
+	}
+
+	suggestions := ps.suggester.suggest(req.UserID, req.Query, req.LimitOrDefault())
+	if suggestions == nil {
+		suggestions = []string{}
+	}
+
+	return domain.SuggestProductsResponse{
+		Suggestions: suggestions,
+	}, nil
+}
+
+// checkCategory
+// 상품이 속할 카테고리가 존재하고 요청한 사장님의 카테고리인지 확인한다.
+func (ps productService) checkCategory(ctx context.Context, userID int, categoryID int) error {
+	const op cerrors.Op = "product/service/checkCategory"
+
+	category, err := ps.categoryRepository.GetCategory(ctx, categoryID)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "카테고리를 조회하는 중에 에러가 발생했습니다.")
+	}
+	if category == nil || category.UserID != userID {
+		return cerrors.E(op, cerrors.Invalid, "카테고리를 확인해주세요.")
+	}
+
+	return nil
+}
+
+// checkDuplicateBarcode
+// 사장님의 삭제되지 않은 상품 중 같은 바코드를 가진 다른 상품이 있는지 확인한다.
+func checkDuplicateBarcode(ctx context.Context, repository domain.ProductRepository, userID int, barcode string, exceptID int) error {
+	const op cerrors.Op = "product/service/checkDuplicateBarcode"
+
+	duplicated, err := repository.GetProductByBarcode(ctx, userID, barcode)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+	}
+	if duplicated != nil && duplicated.ID != exceptID {
+		return cerrors.E(op, cerrors.Exist, "이미 같은 바코드의 상품이 있습니다.")
+	}
+
+	return nil
+}
+
+// attachOptionGroups
+// 상품 목록의 옵션 그룹을 한 번에 조회해 각 상품에 채운다.
+func (ps productService) attachOptionGroups(ctx context.Context, products []domain.Product) error {
+	const op cerrors.Op = "product/service/attachOptionGroups"
+
+	if len(products) == 0 {
+		return nil
+	}
+
+	productIDs := make([]int, 0, len(products))
+	for _, product := range products {
+		productIDs = append(productIDs, product.ID)
+	}
+
+	groups, err := ps.productRepository.ListProductOptionGroups(ctx, productIDs)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "상품 옵션을 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	groupsByProduct := make(map[int][]domain.ProductOptionGroup)
+	for _, group := range groups {
+		groupsByProduct[group.ProductID] = append(groupsByProduct[group.ProductID], group)
+	}
+	for i := range products {
+		products[i].OptionGroups = groupsByProduct[products[i].ID]
+	}
+
+	return nil
+}
+
+// attachImages
+// 상품 목록의 이미지를 한 번에 조회해 주소와 함께 각 상품에 채운다.
+func (ps productService) attachImages(ctx context.Context, products []domain.Product) error {
+	const op cerrors.Op = "product/service/attachImages"
+
+	if len(products) == 0 {
+		return nil
+	}
+
+	productIDs := make([]int, 0, len(products))
+	for _, product := range products {
+		productIDs = append(productIDs, product.ID)
+	}
+
+	images, err := ps.productRepository.ListProductImages(ctx, productIDs)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "상품 이미지를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	imagesByProduct := make(map[int][]domain.ProductImage)
+	for _, image := range images {
+		imagesByProduct[image.ProductID] = append(imagesByProduct[image.ProductID], ps.withImageURLs(image))
+	}
+	for i := range products {
+		products[i].Images = imagesByProduct[products[i].ID]
+	}
+
+	return nil
+}
+
+// attachTags
+// 상품 목록의 태그를 한 번에 조회해 각 상품에 채운다.
+func (ps productService) attachTags(ctx context.Context, products []domain.Product) error {
+	const op cerrors.Op = "product/service/attachTags"
+
+	if len(products) == 0 {
+		return nil
+	}
+
+	productIDs := make([]int, 0, len(products))
+	for _, product := range products {
+		productIDs = append(productIDs, product.ID)
+	}
+
+	productTags, err := ps.productRepository.ListProductTags(ctx, productIDs)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "상품 태그를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	tagsByProduct := make(map[int][]domain.Tag)
+	for _, productTag := range productTags {
+		tagsByProduct[productTag.ProductID] = append(tagsByProduct[productTag.ProductID], productTag.Tag)
+	}
+	for i := range products {
+		products[i].Tags = tagsByProduct[products[i].ID]
+	}
+
+	return nil
+}
+
+const backfillRomanizedBatchSize = 100
+
+// BackfillRomanized
+// 로마자 표기 컬럼이 추가되기 전에 만들어진 상품을 포함해 모든 상품의 로마자 표기를 다시 계산하고 변경된 상품 수를 반환한다.
+func (ps productService) BackfillRomanized(ctx context.Context) (int, error) {
+	const op cerrors.Op = "product/service/BackfillRomanized"
+
+	updated := 0
+	cursor := 0
+
+	for {
+		names, err := ps.productRepository.ListAllProductNamesAfter(ctx, cursor, backfillRomanizedBatchSize)
+		if err != nil {
+			return updated, cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+		}
+		if len(names) == 0 {
+			return updated, nil
+		}
+
+		for _, name := range names {
+			romanized := romanize(name.Name)
+			if romanized == name.Romanized {
+				continue
+			}
+			if err := ps.productRepository.UpdateProductRomanized(ctx, name.ID, romanized); err != nil {
+				return updated, cerrors.E(op, cerrors.Internal, err, "상품을 수정하는 중에 에러가 발생했습니다.")
+			}
+			updated++
+		}
+
+		cursor = names[len(names)-1].ID
+	}
+}
+
 func isKoreanChosung(s string) bool {
 	hasChosung := false
 
@@ -2,29 +2,61 @@ package product
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"golang.org/x/image/font/gofont/goregular"
 	"k8s.io/utils/pointer"
+	"os"
+	"path/filepath"
+	"payhere/config"
 	"payhere/domain"
 	"payhere/mocks"
+	cerrors "payhere/pkg/cerrors"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
 
 type productServiceTestSuite struct {
-	userRepository    *mocks.UserRepository
-	productRepository *mocks.ProductRepository
-	productService    domain.ProductService
+	userRepository     *mocks.UserRepository
+	productRepository  *mocks.ProductRepository
+	categoryRepository *mocks.CategoryRepository
+	imageStorage       *mocks.ImageStorage
+	productService     domain.ProductService
 }
 
 func setupUserServiceTestSuite(t *testing.T) productServiceTestSuite {
 	var us productServiceTestSuite
 
+	// 테스트에서는 한글 글리프가 없는 Go 폰트로 라벨을 그린다.
+	fontPath := filepath.Join(t.TempDir(), "label.ttf")
+	if err := os.WriteFile(fontPath, goregular.TTF, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
 	us.userRepository = mocks.NewUserRepository(t)
 	us.productRepository = mocks.NewProductRepository(t)
+	us.categoryRepository = mocks.NewCategoryRepository(t)
+	us.imageStorage = mocks.NewImageStorage(t)
 	us.productService = NewProductService(
 		us.userRepository,
 		us.productRepository,
+		us.categoryRepository,
+		us.imageStorage,
+		&config.Config{
+			App: config.App{
+				PublicURL: "https://payhere.in",
+			},
+			Label: config.Label{
+				FontPath: fontPath,
+			},
+			Trash: config.Trash{
+				RetentionDays: 7,
+			},
+		},
 	)
 
 	return us
@@ -48,33 +80,204 @@ func Test_productService_CreateProduct(t *testing.T) {
 				ctx: context.Background(),
 				req: domain.CreateProductRequest{
 					UserID:      1,
-					Category:    "category",
+					CategoryID:  1,
 					Price:       1000,
 					Cost:        500,
 					Name:        "슈크림 라떼",
 					Description: "description",
 					Barcode:     "barcode",
 					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
-					Size:        domain.ProductSizeTypeSmall,
+					OptionGroups: []domain.ProductOptionGroupRequest{
+						{
+							Name:       "사이즈",
+							SelectType: domain.ProductOptionSelectTypeSingle,
+							Required:   true,
+							Options: []domain.ProductOptionRequest{
+								{Name: "레귤러"},
+								{Name: "라지", PriceDelta: 500, CostDelta: 200},
+							},
+						},
+						{
+							Name:       "시럽 추가",
+							SelectType: domain.ProductOptionSelectTypeMulti,
+							Options: []domain.ProductOptionRequest{
+								{Name: "바닐라 시럽", PriceDelta: 300, CostDelta: 100},
+								{Name: "헤이즐넛 시럽", PriceDelta: 300, CostDelta: 100},
+							},
+						},
+					},
 				},
 			},
 			mock: func(ts productServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&domain.Category{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "category",
+				}, nil).Once()
+				ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 1, "barcode").Return(nil, nil).Once()
 				ts.productRepository.On("CreateProduct", context.Background(), domain.Product{
 					UserID:      1,
-					Category:    "category",
+					CategoryID:  1,
 					Initial:     "ㅅㅋㄹ ㄹㄸ",
+					Romanized:   "syukeurim ratte",
 					Price:       1000,
 					Cost:        500,
 					Name:        "슈크림 라떼",
 					Description: "description",
 					Barcode:     "barcode",
 					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
-					Size:        domain.ProductSizeTypeSmall,
+					OptionGroups: []domain.ProductOptionGroup{
+						{
+							Name:       "사이즈",
+							SelectType: domain.ProductOptionSelectTypeSingle,
+							Required:   true,
+							MinSelect:  1,
+							MaxSelect:  1,
+							Options: []domain.ProductOption{
+								{Name: "레귤러"},
+								{Name: "라지", PriceDelta: 500, CostDelta: 200, DisplayOrder: 1},
+							},
+						},
+						{
+							Name:         "시럽 추가",
+							SelectType:   domain.ProductOptionSelectTypeMulti,
+							MinSelect:    0,
+							MaxSelect:    2,
+							DisplayOrder: 1,
+							Options: []domain.ProductOption{
+								{Name: "바닐라 시럽", PriceDelta: 300, CostDelta: 100},
+								{Name: "헤이즐넛 시럽", PriceDelta: 300, CostDelta: 100, DisplayOrder: 1},
+							},
+						},
+					},
 				}).Return(0, nil)
 
 			},
 			wantErr: false,
 		},
+		{
+			name: "FAIL - 다른 사장님의 카테고리",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateProductRequest{
+					UserID:      1,
+					CategoryID:  3,
+					Price:       1000,
+					Cost:        500,
+					Name:        "슈크림 라떼",
+					Description: "description",
+					Barcode:     "barcode",
+					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 3).Return(&domain.Category{
+					Base: domain.Base{
+						ID: 3,
+					},
+					UserID: 2,
+					Name:   "category",
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 이미 같은 바코드의 상품이 있음",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateProductRequest{
+					UserID:      1,
+					CategoryID:  1,
+					Price:       1000,
+					Cost:        500,
+					Name:        "슈크림 라떼",
+					Description: "description",
+					Barcode:     "barcode",
+					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&domain.Category{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "category",
+				}, nil).Once()
+				ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 1, "barcode").Return(&domain.Product{
+					Base: domain.Base{
+						ID: 7,
+					},
+					UserID:  1,
+					Barcode: "barcode",
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "PASS - 템플릿에서 시작해 바코드와 유통기한만 보냄",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateProductRequest{
+					UserID:          1,
+					Barcode:         "barcode",
+					InternalBarcode: true,
+					ExpiryDate:      time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+					TemplateID:      pointer.Int(3),
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProductTemplate(mock.Anything, 3).Return(&domain.ProductTemplate{
+					ID:          3,
+					UserID:      1,
+					Name:        "라떼 기본",
+					CategoryID:  1,
+					Price:       1000,
+					Cost:        500,
+					ProductName: "슈크림 라떼",
+					Description: "description",
+				}, nil).Once()
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&domain.Category{
+					Base: domain.Base{
+						ID: 1,
+					},
+					UserID: 1,
+					Name:   "category",
+				}, nil).Once()
+				ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 1, "barcode").Return(nil, nil).Once()
+				ts.productRepository.EXPECT().CreateProduct(mock.Anything, domain.Product{
+					UserID:      1,
+					CategoryID:  1,
+					Initial:     "ㅅㅋㄹ ㄹㄸ",
+					Romanized:   "syukeurim ratte",
+					Price:       1000,
+					Cost:        500,
+					Name:        "슈크림 라떼",
+					Description: "description",
+					Barcode:     "barcode",
+					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+				}).Return(101, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 다른 사장님의 템플릿",
+			args: args{
+				ctx: context.Background(),
+				req: domain.CreateProductRequest{
+					UserID:     1,
+					Barcode:    "barcode",
+					ExpiryDate: time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+					TemplateID: pointer.Int(3),
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProductTemplate(mock.Anything, 3).Return(&domain.ProductTemplate{ID: 3, UserID: 2}, nil).Once()
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -96,6 +299,223 @@ func Test_productService_CreateProduct(t *testing.T) {
 	}
 }
 
+func Test_productService_ImportProducts(t *testing.T) {
+	expiryDate := time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC)
+	file := []byte("categoryID,price,cost,name,description,barcode,expiryDate\n" +
+		"1,1000,500,슈크림 라떼,설명,8801234567893,2025-06-10\n" +
+		"1,3000,1500,아메리카노,설명,1234,2025-06-10\n" +
+		"1,3500,1500,카페라떼,설명,8801234567893,2025-06-10\n" +
+		"2,4000,2000,녹차라떼,설명,96385074,2025-06-10\n")
+	latte := domain.Product{
+		UserID:      1,
+		Initial:     "ㅅㅋㄹ ㄹㄸ",
+		Romanized:   "syukeurim ratte",
+		CategoryID:  1,
+		Price:       1000,
+		Cost:        500,
+		Name:        "슈크림 라떼",
+		Description: "설명",
+		Barcode:     "8801234567893",
+		ExpiryDate:  expiryDate,
+	}
+	wantRows := func(productID *int) []domain.ImportProductRowDTO {
+		return []domain.ImportProductRowDTO{
+			{Row: 2, Name: "슈크림 라떼", Barcode: "8801234567893", Status: domain.ImportProductRowStatusAccepted, ProductID: productID},
+			{Row: 3, Name: "아메리카노", Barcode: "1234", Status: domain.ImportProductRowStatusFailed, Error: "바코드를 확인해주세요."},
+			{Row: 4, Name: "카페라떼", Barcode: "8801234567893", Status: domain.ImportProductRowStatusFailed, Error: "2행과 바코드가 같습니다."},
+			{Row: 5, Name: "녹차라떼", Barcode: "96385074", Status: domain.ImportProductRowStatusFailed, Error: "카테고리를 확인해주세요."},
+		}
+	}
+	expectChecks := func(ts productServiceTestSuite) {
+		ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&domain.Category{UserID: 1}, nil).Once()
+		ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 2).Return(&domain.Category{UserID: 2}, nil).Once()
+		ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 1, "8801234567893").Return(nil, nil).Once()
+	}
+
+	tests := []struct {
+		name    string
+		req     domain.ImportProductsRequest
+		mock    func(ts productServiceTestSuite)
+		want    domain.ImportProductsResponse
+		wantErr bool
+	}{
+		{
+			name: "PASS - 검사만 하고 상품은 만들지 않는다",
+			req:  domain.ImportProductsRequest{UserID: 1, DryRun: true, FileName: "menu.csv", File: file},
+			mock: expectChecks,
+			want: domain.ImportProductsResponse{DryRun: true, Total: 4, Accepted: 1, Failed: 3, Rows: wantRows(nil)},
+		},
+		{
+			name: "PASS - 통과한 행만 만든다",
+			req:  domain.ImportProductsRequest{UserID: 1, FileName: "menu.csv", File: file},
+			mock: func(ts productServiceTestSuite) {
+				expectChecks(ts)
+				ts.productRepository.EXPECT().CreateProducts(mock.Anything, []domain.Product{latte}).Return([]int{33}, nil).Once()
+			},
+			want: domain.ImportProductsResponse{Total: 4, Accepted: 1, Failed: 3, Rows: wantRows(pointer.Int(33))},
+		},
+		{
+			name:    "FAIL - 상품이 없는 파일",
+			req:     domain.ImportProductsRequest{UserID: 1, FileName: "menu.csv", File: []byte("categoryID,price,cost,name,description,barcode,expiryDate\n")},
+			mock:    func(ts productServiceTestSuite) {},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.productService.ImportProducts(context.Background(), tt.req)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_productService_BatchProducts(t *testing.T) {
+	create := domain.CreateProductRequest{
+		CategoryID:  1,
+		Price:       1000,
+		Cost:        500,
+		Name:        "슈크림 라떼",
+		Description: "description",
+		Barcode:     "8801234567893",
+		ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+	}
+	operations := []domain.BatchProductOperation{
+		{Op: domain.BatchProductOperationCreate, Create: &create},
+		{Op: domain.BatchProductOperationPatch, Patch: &domain.PatchProductRequest{ID: 100, Price: pointer.Float64(2000)}},
+		{Op: domain.BatchProductOperationDelete, Delete: &domain.DeleteProductRequest{ID: 101}},
+	}
+
+	t.Run("PASS - 모든 작업을 한 트랜잭션으로 반영한다", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(domain.ProductRepository) error) error {
+			return fn(ts.productRepository)
+		}).Once()
+		ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&domain.Category{Base: domain.Base{ID: 1}, UserID: 1}, nil).Once()
+		ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 1, "8801234567893").Return(nil, nil).Once()
+		ts.productRepository.EXPECT().CreateProduct(mock.Anything, mock.MatchedBy(func(product domain.Product) bool {
+			return product.UserID == 1 && product.Initial == "ㅅㅋㄹ ㄹㄸ"
+		})).Return(10, nil).Once()
+		ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{Base: domain.Base{ID: 100}, UserID: 1, Price: 1000}, nil).Once()
+		ts.productRepository.EXPECT().UpdateProduct(mock.Anything, mock.MatchedBy(func(product domain.Product) bool {
+			return product.ID == 100 && product.Price == 2000
+		})).Return(nil).Once()
+		ts.productRepository.EXPECT().CreatePriceHistory(mock.Anything, mock.MatchedBy(func(history domain.PriceHistory) bool {
+			return history.ProductID == 100 && history.OldPrice == 1000 && history.NewPrice == 2000 && *history.UserID == 1
+		})).Return(nil).Once()
+		ts.productRepository.EXPECT().GetProduct(mock.Anything, 101).Return(&domain.Product{Base: domain.Base{ID: 101}, UserID: 1}, nil).Once()
+		ts.productRepository.EXPECT().DeleteProduct(mock.Anything, 101, 0).Return(nil).Once()
+
+		// when
+		got, err := ts.productService.BatchProducts(context.Background(), domain.BatchProductsRequest{UserID: 1, Operations: operations})
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, got.Applied)
+		assert.Len(t, got.Results, 3)
+		for i, productID := range []int{10, 100, 101} {
+			assert.Equal(t, domain.BatchProductResultStatusApplied, got.Results[i].Status)
+			assert.Equal(t, productID, *got.Results[i].ProductID)
+		}
+	})
+
+	t.Run("FAIL - 실패한 작업이 있으면 모두 되돌리고 작업별 결과를 응답한다", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(domain.ProductRepository) error) error {
+			return fn(ts.productRepository)
+		}).Once()
+		ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&domain.Category{Base: domain.Base{ID: 1}, UserID: 1}, nil).Once()
+		ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 1, "8801234567893").Return(nil, nil).Once()
+		ts.productRepository.EXPECT().CreateProduct(mock.Anything, mock.Anything).Return(10, nil).Once()
+		ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{Base: domain.Base{ID: 100}, UserID: 2}, nil).Once()
+
+		// when
+		got, err := ts.productService.BatchProducts(context.Background(), domain.BatchProductsRequest{UserID: 1, Operations: operations})
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, got.Applied)
+		assert.Equal(t, domain.BatchProductResultStatusRolledBack, got.Results[0].Status)
+		assert.Nil(t, got.Results[0].ProductID)
+		assert.Equal(t, domain.BatchProductResultStatusFailed, got.Results[1].Status)
+		assert.Equal(t, "상품을 수정할 권한이 없습니다.", got.Results[1].Error)
+		assert.Equal(t, domain.BatchProductResultStatusSkipped, got.Results[2].Status)
+	})
+
+	t.Run("FAIL - DB 에러는 결과 대신 에러로 반환한다", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().WithTx(mock.Anything, mock.Anything).Return(cerrors.E(cerrors.Op("test"), cerrors.Internal, errors.New("db"), "서버 에러가 발생했습니다.")).Once()
+
+		// when
+		_, err := ts.productService.BatchProducts(context.Background(), domain.BatchProductsRequest{UserID: 1, Operations: operations})
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Internal, err))
+	})
+}
+
+func Test_productService_ExportProducts(t *testing.T) {
+	t.Run("PASS - 목록 조회 조건으로 상품을 모두 batch 로 나눠 내보낸다", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		count := exportBatchSize + 1
+		ts.productRepository.EXPECT().EachProduct(mock.Anything, mock.MatchedBy(func(params domain.ListProductsParams) bool {
+			return params.UserID == 1 && *params.Name == "라떼" && *params.CategoryID == 2 &&
+				reflect.DeepEqual(params.TagIDs, []int{3}) && params.TagMatch == domain.TagMatchAll
+		}), mock.Anything).RunAndReturn(func(ctx context.Context, params domain.ListProductsParams, fn func(domain.Product) error) error {
+			for i := 1; i <= count; i++ {
+				if err := fn(domain.Product{Base: domain.Base{ID: i}, UserID: 1, Name: "라떼"}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Once()
+		ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, mock.Anything).Return(nil, nil).Times(2)
+
+		// when
+		var buf strings.Builder
+		err := ts.productService.ExportProducts(context.Background(), domain.ExportProductsRequest{
+			UserID:     1,
+			Format:     domain.ProductExportFormatCSV,
+			Search:     pointer.String("라떼"),
+			CategoryID: pointer.Int(2),
+			TagIDs:     []int{3},
+			TagMatch:   domain.TagMatchAll,
+		}, &buf)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n"), count+1)
+	})
+
+	t.Run("FAIL - 상품 조회 에러", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().EachProduct(mock.Anything, mock.Anything, mock.Anything).Return(cerrors.E(cerrors.Op("test"), cerrors.Internal, errors.New("db"), "서버 에러가 발생했습니다.")).Once()
+
+		// when
+		var buf strings.Builder
+		err := ts.productService.ExportProducts(context.Background(), domain.ExportProductsRequest{UserID: 1}, &buf)
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Internal, err))
+		assert.Empty(t, buf.String())
+	})
+}
+
 func Test_productService_GetProduct(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -132,24 +552,75 @@ func Test_productService_GetProduct(t *testing.T) {
 					Description: "description",
 					Barcode:     "barcode",
 					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
-					Size:        domain.ProductSizeTypeSmall,
 				}, nil)
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{100}).Return([]domain.ProductOptionGroup{
+					{
+						ID:         1,
+						ProductID:  100,
+						Name:       "사이즈",
+						SelectType: domain.ProductOptionSelectTypeSingle,
+						Required:   true,
+						MinSelect:  1,
+						MaxSelect:  1,
+						Options: []domain.ProductOption{
+							{ID: 1, OptionGroupID: 1, Name: "레귤러"},
+							{ID: 2, OptionGroupID: 1, Name: "라지", PriceDelta: 500, CostDelta: 200, DisplayOrder: 1},
+						},
+					},
+				}, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return([]domain.ProductImage{
+					{ID: 1, ProductID: 100, StorageKey: "products/100/abc", ContentType: "image/png", Width: 800, Height: 600, Primary: true},
+				}, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{100}).Return([]domain.ProductTag{
+					{ProductID: 100, Tag: domain.Tag{ID: 1, UserID: 1, Name: "신메뉴"}},
+				}, nil).Once()
+				ts.imageStorage.EXPECT().URL(mock.Anything).RunAndReturn(func(key string) string {
+					return "https://cdn.payhere.in/" + key
+				}).Times(3)
 			},
 			want: domain.GetProductResponse{
 				Product: domain.ProductDTO{
 					BaseDTO: domain.BaseDTO{
 						ID: 100,
 					},
-					UserID:      1,
-					Category:    "category",
-					Initial:     "ㅅㅋㄹ ㄹㄸ",
-					Price:       1000,
-					Cost:        500,
-					Name:        "슈크림 라떼",
-					Description: "description",
-					Barcode:     "barcode",
-					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
-					Size:        domain.ProductSizeTypeSmall,
+					UserID:         1,
+					Category:       "category",
+					Initial:        "ㅅㅋㄹ ㄹㄸ",
+					Price:          1000,
+					EffectivePrice: 1000,
+					Cost:           500,
+					Name:           "슈크림 라떼",
+					Description:    "description",
+					Barcode:        "barcode",
+					ExpiryDate:     time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+					OptionGroups: []domain.ProductOptionGroupDTO{
+						{
+							ID:         1,
+							Name:       "사이즈",
+							SelectType: domain.ProductOptionSelectTypeSingle,
+							Required:   true,
+							MinSelect:  1,
+							MaxSelect:  1,
+							Options: []domain.ProductOptionDTO{
+								{ID: 1, Name: "레귤러"},
+								{ID: 2, Name: "라지", PriceDelta: 500, CostDelta: 200},
+							},
+						},
+					},
+					Images: []domain.ProductImageDTO{
+						{
+							ID:           1,
+							Primary:      true,
+							Width:        800,
+							Height:       600,
+							URL:          "https://cdn.payhere.in/products/100/abc/original.png",
+							MediumURL:    "https://cdn.payhere.in/products/100/abc/medium.png",
+							ThumbnailURL: "https://cdn.payhere.in/products/100/abc/thumbnail.png",
+						},
+					},
+					Tags: []domain.ProductTagDTO{
+						{ID: 1, Name: "신메뉴"},
+					},
 				},
 			},
 			wantErr: false,
@@ -192,7 +663,6 @@ func Test_productService_GetProduct(t *testing.T) {
 					Description: "description",
 					Barcode:     "barcode",
 					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
-					Size:        domain.ProductSizeTypeSmall,
 				}, nil).Once()
 			},
 			want:    domain.GetProductResponse{},
@@ -220,72 +690,84 @@ func Test_productService_GetProduct(t *testing.T) {
 	}
 }
 
-func Test_productService_PatchProduct(t *testing.T) {
+func Test_productService_GetProductByBarcode(t *testing.T) {
 	type args struct {
 		ctx context.Context
-		req domain.PatchProductRequest
+		req domain.GetProductByBarcodeRequest
 	}
 
 	tests := []struct {
 		name    string
 		args    args
 		mock    func(ts productServiceTestSuite)
+		want    domain.GetProductResponse
 		wantErr bool
 	}{
 		{
-			name: "PASS - 상품 수정",
+			name: "PASS - 바코드로 상품 조회 성공",
 			args: args{
-				ctx: nil,
-				req: domain.PatchProductRequest{
-					UserID:      2,
-					ID:          100,
-					Category:    pointer.String("modified category"),
-					Price:       pointer.Float64(2000),
-					Cost:        pointer.Float64(1000),
-					Name:        pointer.String("수정된 모카"),
-					Description: pointer.String("modified description"),
-					Barcode:     pointer.String("modified barcode"),
-					ExpiryDate: func() *time.Time {
-						t := time.Date(2026, time.June, 10, 0, 0, 0, 0, time.UTC)
-						return &t
-					}(),
-					Size: domain.ProductSizeTypeLarge.ToPointer(),
+				ctx: context.Background(),
+				req: domain.GetProductByBarcodeRequest{
+					UserID:  1,
+					Barcode: "8801234567893",
 				},
 			},
 			mock: func(ts productServiceTestSuite) {
-				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+				ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 1, "8801234567893").Return(&domain.Product{
 					Base: domain.Base{
 						ID: 100,
 					},
-					UserID:      2,
-					Category:    "original category",
-					Initial:     "ㅇㄹㅈㄴ ㄹㄸ",
+					UserID:      1,
+					Category:    "category",
+					Initial:     "ㅅㅋㄹ ㄹㄸ",
 					Price:       1000,
 					Cost:        500,
-					Name:        "오리지널 라떼",
+					Name:        "슈크림 라떼",
 					Description: "description",
-					Barcode:     "barcode",
+					Barcode:     "8801234567893",
 					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
-					Size:        domain.ProductSizeTypeSmall,
 				}, nil).Once()
-				ts.productRepository.EXPECT().UpdateProduct(mock.Anything, domain.Product{
-					Base: domain.Base{
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{100}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{100}).Return(nil, nil).Once()
+			},
+			want: domain.GetProductResponse{
+				Product: domain.ProductDTO{
+					BaseDTO: domain.BaseDTO{
 						ID: 100,
 					},
-					UserID:      2,
-					Initial:     "ㅅㅈㄷ ㅁㅋ",
-					Category:    "modified category",
-					Price:       2000,
-					Cost:        1000,
-					Name:        "수정된 모카",
-					Description: "modified description",
-					Barcode:     "modified barcode",
-					ExpiryDate:  time.Date(2026, time.June, 10, 0, 0, 0, 0, time.UTC),
-					Size:        domain.ProductSizeTypeLarge,
-				}).Return(nil).Once()
+					UserID:         1,
+					Category:       "category",
+					Initial:        "ㅅㅋㄹ ㄹㄸ",
+					Price:          1000,
+					EffectivePrice: 1000,
+					Cost:           500,
+					Name:           "슈크림 라떼",
+					Description:    "description",
+					Barcode:        "8801234567893",
+					ExpiryDate:     time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+					OptionGroups:   []domain.ProductOptionGroupDTO{},
+					Images:         []domain.ProductImageDTO{},
+					Tags:           []domain.ProductTagDTO{},
+				},
 			},
 			wantErr: false,
 		},
+		{
+			name: "FAIL - 바코드에 해당하는 상품이 없는 경우",
+			args: args{
+				ctx: context.Background(),
+				req: domain.GetProductByBarcodeRequest{
+					UserID:  1,
+					Barcode: "8801234567893",
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 1, "8801234567893").Return(nil, nil).Once()
+			},
+			want:    domain.GetProductResponse{},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -295,98 +777,206 @@ func Test_productService_PatchProduct(t *testing.T) {
 			tt.mock(ts)
 
 			// when
-			err := ts.productService.PatchProduct(tt.args.ctx, tt.args.req)
+			got, err := ts.productService.GetProductByBarcode(tt.args.ctx, tt.args.req)
 
 			// then
-			ts.userRepository.AssertExpectations(t)
 			ts.productRepository.AssertExpectations(t)
-			if err != nil {
-				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
-			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
 		})
 	}
 }
 
-func Test_productService_DeleteProduct(t *testing.T) {
-	type args struct {
-		ctx context.Context
-		req domain.DeleteProductRequest
+func Test_productService_GetProductBarcodeImage(t *testing.T) {
+	product := func(barcode string) *domain.Product {
+		return &domain.Product{
+			Base: domain.Base{
+				ID:         100,
+				UpdateDate: time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC),
+			},
+			UserID:  1,
+			Name:    "슈크림 라떼",
+			Barcode: barcode,
+		}
 	}
 
 	tests := []struct {
-		name    string
-		args    args
-		mock    func(ts productServiceTestSuite)
-		wantErr bool
+		name            string
+		req             domain.GetProductBarcodeImageRequest
+		mock            func(ts productServiceTestSuite)
+		wantContentType string
+		wantErr         bool
 	}{
 		{
-			name: "PASS - 상품 삭제",
-			args: args{
-				ctx: context.Background(),
-				req: domain.DeleteProductRequest{
-					UserID: 1,
-					ID:     100,
-				},
+			name: "PASS - EAN-13 바코드 PNG",
+			req:  domain.GetProductBarcodeImageRequest{UserID: 1, ProductID: 100, Format: domain.BarcodeImageFormatPNG},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product("8801234567893"), nil).Once()
 			},
+			wantContentType: "image/png",
+			wantErr:         false,
+		},
+		{
+			name: "PASS - 매장 자체 바코드 SVG",
+			req:  domain.GetProductBarcodeImageRequest{UserID: 1, ProductID: 100, Format: domain.BarcodeImageFormatSVG},
 			mock: func(ts productServiceTestSuite) {
-				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
-					Base: domain.Base{
-						ID: 100,
-					},
-					UserID:      1,
-					Category:    "original category",
-					Initial:     "ㅇㄹㅈㄴ ㄹㄸ",
-					Price:       1000,
-					Cost:        500,
-					Name:        "오리지널 라떼",
-					Description: "description",
-					Barcode:     "barcode",
-					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
-					Size:        domain.ProductSizeTypeSmall,
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product("STORE-001"), nil).Once()
+			},
+			wantContentType: "image/svg+xml",
+			wantErr:         false,
+		},
+		{
+			name: "FAIL - EAN-13 으로 그릴 수 없는 바코드",
+			req:  domain.GetProductBarcodeImageRequest{UserID: 1, ProductID: 100, Format: domain.BarcodeImageFormatPNG, Symbology: domain.BarcodeSymbologyEAN13},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product("STORE-001"), nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 바코드를 그리기에 작은 이미지",
+			req:  domain.GetProductBarcodeImageRequest{UserID: 1, ProductID: 100, Format: domain.BarcodeImageFormatPNG, Width: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product("8801234567893"), nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 다른 사장님의 상품",
+			req:  domain.GetProductBarcodeImageRequest{UserID: 2, ProductID: 100, Format: domain.BarcodeImageFormatPNG},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product("8801234567893"), nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.productService.GetProductBarcodeImage(context.Background(), tt.req)
+
+			// then
+			ts.productRepository.AssertExpectations(t)
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.Equal(t, tt.wantContentType, got.ContentType)
+				assert.NotEmpty(t, got.Data)
+				assert.NotEmpty(t, got.ETag)
+				assert.Equal(t, time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC), got.LastModified)
+			}
+		})
+	}
+}
+
+func Test_productService_GetProductQRCode(t *testing.T) {
+	// given
+	ts := setupUserServiceTestSuite(t)
+	ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+		Base: domain.Base{
+			ID: 100,
+		},
+		UserID: 1,
+	}, nil).Twice()
+
+	// when
+	first, err := ts.productService.GetProductQRCode(context.Background(), domain.GetProductQRCodeRequest{UserID: 1, ProductID: 100, Format: domain.BarcodeImageFormatSVG})
+	assert.NoError(t, err)
+	second, err := ts.productService.GetProductQRCode(context.Background(), domain.GetProductQRCodeRequest{UserID: 1, ProductID: 100, Format: domain.BarcodeImageFormatSVG})
+	assert.NoError(t, err)
+
+	// then
+	assert.Equal(t, "image/svg+xml", first.ContentType)
+	assert.Contains(t, string(first.Data), `width="256" height="256"`)
+	assert.Equal(t, first.ETag, second.ETag)
+}
+
+func Test_productService_CreateProductLabels(t *testing.T) {
+	product := func(id int, name string) domain.Product {
+		return domain.Product{
+			Base: domain.Base{
+				ID: id,
+			},
+			UserID:  1,
+			Name:    name,
+			Price:   3500,
+			Barcode: "8801234567893",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		req     domain.CreateProductLabelsRequest
+		mock    func(ts productServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 상품 ID 로 라벨 출력",
+			req:  domain.CreateProductLabelsRequest{UserID: 1, ProductIDs: []int{2, 1}, Copies: 2},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListProductsByIDs(mock.Anything, 1, []int{2, 1}).Return([]domain.Product{
+					product(1, "아메리카노"),
+					product(2, "카페라떼"),
 				}, nil).Once()
-				ts.productRepository.EXPECT().DeleteProduct(mock.Anything, 100).Return(nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{2, 1}).Return(nil, nil).Once()
 			},
 			wantErr: false,
 		},
 		{
-			name: "PASS - 상품을 찾을 수 없는 경우",
-			args: args{
-				ctx: context.Background(),
-				req: domain.DeleteProductRequest{
-					UserID: 1,
-					ID:     100,
-				},
+			name: "PASS - 검색 조건으로 모든 페이지의 상품 라벨 출력",
+			req:  domain.CreateProductLabelsRequest{UserID: 1, Filter: &domain.ProductLabelFilter{CategoryID: pointer.Int(1), TagIDs: []int{3}, TagMatch: domain.TagMatchAny}},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
+					UserID:     1,
+					CategoryID: pointer.Int(1),
+					TagIDs:     []int{3},
+					TagMatch:   domain.TagMatchAny,
+				}).Return([]domain.Product{product(1, "아메리카노"), product(2, "카페라떼")}, nil).Once()
+				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
+					UserID:     1,
+					CategoryID: pointer.Int(1),
+					TagIDs:     []int{3},
+					TagMatch:   domain.TagMatchAny,
+					Cursor:     pointer.Int(2),
+				}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{1, 2}).Return(nil, nil).Once()
 			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 없거나 다른 사장님의 상품",
+			req:  domain.CreateProductLabelsRequest{UserID: 1, ProductIDs: []int{1, 3}},
 			mock: func(ts productServiceTestSuite) {
-				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductsByIDs(mock.Anything, 1, []int{1, 3}).Return([]domain.Product{
+					product(1, "아메리카노"),
+				}, nil).Once()
 			},
 			wantErr: true,
 		},
 		{
-			name: "PASS - 상품을 삭제할 권한이 없는 경우",
-			args: args{
-				ctx: context.Background(),
-				req: domain.DeleteProductRequest{
+			name: "FAIL - 검색 결과가 없음",
+			req:  domain.CreateProductLabelsRequest{UserID: 1, Filter: &domain.ProductLabelFilter{Search: pointer.String("없는 상품")}},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
 					UserID: 1,
-					ID:     100,
-				},
+					Name:   pointer.String("없는 상품"),
+				}).Return(nil, nil).Once()
 			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 최대 라벨 수 초과",
+			req:  domain.CreateProductLabelsRequest{UserID: 1, ProductIDs: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, Copies: 100},
 			mock: func(ts productServiceTestSuite) {
-				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
-					Base: domain.Base{
-						ID: 100,
-					},
-					UserID:      2,
-					Category:    "original category",
-					Initial:     "ㅇㄹㅈㄴ ㄹㄸ",
-					Price:       1000,
-					Cost:        500,
-					Name:        "오리지널 라떼",
-					Description: "description",
-					Barcode:     "barcode",
-					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
-					Size:        domain.ProductSizeTypeSmall,
-				}, nil).Once()
+				var products []domain.Product
+				for id := 1; id <= 11; id++ {
+					products = append(products, product(id, "아메리카노"))
+				}
+				ts.productRepository.EXPECT().ListProductsByIDs(mock.Anything, 1, mock.Anything).Return(products, nil).Once()
 			},
 			wantErr: true,
 		},
@@ -399,301 +989,266 @@ func Test_productService_DeleteProduct(t *testing.T) {
 			tt.mock(ts)
 
 			// when
-			err := ts.productService.DeleteProduct(tt.args.ctx, tt.args.req)
+			got, err := ts.productService.CreateProductLabels(context.Background(), tt.req)
 
 			// then
-			ts.userRepository.AssertExpectations(t)
 			ts.productRepository.AssertExpectations(t)
-			if err != nil {
-				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				assert.True(t, strings.HasPrefix(string(got), "%PDF"))
 			}
 		})
 	}
 }
 
-func Test_productService_ListProducts(t *testing.T) {
+func Test_productService_PatchProduct(t *testing.T) {
 	type args struct {
 		ctx context.Context
-		req domain.ListProductsRequest
+		req domain.PatchProductRequest
 	}
 
 	tests := []struct {
 		name    string
 		args    args
 		mock    func(ts productServiceTestSuite)
-		want    domain.ListProductsResponse
 		wantErr bool
 	}{
 		{
-			name: "PASS - 조건 없이 조회",
+			name: "PASS - 상품 수정",
 			args: args{
-				ctx: context.Background(),
-				req: domain.ListProductsRequest{
-					UserID: 1,
-					Cursor: nil,
-					Search: nil,
+				ctx: nil,
+				req: domain.PatchProductRequest{
+					UserID:      2,
+					ID:          100,
+					CategoryID:  pointer.Int(2),
+					Price:       pointer.Float64(2000),
+					Cost:        pointer.Float64(1000),
+					Name:        pointer.String("수정된 모카"),
+					Description: pointer.String("modified description"),
+					Barcode:     pointer.String("modified barcode"),
+					OptionGroups: &[]domain.ProductOptionGroupRequest{
+						{
+							Name:       "온도",
+							SelectType: domain.ProductOptionSelectTypeSingle,
+							Required:   true,
+							Options:    []domain.ProductOptionRequest{{Name: "HOT"}, {Name: "ICE"}},
+						},
+					},
 				},
 			},
 			mock: func(ts productServiceTestSuite) {
-				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
-					UserID: 1,
-					Cursor: nil,
-					Name:   nil,
-				}).Return([]domain.Product{
-					{
-						Base: domain.Base{
-							ID: 1,
-						},
-						UserID:      1,
-						Initial:     "ㅅㅋㄹ ㄹㄸ",
-						Category:    "payhere",
-						Price:       1000,
-						Cost:        500,
-						Name:        "슈크림 라떼",
-						Description: "description",
-						Barcode:     "barcode",
-						ExpiryDate:  time.Time{},
-						Size:        domain.ProductSizeTypeSmall,
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+					Base: domain.Base{
+						ID: 100,
+					},
+					UserID:      2,
+					CategoryID:  1,
+					Category:    "original category",
+					Initial:     "ㅇㄹㅈㄴ ㄹㄸ",
+					Price:       1000,
+					Cost:        500,
+					Name:        "오리지널 라떼",
+					Description: "description",
+					Barcode:     "barcode",
+					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+				}, nil).Once()
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 2).Return(&domain.Category{
+					Base: domain.Base{
+						ID: 2,
 					},
+					UserID: 2,
+					Name:   "modified category",
 				}, nil).Once()
-			},
-			want: domain.ListProductsResponse{
-				Products: []domain.ProductDTO{
+				ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 2, "modified barcode").Return(nil, nil).Once()
+				ts.productRepository.EXPECT().UpdateProduct(mock.Anything, domain.Product{
+					Base: domain.Base{
+						ID: 100,
+					},
+					UserID:      2,
+					Initial:     "ㅅㅈㄷ ㅁㅋ",
+					Romanized:   "sujeongdoen moka",
+					CategoryID:  2,
+					Category:    "original category",
+					Price:       2000,
+					Cost:        1000,
+					Name:        "수정된 모카",
+					Description: "modified description",
+					Barcode:     "modified barcode",
+					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+				}).Return(nil).Once()
+				ts.productRepository.EXPECT().CreatePriceHistory(mock.Anything, mock.MatchedBy(func(history domain.PriceHistory) bool {
+					return history.ProductID == 100 &&
+						*history.UserID == 2 &&
+						history.Reason == domain.PriceChangeReasonManual &&
+						history.OldPrice == 1000 && history.NewPrice == 2000 &&
+						history.OldCost == 500 && history.NewCost == 1000 &&
+						history.OldEffectivePrice == 1000 && history.NewEffectivePrice == 2000
+				})).Return(nil).Once()
+				ts.productRepository.EXPECT().ReplaceProductOptionGroups(mock.Anything, 100, []domain.ProductOptionGroup{
 					{
-						BaseDTO: domain.BaseDTO{
-							ID: 1,
+						Name:       "온도",
+						SelectType: domain.ProductOptionSelectTypeSingle,
+						Required:   true,
+						MinSelect:  1,
+						MaxSelect:  1,
+						Options: []domain.ProductOption{
+							{Name: "HOT"},
+							{Name: "ICE", DisplayOrder: 1},
 						},
-						UserID:      1,
-						Initial:     "ㅅㅋㄹ ㄹㄸ",
-						Category:    "payhere",
-						Price:       1000,
-						Cost:        500,
-						Name:        "슈크림 라떼",
-						Description: "description",
-						Barcode:     "barcode",
-						ExpiryDate:  time.Time{},
-						Size:        domain.ProductSizeTypeSmall,
 					},
-				},
-				Cursor: pointer.Int(1),
+				}).Return(nil).Once()
 			},
 			wantErr: false,
 		},
 		{
-			name: "PASS - 커서 값이 있는 경우",
+			name: "PASS - 할인 중인 상품의 정가를 내리면 할인가를 지우고 새 정가로 기록",
 			args: args{
-				ctx: context.Background(),
-				req: domain.ListProductsRequest{
-					UserID: 1,
-					Cursor: pointer.Int(10),
-					Search: nil,
+				ctx: nil,
+				req: domain.PatchProductRequest{
+					UserID: 2,
+					ID:     100,
+					Price:  pointer.Float64(2500),
 				},
 			},
 			mock: func(ts productServiceTestSuite) {
-				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
-					UserID: 1,
-					Cursor: pointer.Int(10),
-				}).Return([]domain.Product{
-					{
-						Base: domain.Base{
-							ID: 11,
-						},
-						UserID:      1,
-						Initial:     "ㅅㅋㄹ ㄹㄸ",
-						Category:    "payhere",
-						Price:       1000,
-						Cost:        500,
-						Name:        "슈크림 라떼",
-						Description: "description",
-						Barcode:     "barcode",
-						ExpiryDate:  time.Time{},
-						Size:        domain.ProductSizeTypeSmall,
-					},
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+					Base:          domain.Base{ID: 100},
+					UserID:        2,
+					Name:          "원두",
+					Price:         4000,
+					Cost:          1500,
+					MarkdownPrice: pointer.Float64(2800),
 				}, nil).Once()
+				ts.productRepository.EXPECT().UpdateProduct(mock.Anything, domain.Product{
+					Base:   domain.Base{ID: 100},
+					UserID: 2,
+					Name:   "원두",
+					Price:  2500,
+					Cost:   1500,
+				}).Return(nil).Once()
+				ts.productRepository.EXPECT().CreatePriceHistory(mock.Anything, mock.MatchedBy(func(history domain.PriceHistory) bool {
+					return history.OldPrice == 4000 && history.NewPrice == 2500 &&
+						history.OldEffectivePrice == 2800 && history.NewEffectivePrice == 2500
+				})).Return(nil).Once()
 			},
-			want: domain.ListProductsResponse{
-				Products: []domain.ProductDTO{
-					{
-						BaseDTO: domain.BaseDTO{
-							ID: 11,
-						},
-						UserID:      1,
-						Initial:     "ㅅㅋㄹ ㄹㄸ",
-						Category:    "payhere",
-						Price:       1000,
-						Cost:        500,
-						Name:        "슈크림 라떼",
-						Description: "description",
-						Barcode:     "barcode",
-						ExpiryDate:  time.Time{},
-						Size:        domain.ProductSizeTypeSmall,
-					},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 재고 알림 끄기",
+			args: args{
+				ctx: nil,
+				req: domain.PatchProductRequest{
+					UserID:            2,
+					ID:                100,
+					ClearReorderPoint: true,
+					ReorderQuantity:   pointer.Int(0),
 				},
-				Cursor: pointer.Int(11),
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+					Base:            domain.Base{ID: 100},
+					UserID:          2,
+					Name:            "원두",
+					ReorderPoint:    pointer.Int(5),
+					ReorderQuantity: 20,
+				}, nil).Once()
+				ts.productRepository.EXPECT().UpdateProduct(mock.Anything, domain.Product{
+					Base:   domain.Base{ID: 100},
+					UserID: 2,
+					Name:   "원두",
+				}).Return(nil).Once()
 			},
 			wantErr: false,
 		},
 		{
-			name: "PASS - 검색 조건 한글 초성만 있는 경우",
+			name: "PASS - 태그만 바꾸면 태그를 교체",
 			args: args{
-				ctx: context.Background(),
-				req: domain.ListProductsRequest{
-					UserID: 1,
-					Cursor: nil,
-					Search: pointer.String("ㅅㅋㄹ"),
+				ctx: nil,
+				req: domain.PatchProductRequest{
+					UserID: 2,
+					ID:     100,
+					Tags:   &[]string{"시즌", "#비건"},
 				},
 			},
 			mock: func(ts productServiceTestSuite) {
-				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
-					UserID:  1,
-					Initial: pointer.String("ㅅㅋㄹ"),
-				}).Return([]domain.Product{
-					{
-						Base: domain.Base{
-							ID: 11,
-						},
-						UserID:      1,
-						Initial:     "ㅅㅋㄹ ㄹㄸ",
-						Category:    "payhere",
-						Price:       1000,
-						Cost:        500,
-						Name:        "슈크림 라떼",
-						Description: "description",
-						Barcode:     "barcode",
-						ExpiryDate:  time.Time{},
-						Size:        domain.ProductSizeTypeSmall,
-					},
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+					Base:   domain.Base{ID: 100},
+					UserID: 2,
+					Name:   "원두",
 				}, nil).Once()
-			},
-			want: domain.ListProductsResponse{
-				Products: []domain.ProductDTO{
-					{
-						BaseDTO: domain.BaseDTO{
-							ID: 11,
-						},
-						UserID:      1,
-						Initial:     "ㅅㅋㄹ ㄹㄸ",
-						Category:    "payhere",
-						Price:       1000,
-						Cost:        500,
-						Name:        "슈크림 라떼",
-						Description: "description",
-						Barcode:     "barcode",
-						ExpiryDate:  time.Time{},
-						Size:        domain.ProductSizeTypeSmall,
-					},
-				},
-				Cursor: pointer.Int(11),
+				ts.productRepository.EXPECT().UpdateProduct(mock.Anything, domain.Product{
+					Base:   domain.Base{ID: 100},
+					UserID: 2,
+					Name:   "원두",
+				}).Return(nil).Once()
+				ts.productRepository.EXPECT().ReplaceProductTags(mock.Anything, 2, 100, []string{"시즌", "#비건"}).Return(nil).Once()
 			},
 			wantErr: false,
 		},
 		{
-			name: "PASS - 검색 조건 한글 문자가 있는 경우",
+			name: "FAIL - If-Match 버전이 현재 버전과 다른 경우",
 			args: args{
-				ctx: context.Background(),
-				req: domain.ListProductsRequest{
-					UserID: 1,
-					Cursor: nil,
-					Search: pointer.String("슈크림"),
+				ctx: nil,
+				req: domain.PatchProductRequest{
+					UserID:  2,
+					ID:      100,
+					Name:    pointer.String("수정된 모카"),
+					Version: pointer.Int(2),
 				},
 			},
 			mock: func(ts productServiceTestSuite) {
-				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
-					UserID: 1,
-					Name:   pointer.String("슈크림"),
-				}).Return([]domain.Product{
-					{
-						Base: domain.Base{
-							ID: 11,
-						},
-						UserID:      1,
-						Initial:     "ㅅㅋㄹ ㄹㄸ",
-						Category:    "payhere",
-						Price:       1000,
-						Cost:        500,
-						Name:        "슈크림 라떼",
-						Description: "description",
-						Barcode:     "barcode",
-						ExpiryDate:  time.Time{},
-						Size:        domain.ProductSizeTypeSmall,
-					},
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+					Base:    domain.Base{ID: 100},
+					UserID:  2,
+					Name:    "원두",
+					Version: 3,
 				}, nil).Once()
 			},
-			want: domain.ListProductsResponse{
-				Products: []domain.ProductDTO{
-					{
-						BaseDTO: domain.BaseDTO{
-							ID: 11,
-						},
-						UserID:      1,
-						Initial:     "ㅅㅋㄹ ㄹㄸ",
-						Category:    "payhere",
-						Price:       1000,
-						Cost:        500,
-						Name:        "슈크림 라떼",
-						Description: "description",
-						Barcode:     "barcode",
-						ExpiryDate:  time.Time{},
-						Size:        domain.ProductSizeTypeSmall,
-					},
-				},
-				Cursor: pointer.Int(11),
-			},
-			wantErr: false,
+			wantErr: true,
 		},
 		{
-			name: "PASS - 검색 조건이 영어인 경우",
+			name: "FAIL - 조회한 뒤 재고가 바뀌어 버전이 오른 경우",
 			args: args{
-				ctx: context.Background(),
-				req: domain.ListProductsRequest{
-					UserID: 1,
-					Cursor: nil,
-					Search: pointer.String("search"),
+				ctx: nil,
+				req: domain.PatchProductRequest{
+					UserID:  2,
+					ID:      100,
+					Price:   pointer.Float64(2000),
+					Version: pointer.Int(3),
 				},
 			},
 			mock: func(ts productServiceTestSuite) {
-				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
-					UserID: 1,
-					Name:   pointer.String("search"),
-				}).Return([]domain.Product{
-					{
-						Base: domain.Base{
-							ID: 11,
-						},
-						UserID:      1,
-						Initial:     "search",
-						Category:    "payhere",
-						Price:       1000,
-						Cost:        500,
-						Name:        "search",
-						Description: "description",
-						Barcode:     "barcode",
-						ExpiryDate:  time.Time{},
-						Size:        domain.ProductSizeTypeSmall,
-					},
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+					Base:          domain.Base{ID: 100},
+					UserID:        2,
+					Name:          "원두",
+					StockQuantity: 13,
+					Version:       4,
 				}, nil).Once()
 			},
-			want: domain.ListProductsResponse{
-				Products: []domain.ProductDTO{
-					{
-						BaseDTO: domain.BaseDTO{
-							ID: 11,
-						},
-						UserID:      1,
-						Initial:     "search",
-						Category:    "payhere",
-						Price:       1000,
-						Cost:        500,
-						Name:        "search",
-						Description: "description",
-						Barcode:     "barcode",
-						ExpiryDate:  time.Time{},
-						Size:        domain.ProductSizeTypeSmall,
-					},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - 조회한 뒤 다른 요청이 먼저 수정한 경우",
+			args: args{
+				ctx: nil,
+				req: domain.PatchProductRequest{
+					UserID:  2,
+					ID:      100,
+					Version: pointer.Int(3),
 				},
-				Cursor: pointer.Int(11),
 			},
-			wantErr: false,
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+					Base:    domain.Base{ID: 100},
+					UserID:  2,
+					Name:    "원두",
+					Version: 3,
+				}, nil).Once()
+				ts.productRepository.EXPECT().UpdateProduct(mock.Anything, mock.Anything).
+					Return(cerrors.E(cerrors.Op("test"), cerrors.PreconditionFailed, "다른 곳에서 상품이 먼저 수정되었습니다.")).Once()
+			},
+			wantErr: true,
 		},
 	}
 
@@ -701,95 +1256,1919 @@ func Test_productService_ListProducts(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// given
 			ts := setupUserServiceTestSuite(t)
+			ts.productRepository.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(domain.ProductRepository) error) error {
+				return fn(ts.productRepository)
+			}).Once()
 			tt.mock(ts)
 
 			// when
-			got, err := ts.productService.ListProducts(tt.args.ctx, tt.args.req)
+			err := ts.productService.PatchProduct(tt.args.ctx, tt.args.req)
 
 			// then
 			ts.userRepository.AssertExpectations(t)
 			ts.productRepository.AssertExpectations(t)
-			assert.Equal(t, tt.want, got)
-			if err != nil {
-				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			assert.Equal(t, tt.wantErr, err != nil)
+			if tt.args.req.Version != nil && tt.wantErr {
+				assert.True(t, cerrors.Is(cerrors.PreconditionFailed, err))
 			}
 		})
 	}
 }
 
-func Test_extractChosung(t *testing.T) {
+func Test_productService_ReplaceProduct(t *testing.T) {
+	// given
+	ts := setupUserServiceTestSuite(t)
+	expiryDate := time.Date(2026, time.June, 10, 0, 0, 0, 0, time.UTC)
+	ts.productRepository.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(domain.ProductRepository) error) error {
+		return fn(ts.productRepository)
+	}).Once()
+	ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+		Base:            domain.Base{ID: 100},
+		UserID:          1,
+		CategoryID:      1,
+		Price:           1000,
+		Cost:            500,
+		Name:            "슈크림 라떼",
+		Description:     "description",
+		Barcode:         "8801234567893",
+		ExpiryDate:      expiryDate,
+		ReorderPoint:    pointer.Int(5),
+		ReorderQuantity: 20,
+		Version:         3,
+	}, nil).Once()
+	ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&domain.Category{Base: domain.Base{ID: 1}, UserID: 1}, nil).Once()
+	ts.productRepository.EXPECT().UpdateProduct(mock.Anything, domain.Product{
+		Base:        domain.Base{ID: 100},
+		UserID:      1,
+		Initial:     "ㅂㄴㄹ ㄹㄸ",
+		Romanized:   "banilla ratte",
+		CategoryID:  1,
+		Price:       1000,
+		Cost:        500,
+		Name:        "바닐라 라떼",
+		Description: "description",
+		Barcode:     "8801234567893",
+		ExpiryDate:  expiryDate,
+		Version:     3,
+	}).Return(nil).Once()
+	ts.productRepository.EXPECT().ReplaceProductOptionGroups(mock.Anything, 100, []domain.ProductOptionGroup(nil)).Return(nil).Once()
+	ts.productRepository.EXPECT().ReplaceProductTags(mock.Anything, 1, 100, []string{}).Return(nil).Once()
+
+	// when
+	err := ts.productService.ReplaceProduct(context.Background(), domain.ReplaceProductRequest{
+		UserID:      1,
+		ID:          100,
+		CategoryID:  1,
+		Price:       1000,
+		Cost:        500,
+		Name:        "바닐라 라떼",
+		Description: "description",
+		Barcode:     "8801234567893",
+	})
+
+	// then
+	assert.NoError(t, err)
+	ts.productRepository.AssertExpectations(t)
+}
+
+func Test_productService_ApplyProductPatch(t *testing.T) {
+	current := func() *domain.Product {
+		return &domain.Product{
+			Base:        domain.Base{ID: 100},
+			UserID:      1,
+			Initial:     "ㅅㅋㄹ ㄹㄸ",
+			Romanized:   "syukeurim ratte",
+			CategoryID:  1,
+			Price:       1000,
+			Cost:        500,
+			Name:        "슈크림 라떼",
+			Description: "description",
+			Barcode:     "8801234567893",
+			ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+			Version:     3,
+		}
+	}
+	optionGroups := []domain.ProductOptionGroup{
+		{ProductID: 100, Name: "온도", SelectType: domain.ProductOptionSelectTypeSingle, MinSelect: 0, MaxSelect: 1, Options: []domain.ProductOption{{Name: "HOT"}, {Name: "ICE", DisplayOrder: 1}}},
+	}
+
 	tests := []struct {
 		name     string
-		input    string
-		expected string
+		req      domain.ApplyProductPatchRequest
+		mock     func(ts productServiceTestSuite)
+		wantKind cerrors.Kind
 	}{
-		{name: "한글만 입력", input: "한글만 입력", expected: "ㅎㄱㅁ ㅇㄹ"},
-		{name: "한글 영어 혼합", input: "한글abc혼합", expected: "ㅎㄱabcㅎㅎ"},
-		{name: "한글 영어 특수문자", input: "한글abc!@#", expected: "ㅎㄱabc!@#"},
-		{name: "한글 자음만", input: "ㄱㄴㄷ", expected: "ㄱㄴㄷ"},
-		{name: "영어만 입력", input: "onlyenglish", expected: "onlyenglish"},
-		{name: "숫자만 입력", input: "1234567890", expected: "1234567890"},
-		{name: "띄어쓰기도 포함", input: "한 글  테 스 트", expected: "ㅎ ㄱ  ㅌ ㅅ ㅌ"},
-		{name: "아메리카노", input: "아메리카노", expected: "ㅇㅁㄹㅋㄴ"},
-		{name: "카페라떼", input: "카페라떼", expected: "ㅋㅍㄹㄸ"},
-		{name: "카페모카", input: "카페모카", expected: "ㅋㅍㅁㅋ"},
-		{name: "헤이즐넛라떼", input: "헤이즐넛라떼", expected: "ㅎㅇㅈㄴㄹㄸ"},
-		{name: "바닐라라떼", input: "바닐라라떼", expected: "ㅂㄴㄹㄹㄸ"},
-		{name: "카푸치노", input: "카푸치노", expected: "ㅋㅍㅊㄴ"},
-		{name: "모카라떼", input: "모카라떼", expected: "ㅁㅋㄹㄸ"},
-		{name: "콜드브루", input: "콜드브루", expected: "ㅋㄷㅂㄹ"},
-		{name: "아이스티", input: "아이스티", expected: "ㅇㅇㅅㅌ"},
-		{name: "스무디", input: "스무디", expected: "ㅅㅁㄷ"},
-		{name: "플레인요거트", input: "플레인요거트", expected: "ㅍㄹㅇㅇㄱㅌ"},
-		{name: "딸기요거트", input: "딸기요거트", expected: "ㄸㄱㅇㄱㅌ"},
-		{name: "딸기 요거트", input: "딸기 요거트", expected: "ㄸㄱ ㅇㄱㅌ"},
-		{name: "블루베리 요거트", input: "블루베리 요거트", expected: "ㅂㄹㅂㄹ ㅇㄱㅌ"},
-		{name: "치즈 케이크", input: "치즈 케이크", expected: "ㅊㅈ ㅋㅇㅋ"},
-		{name: "초코 브라우니", input: "초코 브라우니", expected: "ㅊㅋ ㅂㄹㅇㄴ"},
-		{name: "카라멜 마카롱", input: "카라멜 마카롱", expected: "ㅋㄹㅁ ㅁㅋㄹ"},
-		{name: "말차 빙수", input: "말차 빙수", expected: "ㅁㅊ ㅂㅅ"},
-		{name: "아이스크림", input: "아이스크림", expected: "ㅇㅇㅅㅋㄹ"},
-		{name: "딸기 쉐이크", input: "딸기 쉐이크", expected: "ㄸㄱ ㅅㅇㅋ"},
-		{name: "바나나 크림", input: "바나나 크림", expected: "ㅂㄴㄴ ㅋㄹ"},
-		{name: "망고 스무디", input: "망고 스무디", expected: "ㅁㄱ ㅅㅁㄷ"},
-		{name: "나이키 운동화", input: "나이키 운동화", expected: "ㄴㅇㅋ ㅇㄷㅎ"},
-		{name: "아디다스 운동화", input: "아디다스 운동화", expected: "ㅇㄷㄷㅅ ㅇㄷㅎ"},
-		{name: "지오다노 티셔츠", input: "지오다노 티셔츠", expected: "ㅈㅇㄷㄴ ㅌㅅㅊ"},
-		{name: "폴로 셔츠", input: "폴로 셔츠", expected: "ㅍㄹ ㅅㅊ"},
-		{name: "구찌 반지갑", input: "구찌 반지갑", expected: "ㄱㅉ ㅂㅈㄱ"},
-		{name: "루이비통 가방", input: "루이비통 가방", expected: "ㄹㅇㅂㅌ ㄱㅂ"},
-		{name: "샤넬 향수", input: "샤넬 향수", expected: "ㅅㄴ ㅎㅅ"},
-		{name: "에르메스 벨트", input: "에르메스 벨트", expected: "ㅇㄹㅁㅅ ㅂㅌ"},
-		{name: "디올 클러치백", input: "디올 클러치백", expected: "ㄷㅇ ㅋㄹㅊㅂ"},
-		{name: "프라다 선글라스", input: "프라다 선글라스", expected: "ㅍㄹㄷ ㅅㄱㄹㅅ"},
+		{
+			name: "PASS - merge patch 로 가격만 바꾸면 옵션은 그대로 두고 가격 변경을 기록",
+			req:  domain.ApplyProductPatchRequest{UserID: 1, ID: 100, ContentType: domain.MergePatchContentType, Patch: []byte(`{"price":2000}`)},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(current(), nil).Twice()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{100}).Return(optionGroups, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{100}).Return(nil, nil).Once()
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&domain.Category{Base: domain.Base{ID: 1}, UserID: 1}, nil).Once()
+				ts.productRepository.EXPECT().UpdateProduct(mock.Anything, mock.MatchedBy(func(product domain.Product) bool {
+					return product.Price == 2000 && product.Name == "슈크림 라떼" && product.Version == 3
+				})).Return(nil).Once()
+				ts.productRepository.EXPECT().CreatePriceHistory(mock.Anything, mock.MatchedBy(func(history domain.PriceHistory) bool {
+					return history.OldPrice == 1000 && history.NewPrice == 2000
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "PASS - JSON patch 로 옵션 그룹을 지움",
+			req:  domain.ApplyProductPatchRequest{UserID: 1, ID: 100, ContentType: domain.JSONPatchContentType, Patch: []byte(`[{"op":"remove","path":"/optionGroups/0"}]`)},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(current(), nil).Twice()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{100}).Return(optionGroups, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{100}).Return(nil, nil).Once()
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&domain.Category{Base: domain.Base{ID: 1}, UserID: 1}, nil).Once()
+				ts.productRepository.EXPECT().UpdateProduct(mock.Anything, mock.Anything).Return(nil).Once()
+				ts.productRepository.EXPECT().ReplaceProductOptionGroups(mock.Anything, 100, []domain.ProductOptionGroup(nil)).Return(nil).Once()
+			},
+		},
+		{
+			name: "PASS - JSON patch 로 태그를 바꾸면 태그만 교체",
+			req:  domain.ApplyProductPatchRequest{UserID: 1, ID: 100, ContentType: domain.JSONPatchContentType, Patch: []byte(`[{"op":"replace","path":"/tags","value":["신메뉴","비건"]}]`)},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(current(), nil).Twice()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{100}).Return(optionGroups, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{100}).Return([]domain.ProductTag{
+					{ProductID: 100, Tag: domain.Tag{ID: 1, UserID: 1, Name: "신메뉴"}},
+				}, nil).Once()
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&domain.Category{Base: domain.Base{ID: 1}, UserID: 1}, nil).Once()
+				ts.productRepository.EXPECT().UpdateProduct(mock.Anything, mock.Anything).Return(nil).Once()
+				ts.productRepository.EXPECT().ReplaceProductTags(mock.Anything, 1, 100, []string{"신메뉴", "비건"}).Return(nil).Once()
+			},
+		},
+		{
+			name: "FAIL - 다른 사장님의 상품",
+			req:  domain.ApplyProductPatchRequest{UserID: 2, ID: 100, ContentType: domain.MergePatchContentType, Patch: []byte(`{"price":2000}`)},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(current(), nil).Once()
+			},
+			wantKind: cerrors.Permission,
+		},
+		{
+			name: "FAIL - If-Match 버전이 현재 버전과 다름",
+			req:  domain.ApplyProductPatchRequest{UserID: 1, ID: 100, ContentType: domain.MergePatchContentType, Patch: []byte(`{"price":2000}`), Version: pointer.Int(2)},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(current(), nil).Once()
+			},
+			wantKind: cerrors.PreconditionFailed,
+		},
+		{
+			name: "FAIL - 적용한 결과가 검사를 통과하지 못함",
+			req:  domain.ApplyProductPatchRequest{UserID: 1, ID: 100, ContentType: domain.MergePatchContentType, Patch: []byte(`{"name":null}`)},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(current(), nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{100}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{100}).Return(nil, nil).Once()
+			},
+			wantKind: cerrors.Invalid,
+		},
+		{
+			name: "FAIL - patch 를 적용하는 사이 다른 곳에서 먼저 수정함",
+			req:  domain.ApplyProductPatchRequest{UserID: 1, ID: 100, ContentType: domain.MergePatchContentType, Patch: []byte(`{"description":"new"}`)},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(current(), nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{100}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{100}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(func() *domain.Product {
+					product := current()
+					product.Version = 4
+					return product
+				}(), nil).Once()
+			},
+			wantKind: cerrors.PreconditionFailed,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := extractChosung(tt.input)
-			assert.Equal(t, tt.expected, result)
+			// given
+			ts := setupUserServiceTestSuite(t)
+			ts.productRepository.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(domain.ProductRepository) error) error {
+				return fn(ts.productRepository)
+			}).Once()
+			tt.mock(ts)
+
+			// when
+			err := ts.productService.ApplyProductPatch(context.Background(), tt.req)
+
+			// then
+			ts.productRepository.AssertExpectations(t)
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
 		})
 	}
 }
 
-func Test_isKoreanChosung(t *testing.T) {
-	testCases := []struct {
-		name     string
-		input    string
-		expected bool
-	}{
-		{"한글 초성만 있는 경우", "ㄱㄴㄷ", true},
-		{"한글 초성과 공백이 있는 경우", "ㄱㄴㅎ ㅁㅇ", true},
-		{"한글 초성과 특수 문자가 있는 경우", "ㄱㄴㅎ !@#$", true},
-		{"한글 초성과 영어가 있는 경우", "ㄱㄴㅎ abc", true},
-		{"한글 초성과 한글 문자가 있는 경우", "ㄱㄴㅎ 가나다", false},
-		{"한글 외 다른 문자만 있는 경우", "abc #!@", false},
+func Test_productService_DeleteProduct(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.DeleteProductRequest
 	}
 
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			result := isKoreanChosung(tc.input)
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts productServiceTestSuite)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 상품 삭제",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteProductRequest{
+					UserID: 1,
+					ID:     100,
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+					Base: domain.Base{
+						ID: 100,
+					},
+					UserID:      1,
+					Category:    "original category",
+					Initial:     "ㅇㄹㅈㄴ ㄹㄸ",
+					Price:       1000,
+					Cost:        500,
+					Name:        "오리지널 라떼",
+					Description: "description",
+					Barcode:     "barcode",
+					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+				}, nil).Once()
+				ts.productRepository.EXPECT().DeleteProduct(mock.Anything, 100, 0).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 상품을 찾을 수 없는 경우",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteProductRequest{
+					UserID: 1,
+					ID:     100,
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "PASS - 상품을 삭제할 권한이 없는 경우",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteProductRequest{
+					UserID: 1,
+					ID:     100,
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+					Base: domain.Base{
+						ID: 100,
+					},
+					UserID:      2,
+					Category:    "original category",
+					Initial:     "ㅇㄹㅈㄴ ㄹㄸ",
+					Price:       1000,
+					Cost:        500,
+					Name:        "오리지널 라떼",
+					Description: "description",
+					Barcode:     "barcode",
+					ExpiryDate:  time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "FAIL - If-Match 버전이 현재 버전과 다른 경우",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteProductRequest{
+					UserID:  1,
+					ID:      100,
+					Version: pointer.Int(1),
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+					Base:    domain.Base{ID: 100},
+					UserID:  1,
+					Version: 2,
+				}, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "PASS - If-Match 버전이 현재 버전과 같은 경우",
+			args: args{
+				ctx: context.Background(),
+				req: domain.DeleteProductRequest{
+					UserID:  1,
+					ID:      100,
+					Version: pointer.Int(2),
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(&domain.Product{
+					Base:    domain.Base{ID: 100},
+					UserID:  1,
+					Version: 2,
+				}, nil).Once()
+				ts.productRepository.EXPECT().DeleteProduct(mock.Anything, 100, 2).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.productService.DeleteProduct(tt.args.ctx, tt.args.req)
+
+			// then
+			ts.userRepository.AssertExpectations(t)
+			ts.productRepository.AssertExpectations(t)
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			}
+		})
+	}
+}
+
+func Test_productService_ListProducts(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.ListProductsRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts productServiceTestSuite)
+		want    domain.ListProductsResponse
+		wantErr bool
+	}{
+		{
+			name: "PASS - 조건 없이 조회",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListProductsRequest{
+					UserID: 1,
+					Cursor: nil,
+					Search: nil,
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
+					UserID: 1,
+					Cursor: nil,
+					Name:   nil,
+				}).Return([]domain.Product{
+					{
+						Base: domain.Base{
+							ID: 1,
+						},
+						UserID:      1,
+						Initial:     "ㅅㅋㄹ ㄹㄸ",
+						Category:    "payhere",
+						Price:       1000,
+						Cost:        500,
+						Name:        "슈크림 라떼",
+						Description: "description",
+						Barcode:     "barcode",
+						ExpiryDate:  time.Time{},
+					},
+				}, nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			want: domain.ListProductsResponse{
+				Products: []domain.ProductDTO{
+					{
+						BaseDTO: domain.BaseDTO{
+							ID: 1,
+						},
+						UserID:         1,
+						Initial:        "ㅅㅋㄹ ㄹㄸ",
+						Category:       "payhere",
+						Price:          1000,
+						EffectivePrice: 1000,
+						Cost:           500,
+						Name:           "슈크림 라떼",
+						Description:    "description",
+						Barcode:        "barcode",
+						ExpiryDate:     time.Time{},
+						OptionGroups:   []domain.ProductOptionGroupDTO{},
+						Images:         []domain.ProductImageDTO{},
+						Tags:           []domain.ProductTagDTO{},
+					},
+				},
+				Cursor: pointer.Int(1),
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 커서 값이 있는 경우",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListProductsRequest{
+					UserID: 1,
+					Cursor: pointer.Int(10),
+					Search: nil,
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
+					UserID: 1,
+					Cursor: pointer.Int(10),
+				}).Return([]domain.Product{
+					{
+						Base: domain.Base{
+							ID: 11,
+						},
+						UserID:      1,
+						Initial:     "ㅅㅋㄹ ㄹㄸ",
+						Category:    "payhere",
+						Price:       1000,
+						Cost:        500,
+						Name:        "슈크림 라떼",
+						Description: "description",
+						Barcode:     "barcode",
+						ExpiryDate:  time.Time{},
+					},
+				}, nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			want: domain.ListProductsResponse{
+				Products: []domain.ProductDTO{
+					{
+						BaseDTO: domain.BaseDTO{
+							ID: 11,
+						},
+						UserID:         1,
+						Initial:        "ㅅㅋㄹ ㄹㄸ",
+						Category:       "payhere",
+						Price:          1000,
+						EffectivePrice: 1000,
+						Cost:           500,
+						Name:           "슈크림 라떼",
+						Description:    "description",
+						Barcode:        "barcode",
+						ExpiryDate:     time.Time{},
+						OptionGroups:   []domain.ProductOptionGroupDTO{},
+						Images:         []domain.ProductImageDTO{},
+						Tags:           []domain.ProductTagDTO{},
+					},
+				},
+				Cursor: pointer.Int(11),
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 검색 조건 한글 초성만 있는 경우",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListProductsRequest{
+					UserID: 1,
+					Cursor: nil,
+					Search: pointer.String("ㅅㅋㄹ"),
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
+					UserID:  1,
+					Initial: pointer.String("ㅅㅋㄹ"),
+				}).Return([]domain.Product{
+					{
+						Base: domain.Base{
+							ID: 11,
+						},
+						UserID:      1,
+						Initial:     "ㅅㅋㄹ ㄹㄸ",
+						Category:    "payhere",
+						Price:       1000,
+						Cost:        500,
+						Name:        "슈크림 라떼",
+						Description: "description",
+						Barcode:     "barcode",
+						ExpiryDate:  time.Time{},
+					},
+				}, nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			want: domain.ListProductsResponse{
+				Products: []domain.ProductDTO{
+					{
+						BaseDTO: domain.BaseDTO{
+							ID: 11,
+						},
+						UserID:         1,
+						Initial:        "ㅅㅋㄹ ㄹㄸ",
+						Category:       "payhere",
+						Price:          1000,
+						EffectivePrice: 1000,
+						Cost:           500,
+						Name:           "슈크림 라떼",
+						Description:    "description",
+						Barcode:        "barcode",
+						ExpiryDate:     time.Time{},
+						OptionGroups:   []domain.ProductOptionGroupDTO{},
+						Images:         []domain.ProductImageDTO{},
+						Tags:           []domain.ProductTagDTO{},
+					},
+				},
+				Cursor: pointer.Int(11),
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 검색 조건 한글 문자가 있는 경우",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListProductsRequest{
+					UserID: 1,
+					Cursor: nil,
+					Search: pointer.String("슈크림"),
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
+					UserID: 1,
+					Name:   pointer.String("슈크림"),
+				}).Return([]domain.Product{
+					{
+						Base: domain.Base{
+							ID: 11,
+						},
+						UserID:      1,
+						Initial:     "ㅅㅋㄹ ㄹㄸ",
+						Category:    "payhere",
+						Price:       1000,
+						Cost:        500,
+						Name:        "슈크림 라떼",
+						Description: "description",
+						Barcode:     "barcode",
+						ExpiryDate:  time.Time{},
+					},
+				}, nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			want: domain.ListProductsResponse{
+				Products: []domain.ProductDTO{
+					{
+						BaseDTO: domain.BaseDTO{
+							ID: 11,
+						},
+						UserID:         1,
+						Initial:        "ㅅㅋㄹ ㄹㄸ",
+						Category:       "payhere",
+						Price:          1000,
+						EffectivePrice: 1000,
+						Cost:           500,
+						Name:           "슈크림 라떼",
+						Description:    "description",
+						Barcode:        "barcode",
+						ExpiryDate:     time.Time{},
+						OptionGroups:   []domain.ProductOptionGroupDTO{},
+						Images:         []domain.ProductImageDTO{},
+						Tags:           []domain.ProductTagDTO{},
+					},
+				},
+				Cursor: pointer.Int(11),
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 영어식 철자로 로마자 표기 검색",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListProductsRequest{
+					UserID: 1,
+					Search: pointer.String("americano"),
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
+					UserID:    1,
+					Name:      pointer.String("americano"),
+					Romanized: pointer.String("amerikano"),
+				}).Return(nil, nil).Once()
+			},
+			want: domain.ListProductsResponse{
+				Products: nil,
+				Cursor:   nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 검색 조건이 영어인 경우",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListProductsRequest{
+					UserID: 1,
+					Cursor: nil,
+					Search: pointer.String("search"),
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
+					UserID:    1,
+					Name:      pointer.String("search"),
+					Romanized: pointer.String("search"),
+				}).Return([]domain.Product{
+					{
+						Base: domain.Base{
+							ID: 11,
+						},
+						UserID:      1,
+						Initial:     "search",
+						Category:    "payhere",
+						Price:       1000,
+						Cost:        500,
+						Name:        "search",
+						Description: "description",
+						Barcode:     "barcode",
+						ExpiryDate:  time.Time{},
+					},
+				}, nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			want: domain.ListProductsResponse{
+				Products: []domain.ProductDTO{
+					{
+						BaseDTO: domain.BaseDTO{
+							ID: 11,
+						},
+						UserID:         1,
+						Initial:        "search",
+						Category:       "payhere",
+						Price:          1000,
+						EffectivePrice: 1000,
+						Cost:           500,
+						Name:           "search",
+						Description:    "description",
+						Barcode:        "barcode",
+						ExpiryDate:     time.Time{},
+						OptionGroups:   []domain.ProductOptionGroupDTO{},
+						Images:         []domain.ProductImageDTO{},
+						Tags:           []domain.ProductTagDTO{},
+					},
+				},
+				Cursor: pointer.Int(11),
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 태그 조건으로 조회하면 상품마다 태그를 채운다",
+			args: args{
+				ctx: context.Background(),
+				req: domain.ListProductsRequest{
+					UserID:   1,
+					TagIDs:   []int{1, 2},
+					TagMatch: domain.TagMatchAll,
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListProducts(mock.Anything, domain.ListProductsParams{
+					UserID:   1,
+					TagIDs:   []int{1, 2},
+					TagMatch: domain.TagMatchAll,
+				}).Return([]domain.Product{
+					{Base: domain.Base{ID: 1}, UserID: 1, Name: "비건 샐러드"},
+					{Base: domain.Base{ID: 2}, UserID: 1, Name: "비건 버거"},
+				}, nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{1, 2}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{1, 2}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{1, 2}).Return([]domain.ProductTag{
+					{ProductID: 1, Tag: domain.Tag{ID: 2, UserID: 1, Name: "비건"}},
+					{ProductID: 1, Tag: domain.Tag{ID: 1, UserID: 1, Name: "신메뉴"}},
+					{ProductID: 2, Tag: domain.Tag{ID: 2, UserID: 1, Name: "비건"}},
+					{ProductID: 2, Tag: domain.Tag{ID: 1, UserID: 1, Name: "신메뉴"}},
+				}, nil).Once()
+			},
+			want: domain.ListProductsResponse{
+				Products: []domain.ProductDTO{
+					{
+						BaseDTO:      domain.BaseDTO{ID: 1},
+						UserID:       1,
+						Name:         "비건 샐러드",
+						OptionGroups: []domain.ProductOptionGroupDTO{},
+						Images:       []domain.ProductImageDTO{},
+						Tags:         []domain.ProductTagDTO{{ID: 2, Name: "비건"}, {ID: 1, Name: "신메뉴"}},
+					},
+					{
+						BaseDTO:      domain.BaseDTO{ID: 2},
+						UserID:       1,
+						Name:         "비건 버거",
+						OptionGroups: []domain.ProductOptionGroupDTO{},
+						Images:       []domain.ProductImageDTO{},
+						Tags:         []domain.ProductTagDTO{{ID: 2, Name: "비건"}, {ID: 1, Name: "신메뉴"}},
+					},
+				},
+				Cursor: pointer.Int(2),
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.productService.ListProducts(tt.args.ctx, tt.args.req)
+
+			// then
+			ts.userRepository.AssertExpectations(t)
+			ts.productRepository.AssertExpectations(t)
+			assert.Equal(t, tt.want, got)
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			}
+		})
+	}
+}
+
+func Test_extractChosung(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "한글만 입력", input: "한글만 입력", expected: "ㅎㄱㅁ ㅇㄹ"},
+		{name: "한글 영어 혼합", input: "한글abc혼합", expected: "ㅎㄱabcㅎㅎ"},
+		{name: "한글 영어 특수문자", input: "한글abc!@#", expected: "ㅎㄱabc!@#"},
+		{name: "한글 자음만", input: "ㄱㄴㄷ", expected: "ㄱㄴㄷ"},
+		{name: "영어만 입력", input: "onlyenglish", expected: "onlyenglish"},
+		{name: "숫자만 입력", input: "1234567890", expected: "1234567890"},
+		{name: "띄어쓰기도 포함", input: "한 글  테 스 트", expected: "ㅎ ㄱ  ㅌ ㅅ ㅌ"},
+		{name: "아메리카노", input: "아메리카노", expected: "ㅇㅁㄹㅋㄴ"},
+		{name: "카페라떼", input: "카페라떼", expected: "ㅋㅍㄹㄸ"},
+		{name: "카페모카", input: "카페모카", expected: "ㅋㅍㅁㅋ"},
+		{name: "헤이즐넛라떼", input: "헤이즐넛라떼", expected: "ㅎㅇㅈㄴㄹㄸ"},
+		{name: "바닐라라떼", input: "바닐라라떼", expected: "ㅂㄴㄹㄹㄸ"},
+		{name: "카푸치노", input: "카푸치노", expected: "ㅋㅍㅊㄴ"},
+		{name: "모카라떼", input: "모카라떼", expected: "ㅁㅋㄹㄸ"},
+		{name: "콜드브루", input: "콜드브루", expected: "ㅋㄷㅂㄹ"},
+		{name: "아이스티", input: "아이스티", expected: "ㅇㅇㅅㅌ"},
+		{name: "스무디", input: "스무디", expected: "ㅅㅁㄷ"},
+		{name: "플레인요거트", input: "플레인요거트", expected: "ㅍㄹㅇㅇㄱㅌ"},
+		{name: "딸기요거트", input: "딸기요거트", expected: "ㄸㄱㅇㄱㅌ"},
+		{name: "딸기 요거트", input: "딸기 요거트", expected: "ㄸㄱ ㅇㄱㅌ"},
+		{name: "블루베리 요거트", input: "블루베리 요거트", expected: "ㅂㄹㅂㄹ ㅇㄱㅌ"},
+		{name: "치즈 케이크", input: "치즈 케이크", expected: "ㅊㅈ ㅋㅇㅋ"},
+		{name: "초코 브라우니", input: "초코 브라우니", expected: "ㅊㅋ ㅂㄹㅇㄴ"},
+		{name: "카라멜 마카롱", input: "카라멜 마카롱", expected: "ㅋㄹㅁ ㅁㅋㄹ"},
+		{name: "말차 빙수", input: "말차 빙수", expected: "ㅁㅊ ㅂㅅ"},
+		{name: "아이스크림", input: "아이스크림", expected: "ㅇㅇㅅㅋㄹ"},
+		{name: "딸기 쉐이크", input: "딸기 쉐이크", expected: "ㄸㄱ ㅅㅇㅋ"},
+		{name: "바나나 크림", input: "바나나 크림", expected: "ㅂㄴㄴ ㅋㄹ"},
+		{name: "망고 스무디", input: "망고 스무디", expected: "ㅁㄱ ㅅㅁㄷ"},
+		{name: "나이키 운동화", input: "나이키 운동화", expected: "ㄴㅇㅋ ㅇㄷㅎ"},
+		{name: "아디다스 운동화", input: "아디다스 운동화", expected: "ㅇㄷㄷㅅ ㅇㄷㅎ"},
+		{name: "지오다노 티셔츠", input: "지오다노 티셔츠", expected: "ㅈㅇㄷㄴ ㅌㅅㅊ"},
+		{name: "폴로 셔츠", input: "폴로 셔츠", expected: "ㅍㄹ ㅅㅊ"},
+		{name: "구찌 반지갑", input: "구찌 반지갑", expected: "ㄱㅉ ㅂㅈㄱ"},
+		{name: "루이비통 가방", input: "루이비통 가방", expected: "ㄹㅇㅂㅌ ㄱㅂ"},
+		{name: "샤넬 향수", input: "샤넬 향수", expected: "ㅅㄴ ㅎㅅ"},
+		{name: "에르메스 벨트", input: "에르메스 벨트", expected: "ㅇㄹㅁㅅ ㅂㅌ"},
+		{name: "디올 클러치백", input: "디올 클러치백", expected: "ㄷㅇ ㅋㄹㅊㅂ"},
+		{name: "프라다 선글라스", input: "프라다 선글라스", expected: "ㅍㄹㄷ ㅅㄱㄹㅅ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := extractChosung(tt.input)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_isKoreanChosung(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{"한글 초성만 있는 경우", "ㄱㄴㄷ", true},
+		{"한글 초성과 공백이 있는 경우", "ㄱㄴㅎ ㅁㅇ", true},
+		{"한글 초성과 특수 문자가 있는 경우", "ㄱㄴㅎ !@#$", true},
+		{"한글 초성과 영어가 있는 경우", "ㄱㄴㅎ abc", true},
+		{"한글 초성과 한글 문자가 있는 경우", "ㄱㄴㅎ 가나다", false},
+		{"한글 외 다른 문자만 있는 경우", "abc #!@", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := isKoreanChosung(tc.input)
 			assert.Equal(t, tc.expected, result)
 		})
 	}
 }
+
+func Test_productService_SuggestProducts(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req domain.SuggestProductsRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(ts productServiceTestSuite)
+		want    domain.SuggestProductsResponse
+		wantErr bool
+	}{
+		{
+			name: "PASS - 상품명 접두사로 자동완성",
+			args: args{
+				ctx: context.Background(),
+				req: domain.SuggestProductsRequest{
+					UserID: 1,
+					Query:  "딸기",
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListProductNames(mock.Anything, 1).Return([]domain.ProductName{
+					{ID: 1, Name: "딸기 요거트", Initial: "ㄸㄱ ㅇㄱㅌ"},
+					{ID: 2, Name: "딸기 쉐이크", Initial: "ㄸㄱ ㅅㅇㅋ"},
+					{ID: 3, Name: "아메리카노", Initial: "ㅇㅁㄹㅋㄴ"},
+				}, nil).Once()
+			},
+			want: domain.SuggestProductsResponse{
+				Suggestions: []string{"딸기 쉐이크", "딸기 요거트"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 초성으로 자동완성",
+			args: args{
+				ctx: context.Background(),
+				req: domain.SuggestProductsRequest{
+					UserID: 1,
+					Query:  "ㅇㄱ",
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListProductNames(mock.Anything, 1).Return([]domain.ProductName{
+					{ID: 1, Name: "딸기 요거트", Initial: "ㄸㄱ ㅇㄱㅌ"},
+					{ID: 2, Name: "딸기 쉐이크", Initial: "ㄸㄱ ㅅㅇㅋ"},
+				}, nil).Once()
+			},
+			want: domain.SuggestProductsResponse{
+				Suggestions: []string{"딸기 요거트"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 일치하는 상품이 없는 경우",
+			args: args{
+				ctx: context.Background(),
+				req: domain.SuggestProductsRequest{
+					UserID: 1,
+					Query:  "케이크",
+				},
+			},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListProductNames(mock.Anything, 1).Return(nil, nil).Once()
+			},
+			want: domain.SuggestProductsResponse{
+				Suggestions: []string{},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.productService.SuggestProducts(tt.args.ctx, tt.args.req)
+
+			// then
+			ts.productRepository.AssertExpectations(t)
+			assert.Equal(t, tt.want, got)
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			}
+		})
+	}
+}
+
+func Test_productService_BackfillRomanized(t *testing.T) {
+	tests := []struct {
+		name    string
+		mock    func(ts productServiceTestSuite)
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "PASS - 로마자 표기가 다른 상품만 수정",
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().ListAllProductNamesAfter(mock.Anything, 0, backfillRomanizedBatchSize).Return([]domain.ProductName{
+					{ID: 1, Name: "아메리카노", Initial: "ㅇㅁㄹㅋㄴ", Romanized: ""},
+					{ID: 2, Name: "카페라떼", Initial: "ㅋㅍㄹㄸ", Romanized: "kaperatte"},
+				}, nil).Once()
+				ts.productRepository.EXPECT().UpdateProductRomanized(mock.Anything, 1, "amerikano").Return(nil).Once()
+				ts.productRepository.EXPECT().ListAllProductNamesAfter(mock.Anything, 2, backfillRomanizedBatchSize).Return(nil, nil).Once()
+			},
+			want:    1,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := NewProductService(ts.userRepository, ts.productRepository, ts.categoryRepository, ts.imageStorage, &config.Config{}).BackfillRomanized(context.Background())
+
+			// then
+			ts.productRepository.AssertExpectations(t)
+			assert.Equal(t, tt.want, got)
+			if err != nil {
+				assert.Equalf(t, tt.wantErr, err != nil, err.Error())
+			}
+		})
+	}
+}
+
+func Test_productService_ListExpiringProducts(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        domain.ListExpiringProductsRequest
+		wantSince  bool
+		wantWithin time.Duration
+	}{
+		{
+			name:       "PASS - 기본 조회 기간, 지난 상품 제외",
+			req:        domain.ListExpiringProductsRequest{UserID: 1},
+			wantSince:  true,
+			wantWithin: domain.DefaultExpiringWithin,
+		},
+		{
+			name:       "PASS - 지난 상품 포함",
+			req:        domain.ListExpiringProductsRequest{UserID: 1, Within: "24h", CategoryID: pointer.Int(2), IncludeExpired: true},
+			wantSince:  false,
+			wantWithin: 24 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			ts.productRepository.EXPECT().ListExpiringProducts(mock.Anything, mock.MatchedBy(func(params domain.ListExpiringProductsParams) bool {
+				if params.UserID != 1 || params.CategoryID != tt.req.CategoryID || params.InStock {
+					return false
+				}
+				if (params.Since != nil) != tt.wantSince {
+					return false
+				}
+				return time.Until(params.Until) > tt.wantWithin-time.Minute && time.Until(params.Until) <= tt.wantWithin
+			})).Return([]domain.Product{{Base: domain.Base{ID: 7}, UserID: 1, Name: "우유"}}, nil).Once()
+			ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{7}).Return([]domain.ProductOptionGroup{
+				{ID: 1, ProductID: 7, Name: "용량", Options: []domain.ProductOption{{ID: 1, OptionGroupID: 1, Name: "1L"}}},
+			}, nil).Once()
+			ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{7}).Return([]domain.ProductImage{
+				{ID: 1, ProductID: 7, StorageKey: "products/7/abc", ContentType: "image/png", Primary: true},
+			}, nil).Once()
+			ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{7}).Return([]domain.ProductTag{
+				{ProductID: 7, Tag: domain.Tag{ID: 1, UserID: 1, Name: "냉장"}},
+			}, nil).Once()
+			ts.imageStorage.EXPECT().URL(mock.Anything).RunAndReturn(func(key string) string {
+				return "https://cdn.payhere.in/" + key
+			}).Times(3)
+
+			// when
+			got, err := ts.productService.ListExpiringProducts(context.Background(), tt.req)
+
+			// then
+			assert.NoError(t, err)
+			assert.Len(t, got.Products, 1)
+			assert.Equal(t, 7, got.Products[0].ID)
+			assert.Len(t, got.Products[0].OptionGroups, 1)
+			assert.Len(t, got.Products[0].Images, 1)
+			assert.Equal(t, "냉장", got.Products[0].Tags[0].Name)
+		})
+	}
+}
+
+func Test_productService_UploadProductImage(t *testing.T) {
+	data := encodeTestJPEG(t, 100, 80)
+	product := &domain.Product{Base: domain.Base{ID: 100}, UserID: 1}
+
+	t.Run("PASS - 크기별 이미지를 저장하고 주소와 함께 응답한다", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product, nil).Once()
+		ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return(nil, nil).Once()
+		ts.imageStorage.EXPECT().Put(mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "products/100/")
+		}), "image/jpeg", mock.Anything).Return(nil).Times(3)
+		ts.productRepository.EXPECT().CreateProductImage(mock.Anything, mock.MatchedBy(func(image domain.ProductImage) bool {
+			return image.ProductID == 100 && image.Width == 100 && image.Height == 80
+		})).RunAndReturn(func(ctx context.Context, image domain.ProductImage) (domain.ProductImage, error) {
+			image.ID = 1
+			image.Primary = true
+			return image, nil
+		}).Once()
+		ts.imageStorage.EXPECT().URL(mock.Anything).RunAndReturn(func(key string) string {
+			return "/uploads/" + key
+		}).Times(3)
+
+		// when
+		got, err := ts.productService.UploadProductImage(context.Background(), domain.UploadProductImageRequest{UserID: 1, ProductID: 100, File: data})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 1, got.Image.ID)
+		assert.True(t, got.Image.Primary)
+		assert.Regexp(t, `^/uploads/products/100/[0-9a-f]{32}/thumbnail\.jpg$`, got.Image.ThumbnailURL)
+	})
+
+	t.Run("FAIL - 다른 사장님의 상품", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product, nil).Once()
+
+		// when
+		_, err := ts.productService.UploadProductImage(context.Background(), domain.UploadProductImageRequest{UserID: 2, ProductID: 100, File: data})
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Permission, err))
+	})
+
+	t.Run("FAIL - 이미지 개수 초과", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product, nil).Once()
+		ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return(make([]domain.ProductImage, domain.MaxProductImages), nil).Once()
+
+		// when
+		_, err := ts.productService.UploadProductImage(context.Background(), domain.UploadProductImageRequest{UserID: 1, ProductID: 100, File: data})
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Invalid, err))
+	})
+
+	t.Run("FAIL - 스토리지 에러면 이미 저장한 파일을 지운다", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product, nil).Once()
+		ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return(nil, nil).Once()
+		ts.imageStorage.EXPECT().Put(mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasSuffix(key, "/original.jpg")
+		}), mock.Anything, mock.Anything).Return(nil).Once()
+		ts.imageStorage.EXPECT().Put(mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasSuffix(key, "/medium.jpg")
+		}), mock.Anything, mock.Anything).Return(errors.New("storage")).Once()
+		ts.imageStorage.EXPECT().Delete(mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasSuffix(key, "/original.jpg")
+		})).Return(nil).Once()
+
+		// when
+		_, err := ts.productService.UploadProductImage(context.Background(), domain.UploadProductImageRequest{UserID: 1, ProductID: 100, File: data})
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Internal, err))
+	})
+}
+
+func Test_productService_PatchProductImages(t *testing.T) {
+	product := &domain.Product{Base: domain.Base{ID: 100}, UserID: 1}
+	images := []domain.ProductImage{
+		{ID: 1, ProductID: 100, StorageKey: "products/100/a", ContentType: "image/jpeg", Primary: true},
+		{ID: 2, ProductID: 100, StorageKey: "products/100/b", ContentType: "image/jpeg", DisplayOrder: 1},
+	}
+
+	tests := []struct {
+		name    string
+		req     domain.PatchProductImagesRequest
+		mock    func(ts productServiceTestSuite)
+		wantErr cerrors.Kind
+	}{
+		{
+			name: "PASS - 순서와 대표 이미지 변경",
+			req:  domain.PatchProductImagesRequest{UserID: 1, ProductID: 100, ImageIDs: []int{2, 1}, PrimaryImageID: pointer.Int(2)},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return(images, nil).Twice()
+				ts.productRepository.EXPECT().UpdateProductImages(mock.Anything, 100, []int{2, 1}, pointer.Int(2)).Return(nil).Once()
+				ts.imageStorage.EXPECT().URL(mock.Anything).Return("").Times(6)
+			},
+		},
+		{
+			name: "FAIL - 일부 이미지만 보낸 경우",
+			req:  domain.PatchProductImagesRequest{UserID: 1, ProductID: 100, ImageIDs: []int{2}},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return(images, nil).Once()
+			},
+			wantErr: cerrors.Invalid,
+		},
+		{
+			name: "FAIL - 다른 상품의 이미지를 대표 이미지로",
+			req:  domain.PatchProductImagesRequest{UserID: 1, ProductID: 100, PrimaryImageID: pointer.Int(3)},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return(images, nil).Once()
+			},
+			wantErr: cerrors.NotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.productService.PatchProductImages(context.Background(), tt.req)
+
+			// then
+			if tt.wantErr != 0 {
+				assert.True(t, cerrors.Is(tt.wantErr, err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, got.Images, 2)
+		})
+	}
+}
+
+func Test_productService_DeleteProductImage(t *testing.T) {
+	product := &domain.Product{Base: domain.Base{ID: 100}, UserID: 1}
+	image := domain.ProductImage{ID: 1, ProductID: 100, StorageKey: "products/100/a", ContentType: "image/png", Primary: true}
+
+	t.Run("PASS - DB 에서 지운 다음 크기별 파일을 모두 지운다", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product, nil).Once()
+		ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return([]domain.ProductImage{image}, nil).Once()
+		ts.productRepository.EXPECT().DeleteProductImage(mock.Anything, image).Return(nil).Once()
+		ts.imageStorage.EXPECT().Delete(mock.Anything, "products/100/a/original.png").Return(nil).Once()
+		ts.imageStorage.EXPECT().Delete(mock.Anything, "products/100/a/medium.png").Return(errors.New("storage")).Once()
+		ts.imageStorage.EXPECT().Delete(mock.Anything, "products/100/a/thumbnail.png").Return(nil).Once()
+
+		// when
+		err := ts.productService.DeleteProductImage(context.Background(), domain.DeleteProductImageRequest{UserID: 1, ProductID: 100, ImageID: 1})
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("FAIL - 없는 이미지", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product, nil).Once()
+		ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return([]domain.ProductImage{image}, nil).Once()
+
+		// when
+		err := ts.productService.DeleteProductImage(context.Background(), domain.DeleteProductImageRequest{UserID: 1, ProductID: 100, ImageID: 2})
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.NotExist, err))
+	})
+}
+
+func Test_productService_GetProductPriceHistory(t *testing.T) {
+	product := &domain.Product{Base: domain.Base{ID: 100}, UserID: 1, Price: 5000, Cost: 1800}
+
+	t.Run("PASS - 기간 안의 변경과 날짜별 가격", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		seoul, _ := time.LoadLocation("Asia/Seoul")
+		ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product, nil).Once()
+		ts.userRepository.EXPECT().GetUser(mock.Anything, 1).Return(&domain.User{Base: domain.Base{ID: 1}, TimeZone: "Asia/Seoul"}, nil).Once()
+		ts.productRepository.EXPECT().ListPriceHistory(mock.Anything, 100, time.Date(2024, time.February, 1, 0, 0, 0, 0, seoul)).Return([]domain.PriceHistory{
+			{ID: 1, ProductID: 100, UserID: pointer.Int(1), Reason: domain.PriceChangeReasonManual, OldPrice: 4500, NewPrice: 5000, OldCost: 1800, NewCost: 1800, OldEffectivePrice: 4500, NewEffectivePrice: 5000, CreateDate: time.Date(2024, time.February, 2, 3, 0, 0, 0, time.UTC)},
+			{ID: 2, ProductID: 100, Reason: domain.PriceChangeReasonMarkdown, OldPrice: 5000, NewPrice: 5000, OldCost: 1800, NewCost: 1800, OldEffectivePrice: 5000, NewEffectivePrice: 4000, CreateDate: time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)},
+		}, nil).Once()
+
+		// when
+		got, err := ts.productService.GetProductPriceHistory(context.Background(), domain.GetProductPriceHistoryRequest{
+			UserID:    1,
+			ProductID: 100,
+			From:      pointer.String("2024-02-01"),
+			To:        pointer.String("2024-02-03"),
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "2024-02-01", got.From)
+		assert.Equal(t, "2024-02-03", got.To)
+		assert.Equal(t, "Asia/Seoul", got.TimeZone)
+		assert.Len(t, got.Changes, 1)
+		assert.Equal(t, pointer.Int(1), got.Changes[0].ActorID)
+		assert.Equal(t, []domain.PricePointDTO{
+			{Date: "2024-02-01", Price: 4500, Cost: 1800, EffectivePrice: 4500},
+			{Date: "2024-02-02", Price: 5000, Cost: 1800, EffectivePrice: 5000},
+			{Date: "2024-02-03", Price: 5000, Cost: 1800, EffectivePrice: 5000},
+		}, got.Series)
+	})
+
+	t.Run("FAIL - 다른 사장님의 상품", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product, nil).Once()
+
+		// when
+		_, err := ts.productService.GetProductPriceHistory(context.Background(), domain.GetProductPriceHistoryRequest{UserID: 2, ProductID: 100})
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Permission, err))
+	})
+}
+
+func Test_priceSeriesFrom(t *testing.T) {
+	since := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	until := since.AddDate(0, 0, 5)
+	markdownPrice := 4000.0
+	product := domain.Product{Price: 5000, Cost: 1800, MarkdownPrice: &markdownPrice}
+
+	t.Run("PASS - 변경이 없으면 지금 상품의 값", func(t *testing.T) {
+		got := priceSeriesFrom(product, nil, since, until, until.Add(time.Hour))
+
+		assert.Len(t, got, 5)
+		for _, point := range got {
+			assert.Equal(t, 4000.0, point.EffectivePrice)
+			assert.Equal(t, 5000.0, point.Price)
+		}
+	})
+
+	t.Run("PASS - 하루에 여러 번 바뀌면 마지막 값, 오지 않은 날짜는 빼기", func(t *testing.T) {
+		histories := []domain.PriceHistory{
+			{OldPrice: 3000, NewPrice: 3500, OldCost: 1000, NewCost: 1000, OldEffectivePrice: 3000, NewEffectivePrice: 3500, CreateDate: since.Add(25 * time.Hour)},
+			{OldPrice: 3500, NewPrice: 4000, OldCost: 1000, NewCost: 1200, OldEffectivePrice: 3500, NewEffectivePrice: 4000, CreateDate: since.Add(26 * time.Hour)},
+		}
+
+		got := priceSeriesFrom(product, histories, since, until, since.Add(50*time.Hour))
+
+		assert.Equal(t, []domain.PricePointDTO{
+			{Date: "2024-02-01", Price: 3000, Cost: 1000, EffectivePrice: 3000},
+			{Date: "2024-02-02", Price: 4000, Cost: 1200, EffectivePrice: 4000},
+			{Date: "2024-02-03", Price: 4000, Cost: 1200, EffectivePrice: 4000},
+		}, got)
+	})
+}
+
+func Test_productService_ListTrashProducts(t *testing.T) {
+	t.Run("PASS - 보관 기간으로 완전히 지워지는 시각과 태그를 채운다", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		deleteDate := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+		ts.productRepository.EXPECT().ListDeletedProducts(mock.Anything, 1, pointer.Int(10)).Return([]domain.Product{
+			{Base: domain.Base{ID: 11, DeleteDate: sql.NullTime{Time: deleteDate, Valid: true}}, UserID: 1, Name: "아메리카노"},
+			{Base: domain.Base{ID: 12, DeleteDate: sql.NullTime{Time: deleteDate.Add(time.Hour), Valid: true}}, UserID: 1, Name: "카페라떼"},
+		}, nil).Once()
+		ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{11, 12}).Return(nil, nil).Once()
+		ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{11, 12}).Return(nil, nil).Once()
+		ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{11, 12}).Return([]domain.ProductTag{
+			{ProductID: 12, Tag: domain.Tag{ID: 1, UserID: 1, Name: "신메뉴"}},
+		}, nil).Once()
+
+		// when
+		got, err := ts.productService.ListTrashProducts(context.Background(), domain.ListTrashProductsRequest{UserID: 1, Cursor: pointer.Int(10)})
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, got.Products, 2)
+		assert.Equal(t, deleteDate, got.Products[0].DeleteDate)
+		assert.Equal(t, deleteDate.Add(7*24*time.Hour), got.Products[0].PurgeDate)
+		assert.Empty(t, got.Products[0].Tags)
+		assert.Equal(t, []domain.ProductTagDTO{{ID: 1, Name: "신메뉴"}}, got.Products[1].Tags)
+		assert.Equal(t, pointer.Int(12), got.Cursor)
+	})
+
+	t.Run("FAIL - 조회 에러", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().ListDeletedProducts(mock.Anything, 1, (*int)(nil)).Return(nil, errors.New("db")).Once()
+
+		// when
+		_, err := ts.productService.ListTrashProducts(context.Background(), domain.ListTrashProductsRequest{UserID: 1})
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Internal, err))
+	})
+}
+
+func Test_productService_RestoreProduct(t *testing.T) {
+	deleted := &domain.Product{Base: domain.Base{ID: 100, DeleteDate: sql.NullTime{Time: time.Now(), Valid: true}}, UserID: 1, Name: "슈크림 라떼", Barcode: "8801234567893"}
+	restored := &domain.Product{Base: domain.Base{ID: 100}, UserID: 1, Name: "슈크림 라떼", Barcode: "8801234567893"}
+
+	tests := []struct {
+		name     string
+		req      domain.RestoreProductRequest
+		mock     func(ts productServiceTestSuite)
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 복원한 상품을 응답",
+			req:  domain.RestoreProductRequest{UserID: 1, ID: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetDeletedProduct(mock.Anything, 100).Return(deleted, nil).Once()
+				ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 1, "8801234567893").Return(nil, nil).Once()
+				ts.productRepository.EXPECT().RestoreProduct(mock.Anything, 100).Return(true, nil).Once()
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(restored, nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{100}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{100}).Return(nil, nil).Once()
+			},
+		},
+		{
+			name: "FAIL - 휴지통에 없는 상품",
+			req:  domain.RestoreProductRequest{UserID: 1, ID: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetDeletedProduct(mock.Anything, 100).Return(nil, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+		{
+			name: "FAIL - 다른 사장님의 상품",
+			req:  domain.RestoreProductRequest{UserID: 2, ID: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetDeletedProduct(mock.Anything, 100).Return(deleted, nil).Once()
+			},
+			wantKind: cerrors.Permission,
+		},
+		{
+			name: "FAIL - 그 사이 같은 바코드의 상품을 만듦",
+			req:  domain.RestoreProductRequest{UserID: 1, ID: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetDeletedProduct(mock.Anything, 100).Return(deleted, nil).Once()
+				ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 1, "8801234567893").Return(&domain.Product{Base: domain.Base{ID: 200}, UserID: 1}, nil).Once()
+			},
+			wantKind: cerrors.Exist,
+		},
+		{
+			name: "FAIL - 그 사이 다른 요청이 완전히 지움",
+			req:  domain.RestoreProductRequest{UserID: 1, ID: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetDeletedProduct(mock.Anything, 100).Return(deleted, nil).Once()
+				ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 1, "8801234567893").Return(nil, nil).Once()
+				ts.productRepository.EXPECT().RestoreProduct(mock.Anything, 100).Return(false, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.productService.RestoreProduct(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 100, got.Product.ID)
+		})
+	}
+}
+
+func Test_productService_PurgeProduct(t *testing.T) {
+	deleted := &domain.Product{Base: domain.Base{ID: 100, DeleteDate: sql.NullTime{Time: time.Now(), Valid: true}}, UserID: 1}
+	image := domain.ProductImage{ID: 1, ProductID: 100, StorageKey: "products/100/a", ContentType: "image/png"}
+
+	tests := []struct {
+		name     string
+		req      domain.PurgeProductRequest
+		mock     func(ts productServiceTestSuite)
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - DB 에서 지운 다음 이미지 파일을 지운다",
+			req:  domain.PurgeProductRequest{UserID: 1, ID: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetDeletedProduct(mock.Anything, 100).Return(deleted, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return([]domain.ProductImage{image}, nil).Once()
+				ts.productRepository.EXPECT().PurgeProduct(mock.Anything, 100).Return(true, nil).Once()
+				ts.imageStorage.EXPECT().Delete(mock.Anything, mock.Anything).Return(nil).Times(len(domain.ProductImageVariants))
+			},
+		},
+		{
+			name: "FAIL - 삭제하지 않은 상품",
+			req:  domain.PurgeProductRequest{UserID: 1, ID: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetDeletedProduct(mock.Anything, 100).Return(nil, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+		{
+			name: "FAIL - 다른 사장님의 상품",
+			req:  domain.PurgeProductRequest{UserID: 2, ID: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetDeletedProduct(mock.Anything, 100).Return(deleted, nil).Once()
+			},
+			wantKind: cerrors.Permission,
+		},
+		{
+			name: "FAIL - 그 사이 복원되면 이미지 파일을 지우지 않는다",
+			req:  domain.PurgeProductRequest{UserID: 1, ID: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetDeletedProduct(mock.Anything, 100).Return(deleted, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return([]domain.ProductImage{image}, nil).Once()
+				ts.productRepository.EXPECT().PurgeProduct(mock.Anything, 100).Return(false, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+		{
+			name: "FAIL - 삭제 에러",
+			req:  domain.PurgeProductRequest{UserID: 1, ID: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetDeletedProduct(mock.Anything, 100).Return(deleted, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{100}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().PurgeProduct(mock.Anything, 100).Return(false, errors.New("db")).Once()
+			},
+			wantKind: cerrors.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.productService.PurgeProduct(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_productService_DuplicateProduct(t *testing.T) {
+	source := &domain.Product{
+		Base:            domain.Base{ID: 100},
+		UserID:          1,
+		CategoryID:      1,
+		Initial:         "ㅅㅋㄹ ㄹㄸ",
+		Romanized:       "syukeurim ratte",
+		Price:           1000,
+		Cost:            500,
+		Name:            "슈크림 라떼",
+		Description:     "description",
+		Barcode:         "8801234567893",
+		ExpiryDate:      time.Date(2025, time.June, 10, 0, 0, 0, 0, time.UTC),
+		StockQuantity:   30,
+		ReorderPoint:    pointer.Int(5),
+		ReorderQuantity: 20,
+	}
+	groups := []domain.ProductOptionGroup{
+		{
+			ID:         1,
+			ProductID:  100,
+			Name:       "온도",
+			SelectType: domain.ProductOptionSelectTypeSingle,
+			Required:   true,
+			MinSelect:  1,
+			MaxSelect:  1,
+			Options:    []domain.ProductOption{{ID: 1, OptionGroupID: 1, Name: "HOT"}, {ID: 2, OptionGroupID: 1, Name: "ICE", DisplayOrder: 1}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		req      domain.DuplicateProductRequest
+		mock     func(ts productServiceTestSuite)
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 이름과 가격만 바꿔 복제하면 초성을 새 이름으로 다시 뽑는다",
+			req:  domain.DuplicateProductRequest{UserID: 1, ID: 100, Name: pointer.String("슈크림 라떼 라지"), Price: func() *float64 { p := 1500.0; return &p }(), Barcode: "LATTE-L", InternalBarcode: true},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(source, nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{100}).Return(groups, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{100}).Return([]domain.ProductTag{
+					{ProductID: 100, Tag: domain.Tag{ID: 1, UserID: 1, Name: "신메뉴"}},
+				}, nil).Once()
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&domain.Category{Base: domain.Base{ID: 1}, UserID: 1}, nil).Once()
+				ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 1, "LATTE-L").Return(nil, nil).Once()
+				ts.productRepository.EXPECT().CreateProduct(mock.Anything, mock.MatchedBy(func(product domain.Product) bool {
+					return product.Name == "슈크림 라떼 라지" &&
+						product.Initial == "ㅅㅋㄹ ㄹㄸ ㄹㅈ" &&
+						product.Romanized != source.Romanized &&
+						product.Price == 1500 &&
+						product.Cost == 500 &&
+						product.Barcode == "LATTE-L" &&
+						product.StockQuantity == 0 &&
+						*product.ReorderPoint == 5 &&
+						len(product.OptionGroups) == 1 &&
+						product.OptionGroups[0].ID == 0 &&
+						len(product.OptionGroups[0].Options) == 2 &&
+						reflect.DeepEqual(product.Tags, []domain.Tag{{Name: "신메뉴"}})
+				})).Return(101, nil).Once()
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 101).Return(&domain.Product{Base: domain.Base{ID: 101}, UserID: 1, Name: "슈크림 라떼 라지"}, nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{101}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductImages(mock.Anything, []int{101}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{101}).Return(nil, nil).Once()
+			},
+		},
+		{
+			name: "FAIL - 다른 사장님의 상품",
+			req:  domain.DuplicateProductRequest{UserID: 2, ID: 100, Barcode: "LATTE-L", InternalBarcode: true},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(source, nil).Once()
+			},
+			wantKind: cerrors.Permission,
+		},
+		{
+			name: "FAIL - 바꾼 값이 상품 검사를 통과하지 못함",
+			req:  domain.DuplicateProductRequest{UserID: 1, ID: 100, Name: pointer.String(""), Barcode: "LATTE-L", InternalBarcode: true},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(source, nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{100}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{100}).Return(nil, nil).Once()
+			},
+			wantKind: cerrors.Invalid,
+		},
+		{
+			name: "FAIL - 이미 같은 바코드의 상품이 있음",
+			req:  domain.DuplicateProductRequest{UserID: 1, ID: 100, Barcode: "8801234567893"},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(source, nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{100}).Return(nil, nil).Once()
+				ts.productRepository.EXPECT().ListProductTags(mock.Anything, []int{100}).Return(nil, nil).Once()
+				ts.categoryRepository.EXPECT().GetCategory(mock.Anything, 1).Return(&domain.Category{Base: domain.Base{ID: 1}, UserID: 1}, nil).Once()
+				ts.productRepository.EXPECT().GetProductByBarcode(mock.Anything, 1, "8801234567893").Return(source, nil).Once()
+			},
+			wantKind: cerrors.Exist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.productService.DuplicateProduct(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 101, got.Product.ID)
+		})
+	}
+}
+
+func Test_productService_CreateProductTemplate(t *testing.T) {
+	product := &domain.Product{
+		Base:        domain.Base{ID: 100},
+		UserID:      1,
+		CategoryID:  1,
+		Price:       1000,
+		Cost:        500,
+		Name:        "슈크림 라떼",
+		Description: "description",
+		Barcode:     "8801234567893",
+	}
+
+	tests := []struct {
+		name     string
+		req      domain.CreateProductTemplateRequest
+		mock     func(ts productServiceTestSuite)
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 이름을 보내지 않으면 상품명으로 저장",
+			req:  domain.CreateProductTemplateRequest{UserID: 1, ProductID: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product, nil).Once()
+				ts.productRepository.EXPECT().ListProductOptionGroups(mock.Anything, []int{100}).Return([]domain.ProductOptionGroup{
+					{ID: 1, ProductID: 100, Name: "온도", SelectType: domain.ProductOptionSelectTypeSingle, Required: true, MinSelect: 1, MaxSelect: 1, Options: []domain.ProductOption{{ID: 1, Name: "HOT"}}},
+				}, nil).Once()
+				ts.productRepository.EXPECT().CreateProductTemplate(mock.Anything, domain.ProductTemplate{
+					UserID:      1,
+					Name:        "슈크림 라떼",
+					CategoryID:  1,
+					Price:       1000,
+					Cost:        500,
+					ProductName: "슈크림 라떼",
+					Description: "description",
+					OptionGroups: []domain.ProductOptionGroupRequest{
+						{Name: "온도", SelectType: domain.ProductOptionSelectTypeSingle, Required: true, MinSelect: 1, MaxSelect: 1, Options: []domain.ProductOptionRequest{{Name: "HOT"}}},
+					},
+				}).Return(3, nil).Once()
+			},
+		},
+		{
+			name: "FAIL - 다른 사장님의 상품",
+			req:  domain.CreateProductTemplateRequest{UserID: 2, ProductID: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(product, nil).Once()
+			},
+			wantKind: cerrors.Permission,
+		},
+		{
+			name: "FAIL - 없는 상품",
+			req:  domain.CreateProductTemplateRequest{UserID: 1, ProductID: 100},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 100).Return(nil, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.productService.CreateProductTemplate(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 3, got.Template.ID)
+			assert.Equal(t, "슈크림 라떼", got.Template.Name)
+			assert.False(t, got.Template.CreateDate.IsZero())
+		})
+	}
+}
+
+func Test_productService_ListProductTemplates(t *testing.T) {
+	t.Run("PASS - 템플릿이 없으면 빈 목록", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().ListProductTemplates(mock.Anything, 1).Return(nil, nil).Once()
+
+		// when
+		got, err := ts.productService.ListProductTemplates(context.Background(), domain.ListProductTemplatesRequest{UserID: 1})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.ProductTemplateDTO{}, got.Templates)
+	})
+
+	t.Run("PASS - 템플릿 목록", func(t *testing.T) {
+		// given
+		ts := setupUserServiceTestSuite(t)
+		ts.productRepository.EXPECT().ListProductTemplates(mock.Anything, 1).Return([]domain.ProductTemplate{
+			{ID: 3, UserID: 1, Name: "라떼 기본", CategoryID: 1, Price: 1000, ProductName: "슈크림 라떼"},
+		}, nil).Once()
+
+		// when
+		got, err := ts.productService.ListProductTemplates(context.Background(), domain.ListProductTemplatesRequest{UserID: 1})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.ProductTemplateDTO{
+			{ID: 3, Name: "라떼 기본", CategoryID: 1, Price: 1000, ProductName: "슈크림 라떼"},
+		}, got.Templates)
+	})
+}
+
+func Test_productService_DeleteProductTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      domain.DeleteProductTemplateRequest
+		mock     func(ts productServiceTestSuite)
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 템플릿 삭제",
+			req:  domain.DeleteProductTemplateRequest{UserID: 1, ID: 3},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProductTemplate(mock.Anything, 3).Return(&domain.ProductTemplate{ID: 3, UserID: 1}, nil).Once()
+				ts.productRepository.EXPECT().DeleteProductTemplate(mock.Anything, 3).Return(nil).Once()
+			},
+		},
+		{
+			name: "FAIL - 다른 사장님의 템플릿은 없는 것으로 본다",
+			req:  domain.DeleteProductTemplateRequest{UserID: 2, ID: 3},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProductTemplate(mock.Anything, 3).Return(&domain.ProductTemplate{ID: 3, UserID: 1}, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+		{
+			name: "FAIL - 없는 템플릿",
+			req:  domain.DeleteProductTemplateRequest{UserID: 1, ID: 3},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProductTemplate(mock.Anything, 3).Return(nil, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.productService.DeleteProductTemplate(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_productService_CreateScheduledPriceChange(t *testing.T) {
+	price := float64(5000)
+	effectiveDate := time.Now().UTC().Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		req      domain.CreateScheduledPriceChangeRequest
+		mock     func(ts productServiceTestSuite)
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 가격 예약",
+			req:  domain.CreateScheduledPriceChangeRequest{UserID: 1, ProductID: 3, Price: &price, EffectiveDate: effectiveDate},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 3).Return(&domain.Product{Base: domain.Base{ID: 3}, UserID: 1, Name: "슈크림 라떼"}, nil).Once()
+				ts.productRepository.EXPECT().CreateScheduledPriceChange(mock.Anything, mock.MatchedBy(func(change domain.ScheduledPriceChange) bool {
+					return change.ProductID == 3 && change.UserID == 1 && change.Price != nil && *change.Price == 5000 && change.Cost == nil && change.EffectiveDate.Equal(effectiveDate)
+				})).Return(7, nil).Once()
+			},
+		},
+		{
+			name:     "FAIL - 지난 시각",
+			req:      domain.CreateScheduledPriceChangeRequest{UserID: 1, ProductID: 3, Price: &price, EffectiveDate: time.Now().UTC().Add(-time.Minute)},
+			mock:     func(ts productServiceTestSuite) {},
+			wantKind: cerrors.Invalid,
+		},
+		{
+			name: "FAIL - 다른 사장님의 상품",
+			req:  domain.CreateScheduledPriceChangeRequest{UserID: 2, ProductID: 3, Price: &price, EffectiveDate: effectiveDate},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 3).Return(&domain.Product{Base: domain.Base{ID: 3}, UserID: 1}, nil).Once()
+			},
+			wantKind: cerrors.Permission,
+		},
+		{
+			name: "FAIL - 없는 상품",
+			req:  domain.CreateScheduledPriceChangeRequest{UserID: 1, ProductID: 3, Price: &price, EffectiveDate: effectiveDate},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 3).Return(nil, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.productService.CreateScheduledPriceChange(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 7, got.ScheduledPrice.ID)
+			assert.Equal(t, "슈크림 라떼", got.ScheduledPrice.ProductName)
+			assert.Equal(t, 1, got.ScheduledPrice.ActorID)
+		})
+	}
+}
+
+func Test_productService_ListScheduledPriceChanges(t *testing.T) {
+	// given
+	ts := setupUserServiceTestSuite(t)
+	ts.productRepository.EXPECT().ListScheduledPriceChanges(mock.Anything, domain.ListScheduledPriceChangesParams{UserID: 1, ProductID: pointer.Int(3)}).Return(nil, nil).Once()
+
+	// when
+	got, err := ts.productService.ListScheduledPriceChanges(context.Background(), domain.ListScheduledPriceChangesRequest{UserID: 1, ProductID: pointer.Int(3)})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ListScheduledPriceChangesResponse{ScheduledPrices: []domain.ScheduledPriceChangeDTO{}}, got)
+}
+
+func Test_productService_CancelScheduledPriceChange(t *testing.T) {
+	pending := &domain.ScheduledPriceChange{ID: 7, ProductID: 3, UserID: 1}
+	product := &domain.Product{Base: domain.Base{ID: 3}, UserID: 1}
+
+	tests := []struct {
+		name     string
+		req      domain.CancelScheduledPriceChangeRequest
+		mock     func(ts productServiceTestSuite)
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 가격 예약 취소",
+			req:  domain.CancelScheduledPriceChangeRequest{UserID: 1, ID: 7},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetScheduledPriceChange(mock.Anything, 7).Return(pending, nil).Once()
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 3).Return(product, nil).Once()
+				ts.productRepository.EXPECT().CancelScheduledPriceChange(mock.Anything, 7, mock.Anything).Return(true, nil).Once()
+			},
+		},
+		{
+			name: "FAIL - 다른 사장님의 예약은 없는 것으로 본다",
+			req:  domain.CancelScheduledPriceChangeRequest{UserID: 2, ID: 7},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetScheduledPriceChange(mock.Anything, 7).Return(pending, nil).Once()
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 3).Return(product, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+		{
+			name: "FAIL - 이미 적용한 예약",
+			req:  domain.CancelScheduledPriceChangeRequest{UserID: 1, ID: 7},
+			mock: func(ts productServiceTestSuite) {
+				applied := *pending
+				applied.ApplyDate = sql.NullTime{Time: time.Now(), Valid: true}
+				ts.productRepository.EXPECT().GetScheduledPriceChange(mock.Anything, 7).Return(&applied, nil).Once()
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 3).Return(product, nil).Once()
+			},
+			wantKind: cerrors.Exist,
+		},
+		{
+			name: "FAIL - 취소하는 사이에 서버 작업이 먼저 적용함",
+			req:  domain.CancelScheduledPriceChangeRequest{UserID: 1, ID: 7},
+			mock: func(ts productServiceTestSuite) {
+				ts.productRepository.EXPECT().GetScheduledPriceChange(mock.Anything, 7).Return(pending, nil).Once()
+				ts.productRepository.EXPECT().GetProduct(mock.Anything, 3).Return(product, nil).Once()
+				ts.productRepository.EXPECT().CancelScheduledPriceChange(mock.Anything, 7, mock.Anything).Return(false, nil).Once()
+			},
+			wantKind: cerrors.Exist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupUserServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.productService.CancelScheduledPriceChange(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
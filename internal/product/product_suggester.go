@@ -0,0 +1,319 @@
+package product
+
+import (
+	"payhere/domain"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// suggestIndexTTL
+// 인덱스는 서버마다 따로 들고 있어 다른 서버에서 바뀐 상품은 반영되지 않으므로 일정 시간이 지나면 저장소에서 다시 읽어온다.
+const suggestIndexTTL = 5 * time.Minute
+
+// productSuggester
+// 사장님별 상품명 자동완성 인덱스. 상품명, 초성, 로마자 표기를 접두사 트리에 넣어두고 상품 생성, 수정, 삭제 시 함께 갱신한다.
+// 인덱스는 사장님이 처음 자동완성을 요청할 때 저장소에서 읽어와 만들고 suggestIndexTTL 이 지나면 다시 만든다.
+type productSuggester struct {
+	mu      sync.RWMutex
+	indexes map[int]*productNameIndex
+	loads   map[int][]*suggestLoad
+	now     func() time.Time
+}
+
+type productNameIndex struct {
+	trie     *productNameTrie
+	loadedAt time.Time
+}
+
+// suggestLoad
+// 저장소에서 상품명을 읽는 동안 들어온 변경. 읽어온 상품명에 변경이 빠져 있을 수 있으므로 인덱스를 만든 뒤 순서대로 다시 적용한다.
+type suggestLoad struct {
+	changes []suggestChange
+}
+
+type suggestChange struct {
+	name      domain.ProductName
+	productID int
+	removed   bool
+}
+
+func (c suggestChange) apply(trie *productNameTrie) {
+	if c.removed {
+		trie.remove(c.productID)
+		return
+	}
+	trie.remove(c.name.ID)
+	trie.insert(c.name)
+}
+
+func newProductSuggester() *productSuggester {
+	return &productSuggester{
+		indexes: make(map[int]*productNameIndex),
+		loads:   make(map[int][]*suggestLoad),
+		now:     time.Now,
+	}
+}
+
+// loaded
+// 만든 지 suggestIndexTTL 이 지나지 않은 인덱스가 있는지 확인한다.
+func (s *productSuggester) loaded(userID int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.fresh(userID)
+}
+
+func (s *productSuggester) fresh(userID int) bool {
+	index, ok := s.indexes[userID]
+	return ok && s.now().Sub(index.loadedAt) < suggestIndexTTL
+}
+
+// beginLoad
+// 인덱스를 새로 만들어야 하면 변경을 모아둘 suggestLoad 를 등록해 돌려주고, 그렇지 않으면 nil 을 돌려준다.
+// 상품명은 beginLoad 다음에 읽어야 그 사이의 변경이 빠지지 않는다.
+// 다른 요청이 이미 다시 만들고 있으면 오래된 인덱스로 응답하도록 nil 을 돌려준다.
+func (s *productSuggester) beginLoad(userID int) *suggestLoad {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.fresh(userID) {
+		return nil
+	}
+	if _, ok := s.indexes[userID]; ok && len(s.loads[userID]) > 0 {
+		return nil
+	}
+
+	load := &suggestLoad{}
+	s.loads[userID] = append(s.loads[userID], load)
+	return load
+}
+
+// finishLoad
+// 읽어온 상품명으로 인덱스를 만들고 읽는 동안 들어온 변경을 다시 적용한 다음 교체한다.
+func (s *productSuggester) finishLoad(userID int, load *suggestLoad, names []domain.ProductName) {
+	trie := newProductNameTrie()
+	for _, name := range names {
+		trie.insert(name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.unregister(userID, load)
+	for _, change := range load.changes {
+		change.apply(trie)
+	}
+	s.indexes[userID] = &productNameIndex{trie: trie, loadedAt: s.now()}
+}
+
+// abortLoad
+// 상품명을 읽지 못했으면 모아둔 변경을 버린다. 인덱스는 다음 조회 때 다시 만든다.
+func (s *productSuggester) abortLoad(userID int, load *suggestLoad) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.unregister(userID, load)
+}
+
+func (s *productSuggester) unregister(userID int, load *suggestLoad) {
+	loads := s.loads[userID]
+	for i := range loads {
+		if loads[i] == load {
+			loads = append(loads[:i], loads[i+1:]...)
+			break
+		}
+	}
+	if len(loads) == 0 {
+		delete(s.loads, userID)
+		return
+	}
+	s.loads[userID] = loads
+}
+
+// upsert
+// 인덱스가 아직 만들어지지 않았고 만드는 중도 아닌 사장님은 다음 조회 시 저장소에서 새로 읽어오므로 무시한다.
+func (s *productSuggester) upsert(userID int, name domain.ProductName) {
+	s.change(userID, suggestChange{name: name})
+}
+
+func (s *productSuggester) remove(userID int, productID int) {
+	s.change(userID, suggestChange{productID: productID, removed: true})
+}
+
+func (s *productSuggester) change(userID int, change suggestChange) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if index, ok := s.indexes[userID]; ok {
+		change.apply(index.trie)
+	}
+	for _, load := range s.loads[userID] {
+		load.changes = append(load.changes, change)
+	}
+}
+
+func (s *productSuggester) suggest(userID int, query string, limit int) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	index, ok := s.indexes[userID]
+	if !ok {
+		return nil
+	}
+	return index.trie.suggest(query, limit)
+}
+
+type productNameTrieNode struct {
+	children   map[rune]*productNameTrieNode
+	productIDs map[int]struct{}
+}
+
+func newProductNameTrieNode() *productNameTrieNode {
+	return &productNameTrieNode{
+		children:   make(map[rune]*productNameTrieNode),
+		productIDs: make(map[int]struct{}),
+	}
+}
+
+// productNameTrie
+// 각 노드는 해당 접두사를 가진 상품 ID 집합을 들고 있어 접두사 노드에 도달하면 바로 후보를 얻을 수 있다.
+type productNameTrie struct {
+	root     *productNameTrieNode
+	products map[int]domain.ProductName
+	keys     map[int][]string
+}
+
+func newProductNameTrie() *productNameTrie {
+	return &productNameTrie{
+		root:     newProductNameTrieNode(),
+		products: make(map[int]domain.ProductName),
+		keys:     make(map[int][]string),
+	}
+}
+
+func (t *productNameTrie) insert(name domain.ProductName) {
+	keys := suggestKeys(name)
+
+	for _, key := range keys {
+		node := t.root
+		for _, r := range key {
+			child, ok := node.children[r]
+			if !ok {
+				child = newProductNameTrieNode()
+				node.children[r] = child
+			}
+			child.productIDs[name.ID] = struct{}{}
+			node = child
+		}
+	}
+
+	t.products[name.ID] = name
+	t.keys[name.ID] = keys
+}
+
+func (t *productNameTrie) remove(productID int) {
+	for _, key := range t.keys[productID] {
+		t.removeKey(t.root, []rune(key), productID)
+	}
+
+	delete(t.products, productID)
+	delete(t.keys, productID)
+}
+
+func (t *productNameTrie) removeKey(node *productNameTrieNode, key []rune, productID int) {
+	if len(key) == 0 {
+		return
+	}
+
+	child, ok := node.children[key[0]]
+	if !ok {
+		return
+	}
+	t.removeKey(child, key[1:], productID)
+
+	delete(child.productIDs, productID)
+	if len(child.productIDs) == 0 {
+		delete(node.children, key[0])
+	}
+}
+
+// suggest
+// 상품명 전체가 검색어로 시작하는 상품을 단어 중간에서 일치한 상품보다 먼저, 그 안에서는 짧은 이름 순으로 돌려준다.
+func (t *productNameTrie) suggest(query string, limit int) []string {
+	query = normalizeSuggestKey(query)
+
+	node := t.root
+	for _, r := range query {
+		child, ok := node.children[r]
+		if !ok {
+			return nil
+		}
+		node = child
+	}
+
+	candidates := make([]domain.ProductName, 0, len(node.productIDs))
+	for productID := range node.productIDs {
+		candidates = append(candidates, t.products[productID])
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		iFull, jFull := matchesFromStart(candidates[i], query), matchesFromStart(candidates[j], query)
+		if iFull != jFull {
+			return iFull
+		}
+		iLen, jLen := len([]rune(candidates[i].Name)), len([]rune(candidates[j].Name))
+		if iLen != jLen {
+			return iLen < jLen
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	suggestions := make([]string, 0, limit)
+	seen := make(map[string]struct{})
+	for _, candidate := range candidates {
+		if len(suggestions) >= limit {
+			break
+		}
+		if _, ok := seen[candidate.Name]; ok {
+			continue
+		}
+		seen[candidate.Name] = struct{}{}
+		suggestions = append(suggestions, candidate.Name)
+	}
+
+	return suggestions
+}
+
+func matchesFromStart(name domain.ProductName, query string) bool {
+	return strings.HasPrefix(normalizeSuggestKey(name.Name), query) ||
+		strings.HasPrefix(normalizeSuggestKey(name.Initial), query) ||
+		strings.HasPrefix(normalizeSuggestKey(name.Romanized), query)
+}
+
+// suggestKeys
+// 상품명, 초성, 로마자 표기 각각에 대해 전체 문자열과 띄어쓰기 뒤의 각 단어부터 시작하는 문자열을 키로 사용한다.
+// ex) "딸기 요거트" -> "딸기 요거트", "요거트", "ㄸㄱ ㅇㄱㅌ", "ㅇㄱㅌ"
+func suggestKeys(name domain.ProductName) []string {
+	var keys []string
+	seen := make(map[string]struct{})
+
+	for _, source := range []string{name.Name, name.Initial, name.Romanized} {
+		words := strings.Fields(normalizeSuggestKey(source))
+		for i := range words {
+			key := strings.Join(words[i:], " ")
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func normalizeSuggestKey(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
@@ -0,0 +1,143 @@
+package product
+
+import (
+	"github.com/stretchr/testify/assert"
+	"payhere/domain"
+	"testing"
+	"time"
+)
+
+func Test_productSuggester_suggest(t *testing.T) {
+	names := []domain.ProductName{
+		{ID: 1, Name: "아메리카노", Initial: "ㅇㅁㄹㅋㄴ", Romanized: "amerikano"},
+		{ID: 2, Name: "아이스티", Initial: "ㅇㅇㅅㅌ"},
+		{ID: 3, Name: "아이스크림", Initial: "ㅇㅇㅅㅋㄹ"},
+		{ID: 4, Name: "딸기 요거트", Initial: "ㄸㄱ ㅇㄱㅌ"},
+		{ID: 5, Name: "플레인요거트", Initial: "ㅍㄹㅇㅇㄱㅌ"},
+		{ID: 6, Name: "Cold Brew", Initial: "Cold Brew"},
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		limit int
+		want  []string
+	}{
+		{name: "상품명 접두사", query: "아이", limit: 10, want: []string{"아이스티", "아이스크림"}},
+		{name: "초성 접두사", query: "ㅇㅇㅅ", limit: 10, want: []string{"아이스티", "아이스크림"}},
+		{name: "단어 중간 일치는 뒤에 노출", query: "ㅇ", limit: 10, want: []string{"아이스티", "아메리카노", "아이스크림", "딸기 요거트"}},
+		{name: "띄어쓰기 뒤 단어 접두사", query: "요거", limit: 10, want: []string{"딸기 요거트"}},
+		{name: "로마자 표기 접두사", query: "ameri", limit: 10, want: []string{"아메리카노"}},
+		{name: "대소문자 무시", query: "brew", limit: 10, want: []string{"Cold Brew"}},
+		{name: "개수 제한", query: "아", limit: 1, want: []string{"아이스티"}},
+		{name: "일치하는 상품 없음", query: "케이크", limit: 10, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			suggester := newProductSuggester()
+			suggester.finishLoad(1, suggester.beginLoad(1), names)
+
+			// when
+			got := suggester.suggest(1, tt.query, tt.limit)
+
+			// then
+			if tt.want == nil {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_productSuggester_upsertAndRemove(t *testing.T) {
+	// given
+	suggester := newProductSuggester()
+	suggester.upsert(1, domain.ProductName{ID: 1, Name: "카페라떼", Initial: "ㅋㅍㄹㄸ"})
+	assert.False(t, suggester.loaded(1))
+
+	suggester.finishLoad(1, suggester.beginLoad(1), []domain.ProductName{
+		{ID: 1, Name: "카페라떼", Initial: "ㅋㅍㄹㄸ"},
+	})
+
+	// when
+	suggester.upsert(1, domain.ProductName{ID: 1, Name: "카페모카", Initial: "ㅋㅍㅁㅋ"})
+	suggester.upsert(1, domain.ProductName{ID: 2, Name: "카푸치노", Initial: "ㅋㅍㅊㄴ"})
+
+	// then
+	assert.Equal(t, []string{"카페모카", "카푸치노"}, suggester.suggest(1, "ㅋㅍ", 10))
+	assert.Empty(t, suggester.suggest(1, "카페라", 10))
+
+	// when
+	suggester.remove(1, 1)
+
+	// then
+	assert.Equal(t, []string{"카푸치노"}, suggester.suggest(1, "카", 10))
+	assert.Empty(t, suggester.suggest(2, "카", 10))
+}
+
+func Test_productSuggester_load(t *testing.T) {
+	t.Run("PASS - 상품명을 읽는 동안 들어온 변경을 다시 적용", func(t *testing.T) {
+		// given
+		suggester := newProductSuggester()
+		load := suggester.beginLoad(1)
+		assert.NotNil(t, load)
+
+		// when
+		suggester.upsert(1, domain.ProductName{ID: 2, Name: "카푸치노", Initial: "ㅋㅍㅊㄴ"})
+		suggester.remove(1, 1)
+		suggester.finishLoad(1, load, []domain.ProductName{
+			{ID: 1, Name: "카페라떼", Initial: "ㅋㅍㄹㄸ"},
+		})
+
+		// then
+		assert.Equal(t, []string{"카푸치노"}, suggester.suggest(1, "카", 10))
+	})
+
+	t.Run("PASS - 다른 요청이 다시 만드는 중이면 오래된 인덱스로 응답", func(t *testing.T) {
+		// given
+		suggester := newProductSuggester()
+		suggester.finishLoad(1, suggester.beginLoad(1), []domain.ProductName{{ID: 1, Name: "카페라떼"}})
+		suggester.now = func() time.Time { return time.Now().Add(suggestIndexTTL) }
+		load := suggester.beginLoad(1)
+		assert.NotNil(t, load)
+
+		// when
+		got := suggester.beginLoad(1)
+
+		// then
+		assert.Nil(t, got)
+		assert.Equal(t, []string{"카페라떼"}, suggester.suggest(1, "카", 10))
+	})
+
+	t.Run("PASS - 읽지 못한 인덱스는 다음 조회 때 다시 만듦", func(t *testing.T) {
+		// given
+		suggester := newProductSuggester()
+		suggester.abortLoad(1, suggester.beginLoad(1))
+
+		// when
+		suggester.upsert(1, domain.ProductName{ID: 1, Name: "카페라떼"})
+
+		// then
+		assert.False(t, suggester.loaded(1))
+		assert.NotNil(t, suggester.beginLoad(1))
+	})
+
+	t.Run("PASS - TTL 이 지난 인덱스는 다시 만듦", func(t *testing.T) {
+		// given
+		now := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+		suggester := newProductSuggester()
+		suggester.now = func() time.Time { return now }
+		suggester.finishLoad(1, suggester.beginLoad(1), nil)
+		assert.Nil(t, suggester.beginLoad(1))
+
+		// when
+		now = now.Add(suggestIndexTTL)
+
+		// then
+		assert.False(t, suggester.loaded(1))
+		assert.NotNil(t, suggester.beginLoad(1))
+	})
+}
@@ -0,0 +1,197 @@
+package product
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 국어의 로마자 표기법(Revised Romanization) 자모 표기
+var (
+	romanInitials = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	romanMedials  = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+	// 받침을 단독으로 읽을 때의 대표음
+	romanFinals = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+	// 뒤 음절이 모음(ㅇ)으로 시작할 때 넘어가는 받침의 소리 (연음)
+	romanLinkedFinals = []string{"", "g", "kk", "ks", "n", "nj", "n", "d", "r", "lg", "lm", "lb", "ls", "lt", "lp", "r", "m", "b", "ps", "s", "ss", "ng", "j", "ch", "k", "t", "p", ""}
+)
+
+const (
+	jongNone  = 0
+	jongRieul = 8
+	jongIeung = 21
+	choNieun  = 2
+	choRieul  = 5
+	choMieum  = 6
+	choIeung  = 11
+)
+
+// romanize
+// 한글 상품명을 국어의 로마자 표기법에 맞춰 변환한다. 연음, 비음화, 유음화 정도의 기본적인 음운 변화만 반영하고
+// 한글이 아닌 문자는 소문자로 바꿔 그대로 둔다.
+// ex) "아메리카노" -> "amerikano", "블루베리 요거트" -> "beulluberi yogeoteu"
+func romanize(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+
+	for i, c := range runes {
+		if c < hangulBase || c > hangulEnd {
+			b.WriteString(strings.ToLower(string(c)))
+			continue
+		}
+
+		offset := int(c - hangulBase)
+		cho, jung, jong := offset/588, (offset%588)/28, offset%28
+
+		if i == 0 || !isHangulSyllable(runes[i-1]) {
+			b.WriteString(romanInitials[cho])
+		} else {
+			prevJong := int(runes[i-1]-hangulBase) % 28
+			b.WriteString(romanInitialAfter(prevJong, cho))
+		}
+		b.WriteString(romanMedials[jung])
+
+		if i+1 < len(runes) && isHangulSyllable(runes[i+1]) {
+			nextCho := int(runes[i+1]-hangulBase) / 588
+			b.WriteString(romanFinalBefore(jong, nextCho))
+		} else {
+			b.WriteString(romanFinals[jong])
+		}
+	}
+
+	return b.String()
+}
+
+func isHangulSyllable(c rune) bool {
+	return c >= hangulBase && c <= hangulEnd
+}
+
+// romanFinalBefore
+// 다음 음절의 초성에 따라 받침이 어떻게 읽히는지 반환한다.
+func romanFinalBefore(jong, nextCho int) string {
+	if jong == jongNone {
+		return ""
+	}
+
+	switch {
+	case nextCho == choIeung:
+		// 연음되는 받침은 다음 음절의 초성에서 표기한다.
+		if jong == jongIeung {
+			return "ng"
+		}
+		return ""
+	case nextCho == choRieul && jong == jongRieul:
+		return "l"
+	case nextCho == choNieun || nextCho == choMieum || nextCho == choRieul:
+		switch romanFinals[jong] {
+		case "k":
+			return "ng"
+		case "t":
+			return "n"
+		case "p":
+			return "m"
+		case "n":
+			if nextCho == choRieul {
+				return "l"
+			}
+		}
+	}
+
+	return romanFinals[jong]
+}
+
+// romanInitialAfter
+// 앞 음절의 받침에 따라 초성이 어떻게 읽히는지 반환한다.
+func romanInitialAfter(prevJong, cho int) string {
+	switch {
+	case cho == choIeung:
+		if prevJong == jongIeung {
+			return ""
+		}
+		return romanLinkedFinals[prevJong]
+	case cho == choRieul && prevJong != jongNone:
+		// ㄹㄹ, ㄴㄹ 은 ll 로, 그 외 받침 뒤의 ㄹ 은 ㄴ 으로 읽는다.
+		if prevJong == jongRieul || romanFinals[prevJong] == "n" {
+			return "l"
+		}
+		return "n"
+	case cho == choNieun && romanFinals[prevJong] == "l":
+		return "l"
+	}
+
+	return romanInitials[cho]
+}
+
+// isRomanizedQuery
+// 검색어가 로마자 검색 대상(영문자, 공백, 하이픈)으로만 이루어져 있는지 확인한다.
+func isRomanizedQuery(s string) bool {
+	hasLetter := false
+
+	for _, c := range s {
+		switch {
+		case c <= unicode.MaxASCII && unicode.IsLetter(c):
+			hasLetter = true
+		case c == ' ' || c == '-':
+		default:
+			return false
+		}
+	}
+	return hasLetter
+}
+
+// normalizeRomanizedQuery
+// 영어식 철자를 로마자 표기법에 가깝게 바꿔 "americano", "cafe latte" 처럼 입력해도 검색되도록 한다.
+// 띄어쓰기는 상품명마다 달라 비교하지 않으므로 모두 제거한다.
+// ex) "Americano" -> "amerikano", "cafe latte" -> "kaperatte"
+func normalizeRomanizedQuery(s string) string {
+	s = strings.ToLower(s)
+	s = strings.ReplaceAll(s, " ", "")
+	s = strings.ReplaceAll(s, "-", "")
+	s = strings.ReplaceAll(s, "ph", "p")
+
+	runes := []rune(s)
+	var b strings.Builder
+
+	for i, c := range runes {
+		var prev, next rune
+		if i > 0 {
+			prev = runes[i-1]
+		}
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+
+		switch c {
+		case 'c':
+			if next == 'h' {
+				b.WriteRune(c)
+			} else {
+				b.WriteRune('k')
+			}
+		case 'f':
+			b.WriteRune('p')
+		case 'v':
+			b.WriteRune('b')
+		case 'z':
+			b.WriteRune('j')
+		case 'q':
+			b.WriteRune('k')
+		case 'x':
+			b.WriteString("ks")
+		case 'l':
+			// 모음 앞의 ㄹ 은 r 로 표기한다. (ll 은 그대로)
+			if prev != 'l' && isRomanVowel(next) {
+				b.WriteRune('r')
+			} else {
+				b.WriteRune(c)
+			}
+		default:
+			b.WriteRune(c)
+		}
+	}
+
+	return b.String()
+}
+
+func isRomanVowel(c rune) bool {
+	return strings.ContainsRune("aeiouy", c)
+}
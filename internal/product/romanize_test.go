@@ -0,0 +1,75 @@
+package product
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_romanize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "아메리카노", input: "아메리카노", expected: "amerikano"},
+		{name: "카페라떼", input: "카페라떼", expected: "kaperatte"},
+		{name: "바닐라라떼 (ㄹㄹ)", input: "바닐라라떼", expected: "banillaratte"},
+		{name: "헤이즐넛라떼 (ㄹ 앞 받침)", input: "헤이즐넛라떼", expected: "heijeulleonnatte"},
+		{name: "블루베리 요거트", input: "블루베리 요거트", expected: "beulluberi yogeoteu"},
+		{name: "망고 스무디 (ㅇ 받침)", input: "망고 스무디", expected: "manggo seumudi"},
+		{name: "구찌 반지갑 (단어 끝 받침)", input: "구찌 반지갑", expected: "gujji banjigap"},
+		{name: "연음", input: "먹어요", expected: "meogeoyo"},
+		{name: "비음화", input: "국물", expected: "gungmul"},
+		{name: "유음화 (ㄴㄹ)", input: "신라", expected: "silla"},
+		{name: "유음화 (ㄹㄴ)", input: "설날", expected: "seollal"},
+		{name: "한글 영어 혼합", input: "ABC 초코", expected: "abc choko"},
+		{name: "영어만 입력", input: "Latte", expected: "latte"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, romanize(tt.input))
+		})
+	}
+}
+
+func Test_isRomanizedQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{name: "영어만 있는 경우", input: "americano", expected: true},
+		{name: "영어와 공백, 하이픈", input: "cafe-latte mocha", expected: true},
+		{name: "한글이 섞인 경우", input: "cafe 라떼", expected: false},
+		{name: "숫자가 섞인 경우", input: "latte2", expected: false},
+		{name: "공백만 있는 경우", input: "  ", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isRomanizedQuery(tt.input))
+		})
+	}
+}
+
+func Test_normalizeRomanizedQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "로마자 표기 그대로", input: "amerikano", expected: "amerikano"},
+		{name: "c -> k", input: "Americano", expected: "amerikano"},
+		{name: "f -> p, 모음 앞 l -> r, 띄어쓰기 제거", input: "cafe latte", expected: "kaperatte"},
+		{name: "ll 은 유지", input: "Vanilla Latte", expected: "banillaratte"},
+		{name: "ch 는 유지", input: "chocolate", expected: "chokorate"},
+		{name: "ph -> p", input: "phone", expected: "pone"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, normalizeRomanizedQuery(tt.input))
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package product
+
+import (
+	"context"
+	"log"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+const (
+	defaultScheduledPriceInterval = time.Minute
+	scheduledPriceBatchSize       = 100
+)
+
+// ScheduledPriceJob
+// 주기적으로 적용할 시각이 지난 가격 예약을 상품에 적용한다.
+// 예약에 적용 시각을 기록하는 것과 가격을 바꾸는 것을 한 트랜잭션에서 하고, 적용 시각을 먼저 기록한 쪽만 가격을 바꾸므로
+// 여러 서버에서 함께 실행해도 예약을 한 번만 적용한다.
+type ScheduledPriceJob struct {
+	productRepository domain.ProductRepository
+	interval          time.Duration
+}
+
+func NewScheduledPriceJob(productRepository domain.ProductRepository, interval time.Duration) *ScheduledPriceJob {
+	if interval <= 0 {
+		interval = defaultScheduledPriceInterval
+	}
+	return &ScheduledPriceJob{
+		productRepository: productRepository,
+		interval:          interval,
+	}
+}
+
+// Run
+// ctx 가 끝날 때까지 interval 마다 Apply 를 실행한다.
+func (j *ScheduledPriceJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.Apply(ctx, time.Now().UTC()); err != nil {
+			log.Println(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Apply
+// 적용할 예약이 없을 때까지 batch 단위로 적용한다.
+// 예약 하나를 적용하지 못해도 나머지는 적용하고, 적용하지 못한 예약은 다음 실행 때 다시 적용한다.
+func (j *ScheduledPriceJob) Apply(ctx context.Context, now time.Time) error {
+	const op cerrors.Op = "product/ScheduledPriceJob/Apply"
+
+	for {
+		changes, err := j.productRepository.ListDueScheduledPriceChanges(ctx, now, scheduledPriceBatchSize)
+		if err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "적용할 가격 예약을 조회하는 중에 에러가 발생했습니다.")
+		}
+
+		var firstErr error
+		for _, change := range changes {
+			if err := applyScheduledPriceChange(ctx, j.productRepository, change, now); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+		// 적용하지 못한 예약은 다시 조회되므로 같은 batch 를 반복하지 않도록 여기서 멈춘다.
+		if firstErr != nil {
+			return firstErr
+		}
+
+		if len(changes) < scheduledPriceBatchSize {
+			return nil
+		}
+	}
+}
+
+// applyScheduledPriceChange
+// 다른 서버가 먼저 적용했거나 사장님이 취소했으면 아무것도 하지 않는다.
+// 상품이 휴지통에 있으면 예약을 남겨두고 복구한 다음 적용한다.
+func applyScheduledPriceChange(ctx context.Context, repository domain.ProductRepository, change domain.ScheduledPriceChange, now time.Time) error {
+	const op cerrors.Op = "product/applyScheduledPriceChange"
+
+	return repository.WithTx(ctx, func(repository domain.ProductRepository) error {
+		product, err := repository.GetProduct(ctx, change.ProductID)
+		if err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "상품을 조회하는 중에 에러가 발생했습니다.")
+		}
+		if product == nil {
+			return nil
+		}
+
+		claimed, err := repository.ClaimScheduledPriceChange(ctx, change.ID, now)
+		if err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "가격 예약을 적용하는 중에 에러가 발생했습니다.")
+		}
+		if !claimed {
+			return nil
+		}
+
+		after := change.Apply(*product)
+		if err := repository.UpdateProduct(ctx, after); err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "상품 가격을 바꾸는 중에 에러가 발생했습니다.")
+		}
+
+		if history := domain.PriceHistoryFrom(*product, after, &change.UserID, domain.PriceChangeReasonScheduled, now); history != nil {
+			if err := repository.CreatePriceHistory(ctx, *history); err != nil {
+				return cerrors.E(op, cerrors.Internal, err, "가격 변경 기록을 저장하는 중에 에러가 발생했습니다.")
+			}
+		}
+
+		return nil
+	})
+}
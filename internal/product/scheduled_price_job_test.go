@@ -0,0 +1,112 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"payhere/domain"
+	"payhere/mocks"
+	"testing"
+	"time"
+)
+
+func TestScheduledPriceJob_Apply(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	price := float64(5000)
+	change := domain.ScheduledPriceChange{ID: 7, ProductID: 3, UserID: 2, Price: &price, EffectiveDate: now}
+	product := domain.Product{Base: domain.Base{ID: 3}, UserID: 1, Price: 4500, Cost: 1500, Version: 4}
+
+	withTx := func(productRepository *mocks.ProductRepository) {
+		productRepository.EXPECT().WithTx(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn func(domain.ProductRepository) error) error {
+			return fn(productRepository)
+		}).Once()
+	}
+
+	tests := []struct {
+		name    string
+		mock    func(productRepository *mocks.ProductRepository)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 예약한 가격을 적용하고 예약한 사장님을 기록",
+			mock: func(productRepository *mocks.ProductRepository) {
+				productRepository.EXPECT().ListDueScheduledPriceChanges(mock.Anything, now, scheduledPriceBatchSize).Return([]domain.ScheduledPriceChange{change}, nil).Once()
+				withTx(productRepository)
+				productRepository.EXPECT().GetProduct(mock.Anything, 3).Return(&product, nil).Once()
+				productRepository.EXPECT().ClaimScheduledPriceChange(mock.Anything, 7, now).Return(true, nil).Once()
+				productRepository.EXPECT().UpdateProduct(mock.Anything, mock.MatchedBy(func(p domain.Product) bool {
+					return p.ID == 3 && p.Price == 5000 && p.Cost == 1500 && p.Version == 4
+				})).Return(nil).Once()
+				productRepository.EXPECT().CreatePriceHistory(mock.Anything, mock.MatchedBy(func(history domain.PriceHistory) bool {
+					return history.ProductID == 3 &&
+						history.UserID != nil && *history.UserID == 2 &&
+						history.Reason == domain.PriceChangeReasonScheduled &&
+						history.OldPrice == 4500 && history.NewPrice == 5000 &&
+						history.CreateDate.Equal(now)
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "PASS - 할인 중인 상품은 이전 정가로 계산한 할인가를 지우고 적용",
+			mock: func(productRepository *mocks.ProductRepository) {
+				markdownPrice := float64(3150)
+				discounted := product
+				discounted.MarkdownPrice = &markdownPrice
+				productRepository.EXPECT().ListDueScheduledPriceChanges(mock.Anything, now, scheduledPriceBatchSize).Return([]domain.ScheduledPriceChange{change}, nil).Once()
+				withTx(productRepository)
+				productRepository.EXPECT().GetProduct(mock.Anything, 3).Return(&discounted, nil).Once()
+				productRepository.EXPECT().ClaimScheduledPriceChange(mock.Anything, 7, now).Return(true, nil).Once()
+				productRepository.EXPECT().UpdateProduct(mock.Anything, mock.MatchedBy(func(p domain.Product) bool {
+					return p.ID == 3 && p.Price == 5000 && p.MarkdownPrice == nil
+				})).Return(nil).Once()
+				productRepository.EXPECT().CreatePriceHistory(mock.Anything, mock.MatchedBy(func(history domain.PriceHistory) bool {
+					return history.OldEffectivePrice == 3150 && history.NewEffectivePrice == 5000
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "PASS - 다른 서버가 먼저 적용한 예약은 건너뜀",
+			mock: func(productRepository *mocks.ProductRepository) {
+				productRepository.EXPECT().ListDueScheduledPriceChanges(mock.Anything, now, scheduledPriceBatchSize).Return([]domain.ScheduledPriceChange{change}, nil).Once()
+				withTx(productRepository)
+				productRepository.EXPECT().GetProduct(mock.Anything, 3).Return(&product, nil).Once()
+				productRepository.EXPECT().ClaimScheduledPriceChange(mock.Anything, 7, now).Return(false, nil).Once()
+			},
+		},
+		{
+			name: "PASS - 휴지통에 있는 상품의 예약은 남겨둠",
+			mock: func(productRepository *mocks.ProductRepository) {
+				productRepository.EXPECT().ListDueScheduledPriceChanges(mock.Anything, now, scheduledPriceBatchSize).Return([]domain.ScheduledPriceChange{change}, nil).Once()
+				withTx(productRepository)
+				productRepository.EXPECT().GetProduct(mock.Anything, 3).Return(nil, nil).Once()
+			},
+		},
+		{
+			name: "FAIL - 적용하지 못한 예약이 있어도 나머지는 적용",
+			mock: func(productRepository *mocks.ProductRepository) {
+				other := domain.ScheduledPriceChange{ID: 8, ProductID: 5, UserID: 1, Price: &price, EffectiveDate: now}
+				productRepository.EXPECT().ListDueScheduledPriceChanges(mock.Anything, now, scheduledPriceBatchSize).Return([]domain.ScheduledPriceChange{change, other}, nil).Once()
+				productRepository.EXPECT().WithTx(mock.Anything, mock.Anything).Return(errors.New("lock wait timeout")).Once()
+				withTx(productRepository)
+				productRepository.EXPECT().GetProduct(mock.Anything, 5).Return(nil, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			productRepository := mocks.NewProductRepository(t)
+			tt.mock(productRepository)
+			job := NewScheduledPriceJob(productRepository, 0)
+
+			// when
+			err := job.Apply(context.Background(), now)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
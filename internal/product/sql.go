@@ -1,55 +1,591 @@
 package product
 
-const createProductQuery = "INSERT INTO products (user_id, initial, category, price, cost, name, description, barcode, expiry_date, size) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+const createProductQuery = "INSERT INTO products (user_id, initial, romanized, category_id, price, cost, name, description, barcode, expiry_date, reorder_point, reorder_quantity) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
 
 const findProductByIDQuery = `
     SELECT 
-        id,
-        create_date,
-        update_date,
-        delete_date,
-        user_id,
-        initial, 
-        category, 
-        price, 
-        cost,
-        name,
-        description, 
-        barcode,
-        expiry_date,
-        size
+        p.id,
+        p.create_date,
+        p.update_date,
+        p.delete_date,
+        p.user_id,
+        p.initial, 
+        p.romanized, 
+        p.category_id, 
+        COALESCE(c.name, ''), 
+        p.price, 
+        p.cost,
+        p.name,
+        p.description, 
+        p.barcode,
+        p.expiry_date,
+        p.stock_quantity,
+        p.reorder_point,
+        p.reorder_quantity,
+        p.markdown_price,
+        p.version
     FROM 
-        products 
+        products p 
+        LEFT JOIN categories c ON c.id = p.category_id 
     WHERE 
-        delete_date IS NULL 
-        AND id = ?
+        p.delete_date IS NULL 
+        AND p.id = ?
 `
 
-const updateProductQuery = `UPDATE products SET initial = ?, category = ?, price = ?, cost = ?, name = ?, description = ?, barcode = ?, expiry_date = ?, size = ? WHERE id = ?`
+const findProductByBarcodeQuery = `
+    SELECT 
+        p.id,
+        p.create_date,
+        p.update_date,
+        p.delete_date,
+        p.user_id,
+        p.initial, 
+        p.romanized, 
+        p.category_id, 
+        COALESCE(c.name, ''), 
+        p.price, 
+        p.cost,
+        p.name,
+        p.description, 
+        p.barcode,
+        p.expiry_date,
+        p.stock_quantity,
+        p.reorder_point,
+        p.reorder_quantity,
+        p.markdown_price,
+        p.version
+    FROM 
+        products p 
+        LEFT JOIN categories c ON c.id = p.category_id 
+    WHERE 
+        p.delete_date IS NULL 
+        AND p.user_id = ? 
+        AND p.barcode = ?
+`
+
+// 읽은 뒤 다른 요청이 먼저 수정했으면 version 이 달라 아무 행도 바뀌지 않는다.
+const updateProductQuery = `UPDATE products SET initial = ?, romanized = ?, category_id = ?, price = ?, cost = ?, name = ?, description = ?, barcode = ?, reorder_point = ?, reorder_quantity = ?, markdown_price = ?, version = version + 1 WHERE id = ? AND version = ?`
 
-const deleteProductQuery = `UPDATE products SET delete_date = ? WHERE id = ?`
+const deleteProductQuery = `UPDATE products SET delete_date = ?, version = version + 1 WHERE id = ? AND version = ?`
 
 const listProductsQuery = `
+	SELECT 
+		p.id, 
+		p.create_date, 
+		p.update_date, 
+		p.delete_date, 
+		p.user_id, 
+		p.initial, 
+		p.romanized, 
+		p.category_id, 
+		COALESCE(c.name, ''), 
+		p.price, 
+		p.cost, 
+		p.name, 
+		p.description, 
+		p.barcode, 
+		p.expiry_date,
+		p.stock_quantity,
+		p.reorder_point,
+		p.reorder_quantity,
+		p.markdown_price,
+		p.version
+	FROM 
+		products p 
+		LEFT JOIN categories c ON c.id = p.category_id 
+	WHERE 
+		p.user_id = ? 
+		AND p.delete_date IS NULL
+		%s %s
+	ORDER BY 
+		p.id
+	LIMIT 10
+`
+
+// 상품을 내보낼 때는 페이지 없이 조건에 맞는 상품을 모두 조회한다.
+const exportProductsQuery = `
+	SELECT 
+		p.id, 
+		p.create_date, 
+		p.update_date, 
+		p.delete_date, 
+		p.user_id, 
+		p.initial, 
+		p.romanized, 
+		p.category_id, 
+		COALESCE(c.name, ''), 
+		p.price, 
+		p.cost, 
+		p.name, 
+		p.description, 
+		p.barcode, 
+		p.expiry_date,
+		p.stock_quantity,
+		p.reorder_point,
+		p.reorder_quantity,
+		p.markdown_price,
+		p.version
+	FROM 
+		products p 
+		LEFT JOIN categories c ON c.id = p.category_id 
+	WHERE 
+		p.user_id = ? 
+		AND p.delete_date IS NULL
+		%s
+	ORDER BY 
+		p.id
+`
+
+const listProductsByIDsQuery = `
+	SELECT 
+		p.id, 
+		p.create_date, 
+		p.update_date, 
+		p.delete_date, 
+		p.user_id, 
+		p.initial, 
+		p.romanized, 
+		p.category_id, 
+		COALESCE(c.name, ''), 
+		p.price, 
+		p.cost, 
+		p.name, 
+		p.description, 
+		p.barcode, 
+		p.expiry_date,
+		p.stock_quantity,
+		p.reorder_point,
+		p.reorder_quantity,
+		p.markdown_price,
+		p.version
+	FROM 
+		products p 
+		LEFT JOIN categories c ON c.id = p.category_id 
+	WHERE 
+		p.user_id = ? 
+		AND p.delete_date IS NULL
+		AND p.id IN (%s)
+	ORDER BY 
+		p.id
+`
+
+const listProductNamesQuery = `
 	SELECT 
 		id, 
-		create_date, 
-		update_date, 
-		delete_date, 
-		user_id, 
-		initial, 
-		category, 
-		price, 
-		cost, 
 		name, 
-		description, 
-		barcode, 
-		expiry_date, 
-		size 
+		initial, 
+		romanized 
 	FROM 
 		products 
 	WHERE 
 		user_id = ? 
 		AND delete_date IS NULL
+`
+
+const listAllProductNamesAfterQuery = `
+	SELECT 
+		id, 
+		name, 
+		initial, 
+		romanized 
+	FROM 
+		products 
+	WHERE 
+		id > ? 
+	ORDER BY 
+		id 
+	LIMIT ?
+`
+
+const updateProductRomanizedQuery = `UPDATE products SET romanized = ? WHERE id = ?`
+
+const createProductOptionGroupQuery = `INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select, display_order) VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+const createProductOptionQuery = `INSERT INTO product_options (option_group_id, name, price_delta, cost_delta, display_order) VALUES (?, ?, ?, ?, ?)`
+
+const deleteProductOptionsQuery = `DELETE o FROM product_options o JOIN product_option_groups g ON g.id = o.option_group_id WHERE g.product_id = ?`
+
+const deleteProductOptionGroupsQuery = `DELETE FROM product_option_groups WHERE product_id = ?`
+
+const listProductOptionGroupsQuery = `
+	SELECT 
+		g.id, 
+		g.product_id, 
+		g.name, 
+		g.select_type, 
+		g.required, 
+		g.min_select, 
+		g.max_select, 
+		g.display_order, 
+		o.id, 
+		o.name, 
+		o.price_delta, 
+		o.cost_delta, 
+		o.display_order 
+	FROM 
+		product_option_groups g 
+		JOIN product_options o ON o.option_group_id = g.id 
+	WHERE 
+		g.product_id IN (%s) 
+	ORDER BY 
+		g.product_id, g.display_order, g.id, o.display_order, o.id
+`
+
+const listLowStockProductsQuery = `
+	SELECT 
+		p.id, 
+		p.create_date, 
+		p.update_date, 
+		p.delete_date, 
+		p.user_id, 
+		p.initial, 
+		p.romanized, 
+		p.category_id, 
+		COALESCE(c.name, ''), 
+		p.price, 
+		p.cost, 
+		p.name, 
+		p.description, 
+		p.barcode, 
+		p.expiry_date,
+		p.stock_quantity,
+		p.reorder_point,
+		p.reorder_quantity,
+		p.markdown_price,
+		p.version
+	FROM 
+		products p 
+		LEFT JOIN categories c ON c.id = p.category_id 
+	WHERE 
+		p.user_id = ? 
+		AND p.delete_date IS NULL
+		AND p.reorder_point IS NOT NULL
+		AND p.stock_quantity <= p.reorder_point
+		%s
+	ORDER BY 
+		p.id
+	LIMIT ?
+`
+
+const listExpiringProductsQuery = `
+	SELECT 
+		p.id, 
+		p.create_date, 
+		p.update_date, 
+		p.delete_date, 
+		p.user_id, 
+		p.initial, 
+		p.romanized, 
+		p.category_id, 
+		COALESCE(c.name, ''), 
+		p.price, 
+		p.cost, 
+		p.name, 
+		p.description, 
+		p.barcode, 
+		p.expiry_date,
+		p.stock_quantity,
+		p.reorder_point,
+		p.reorder_quantity,
+		p.markdown_price,
+		p.version
+	FROM 
+		products p 
+		LEFT JOIN categories c ON c.id = p.category_id 
+	WHERE 
+		p.user_id = ? 
+		AND p.delete_date IS NULL
+		AND p.expiry_date < ?
 		%s %s %s
+	ORDER BY 
+		p.expiry_date, p.id
+	LIMIT ?
+`
+
+const claimExpiryDigestQuery = `INSERT IGNORE INTO expiry_digests (user_id, digest_date) VALUES (?, ?)`
+
+const releaseExpiryDigestQuery = `DELETE FROM expiry_digests WHERE user_id = ? AND digest_date = ?`
+
+const lockProductQuery = `SELECT id FROM products WHERE id = ? FOR UPDATE`
+
+const nextProductImageQuery = `SELECT COUNT(*), COALESCE(MAX(display_order) + 1, 0) FROM product_images WHERE product_id = ?`
+
+const createProductImageQuery = `INSERT INTO product_images (product_id, storage_key, content_type, width, height, display_order, is_primary) VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+const listProductImagesQuery = `
+	SELECT 
+		id, 
+		product_id, 
+		storage_key, 
+		content_type, 
+		width, 
+		height, 
+		display_order, 
+		is_primary, 
+		create_date 
+	FROM 
+		product_images 
+	WHERE 
+		product_id IN (%s) 
+	ORDER BY 
+		product_id, display_order, id
+`
+
+const updateProductImageOrderQuery = `UPDATE product_images SET display_order = ? WHERE id = ? AND product_id = ?`
+
+const updateProductPrimaryImageQuery = `UPDATE product_images SET is_primary = (id = ?) WHERE product_id = ?`
+
+const deleteProductImageQuery = `DELETE FROM product_images WHERE id = ? AND product_id = ?`
+
+// 대표 이미지를 지우면 남은 이미지 중 맨 앞의 이미지가 대표 이미지가 된다.
+const promoteProductImageQuery = `UPDATE product_images SET is_primary = TRUE WHERE product_id = ? ORDER BY display_order, id LIMIT 1`
+
+const createPriceHistoryQuery = `INSERT INTO product_price_history (product_id, user_id, reason, old_price, new_price, old_cost, new_cost, old_effective_price, new_effective_price, create_date) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+const listPriceHistoryQuery = `
+	SELECT 
+		id, 
+		product_id, 
+		user_id, 
+		reason, 
+		old_price, 
+		new_price, 
+		old_cost, 
+		new_cost, 
+		old_effective_price, 
+		new_effective_price, 
+		create_date 
+	FROM product_price_history 
+	WHERE product_id = ? AND create_date >= ? 
+	ORDER BY create_date, id
+`
+
+const findDeletedProductByIDQuery = `
+    SELECT 
+        p.id,
+        p.create_date,
+        p.update_date,
+        p.delete_date,
+        p.user_id,
+        p.initial, 
+        p.romanized, 
+        p.category_id, 
+        COALESCE(c.name, ''), 
+        p.price, 
+        p.cost,
+        p.name,
+        p.description, 
+        p.barcode,
+        p.expiry_date,
+        p.stock_quantity,
+        p.reorder_point,
+        p.reorder_quantity,
+        p.markdown_price,
+        p.version
+    FROM 
+        products p 
+        LEFT JOIN categories c ON c.id = p.category_id 
+    WHERE 
+        p.delete_date IS NOT NULL 
+        AND p.id = ?
+`
+
+const listDeletedProductsQuery = `
+	SELECT 
+		p.id, 
+		p.create_date, 
+		p.update_date, 
+		p.delete_date, 
+		p.user_id, 
+		p.initial, 
+		p.romanized, 
+		p.category_id, 
+		COALESCE(c.name, ''), 
+		p.price, 
+		p.cost, 
+		p.name, 
+		p.description, 
+		p.barcode, 
+		p.expiry_date,
+		p.stock_quantity,
+		p.reorder_point,
+		p.reorder_quantity,
+		p.markdown_price,
+		p.version
+	FROM 
+		products p 
+		LEFT JOIN categories c ON c.id = p.category_id 
+	WHERE 
+		p.user_id = ? 
+		AND p.delete_date IS NOT NULL
+		%s
+	ORDER BY 
+		p.id
 	LIMIT 10
 `
+
+const listPurgeableProductIDsQuery = `SELECT id FROM products WHERE delete_date IS NOT NULL AND delete_date < ? ORDER BY delete_date, id LIMIT ?`
+
+// 바코드가 같은 상품이 이미 있으면 유니크 인덱스(active_barcode)에 걸려 복원되지 않는다.
+const restoreProductQuery = `UPDATE products SET delete_date = NULL, version = version + 1 WHERE id = ? AND delete_date IS NOT NULL`
+
+// 완전히 지우는 동안 다른 요청이 복원하지 못하도록 휴지통에 있는 상품 행을 잠근다.
+const lockDeletedProductQuery = `SELECT id FROM products WHERE id = ? AND delete_date IS NOT NULL FOR UPDATE`
+
+// 상품을 참조하는 행을 외래 키 순서대로 먼저 지운다. 폐기 기록은 손실 금액 리포트를 위해 남기고 연결만 끊는다.
+var purgeProductReferencesQueries = []string{
+	`DELETE o FROM product_options o JOIN product_option_groups g ON g.id = o.option_group_id WHERE g.product_id = ?`,
+	`DELETE FROM product_option_groups WHERE product_id = ?`,
+	`DELETE FROM product_images WHERE product_id = ?`,
+	`UPDATE disposals SET product_id = NULL, movement_id = NULL WHERE product_id = ?`,
+	`DELETE FROM stock_movements WHERE product_id = ?`,
+	`DELETE FROM stock_lots WHERE product_id = ?`,
+	`DELETE FROM low_stock_alerts WHERE product_id = ?`,
+	`DELETE FROM markdown_rules WHERE product_id = ?`,
+	`DELETE FROM product_price_history WHERE product_id = ?`,
+	`DELETE FROM product_tags WHERE product_id = ?`,
+	`DELETE FROM scheduled_price_changes WHERE product_id = ?`,
+}
+
+const purgeProductQuery = `DELETE FROM products WHERE id = ?`
+
+const createProductTemplateQuery = `INSERT INTO product_templates (user_id, name, category_id, price, cost, product_name, description, reorder_point, reorder_quantity, option_groups) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+const findProductTemplateByIDQuery = `
+	SELECT 
+		id, 
+		user_id, 
+		name, 
+		category_id, 
+		price, 
+		cost, 
+		product_name, 
+		description, 
+		reorder_point, 
+		reorder_quantity, 
+		option_groups, 
+		create_date 
+	FROM product_templates 
+	WHERE id = ?
+`
+
+const listProductTemplatesQuery = `
+	SELECT 
+		id, 
+		user_id, 
+		name, 
+		category_id, 
+		price, 
+		cost, 
+		product_name, 
+		description, 
+		reorder_point, 
+		reorder_quantity, 
+		option_groups, 
+		create_date 
+	FROM product_templates 
+	WHERE user_id = ? 
+	ORDER BY name, id
+`
+
+const deleteProductTemplateQuery = `DELETE FROM product_templates WHERE id = ?`
+
+const createScheduledPriceChangeQuery = `INSERT INTO scheduled_price_changes (product_id, user_id, price, cost, effective_date) VALUES (?, ?, ?, ?, ?)`
+
+const findScheduledPriceChangeByIDQuery = `
+	SELECT 
+		s.id, 
+		s.product_id, 
+		p.name, 
+		s.user_id, 
+		s.price, 
+		s.cost, 
+		s.effective_date, 
+		s.apply_date, 
+		s.cancel_date, 
+		s.create_date 
+	FROM 
+		scheduled_price_changes s 
+		JOIN products p ON p.id = s.product_id 
+	WHERE 
+		s.id = ?
+`
+
+// 휴지통에 있는 상품의 예약은 조회하지 않는다.
+const listScheduledPriceChangesQuery = `
+	SELECT 
+		s.id, 
+		s.product_id, 
+		p.name, 
+		s.user_id, 
+		s.price, 
+		s.cost, 
+		s.effective_date, 
+		s.apply_date, 
+		s.cancel_date, 
+		s.create_date 
+	FROM 
+		scheduled_price_changes s 
+		JOIN products p ON p.id = s.product_id AND p.delete_date IS NULL 
+	WHERE 
+		p.user_id = ? 
+		AND s.apply_date IS NULL 
+		AND s.cancel_date IS NULL 
+		%s 
+	ORDER BY 
+		s.effective_date, s.id
+`
+
+// 휴지통에 있는 상품의 예약은 상품을 되돌릴 때까지 적용하지 않는다.
+const listDueScheduledPriceChangesQuery = `
+	SELECT 
+		s.id, 
+		s.product_id, 
+		p.name, 
+		s.user_id, 
+		s.price, 
+		s.cost, 
+		s.effective_date, 
+		s.apply_date, 
+		s.cancel_date, 
+		s.create_date 
+	FROM 
+		scheduled_price_changes s 
+		JOIN products p ON p.id = s.product_id AND p.delete_date IS NULL 
+	WHERE 
+		s.effective_date <= ? 
+		AND s.apply_date IS NULL 
+		AND s.cancel_date IS NULL 
+	ORDER BY 
+		s.effective_date, s.id 
+	LIMIT ?
+`
+
+const claimScheduledPriceChangeQuery = `UPDATE scheduled_price_changes SET apply_date = ? WHERE id = ? AND apply_date IS NULL AND cancel_date IS NULL`
+
+const cancelScheduledPriceChangeQuery = `UPDATE scheduled_price_changes SET cancel_date = ? WHERE id = ? AND apply_date IS NULL AND cancel_date IS NULL`
+
+// 이미 있는 이름은 그대로 두고 없는 이름만 만든다. (이름은 대소문자를 구분하지 않는 유니크 인덱스)
+const createTagsQuery = `INSERT INTO tags (user_id, name) VALUES %s ON DUPLICATE KEY UPDATE id = id`
+
+const findTagIDsByNamesQuery = `SELECT id FROM tags WHERE user_id = ? AND name IN (%s)`
+
+const createProductTagsQuery = `INSERT INTO product_tags (product_id, tag_id) VALUES %s`
+
+const deleteProductTagsQuery = `DELETE FROM product_tags WHERE product_id = ?`
+
+const listProductTagsQuery = `
+	SELECT 
+		pt.product_id, 
+		t.id, 
+		t.user_id, 
+		t.name, 
+		t.create_date 
+	FROM 
+		product_tags pt 
+		JOIN tags t ON t.id = pt.tag_id 
+	WHERE 
+		pt.product_id IN (%s) 
+	ORDER BY 
+		pt.product_id, t.name, t.id
+`
@@ -0,0 +1,79 @@
+package product
+
+import (
+	"context"
+	"log"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+const (
+	defaultTrashPurgeInterval = time.Hour
+	trashPurgeBatchSize       = 100
+)
+
+// TrashPurgeJob
+// 주기적으로 휴지통에 들어간 지 retention 이 지난 상품을 완전히 지운다.
+// 상품 행을 잠근 쪽만 지우므로 여러 서버에서 함께 실행해도 같은 상품을 두 번 지우지 않는다.
+type TrashPurgeJob struct {
+	productRepository domain.ProductRepository
+	imageStorage      domain.ImageStorage
+	retention         time.Duration
+	interval          time.Duration
+}
+
+func NewTrashPurgeJob(productRepository domain.ProductRepository, imageStorage domain.ImageStorage, retentionDays int, interval time.Duration) *TrashPurgeJob {
+	if interval <= 0 {
+		interval = defaultTrashPurgeInterval
+	}
+	return &TrashPurgeJob{
+		productRepository: productRepository,
+		imageStorage:      imageStorage,
+		retention:         domain.TrashRetentionFrom(retentionDays),
+		interval:          interval,
+	}
+}
+
+// Run
+// ctx 가 끝날 때까지 interval 마다 Purge 를 실행한다.
+func (j *TrashPurgeJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.Purge(ctx, time.Now().UTC()); err != nil {
+			log.Println(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge
+// 보관 기간이 지난 상품이 없을 때까지 batch 단위로 지운다.
+func (j *TrashPurgeJob) Purge(ctx context.Context, now time.Time) error {
+	const op cerrors.Op = "product/TrashPurgeJob/Purge"
+
+	deletedBefore := now.Add(-j.retention)
+	for {
+		productIDs, err := j.productRepository.ListPurgeableProductIDs(ctx, deletedBefore, trashPurgeBatchSize)
+		if err != nil {
+			return cerrors.E(op, cerrors.Internal, err, "휴지통에서 지울 상품을 조회하는 중에 에러가 발생했습니다.")
+		}
+
+		for _, productID := range productIDs {
+			if _, err := purgeProduct(ctx, j.productRepository, j.imageStorage, productID); err != nil {
+				return err
+			}
+		}
+
+		if len(productIDs) < trashPurgeBatchSize {
+			return nil
+		}
+	}
+}
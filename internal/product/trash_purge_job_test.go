@@ -0,0 +1,71 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"payhere/domain"
+	"payhere/mocks"
+	"testing"
+	"time"
+)
+
+func TestTrashPurgeJob_Purge(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	deletedBefore := now.Add(-30 * 24 * time.Hour)
+	image := domain.ProductImage{ID: 1, ProductID: 3, StorageKey: "products/3/a", ContentType: "image/jpeg"}
+
+	tests := []struct {
+		name    string
+		mock    func(productRepository *mocks.ProductRepository, imageStorage *mocks.ImageStorage)
+		wantErr bool
+	}{
+		{
+			name: "PASS - 보관 기간이 지난 상품을 지우고 이미지 파일도 지운다",
+			mock: func(productRepository *mocks.ProductRepository, imageStorage *mocks.ImageStorage) {
+				productRepository.EXPECT().ListPurgeableProductIDs(mock.Anything, deletedBefore, trashPurgeBatchSize).Return([]int{3, 5}, nil).Once()
+				productRepository.EXPECT().ListProductImages(mock.Anything, []int{3}).Return([]domain.ProductImage{image}, nil).Once()
+				productRepository.EXPECT().PurgeProduct(mock.Anything, 3).Return(true, nil).Once()
+				imageStorage.EXPECT().Delete(mock.Anything, mock.Anything).Return(nil).Times(len(domain.ProductImageVariants))
+				productRepository.EXPECT().ListProductImages(mock.Anything, []int{5}).Return(nil, nil).Once()
+				productRepository.EXPECT().PurgeProduct(mock.Anything, 5).Return(true, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "PASS - 다른 서버가 먼저 지운 상품은 건너뜀",
+			mock: func(productRepository *mocks.ProductRepository, imageStorage *mocks.ImageStorage) {
+				productRepository.EXPECT().ListPurgeableProductIDs(mock.Anything, deletedBefore, trashPurgeBatchSize).Return([]int{3}, nil).Once()
+				productRepository.EXPECT().ListProductImages(mock.Anything, []int{3}).Return([]domain.ProductImage{image}, nil).Once()
+				productRepository.EXPECT().PurgeProduct(mock.Anything, 3).Return(false, nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "FAIL - 지우는 중에 에러가 나면 멈춤",
+			mock: func(productRepository *mocks.ProductRepository, imageStorage *mocks.ImageStorage) {
+				productRepository.EXPECT().ListPurgeableProductIDs(mock.Anything, deletedBefore, trashPurgeBatchSize).Return([]int{3, 5}, nil).Once()
+				productRepository.EXPECT().ListProductImages(mock.Anything, []int{3}).Return(nil, nil).Once()
+				productRepository.EXPECT().PurgeProduct(mock.Anything, 3).Return(false, errors.New("lock wait timeout")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			productRepository := mocks.NewProductRepository(t)
+			imageStorage := mocks.NewImageStorage(t)
+			tt.mock(productRepository, imageStorage)
+			job := NewTrashPurgeJob(productRepository, imageStorage, 0, 0)
+
+			// when
+			err := job.Purge(context.Background(), now)
+
+			// then
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package report
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"payhere/config"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"payhere/pkg/router"
+	"time"
+)
+
+func RegisterRoutes(e *gin.Engine, controller domain.ReportController, authTokenRepository domain.AuthTokenRepository, cfg *config.Config) {
+	reports := e.Group("/reports")
+	{
+		reports.GET("/waste", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.GetWasteReport)
+		reports.GET("/margins", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.GetMarginReport)
+	}
+}
+
+type reportController struct {
+	reportService domain.ReportService
+}
+
+func NewReportController(service domain.ReportService) *reportController {
+	return &reportController{
+		reportService: service,
+	}
+}
+
+var _ domain.ReportController = (*reportController)(nil)
+
+// GetWasteReport
+// @Summary 폐기 손실 리포트
+// @Description 기간 동안 폐기한 수량과 손실액(폐기 시점의 원가 기준)을 카테고리별, 기간별, 사유별로 합산합니다. 날짜와 기간은 매장 시간대를 기준으로 하며, from 과 to 를 보내지 않으면 오늘까지 최근 30일을 조회합니다. 조회 기간은 최대 366일입니다.
+// @Tags Report
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "시작일 (2024-02-01)"
+// @Param to query string false "종료일, 포함 (2024-02-29)"
+// @Param groupBy query string false "기간 단위 (day, month)" default(day)
+// @Success 200 {object} domain.GetWasteReportResponse "폐기 손실 리포트"
+// @Router /reports/waste [get]
+func (rc reportController) GetWasteReport(c *gin.Context) {
+	var req domain.GetWasteReportRequest
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := rc.reportService.GetWasteReport(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// GetMarginReport
+// @Summary 마진 리포트
+// @Description 상품별, 카테고리별 마진(정가 - 원가)과 마진율(마진 / 정가 * 100)을 조회합니다. 검색 조건은 상품 목록 조회와 같고, 상품별 마진은 상품 ID 순서로 100개씩 cursor 로 나눠 조회합니다. 전체 합계와 카테고리별 합계는 조건에 맞는 모든 상품을 합산합니다. targetMargin 을 보내지 않으면 설정의 목표 마진율을 쓰며, 목표에 못 미치는 상품은 마진율이 낮은 순서로 100개까지 belowTarget 에 담습니다. 정가가 0 인 상품은 마진율이 없습니다.
+// @Tags Report
+// @Produce json
+// @Security BearerAuth
+// @Param cursor query int false "이전 페이지의 마지막 상품 ID"
+// @Param search query string false "상품명 또는 초성 검색어"
+// @Param categoryID query int false "카테고리 ID"
+// @Param tagID query []int false "태그 ID (여러 번 보낼 수 있음)" collectionFormat(multi)
+// @Param tagMatch query string false "태그 조건 (any: 하나라도 붙은 상품, all: 모두 붙은 상품, 기본값 any)" Enums(any, all)
+// @Param targetMargin query number false "목표 마진율 (%)"
+// @Success 200 {object} domain.GetMarginReportResponse "마진 리포트"
+// @Router /reports/margins [get]
+func (rc reportController) GetMarginReport(c *gin.Context) {
+	var req domain.GetMarginReportRequest
+
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := rc.reportService.GetMarginReport(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
@@ -0,0 +1,178 @@
+package report
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"payhere/config"
+	"payhere/domain"
+	"payhere/internal/auth_token"
+	"payhere/mocks"
+	"testing"
+	"time"
+)
+
+type reportControllerTestSuite struct {
+	router           *gin.Engine
+	cfg              *config.Config
+	autRepository    *mocks.AuthTokenRepository
+	reportService    *mocks.ReportService
+	reportController domain.ReportController
+}
+
+func setupReportControllerTestSuite(t *testing.T) reportControllerTestSuite {
+	var us reportControllerTestSuite
+
+	gin.SetMode(gin.TestMode)
+	us.router = gin.Default()
+	us.autRepository = mocks.NewAuthTokenRepository(t)
+	us.reportService = mocks.NewReportService(t)
+	us.cfg = &config.Config{
+		Auth: config.Auth{
+			Secret: "payhere_test_secret",
+		},
+	}
+
+	us.reportController = NewReportController(us.reportService)
+	RegisterRoutes(
+		us.router, us.reportController,
+		us.autRepository,
+		us.cfg,
+	)
+
+	return us
+}
+
+func (ts reportControllerTestSuite) newRequest(method string, path string) *http.Request {
+	req, _ := http.NewRequest(method, path, nil)
+	token, _ := auth_token.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return req
+}
+
+func (ts reportControllerTestSuite) expectAuthToken() {
+	ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+		mock.Anything,
+		mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+	).Return(domain.AuthToken{
+		ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+		Active:         true,
+	}, nil).Once()
+}
+
+func Test_reportController_GetWasteReport(t *testing.T) {
+	from, to, month := "2024-02-01", "2024-02-29", domain.ReportGroupByMonth
+
+	tests := []struct {
+		name string
+		path string
+		mock func(ts reportControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 월별 폐기 리포트",
+			path: "/reports/waste?from=2024-02-01&to=2024-02-29&groupBy=month",
+			mock: func(ts reportControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.reportService.EXPECT().GetWasteReport(mock.Anything, domain.GetWasteReportRequest{
+					UserID:  1,
+					From:    &from,
+					To:      &to,
+					GroupBy: &month,
+				}).Return(domain.GetWasteReportResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 잘못된 기간 단위",
+			path: "/reports/waste?groupBy=week",
+			mock: func(ts reportControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 잘못된 날짜 형식",
+			path: "/reports/waste?from=2024/02/01",
+			mock: func(ts reportControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupReportControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodGet, tt.path)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.reportService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_reportController_GetMarginReport(t *testing.T) {
+	search, categoryID, targetMargin := "라떼", 2, 40.0
+
+	tests := []struct {
+		name string
+		path string
+		mock func(ts reportControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 상품 목록과 같은 조건과 목표 마진율",
+			path: "/reports/margins?search=%EB%9D%BC%EB%96%BC&categoryID=2&targetMargin=40",
+			mock: func(ts reportControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.reportService.EXPECT().GetMarginReport(mock.Anything, domain.GetMarginReportRequest{
+					UserID:       1,
+					Search:       &search,
+					CategoryID:   &categoryID,
+					TargetMargin: &targetMargin,
+				}).Return(domain.GetMarginReportResponse{}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 100 보다 큰 목표 마진율",
+			path: "/reports/margins?targetMargin=150",
+			mock: func(ts reportControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupReportControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodGet, tt.path)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.reportService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
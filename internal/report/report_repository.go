@@ -0,0 +1,165 @@
+package report
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+)
+
+type reportRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewReportRepository(sqlDB *sql.DB) *reportRepository {
+	return &reportRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.ReportRepository = (*reportRepository)(nil)
+
+func (rr reportRepository) ListWasteTotals(ctx context.Context, params domain.ListWasteTotalsParams) ([]domain.WasteTotal, error) {
+	const op cerrors.Op = "report/reportRepository/ListWasteTotals"
+
+	var totals []domain.WasteTotal
+
+	rows, err := rr.sqlDB.QueryContext(
+		ctx,
+		listWasteTotalsQuery,
+		params.OffsetSeconds,
+		params.PeriodFormat,
+		params.UserID,
+		params.Since.UTC(),
+		params.Until.UTC(),
+	)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var total domain.WasteTotal
+		err := rows.Scan(
+			&total.CategoryID,
+			&total.CategoryName,
+			&total.Period,
+			&total.Reason,
+			&total.Quantity,
+			&total.LossAmount,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		totals = append(totals, total)
+	}
+
+	return totals, nil
+}
+
+// ListCategoryMargins
+// 조건에 맞는 모든 상품을 카테고리별로 합산한다. 페이지(cursor)는 쓰지 않는다.
+func (rr reportRepository) ListCategoryMargins(ctx context.Context, params domain.ListMarginsParams) ([]domain.CategoryMargin, error) {
+	const op cerrors.Op = "report/reportRepository/ListCategoryMargins"
+
+	var margins []domain.CategoryMargin
+
+	filter, args := params.Products.Filter()
+	query := fmt.Sprintf(listCategoryMarginsQuery, filter)
+
+	rows, err := rr.sqlDB.QueryContext(ctx, query, append([]any{params.TargetMargin, params.Products.UserID}, args...)...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var margin domain.CategoryMargin
+		err := rows.Scan(
+			&margin.CategoryID,
+			&margin.CategoryName,
+			&margin.ProductCount,
+			&margin.BelowTargetCount,
+			&margin.TotalPrice,
+			&margin.TotalCost,
+			&margin.TotalMargin,
+			&margin.MarginPercent,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		margins = append(margins, margin)
+	}
+
+	return margins, nil
+}
+
+// ListProductMargins
+// 상품 목록 조회처럼 cursor 다음의 상품을 ID 순서로 조회한다.
+func (rr reportRepository) ListProductMargins(ctx context.Context, params domain.ListMarginsParams) ([]domain.ProductMargin, error) {
+	const op cerrors.Op = "report/reportRepository/ListProductMargins"
+
+	filter, args := params.Products.Filter()
+	query := fmt.Sprintf(listProductMarginsQuery, filter, params.Products.AfterCursor())
+
+	args = append([]any{params.Products.UserID}, args...)
+	rows, err := rr.sqlDB.QueryContext(ctx, query, append(args, params.Limit)...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	margins, err := scanProductMargins(rows)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return margins, nil
+}
+
+// ListProductsBelowTargetMargin
+// 목표 마진율에 못 미치는 상품을 마진율이 낮은 순서로 조회한다. 페이지(cursor)는 쓰지 않는다.
+func (rr reportRepository) ListProductsBelowTargetMargin(ctx context.Context, params domain.ListMarginsParams) ([]domain.ProductMargin, error) {
+	const op cerrors.Op = "report/reportRepository/ListProductsBelowTargetMargin"
+
+	filter, args := params.Products.Filter()
+	query := fmt.Sprintf(listProductsBelowTargetMarginQuery, filter)
+
+	args = append([]any{params.Products.UserID, params.TargetMargin}, args...)
+	rows, err := rr.sqlDB.QueryContext(ctx, query, append(args, params.Limit)...)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	margins, err := scanProductMargins(rows)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return margins, nil
+}
+
+func scanProductMargins(rows *sql.Rows) ([]domain.ProductMargin, error) {
+	var margins []domain.ProductMargin
+	for rows.Next() {
+		var margin domain.ProductMargin
+		err := rows.Scan(
+			&margin.ProductID,
+			&margin.CategoryID,
+			&margin.CategoryName,
+			&margin.Name,
+			&margin.Price,
+			&margin.Cost,
+			&margin.Margin,
+			&margin.MarginPercent,
+		)
+		if err != nil {
+			return nil, err
+		}
+		margins = append(margins, margin)
+	}
+
+	return margins, nil
+}
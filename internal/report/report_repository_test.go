@@ -0,0 +1,193 @@
+package report
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+	"payhere/domain"
+	"testing"
+	"time"
+)
+
+func Test_reportRepository_ListWasteTotals(t *testing.T) {
+	// given
+	mockDB, sqlMock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	reportRepository := NewReportRepository(mockDB)
+
+	since := time.Date(2024, time.January, 31, 15, 0, 0, 0, time.UTC)
+	until := time.Date(2024, time.February, 29, 15, 0, 0, 0, time.UTC)
+	query := "SELECT d.category_id, c.name, DATE_FORMAT\\(DATE_ADD\\(d.create_date, INTERVAL \\? SECOND\\), \\?\\) AS period, d.reason, SUM\\(d.quantity\\), SUM\\(d.loss_amount\\) FROM disposals d"
+	rows := sqlmock.NewRows([]string{"category_id", "name", "period", "reason", "quantity", "loss_amount"}).
+		AddRow(1, "음료", "2024-02-01", "expired", 3, 1500.0).
+		AddRow(2, "디저트", "2024-02-01", "damaged", 1, 2000.0)
+	sqlMock.ExpectQuery(query).WithArgs(32400, "%Y-%m-%d", 1, since, until).WillReturnRows(rows)
+
+	// when
+	got, err := reportRepository.ListWasteTotals(context.Background(), domain.ListWasteTotalsParams{
+		UserID:        1,
+		Since:         since,
+		Until:         until,
+		OffsetSeconds: 32400,
+		PeriodFormat:  "%Y-%m-%d",
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.WasteTotal{
+		{CategoryID: 1, CategoryName: "음료", Period: "2024-02-01", Reason: domain.DisposalReasonExpired, Quantity: 3, LossAmount: 1500},
+		{CategoryID: 2, CategoryName: "디저트", Period: "2024-02-01", Reason: domain.DisposalReasonDamaged, Quantity: 1, LossAmount: 2000},
+	}, got)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_reportRepository_ListCategoryMargins(t *testing.T) {
+	// given
+	mockDB, sqlMock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	reportRepository := NewReportRepository(mockDB)
+
+	query := `SELECT (.+) FROM products p LEFT JOIN categories c ON c.id = p.category_id WHERE p.user_id = \? AND p.delete_date IS NULL AND p.category_id = 2 GROUP BY p.category_id, c.name`
+	rows := sqlmock.NewRows([]string{"category_id", "name", "count", "below", "price", "cost", "margin", "margin_percent"}).
+		AddRow(2, "음료", 3, 1, 12000.0, 6000.0, 6000.0, 50.0)
+	sqlMock.ExpectQuery(query).WithArgs(30.0, 1).WillReturnRows(rows)
+
+	// when
+	got, err := reportRepository.ListCategoryMargins(context.Background(), domain.ListMarginsParams{
+		Products:     domain.ListProductsParams{UserID: 1, CategoryID: pointer.Int(2)},
+		TargetMargin: 30,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.CategoryMargin{
+		{CategoryID: 2, CategoryName: "음료", ProductCount: 3, BelowTargetCount: 1, TotalPrice: 12000, TotalCost: 6000, TotalMargin: 6000, MarginPercent: pointer.Float64(50)},
+	}, got)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_reportRepository_ListProductMargins(t *testing.T) {
+	// given
+	mockDB, sqlMock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	reportRepository := NewReportRepository(mockDB)
+
+	query := `SELECT (.+) FROM products p (.+) WHERE p.user_id = \? AND p.delete_date IS NULL AND p.name LIKE \? AND p.id > 10 ORDER BY p.id LIMIT \?`
+	rows := sqlmock.NewRows([]string{"id", "category_id", "name", "product_name", "price", "cost", "margin", "margin_percent"}).
+		AddRow(11, 2, "음료", "슈크림 라떼", 4500.0, 1800.0, 2700.0, 60.0).
+		AddRow(12, 0, "", "무료 시음 라떼", 0.0, 500.0, -500.0, nil)
+	sqlMock.ExpectQuery(query).WithArgs(1, "%라떼%", 100).WillReturnRows(rows)
+
+	// when
+	got, err := reportRepository.ListProductMargins(context.Background(), domain.ListMarginsParams{
+		Products: domain.ListProductsParams{UserID: 1, Name: pointer.String("라떼"), Cursor: pointer.Int(10)},
+		Limit:    100,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.ProductMargin{
+		{ProductID: 11, CategoryID: 2, CategoryName: "음료", Name: "슈크림 라떼", Price: 4500, Cost: 1800, Margin: 2700, MarginPercent: pointer.Float64(60)},
+		{ProductID: 12, Name: "무료 시음 라떼", Price: 0, Cost: 500, Margin: -500},
+	}, got)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_reportRepository_ListProductsBelowTargetMargin(t *testing.T) {
+	// given
+	mockDB, sqlMock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	reportRepository := NewReportRepository(mockDB)
+
+	query := `SELECT (.+) FROM products p (.+) WHERE p.user_id = \? AND p.delete_date IS NULL AND p.price - p.cost < p.price \* \? / 100 ORDER BY margin_percent IS NOT NULL, margin_percent, p.id LIMIT \?`
+	rows := sqlmock.NewRows([]string{"id", "category_id", "name", "product_name", "price", "cost", "margin", "margin_percent"}).
+		AddRow(3, 1, "디저트", "마카롱", 2000.0, 1600.0, 400.0, 20.0)
+	sqlMock.ExpectQuery(query).WithArgs(1, 30.0, 100).WillReturnRows(rows)
+
+	// when
+	got, err := reportRepository.ListProductsBelowTargetMargin(context.Background(), domain.ListMarginsParams{
+		Products:     domain.ListProductsParams{UserID: 1},
+		TargetMargin: 30,
+		Limit:        100,
+	})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.ProductMargin{
+		{ProductID: 3, CategoryID: 1, CategoryName: "디저트", Name: "마카롱", Price: 2000, Cost: 1600, Margin: 400, MarginPercent: pointer.Float64(20)},
+	}, got)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func Test_reportRepository_ListMarginsWithTags(t *testing.T) {
+	params := domain.ListMarginsParams{
+		Products:     domain.ListProductsParams{UserID: 1, Name: pointer.String("라떼"), TagIDs: []int{3, 4}},
+		TargetMargin: 30,
+		Limit:        100,
+	}
+	tags := `AND p.name LIKE \? AND p.id IN \(SELECT pt.product_id FROM product_tags pt WHERE pt.tag_id IN \(3, 4\)\)`
+	columns := []string{"id", "category_id", "name", "product_name", "price", "cost", "margin", "margin_percent"}
+
+	t.Run("PASS - 카테고리 합계는 태그가 붙은 상품만 합산한다", func(t *testing.T) {
+		// given
+		mockDB, sqlMock, err := sqlmock.New()
+		if err != nil {
+			panic(err)
+		}
+		sqlMock.ExpectQuery(`WHERE p.user_id = \? AND p.delete_date IS NULL `+tags+` GROUP BY`).
+			WithArgs(30.0, 1, "%라떼%").
+			WillReturnRows(sqlmock.NewRows([]string{"category_id", "name", "count", "below", "price", "cost", "margin", "margin_percent"}))
+
+		// when
+		_, err = NewReportRepository(mockDB).ListCategoryMargins(context.Background(), params)
+
+		// then
+		assert.NoError(t, err)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("PASS - 상품별 마진은 태그가 붙은 상품만 조회한다", func(t *testing.T) {
+		// given
+		mockDB, sqlMock, err := sqlmock.New()
+		if err != nil {
+			panic(err)
+		}
+		sqlMock.ExpectQuery(`WHERE p.user_id = \? AND p.delete_date IS NULL `+tags+` ORDER BY p.id LIMIT \?`).
+			WithArgs(1, "%라떼%", 100).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		// when
+		_, err = NewReportRepository(mockDB).ListProductMargins(context.Background(), params)
+
+		// then
+		assert.NoError(t, err)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("PASS - 목표에 못 미치는 상품도 태그가 붙은 상품만 조회한다", func(t *testing.T) {
+		// given
+		mockDB, sqlMock, err := sqlmock.New()
+		if err != nil {
+			panic(err)
+		}
+		sqlMock.ExpectQuery(`AND p.price - p.cost < p.price \* \? / 100 `+tags+` ORDER BY margin_percent`).
+			WithArgs(1, 30.0, "%라떼%", 100).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		// when
+		_, err = NewReportRepository(mockDB).ListProductsBelowTargetMargin(context.Background(), params)
+
+		// then
+		assert.NoError(t, err)
+		assert.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,190 @@
+package report
+
+import (
+	"context"
+	"math"
+	"payhere/config"
+	"payhere/domain"
+	"payhere/internal/product"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+type reportService struct {
+	userRepository   domain.UserRepository
+	reportRepository domain.ReportRepository
+	cfg              *config.Config
+}
+
+func NewReportService(userRepository domain.UserRepository, reportRepository domain.ReportRepository, cfg *config.Config) *reportService {
+	return &reportService{
+		userRepository:   userRepository,
+		reportRepository: reportRepository,
+		cfg:              cfg,
+	}
+}
+
+var _ domain.ReportService = (*reportService)(nil)
+
+// GetWasteReport
+// 기간은 사장님의 매장 시간대로 나눈다. 시간대의 UTC 오프셋은 조회 시작일 기준으로 한 번만 계산한다.
+func (rs reportService) GetWasteReport(ctx context.Context, req domain.GetWasteReportRequest) (domain.GetWasteReportResponse, error) {
+	const op cerrors.Op = "report/service/GetWasteReport"
+
+	user, err := rs.userRepository.GetUser(ctx, req.UserID)
+	if err != nil {
+		return domain.GetWasteReportResponse{}, cerrors.E(op, cerrors.Internal, err, "사장님 정보를 조회하는 중에 에러가 발생했습니다.")
+	}
+	if user == nil {
+		return domain.GetWasteReportResponse{}, cerrors.E(op, cerrors.NotExist, "사장님을 찾을 수 없습니다.")
+	}
+
+	loc := user.Location()
+	since, until, err := req.DateRange(time.Now(), loc)
+	if err != nil {
+		return domain.GetWasteReportResponse{}, err
+	}
+	_, offset := since.Zone()
+	groupBy := req.GroupByOrDefault()
+
+	totals, err := rs.reportRepository.ListWasteTotals(ctx, domain.ListWasteTotalsParams{
+		UserID:        req.UserID,
+		Since:         since,
+		Until:         until,
+		OffsetSeconds: offset,
+		PeriodFormat:  groupBy.PeriodFormat(),
+	})
+	if err != nil {
+		return domain.GetWasteReportResponse{}, cerrors.E(op, cerrors.Internal, err, "폐기 리포트를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	res := wasteReportFrom(totals)
+	res.From = since.Format(domain.ReportDateLayout)
+	res.To = until.AddDate(0, 0, -1).Format(domain.ReportDateLayout)
+	res.GroupBy = groupBy
+	res.TimeZone = loc.String()
+
+	return res, nil
+}
+
+// wasteReportFrom
+// totals 는 기간, 카테고리, 사유 순으로 정렬되어 있어야 한다. 카테고리와 사유는 처음 나온 순서대로, 기간은 시간 순으로 담는다.
+func wasteReportFrom(totals []domain.WasteTotal) domain.GetWasteReportResponse {
+	res := domain.GetWasteReportResponse{
+		Categories: []domain.WasteCategoryDTO{},
+		Periods:    []domain.WastePeriodDTO{},
+		Reasons:    []domain.WasteReasonDTO{},
+		Items:      []domain.WasteItemDTO{},
+	}
+
+	categoryIndex := make(map[int]int)
+	reasonIndex := make(map[domain.DisposalReason]int)
+	itemIndex := make(map[domain.WasteItemDTO]int)
+
+	for _, total := range totals {
+		res.Total.Quantity += total.Quantity
+		res.Total.LossAmount += total.LossAmount
+
+		i, ok := categoryIndex[total.CategoryID]
+		if !ok {
+			i = len(res.Categories)
+			categoryIndex[total.CategoryID] = i
+			res.Categories = append(res.Categories, domain.WasteCategoryDTO{CategoryID: total.CategoryID, CategoryName: total.CategoryName})
+		}
+		res.Categories[i].Quantity += total.Quantity
+		res.Categories[i].LossAmount += total.LossAmount
+
+		if n := len(res.Periods); n == 0 || res.Periods[n-1].Period != total.Period {
+			res.Periods = append(res.Periods, domain.WastePeriodDTO{Period: total.Period})
+		}
+		res.Periods[len(res.Periods)-1].Quantity += total.Quantity
+		res.Periods[len(res.Periods)-1].LossAmount += total.LossAmount
+
+		i, ok = reasonIndex[total.Reason]
+		if !ok {
+			i = len(res.Reasons)
+			reasonIndex[total.Reason] = i
+			res.Reasons = append(res.Reasons, domain.WasteReasonDTO{Reason: total.Reason})
+		}
+		res.Reasons[i].Quantity += total.Quantity
+		res.Reasons[i].LossAmount += total.LossAmount
+
+		key := domain.WasteItemDTO{CategoryID: total.CategoryID, Period: total.Period}
+		i, ok = itemIndex[key]
+		if !ok {
+			i = len(res.Items)
+			itemIndex[key] = i
+			res.Items = append(res.Items, key)
+		}
+		res.Items[i].Quantity += total.Quantity
+		res.Items[i].LossAmount += total.LossAmount
+	}
+
+	return res
+}
+
+// GetMarginReport
+// 마진과 마진율은 모두 SQL 에서 계산하고, 전체 합계만 카테고리별 합계를 더해 구한다.
+// 상품 목록 조회와 같은 검색 조건을 쓰며 cursor 는 상품별 마진 목록에만 적용한다.
+func (rs reportService) GetMarginReport(ctx context.Context, req domain.GetMarginReportRequest) (domain.GetMarginReportResponse, error) {
+	const op cerrors.Op = "report/service/GetMarginReport"
+
+	targetMargin := rs.cfg.Report.TargetMarginPercent
+	if req.TargetMargin != nil {
+		targetMargin = *req.TargetMargin
+	}
+
+	params := domain.ListMarginsParams{
+		Products:     product.ListProductsParamsFrom(req.ListProductsRequest()),
+		TargetMargin: targetMargin,
+	}
+	allParams := params
+	allParams.Products.Cursor = nil
+
+	categories, err := rs.reportRepository.ListCategoryMargins(ctx, allParams)
+	if err != nil {
+		return domain.GetMarginReportResponse{}, cerrors.E(op, cerrors.Internal, err, "마진 리포트를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	params.Limit = domain.MarginReportPageSize
+	products, err := rs.reportRepository.ListProductMargins(ctx, params)
+	if err != nil {
+		return domain.GetMarginReportResponse{}, cerrors.E(op, cerrors.Internal, err, "마진 리포트를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	allParams.Limit = domain.MarginReportBelowTargetLimit
+	belowTarget, err := rs.reportRepository.ListProductsBelowTargetMargin(ctx, allParams)
+	if err != nil {
+		return domain.GetMarginReportResponse{}, cerrors.E(op, cerrors.Internal, err, "마진 리포트를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	res := domain.GetMarginReportResponse{
+		TargetMargin: targetMargin,
+		Categories:   make([]domain.CategoryMarginDTO, 0, len(categories)),
+		Products:     make([]domain.ProductMarginDTO, 0, len(products)),
+		BelowTarget:  make([]domain.ProductMarginDTO, 0, len(belowTarget)),
+	}
+	for _, category := range categories {
+		res.Summary.ProductCount += category.ProductCount
+		res.Summary.BelowTargetCount += category.BelowTargetCount
+		res.Summary.TotalPrice += category.TotalPrice
+		res.Summary.TotalCost += category.TotalCost
+		res.Summary.TotalMargin += category.TotalMargin
+		res.Categories = append(res.Categories, domain.CategoryMarginDTOFrom(category))
+	}
+	if res.Summary.TotalPrice != 0 {
+		marginPercent := math.Round(res.Summary.TotalMargin/res.Summary.TotalPrice*10000) / 100
+		res.Summary.MarginPercent = &marginPercent
+	}
+	for _, margin := range products {
+		res.Products = append(res.Products, domain.ProductMarginDTOFrom(margin, targetMargin))
+	}
+	for _, margin := range belowTarget {
+		res.BelowTarget = append(res.BelowTarget, domain.ProductMarginDTOFrom(margin, targetMargin))
+	}
+	if len(products) > 0 {
+		res.Cursor = &products[len(products)-1].ProductID
+	}
+
+	return res, nil
+}
@@ -0,0 +1,243 @@
+package report
+
+import (
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/utils/pointer"
+	"payhere/config"
+	"payhere/domain"
+	"payhere/mocks"
+	cerrors "payhere/pkg/cerrors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type reportServiceTestSuite struct {
+	userRepository   *mocks.UserRepository
+	reportRepository *mocks.ReportRepository
+	reportService    domain.ReportService
+}
+
+func setupReportServiceTestSuite(t *testing.T) reportServiceTestSuite {
+	var us reportServiceTestSuite
+
+	us.userRepository = mocks.NewUserRepository(t)
+	us.reportRepository = mocks.NewReportRepository(t)
+	us.reportService = NewReportService(us.userRepository, us.reportRepository, &config.Config{Report: config.Report{TargetMarginPercent: 30}})
+
+	return us
+}
+
+func Test_reportService_GetWasteReport(t *testing.T) {
+	from, to, month := "2024-02-01", "2024-02-29", domain.ReportGroupByMonth
+
+	tests := []struct {
+		name     string
+		req      domain.GetWasteReportRequest
+		mock     func(ts reportServiceTestSuite)
+		want     domain.GetWasteReportResponse
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 매장 시간대 기준으로 카테고리, 기간, 사유별 손실액을 합산한다",
+			req:  domain.GetWasteReportRequest{UserID: 1, From: &from, To: &to},
+			mock: func(ts reportServiceTestSuite) {
+				ts.userRepository.EXPECT().GetUser(mock.Anything, 1).Return(&domain.User{TimeZone: "America/New_York"}, nil).Once()
+				ts.reportRepository.EXPECT().ListWasteTotals(mock.Anything, mock.MatchedBy(func(params domain.ListWasteTotalsParams) bool {
+					return params.UserID == 1 &&
+						params.Since.Equal(time.Date(2024, time.February, 1, 5, 0, 0, 0, time.UTC)) &&
+						params.Until.Equal(time.Date(2024, time.March, 1, 5, 0, 0, 0, time.UTC)) &&
+						params.OffsetSeconds == -5*60*60 &&
+						params.PeriodFormat == "%Y-%m-%d"
+				})).Return([]domain.WasteTotal{
+					{CategoryID: 1, CategoryName: "음료", Period: "2024-02-01", Reason: domain.DisposalReasonExpired, Quantity: 3, LossAmount: 1500},
+					{CategoryID: 1, CategoryName: "음료", Period: "2024-02-01", Reason: domain.DisposalReasonDamaged, Quantity: 1, LossAmount: 500},
+					{CategoryID: 2, CategoryName: "디저트", Period: "2024-02-01", Reason: domain.DisposalReasonExpired, Quantity: 2, LossAmount: 4000},
+					{CategoryID: 1, CategoryName: "음료", Period: "2024-02-03", Reason: domain.DisposalReasonExpired, Quantity: 1, LossAmount: 500},
+				}, nil).Once()
+			},
+			want: domain.GetWasteReportResponse{
+				From:     "2024-02-01",
+				To:       "2024-02-29",
+				GroupBy:  domain.ReportGroupByDay,
+				TimeZone: "America/New_York",
+				Total:    domain.WasteSummaryDTO{Quantity: 7, LossAmount: 6500},
+				Categories: []domain.WasteCategoryDTO{
+					{CategoryID: 1, CategoryName: "음료", Quantity: 5, LossAmount: 2500},
+					{CategoryID: 2, CategoryName: "디저트", Quantity: 2, LossAmount: 4000},
+				},
+				Periods: []domain.WastePeriodDTO{
+					{Period: "2024-02-01", Quantity: 6, LossAmount: 6000},
+					{Period: "2024-02-03", Quantity: 1, LossAmount: 500},
+				},
+				Reasons: []domain.WasteReasonDTO{
+					{Reason: domain.DisposalReasonExpired, Quantity: 6, LossAmount: 6000},
+					{Reason: domain.DisposalReasonDamaged, Quantity: 1, LossAmount: 500},
+				},
+				Items: []domain.WasteItemDTO{
+					{CategoryID: 1, Period: "2024-02-01", Quantity: 4, LossAmount: 2000},
+					{CategoryID: 2, Period: "2024-02-01", Quantity: 2, LossAmount: 4000},
+					{CategoryID: 1, Period: "2024-02-03", Quantity: 1, LossAmount: 500},
+				},
+			},
+		},
+		{
+			name: "PASS - 폐기 기록이 없는 월별 리포트",
+			req:  domain.GetWasteReportRequest{UserID: 1, From: &from, To: &to, GroupBy: &month},
+			mock: func(ts reportServiceTestSuite) {
+				ts.userRepository.EXPECT().GetUser(mock.Anything, 1).Return(&domain.User{TimeZone: "Asia/Seoul"}, nil).Once()
+				ts.reportRepository.EXPECT().ListWasteTotals(mock.Anything, mock.MatchedBy(func(params domain.ListWasteTotalsParams) bool {
+					return params.OffsetSeconds == 9*60*60 && params.PeriodFormat == "%Y-%m"
+				})).Return(nil, nil).Once()
+			},
+			want: domain.GetWasteReportResponse{
+				From:       "2024-02-01",
+				To:         "2024-02-29",
+				GroupBy:    domain.ReportGroupByMonth,
+				TimeZone:   "Asia/Seoul",
+				Categories: []domain.WasteCategoryDTO{},
+				Periods:    []domain.WastePeriodDTO{},
+				Reasons:    []domain.WasteReasonDTO{},
+				Items:      []domain.WasteItemDTO{},
+			},
+		},
+		{
+			name: "FAIL - 종료일보다 늦은 시작일",
+			req:  domain.GetWasteReportRequest{UserID: 1, From: &to, To: &from},
+			mock: func(ts reportServiceTestSuite) {
+				ts.userRepository.EXPECT().GetUser(mock.Anything, 1).Return(&domain.User{TimeZone: "Asia/Seoul"}, nil).Once()
+			},
+			wantKind: cerrors.Invalid,
+		},
+		{
+			name: "FAIL - 조회 중 에러",
+			req:  domain.GetWasteReportRequest{UserID: 1},
+			mock: func(ts reportServiceTestSuite) {
+				ts.userRepository.EXPECT().GetUser(mock.Anything, 1).Return(&domain.User{TimeZone: "Asia/Seoul"}, nil).Once()
+				ts.reportRepository.EXPECT().ListWasteTotals(mock.Anything, mock.Anything).Return(nil, sql.ErrConnDone).Once()
+			},
+			wantKind: cerrors.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupReportServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.reportService.GetWasteReport(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_reportService_GetMarginReport(t *testing.T) {
+	t.Run("PASS - 카테고리 합계로 전체 마진율을 구하고 cursor 는 상품 목록에만 쓴다", func(t *testing.T) {
+		// given
+		ts := setupReportServiceTestSuite(t)
+		search, cursor := "ㄹㄸ", 10
+		ts.reportRepository.EXPECT().ListCategoryMargins(mock.Anything, domain.ListMarginsParams{
+			Products:     domain.ListProductsParams{UserID: 1, Initial: &search},
+			TargetMargin: 30,
+		}).Return([]domain.CategoryMargin{
+			{CategoryID: 1, CategoryName: "음료", ProductCount: 2, TotalPrice: 9000, TotalCost: 3600, TotalMargin: 5400, MarginPercent: pointer.Float64(60)},
+			{CategoryID: 2, CategoryName: "디저트", ProductCount: 1, BelowTargetCount: 1, TotalPrice: 3000, TotalCost: 2400, TotalMargin: 600, MarginPercent: pointer.Float64(20)},
+		}, nil).Once()
+		ts.reportRepository.EXPECT().ListProductMargins(mock.Anything, domain.ListMarginsParams{
+			Products:     domain.ListProductsParams{UserID: 1, Initial: &search, Cursor: &cursor},
+			TargetMargin: 30,
+			Limit:        domain.MarginReportPageSize,
+		}).Return([]domain.ProductMargin{
+			{ProductID: 11, CategoryID: 1, Name: "라떼", Price: 4500, Cost: 1800, Margin: 2700, MarginPercent: pointer.Float64(60)},
+			{ProductID: 12, CategoryID: 2, Name: "롤케이크", Price: 3000, Cost: 2400, Margin: 600, MarginPercent: pointer.Float64(20)},
+		}, nil).Once()
+		ts.reportRepository.EXPECT().ListProductsBelowTargetMargin(mock.Anything, domain.ListMarginsParams{
+			Products:     domain.ListProductsParams{UserID: 1, Initial: &search},
+			TargetMargin: 30,
+			Limit:        domain.MarginReportBelowTargetLimit,
+		}).Return([]domain.ProductMargin{
+			{ProductID: 12, CategoryID: 2, Name: "롤케이크", Price: 3000, Cost: 2400, Margin: 600, MarginPercent: pointer.Float64(20)},
+		}, nil).Once()
+
+		// when
+		got, err := ts.reportService.GetMarginReport(context.Background(), domain.GetMarginReportRequest{UserID: 1, Search: &search, Cursor: &cursor})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 30.0, got.TargetMargin)
+		assert.Equal(t, domain.MarginSummaryDTO{
+			ProductCount:     3,
+			BelowTargetCount: 1,
+			TotalPrice:       12000,
+			TotalCost:        6000,
+			TotalMargin:      6000,
+			MarginPercent:    pointer.Float64(50),
+		}, got.Summary)
+		assert.Len(t, got.Categories, 2)
+		assert.False(t, got.Products[0].BelowTarget)
+		assert.True(t, got.Products[1].BelowTarget)
+		assert.Len(t, got.BelowTarget, 1)
+		assert.Equal(t, pointer.Int(12), got.Cursor)
+	})
+
+	t.Run("PASS - 상품이 없으면 빈 목록과 마진율 없음", func(t *testing.T) {
+		// given
+		ts := setupReportServiceTestSuite(t)
+		ts.reportRepository.EXPECT().ListCategoryMargins(mock.Anything, mock.MatchedBy(func(params domain.ListMarginsParams) bool {
+			return params.TargetMargin == 45
+		})).Return(nil, nil).Once()
+		ts.reportRepository.EXPECT().ListProductMargins(mock.Anything, mock.Anything).Return(nil, nil).Once()
+		ts.reportRepository.EXPECT().ListProductsBelowTargetMargin(mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+		// when
+		got, err := ts.reportService.GetMarginReport(context.Background(), domain.GetMarginReportRequest{UserID: 1, TargetMargin: pointer.Float64(45)})
+
+		// then
+		assert.NoError(t, err)
+		assert.Nil(t, got.Summary.MarginPercent)
+		assert.Equal(t, []domain.CategoryMarginDTO{}, got.Categories)
+		assert.Equal(t, []domain.ProductMarginDTO{}, got.Products)
+		assert.Nil(t, got.Cursor)
+	})
+
+	t.Run("PASS - 태그 조건을 모든 조회에 넘긴다", func(t *testing.T) {
+		// given
+		ts := setupReportServiceTestSuite(t)
+		withTags := mock.MatchedBy(func(params domain.ListMarginsParams) bool {
+			return reflect.DeepEqual(params.Products.TagIDs, []int{3, 4}) && params.Products.TagMatch == domain.TagMatchAll
+		})
+		ts.reportRepository.EXPECT().ListCategoryMargins(mock.Anything, withTags).Return(nil, nil).Once()
+		ts.reportRepository.EXPECT().ListProductMargins(mock.Anything, withTags).Return(nil, nil).Once()
+		ts.reportRepository.EXPECT().ListProductsBelowTargetMargin(mock.Anything, withTags).Return(nil, nil).Once()
+
+		// when
+		_, err := ts.reportService.GetMarginReport(context.Background(), domain.GetMarginReportRequest{UserID: 1, TagIDs: []int{3, 4}, TagMatch: domain.TagMatchAll})
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("FAIL - 조회 에러", func(t *testing.T) {
+		// given
+		ts := setupReportServiceTestSuite(t)
+		ts.reportRepository.EXPECT().ListCategoryMargins(mock.Anything, mock.Anything).Return(nil, sql.ErrConnDone).Once()
+
+		// when
+		_, err := ts.reportService.GetMarginReport(context.Background(), domain.GetMarginReportRequest{UserID: 1})
+
+		// then
+		assert.True(t, cerrors.Is(cerrors.Internal, err))
+	})
+}
@@ -0,0 +1,101 @@
+package report
+
+// 폐기 시각을 매장 시간대로 옮긴 뒤 기간 형식으로 묶는다. 카테고리 이름은 삭제된 카테고리도 보여준다.
+const listWasteTotalsQuery = `
+	SELECT 
+		d.category_id, 
+		c.name, 
+		DATE_FORMAT(DATE_ADD(d.create_date, INTERVAL ? SECOND), ?) AS period, 
+		d.reason, 
+		SUM(d.quantity), 
+		SUM(d.loss_amount) 
+	FROM 
+		disposals d 
+		JOIN categories c ON c.id = d.category_id 
+	WHERE 
+		d.user_id = ? 
+		AND d.create_date >= ? 
+		AND d.create_date < ? 
+	GROUP BY 
+		d.category_id, 
+		c.name, 
+		period, 
+		d.reason 
+	ORDER BY 
+		period, 
+		d.category_id, 
+		d.reason
+`
+
+// 상품 목록 조회와 같은 조건(초성, 상품명, 카테고리, 태그)을 %s 로 넣고, 검색어는 조건 뒤의 ? 로 넘긴다. 정가에 목표 마진율을 곱한 값과 마진을 비교해 정가가 0 이어도 나누지 않는다.
+const listCategoryMarginsQuery = `
+	SELECT 
+		COALESCE(p.category_id, 0), 
+		COALESCE(c.name, ''), 
+		COUNT(*), 
+		SUM(p.price - p.cost < p.price * ? / 100), 
+		SUM(p.price), 
+		SUM(p.cost), 
+		SUM(p.price - p.cost), 
+		ROUND(SUM(p.price - p.cost) / NULLIF(SUM(p.price), 0) * 100, 2) 
+	FROM 
+		products p 
+		LEFT JOIN categories c ON c.id = p.category_id 
+	WHERE 
+		p.user_id = ? 
+		AND p.delete_date IS NULL
+		%s
+	GROUP BY 
+		p.category_id, 
+		c.name 
+	ORDER BY 
+		p.category_id
+`
+
+const listProductMarginsQuery = `
+	SELECT 
+		p.id, 
+		COALESCE(p.category_id, 0), 
+		COALESCE(c.name, ''), 
+		p.name, 
+		p.price, 
+		p.cost, 
+		p.price - p.cost, 
+		ROUND((p.price - p.cost) / NULLIF(p.price, 0) * 100, 2) 
+	FROM 
+		products p 
+		LEFT JOIN categories c ON c.id = p.category_id 
+	WHERE 
+		p.user_id = ? 
+		AND p.delete_date IS NULL
+		%s %s
+	ORDER BY 
+		p.id
+	LIMIT ?
+`
+
+// 정가가 0 인 상품은 마진율이 없으므로 가장 앞에 둔다.
+const listProductsBelowTargetMarginQuery = `
+	SELECT 
+		p.id, 
+		COALESCE(p.category_id, 0), 
+		COALESCE(c.name, ''), 
+		p.name, 
+		p.price, 
+		p.cost, 
+		p.price - p.cost, 
+		ROUND((p.price - p.cost) / NULLIF(p.price, 0) * 100, 2) AS margin_percent 
+	FROM 
+		products p 
+		LEFT JOIN categories c ON c.id = p.category_id 
+	WHERE 
+		p.user_id = ? 
+		AND p.delete_date IS NULL 
+		AND p.price - p.cost < p.price * ? / 100
+		%s
+	ORDER BY 
+		margin_percent IS NOT NULL, 
+		margin_percent, 
+		p.id
+	LIMIT ?
+`
@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"payhere/domain"
+	"strings"
+)
+
+// localStorage
+// 이미지를 서버의 디렉터리에 저장한다. 저장한 파일은 서버가 baseURL 아래에서 그대로 내려준다. 로컬에서 실행할 때 사용한다.
+type localStorage struct {
+	dir     string
+	baseURL string
+}
+
+func NewLocalStorage(dir string, baseURL string) *localStorage {
+	return &localStorage{
+		dir:     dir,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+var _ domain.ImageStorage = (*localStorage)(nil)
+
+// Put
+// 임시 파일에 다 쓴 다음 이름을 바꿔, 내려받는 중인 클라이언트가 반쯤 쓴 파일을 받지 않게 한다.
+func (ls localStorage) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	name, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(file.Name(), 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), name)
+}
+
+// Delete
+// 이미 없는 파일은 지운 것으로 본다.
+func (ls localStorage) Delete(ctx context.Context, key string) error {
+	name, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (ls localStorage) URL(key string) string {
+	return ls.baseURL + "/" + key
+}
+
+// path
+// key 가 저장 디렉터리 밖을 가리키지 못하게 한다.
+func (ls localStorage) path(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if key == "" || cleaned != "/"+key {
+		return "", fmt.Errorf("invalid storage key: %q", key)
+	}
+
+	return filepath.Join(ls.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_localStorage(t *testing.T) {
+	t.Run("PASS - 저장한 파일을 지운다", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		ls := NewLocalStorage(dir, "http://localhost:3000/uploads/")
+		key := "products/1/abcd/original.jpg"
+
+		// when
+		err := ls.Put(context.Background(), key, "image/jpeg", []byte("jpeg"))
+
+		// then
+		assert.NoError(t, err)
+		data, err := os.ReadFile(filepath.Join(dir, "products", "1", "abcd", "original.jpg"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("jpeg"), data)
+		assert.Equal(t, "http://localhost:3000/uploads/products/1/abcd/original.jpg", ls.URL(key))
+
+		assert.NoError(t, ls.Delete(context.Background(), key))
+		_, err = os.Stat(filepath.Join(dir, "products", "1", "abcd", "original.jpg"))
+		assert.True(t, os.IsNotExist(err))
+		assert.NoError(t, ls.Delete(context.Background(), key))
+	})
+
+	t.Run("FAIL - 저장 디렉터리 밖을 가리키는 key", func(t *testing.T) {
+		// given
+		ls := NewLocalStorage(t.TempDir(), "http://localhost:3000/uploads")
+
+		for _, key := range []string{"", "../secret", "products/../../secret", "/products/1"} {
+			// when
+			err := ls.Put(context.Background(), key, "image/jpeg", []byte("jpeg"))
+
+			// then
+			assert.Error(t, err, key)
+		}
+	})
+}
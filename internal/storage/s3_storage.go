@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"payhere/config"
+	"payhere/domain"
+	"sort"
+	"strings"
+	"time"
+)
+
+// s3Storage
+// S3 API 로 이미지를 저장한다. AWS S3 뿐 아니라 MinIO 같은 S3 호환 스토리지에서도 동작하도록
+// 버킷을 경로에 넣는 path-style 주소(endpoint/bucket/key)를 쓰고, 요청은 AWS Signature Version 4 로 직접 서명한다.
+type s3Storage struct {
+	client          *http.Client
+	endpoint        string
+	region          string
+	bucket          string
+	accessKeyID     string
+	secretAccessKey string
+	publicURL       string
+	now             func() time.Time
+}
+
+func NewS3Storage(cfg config.S3Storage, client *http.Client) (*s3Storage, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint: %q", cfg.Endpoint)
+	}
+	if cfg.Bucket == "" || cfg.Region == "" {
+		return nil, fmt.Errorf("s3 bucket and region are required")
+	}
+
+	publicURL := strings.TrimRight(cfg.PublicURL, "/")
+	if publicURL == "" {
+		publicURL = strings.TrimRight(cfg.Endpoint, "/") + "/" + cfg.Bucket
+	}
+
+	return &s3Storage{
+		client:          client,
+		endpoint:        strings.TrimRight(cfg.Endpoint, "/"),
+		region:          cfg.Region,
+		bucket:          cfg.Bucket,
+		accessKeyID:     cfg.AccessKeyID,
+		secretAccessKey: cfg.SecretAccessKey,
+		publicURL:       publicURL,
+		now:             time.Now,
+	}, nil
+}
+
+var _ domain.ImageStorage = (*s3Storage)(nil)
+
+func (ss s3Storage) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, ss.objectURL(key), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	ss.sign(req, data)
+
+	return ss.do(req)
+}
+
+// Delete
+// S3 는 없는 객체를 지워도 성공으로 응답한다.
+func (ss s3Storage) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, ss.objectURL(key), nil)
+	if err != nil {
+		return err
+	}
+	ss.sign(req, nil)
+
+	return ss.do(req)
+}
+
+func (ss s3Storage) URL(key string) string {
+	return ss.publicURL + "/" + escapeKey(key)
+}
+
+func (ss s3Storage) objectURL(key string) string {
+	return ss.endpoint + "/" + url.PathEscape(ss.bucket) + "/" + escapeKey(key)
+}
+
+func (ss s3Storage) do(req *http.Request) error {
+	res, err := ss.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("s3 %s %s: %s: %s", req.Method, req.URL.Path, res.Status, strings.TrimSpace(string(body)))
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	return nil
+}
+
+// sign
+// 요청에 있는 헤더와 host 를 모두 서명한다. 본문의 SHA-256 도 함께 서명해 전송 중에 바뀐 본문은 스토리지가 거절한다.
+func (ss s3Storage) sign(req *http.Request, body []byte) {
+	now := ss.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	values := map[string]string{"host": req.URL.Host}
+	for name := range req.Header {
+		values[strings.ToLower(name)] = strings.TrimSpace(req.Header.Get(name))
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + values[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20"),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + ss.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+ss.secretAccessKey), date)
+	key = hmacSHA256(key, ss.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		ss.accessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"fmt"
+	"net/http"
+	"payhere/config"
+	"payhere/domain"
+	"strings"
+	"time"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// NewImageStorage
+// 설정의 driver 로 이미지 스토리지를 고른다. driver 를 비우면 로컬 디렉터리에 저장한다.
+func NewImageStorage(cfg *config.Config) (domain.ImageStorage, error) {
+	switch cfg.Storage.Driver {
+	case DriverLocal, "":
+		return NewLocalStorage(cfg.Storage.Local.Dir, strings.TrimRight(cfg.App.PublicURL, "/")+cfg.Storage.Local.Path), nil
+	case DriverS3:
+		return NewS3Storage(cfg.Storage.S3, &http.Client{Timeout: 30 * time.Second})
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %q", cfg.Storage.Driver)
+	}
+}
@@ -0,0 +1,32 @@
+package tag
+
+const createTagQuery = `INSERT INTO tags (user_id, name) VALUES (?, ?)`
+
+const findTagByIDQuery = `SELECT id, user_id, name, create_date FROM tags WHERE id = ?`
+
+const findTagByNameQuery = `SELECT id, user_id, name, create_date FROM tags WHERE user_id = ? AND name = ?`
+
+const updateTagQuery = `UPDATE tags SET name = ? WHERE id = ?`
+
+const deleteProductTagsByTagIDQuery = `DELETE FROM product_tags WHERE tag_id = ?`
+
+const deleteTagQuery = `DELETE FROM tags WHERE id = ?`
+
+const listTagsQuery = `
+	SELECT 
+		t.id, 
+		t.user_id, 
+		t.name, 
+		t.create_date, 
+		COUNT(p.id) 
+	FROM 
+		tags t 
+		LEFT JOIN product_tags pt ON pt.tag_id = t.id 
+		LEFT JOIN products p ON p.id = pt.product_id AND p.delete_date IS NULL 
+	WHERE 
+		t.user_id = ? 
+	GROUP BY 
+		t.id 
+	ORDER BY 
+		t.name, t.id
+`
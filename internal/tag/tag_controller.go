@@ -0,0 +1,193 @@
+package tag
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"payhere/config"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"payhere/pkg/router"
+	"time"
+)
+
+func RegisterRoutes(e *gin.Engine, controller domain.TagController, authTokenRepository domain.AuthTokenRepository, cfg *config.Config) {
+	tags := e.Group("/tags")
+	{
+		tags.POST("", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.CreateTag)
+		tags.PATCH("/:tagID", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.PatchTag)
+		tags.DELETE("/:tagID", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.DeleteTag)
+		tags.GET("", router.JWTMiddleware(cfg.Auth.Secret, authTokenRepository), controller.ListTags)
+	}
+}
+
+type tagController struct {
+	tagService domain.TagService
+}
+
+func NewTagController(service domain.TagService) *tagController {
+	return &tagController{
+		tagService: service,
+	}
+}
+
+var _ domain.TagController = (*tagController)(nil)
+
+// CreateTag
+// @Summary 태그 생성
+// @Description 상품에 붙일 태그를 만듭니다. 앞뒤 공백과 앞에 붙인 '#' 은 빼고 저장하며, 대소문자만 다른 이름을 포함해 같은 이름의 태그는 만들 수 없습니다. 상품을 만들거나 수정할 때 없는 태그 이름을 보내도 태그가 만들어집니다.
+// @Tags Tag
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param CreateTagRequest body domain.CreateTagRequest true "태그 생성 요청"
+// @Success 200 {object} domain.CreateTagResponse "만든 태그"
+// @Router /tags [post]
+func (tc tagController) CreateTag(c *gin.Context) {
+	var req domain.CreateTagRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := tc.tagService.CreateTag(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
+
+// PatchTag
+// @Summary 태그 이름 수정
+// @Description 태그 이름을 바꾸면 태그를 붙인 상품에도 바뀐 이름이 보입니다. (단 자신의 태그만 수정 가능)
+// @Tags Tag
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "태그 ID"
+// @Param PatchTagRequest body domain.PatchTagRequest true "태그 수정 요청"
+// @Success 204
+// @Router /tags/{id} [patch]
+func (tc tagController) PatchTag(c *gin.Context) {
+	var req domain.PatchTagRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := tc.tagService.PatchTag(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DeleteTag
+// @Summary 태그 삭제
+// @Description 태그를 지우면 태그를 붙인 상품에서도 떨어집니다. 상품은 지우지 않습니다. (단 자신의 태그만 삭제 가능)
+// @Tags Tag
+// @Produce json
+// @Param id path int true "태그 ID"
+// @Security BearerAuth
+// @Success 204
+// @Router /tags/{id} [delete]
+func (tc tagController) DeleteTag(c *gin.Context) {
+	var req domain.DeleteTagRequest
+
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := tc.tagService.DeleteTag(ctx, req); err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListTags
+// @Summary 태그 목록 조회
+// @Description 태그를 이름 순서로 조회합니다. 상품 수에는 휴지통에 있는 상품을 세지 않습니다. (단 자신의 태그만 조회 가능)
+// @Tags Tag
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} domain.ListTagsResponse "태그 목록"
+// @Router /tags [get]
+func (tc tagController) ListTags(c *gin.Context) {
+	var req domain.ListTagsRequest
+
+	userID, err := router.GetUserIDFromContext(c)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+	req.UserID = userID
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := tc.tagService.ListTags(ctx, req)
+	if err != nil {
+		c.JSON(cerrors.ToSentinelAPIError(err))
+		return
+	}
+
+	c.JSON(domain.PayhereResponseFrom(http.StatusOK, res))
+}
@@ -0,0 +1,279 @@
+package tag
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"payhere/config"
+	"payhere/domain"
+	"payhere/internal/auth_token"
+	"payhere/mocks"
+	cerrors "payhere/pkg/cerrors"
+	"testing"
+	"time"
+)
+
+type tagControllerTestSuite struct {
+	router        *gin.Engine
+	cfg           *config.Config
+	autRepository *mocks.AuthTokenRepository
+	tagService    *mocks.TagService
+	tagController domain.TagController
+}
+
+func setupTagControllerTestSuite(t *testing.T) tagControllerTestSuite {
+	var ts tagControllerTestSuite
+
+	gin.SetMode(gin.TestMode)
+	ts.router = gin.Default()
+	ts.autRepository = mocks.NewAuthTokenRepository(t)
+	ts.tagService = mocks.NewTagService(t)
+	ts.cfg = &config.Config{
+		Auth: config.Auth{
+			Secret: "payhere_test_secret",
+		},
+	}
+
+	ts.tagController = NewTagController(ts.tagService)
+	RegisterRoutes(
+		ts.router, ts.tagController,
+		ts.autRepository,
+		ts.cfg,
+	)
+
+	return ts
+}
+
+func (ts tagControllerTestSuite) newRequest(method string, path string, body *bytes.Reader) *http.Request {
+	var req *http.Request
+	if body == nil {
+		req, _ = http.NewRequest(method, path, nil)
+	} else {
+		req, _ = http.NewRequest(method, path, body)
+		req.Header.Set("Content-Type", "application/json")
+	}
+	token, _ := auth_token.CreateAccessToken(domain.User{
+		Base: domain.Base{
+			ID: 1,
+		},
+	}, ts.cfg.Auth.Secret, time.Now().UTC().Add(time.Hour*time.Duration(24)))
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return req
+}
+
+func (ts tagControllerTestSuite) expectAuthToken() {
+	ts.autRepository.EXPECT().FindAuthTokenByUserIDAndJwtToken(
+		mock.Anything,
+		mock.MatchedBy(func(params domain.FindByUserIDAndJwtTokenParams) bool { return params.UserID == 1 }),
+	).Return(domain.AuthToken{
+		ExpirationTime: time.Now().UTC().Add(time.Hour * time.Duration(24)),
+		Active:         true,
+	}, nil).Once()
+}
+
+func Test_tagController_CreateTag(t *testing.T) {
+	tests := []struct {
+		name string
+		body func() *bytes.Reader
+		mock func(ts tagControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 태그 생성",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateTagRequest{Name: "신메뉴"})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts tagControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.tagService.EXPECT().CreateTag(mock.Anything, domain.CreateTagRequest{
+					UserID: 1,
+					Name:   "신메뉴",
+				}).Return(domain.CreateTagResponse{Tag: domain.TagDTO{ID: 1, Name: "신메뉴"}}, nil).Once()
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "FAIL - 비어있는 태그 이름",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateTagRequest{Name: "#"})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts tagControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 같은 이름의 태그가 있음",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.CreateTagRequest{Name: "신메뉴"})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts tagControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.tagService.EXPECT().CreateTag(mock.Anything, mock.Anything).
+					Return(domain.CreateTagResponse{}, cerrors.E(cerrors.Op("test"), cerrors.Exist, "이미 같은 이름의 태그가 있습니다.")).Once()
+			},
+			code: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTagControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodPost, "/tags", tt.body())
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.tagService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_tagController_PatchTag(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		body func() *bytes.Reader
+		mock func(ts tagControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 태그 이름 수정",
+			path: "/tags/1",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.PatchTagRequest{Name: "시즌"})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts tagControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.tagService.EXPECT().PatchTag(mock.Anything, domain.PatchTagRequest{
+					UserID: 1,
+					ID:     1,
+					Name:   "시즌",
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 유효하지 않은 태그 ID",
+			path: "/tags/payhere",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.PatchTagRequest{Name: "시즌"})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts tagControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "FAIL - 없는 태그",
+			path: "/tags/10",
+			body: func() *bytes.Reader {
+				jsonData, _ := json.Marshal(domain.PatchTagRequest{Name: "시즌"})
+				return bytes.NewReader(jsonData)
+			},
+			mock: func(ts tagControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.tagService.EXPECT().PatchTag(mock.Anything, mock.Anything).
+					Return(cerrors.E(cerrors.Op("test"), cerrors.NotExist, "태그를 찾을 수 없습니다.")).Once()
+			},
+			code: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTagControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodPatch, tt.path, tt.body())
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.tagService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_tagController_DeleteTag(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		mock func(ts tagControllerTestSuite)
+		code int
+	}{
+		{
+			name: "PASS - 태그 삭제",
+			path: "/tags/1",
+			mock: func(ts tagControllerTestSuite) {
+				ts.expectAuthToken()
+				ts.tagService.EXPECT().DeleteTag(mock.Anything, domain.DeleteTagRequest{
+					UserID: 1,
+					ID:     1,
+				}).Return(nil).Once()
+			},
+			code: http.StatusNoContent,
+		},
+		{
+			name: "FAIL - 유효하지 않은 태그 ID",
+			path: "/tags/0",
+			mock: func(ts tagControllerTestSuite) {
+				ts.expectAuthToken()
+			},
+			code: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTagControllerTestSuite(t)
+			tt.mock(ts)
+			req := ts.newRequest(http.MethodDelete, tt.path, nil)
+
+			// when
+			rec := httptest.NewRecorder()
+			ts.router.ServeHTTP(rec, req)
+
+			// then
+			ts.tagService.AssertExpectations(t)
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func Test_tagController_ListTags(t *testing.T) {
+	// given
+	ts := setupTagControllerTestSuite(t)
+	ts.expectAuthToken()
+	ts.tagService.EXPECT().ListTags(mock.Anything, domain.ListTagsRequest{UserID: 1}).
+		Return(domain.ListTagsResponse{Tags: []domain.TagDTO{{ID: 1, Name: "신메뉴", ProductCount: 3}}}, nil).Once()
+	req := ts.newRequest(http.MethodGet, "/tags", nil)
+
+	// when
+	rec := httptest.NewRecorder()
+	ts.router.ServeHTTP(rec, req)
+
+	// then
+	ts.tagService.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"productCount":3`)
+}
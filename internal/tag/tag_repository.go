@@ -0,0 +1,161 @@
+package tag
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/go-sql-driver/mysql"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+)
+
+type tagRepository struct {
+	sqlDB *sql.DB
+}
+
+func NewTagRepository(sqlDB *sql.DB) *tagRepository {
+	return &tagRepository{
+		sqlDB: sqlDB,
+	}
+}
+
+var _ domain.TagRepository = (*tagRepository)(nil)
+
+func (tr tagRepository) CreateTag(ctx context.Context, tag domain.Tag) (int, error) {
+	const op cerrors.Op = "tag/tagRepository/CreateTag"
+
+	result, err := tr.sqlDB.ExecContext(ctx, createTagQuery, tag.UserID, tag.Name)
+	if isDuplicateEntry(err) {
+		return 0, cerrors.E(op, cerrors.Exist, err, "이미 같은 이름의 태그가 있습니다.")
+	}
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	tagID, err := result.LastInsertId()
+	if err != nil {
+		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return int(tagID), nil
+}
+
+func (tr tagRepository) GetTag(ctx context.Context, tagID int) (*domain.Tag, error) {
+	const op cerrors.Op = "tag/tagRepository/GetTag"
+
+	tag, err := scanTag(tr.sqlDB.QueryRowContext(ctx, findTagByIDQuery, tagID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return tag, nil
+}
+
+// FindTagByName
+// 대소문자는 DB collation 에 따라 구분하지 않는다.
+func (tr tagRepository) FindTagByName(ctx context.Context, userID int, name string) (*domain.Tag, error) {
+	const op cerrors.Op = "tag/tagRepository/FindTagByName"
+
+	tag, err := scanTag(tr.sqlDB.QueryRowContext(ctx, findTagByNameQuery, userID, name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return tag, nil
+}
+
+func (tr tagRepository) UpdateTag(ctx context.Context, tag domain.Tag) error {
+	const op cerrors.Op = "tag/tagRepository/UpdateTag"
+
+	_, err := tr.sqlDB.ExecContext(ctx, updateTagQuery, tag.Name, tag.ID)
+	if isDuplicateEntry(err) {
+		return cerrors.E(op, cerrors.Exist, err, "이미 같은 이름의 태그가 있습니다.")
+	}
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// DeleteTag
+// 태그를 붙인 상품에서 태그를 먼저 떼고 태그를 지운다.
+func (tr tagRepository) DeleteTag(ctx context.Context, tagID int) error {
+	const op cerrors.Op = "tag/tagRepository/DeleteTag"
+
+	tx, err := tr.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, deleteProductTagsByTagIDQuery, tagID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	if _, err := tx.ExecContext(ctx, deleteTagQuery, tagID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// ListTags
+// 태그를 이름 순서로 조회한다. 상품 수에는 휴지통에 있는 상품을 세지 않는다.
+func (tr tagRepository) ListTags(ctx context.Context, userID int) ([]domain.Tag, error) {
+	const op cerrors.Op = "tag/tagRepository/ListTags"
+
+	rows, err := tr.sqlDB.QueryContext(ctx, listTagsQuery, userID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	var tags []domain.Tag
+	for rows.Next() {
+		var tag domain.Tag
+		err := rows.Scan(
+			&tag.ID,
+			&tag.UserID,
+			&tag.Name,
+			&tag.CreateDate,
+			&tag.ProductCount,
+		)
+		if err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+func scanTag(row *sql.Row) (*domain.Tag, error) {
+	var tag domain.Tag
+
+	err := row.Scan(
+		&tag.ID,
+		&tag.UserID,
+		&tag.Name,
+		&tag.CreateDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == 1062
+}
@@ -0,0 +1,205 @@
+package tag
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"testing"
+	"time"
+)
+
+type tagRepositoryTestSuite struct {
+	sqlDB         *sql.DB
+	sqlMock       sqlmock.Sqlmock
+	tagRepository domain.TagRepository
+}
+
+func setupTagRepositoryTestSuite() tagRepositoryTestSuite {
+	var ts tagRepositoryTestSuite
+
+	mockDB, mock, err := sqlmock.New()
+	if err != nil {
+		panic(err)
+	}
+	ts.sqlDB = mockDB
+	ts.sqlMock = mock
+	ts.tagRepository = NewTagRepository(mockDB)
+
+	return ts
+}
+
+func Test_tagRepository_CreateTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		mock     func(ts tagRepositoryTestSuite)
+		want     int
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 태그 생성",
+			mock: func(ts tagRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec("INSERT INTO tags").
+					WithArgs(1, "신메뉴").
+					WillReturnResult(sqlmock.NewResult(3, 1))
+			},
+			want: 3,
+		},
+		{
+			name: "FAIL - 같은 이름의 태그가 있음",
+			mock: func(ts tagRepositoryTestSuite) {
+				ts.sqlMock.ExpectExec("INSERT INTO tags").
+					WithArgs(1, "신메뉴").
+					WillReturnError(&mysql.MySQLError{Number: 1062})
+			},
+			wantKind: cerrors.Exist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTagRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.tagRepository.CreateTag(context.Background(), domain.Tag{UserID: 1, Name: "신메뉴"})
+
+			// then
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_tagRepository_GetTag(t *testing.T) {
+	createDate := time.Now()
+
+	tests := []struct {
+		name  string
+		tagID int
+		mock  func(ts tagRepositoryTestSuite)
+		want  *domain.Tag
+	}{
+		{
+			name:  "PASS - 존재하는 태그",
+			tagID: 3,
+			mock: func(ts tagRepositoryTestSuite) {
+				rows := sqlmock.NewRows([]string{"id", "user_id", "name", "create_date"}).
+					AddRow(3, 1, "신메뉴", createDate)
+				ts.sqlMock.ExpectQuery("SELECT (.+) FROM tags WHERE id = \\?").
+					WithArgs(3).
+					WillReturnRows(rows)
+			},
+			want: &domain.Tag{ID: 3, UserID: 1, Name: "신메뉴", CreateDate: createDate},
+		},
+		{
+			name:  "PASS - 존재하지 않는 태그",
+			tagID: 10,
+			mock: func(ts tagRepositoryTestSuite) {
+				ts.sqlMock.ExpectQuery("SELECT (.+) FROM tags WHERE id = \\?").
+					WithArgs(10).
+					WillReturnError(sql.ErrNoRows)
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTagRepositoryTestSuite()
+			tt.mock(ts)
+
+			// when
+			got, err := ts.tagRepository.GetTag(context.Background(), tt.tagID)
+
+			// then
+			assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_tagRepository_FindTagByName(t *testing.T) {
+	// given
+	ts := setupTagRepositoryTestSuite()
+	ts.sqlMock.ExpectQuery("SELECT (.+) FROM tags WHERE user_id = \\? AND name = \\?").
+		WithArgs(1, "비건").
+		WillReturnError(sql.ErrNoRows)
+
+	// when
+	got, err := ts.tagRepository.FindTagByName(context.Background(), 1, "비건")
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func Test_tagRepository_UpdateTag(t *testing.T) {
+	// given
+	ts := setupTagRepositoryTestSuite()
+	ts.sqlMock.ExpectExec("UPDATE tags SET name = \\? WHERE id = \\?").
+		WithArgs("시즌", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// when
+	err := ts.tagRepository.UpdateTag(context.Background(), domain.Tag{ID: 1, UserID: 1, Name: "시즌"})
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func Test_tagRepository_DeleteTag(t *testing.T) {
+	// given
+	ts := setupTagRepositoryTestSuite()
+	ts.sqlMock.ExpectBegin()
+	ts.sqlMock.ExpectExec("DELETE FROM product_tags WHERE tag_id = \\?").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	ts.sqlMock.ExpectExec("DELETE FROM tags WHERE id = \\?").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	ts.sqlMock.ExpectCommit()
+
+	// when
+	err := ts.tagRepository.DeleteTag(context.Background(), 1)
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+}
+
+func Test_tagRepository_ListTags(t *testing.T) {
+	// given
+	ts := setupTagRepositoryTestSuite()
+	createDate := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "user_id", "name", "create_date", "count"}).
+		AddRow(2, 1, "비건", createDate, 0).
+		AddRow(1, 1, "신메뉴", createDate, 3)
+	ts.sqlMock.ExpectQuery("SELECT (.+) FROM tags t LEFT JOIN product_tags pt (.+) GROUP BY t.id ORDER BY t.name, t.id").
+		WithArgs(1).
+		WillReturnRows(rows)
+
+	// when
+	got, err := ts.tagRepository.ListTags(context.Background(), 1)
+
+	// then
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Tag{
+		{ID: 2, UserID: 1, Name: "비건", CreateDate: createDate},
+		{ID: 1, UserID: 1, Name: "신메뉴", ProductCount: 3, CreateDate: createDate},
+	}, got)
+}
@@ -0,0 +1,137 @@
+package tag
+
+import (
+	"context"
+	"payhere/domain"
+	cerrors "payhere/pkg/cerrors"
+	"time"
+)
+
+type tagService struct {
+	tagRepository domain.TagRepository
+}
+
+func NewTagService(tagRepository domain.TagRepository) *tagService {
+	return &tagService{
+		tagRepository: tagRepository,
+	}
+}
+
+var _ domain.TagService = (*tagService)(nil)
+
+func (ts tagService) CreateTag(ctx context.Context, req domain.CreateTagRequest) (domain.CreateTagResponse, error) {
+	const op cerrors.Op = "tag/service/CreateTag"
+
+	name := domain.NormalizeTagName(req.Name)
+	if err := ts.checkDuplicateName(ctx, req.UserID, name, 0); err != nil {
+		return domain.CreateTagResponse{}, err
+	}
+
+	tag := domain.Tag{
+		UserID:     req.UserID,
+		Name:       name,
+		CreateDate: time.Now().UTC(),
+	}
+	tagID, err := ts.tagRepository.CreateTag(ctx, tag)
+	if cerrors.Is(cerrors.Exist, err) {
+		return domain.CreateTagResponse{}, err
+	}
+	if err != nil {
+		return domain.CreateTagResponse{}, cerrors.E(op, cerrors.Internal, err, "태그를 생성하는 중에 에러가 발생했습니다.")
+	}
+	tag.ID = tagID
+
+	return domain.CreateTagResponse{
+		Tag: domain.TagDTOFrom(tag),
+	}, nil
+}
+
+func (ts tagService) PatchTag(ctx context.Context, req domain.PatchTagRequest) error {
+	const op cerrors.Op = "tag/service/PatchTag"
+
+	tag, err := ts.getOwnTag(ctx, req.UserID, req.ID)
+	if err != nil {
+		return err
+	}
+
+	tag.Name = domain.NormalizeTagName(req.Name)
+	if err := ts.checkDuplicateName(ctx, req.UserID, tag.Name, tag.ID); err != nil {
+		return err
+	}
+
+	err = ts.tagRepository.UpdateTag(ctx, *tag)
+	if cerrors.Is(cerrors.Exist, err) {
+		return err
+	}
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "태그를 수정하는 중에 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+// DeleteTag
+// 태그를 지우면 태그를 붙인 상품에서도 떨어진다. 상품은 그대로다.
+func (ts tagService) DeleteTag(ctx context.Context, req domain.DeleteTagRequest) error {
+	const op cerrors.Op = "tag/service/DeleteTag"
+
+	if _, err := ts.getOwnTag(ctx, req.UserID, req.ID); err != nil {
+		return err
+	}
+
+	if err := ts.tagRepository.DeleteTag(ctx, req.ID); err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "태그를 삭제하는 중에 에러가 발생했습니다.")
+	}
+
+	return nil
+}
+
+func (ts tagService) ListTags(ctx context.Context, req domain.ListTagsRequest) (domain.ListTagsResponse, error) {
+	const op cerrors.Op = "tag/service/ListTags"
+
+	tags, err := ts.tagRepository.ListTags(ctx, req.UserID)
+	if err != nil {
+		return domain.ListTagsResponse{}, cerrors.E(op, cerrors.Internal, err, "태그를 조회하는 중에 에러가 발생했습니다.")
+	}
+
+	dtos := make([]domain.TagDTO, 0, len(tags))
+	for _, tag := range tags {
+		dtos = append(dtos, domain.TagDTOFrom(tag))
+	}
+
+	return domain.ListTagsResponse{
+		Tags: dtos,
+	}, nil
+}
+
+// getOwnTag
+// 다른 사장님의 태그는 없는 것으로 본다.
+func (ts tagService) getOwnTag(ctx context.Context, userID int, tagID int) (*domain.Tag, error) {
+	const op cerrors.Op = "tag/service/getOwnTag"
+
+	tag, err := ts.tagRepository.GetTag(ctx, tagID)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "태그를 조회하는 중에 에러가 발생했습니다.")
+	}
+	if tag == nil || tag.UserID != userID {
+		return nil, cerrors.E(op, cerrors.NotExist, "태그를 찾을 수 없습니다.")
+	}
+
+	return tag, nil
+}
+
+// checkDuplicateName
+// 같은 이름의 태그가 있는지 확인한다. (대소문자는 DB collation 에 따라 구분하지 않음)
+func (ts tagService) checkDuplicateName(ctx context.Context, userID int, name string, exceptID int) error {
+	const op cerrors.Op = "tag/service/checkDuplicateName"
+
+	duplicated, err := ts.tagRepository.FindTagByName(ctx, userID, name)
+	if err != nil {
+		return cerrors.E(op, cerrors.Internal, err, "태그를 조회하는 중에 에러가 발생했습니다.")
+	}
+	if duplicated != nil && duplicated.ID != exceptID {
+		return cerrors.E(op, cerrors.Exist, "이미 같은 이름의 태그가 있습니다.")
+	}
+
+	return nil
+}
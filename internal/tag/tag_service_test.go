@@ -0,0 +1,195 @@
+package tag
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"payhere/domain"
+	"payhere/mocks"
+	cerrors "payhere/pkg/cerrors"
+	"testing"
+)
+
+type tagServiceTestSuite struct {
+	tagRepository *mocks.TagRepository
+	tagService    domain.TagService
+}
+
+func setupTagServiceTestSuite(t *testing.T) tagServiceTestSuite {
+	var ts tagServiceTestSuite
+
+	ts.tagRepository = mocks.NewTagRepository(t)
+	ts.tagService = NewTagService(ts.tagRepository)
+
+	return ts
+}
+
+func Test_tagService_CreateTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      domain.CreateTagRequest
+		mock     func(ts tagServiceTestSuite)
+		want     string
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 앞의 '#' 을 빼고 태그 생성",
+			req:  domain.CreateTagRequest{UserID: 1, Name: " #신메뉴 "},
+			mock: func(ts tagServiceTestSuite) {
+				ts.tagRepository.EXPECT().FindTagByName(mock.Anything, 1, "신메뉴").Return(nil, nil).Once()
+				ts.tagRepository.EXPECT().CreateTag(mock.Anything, mock.MatchedBy(func(tag domain.Tag) bool {
+					return tag.UserID == 1 && tag.Name == "신메뉴"
+				})).Return(3, nil).Once()
+			},
+			want: "신메뉴",
+		},
+		{
+			name: "FAIL - 같은 이름의 태그가 있음",
+			req:  domain.CreateTagRequest{UserID: 1, Name: "신메뉴"},
+			mock: func(ts tagServiceTestSuite) {
+				ts.tagRepository.EXPECT().FindTagByName(mock.Anything, 1, "신메뉴").Return(&domain.Tag{ID: 3, UserID: 1, Name: "신메뉴"}, nil).Once()
+			},
+			wantKind: cerrors.Exist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTagServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			got, err := ts.tagService.CreateTag(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 3, got.Tag.ID)
+			assert.Equal(t, tt.want, got.Tag.Name)
+		})
+	}
+}
+
+func Test_tagService_PatchTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      domain.PatchTagRequest
+		mock     func(ts tagServiceTestSuite)
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 태그 이름 수정",
+			req:  domain.PatchTagRequest{UserID: 1, ID: 3, Name: "시즌"},
+			mock: func(ts tagServiceTestSuite) {
+				ts.tagRepository.EXPECT().GetTag(mock.Anything, 3).Return(&domain.Tag{ID: 3, UserID: 1, Name: "신메뉴"}, nil).Once()
+				ts.tagRepository.EXPECT().FindTagByName(mock.Anything, 1, "시즌").Return(nil, nil).Once()
+				ts.tagRepository.EXPECT().UpdateTag(mock.Anything, domain.Tag{ID: 3, UserID: 1, Name: "시즌"}).Return(nil).Once()
+			},
+		},
+		{
+			name: "PASS - 대소문자만 바꿈",
+			req:  domain.PatchTagRequest{UserID: 1, ID: 3, Name: "VEGAN"},
+			mock: func(ts tagServiceTestSuite) {
+				ts.tagRepository.EXPECT().GetTag(mock.Anything, 3).Return(&domain.Tag{ID: 3, UserID: 1, Name: "vegan"}, nil).Once()
+				ts.tagRepository.EXPECT().FindTagByName(mock.Anything, 1, "VEGAN").Return(&domain.Tag{ID: 3, UserID: 1, Name: "vegan"}, nil).Once()
+				ts.tagRepository.EXPECT().UpdateTag(mock.Anything, domain.Tag{ID: 3, UserID: 1, Name: "VEGAN"}).Return(nil).Once()
+			},
+		},
+		{
+			name: "FAIL - 다른 사장님의 태그",
+			req:  domain.PatchTagRequest{UserID: 2, ID: 3, Name: "시즌"},
+			mock: func(ts tagServiceTestSuite) {
+				ts.tagRepository.EXPECT().GetTag(mock.Anything, 3).Return(&domain.Tag{ID: 3, UserID: 1, Name: "신메뉴"}, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+		{
+			name: "FAIL - 같은 이름의 다른 태그가 있음",
+			req:  domain.PatchTagRequest{UserID: 1, ID: 3, Name: "비건"},
+			mock: func(ts tagServiceTestSuite) {
+				ts.tagRepository.EXPECT().GetTag(mock.Anything, 3).Return(&domain.Tag{ID: 3, UserID: 1, Name: "신메뉴"}, nil).Once()
+				ts.tagRepository.EXPECT().FindTagByName(mock.Anything, 1, "비건").Return(&domain.Tag{ID: 4, UserID: 1, Name: "비건"}, nil).Once()
+			},
+			wantKind: cerrors.Exist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTagServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.tagService.PatchTag(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_tagService_DeleteTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      domain.DeleteTagRequest
+		mock     func(ts tagServiceTestSuite)
+		wantKind cerrors.Kind
+	}{
+		{
+			name: "PASS - 태그 삭제",
+			req:  domain.DeleteTagRequest{UserID: 1, ID: 3},
+			mock: func(ts tagServiceTestSuite) {
+				ts.tagRepository.EXPECT().GetTag(mock.Anything, 3).Return(&domain.Tag{ID: 3, UserID: 1, Name: "신메뉴"}, nil).Once()
+				ts.tagRepository.EXPECT().DeleteTag(mock.Anything, 3).Return(nil).Once()
+			},
+		},
+		{
+			name: "FAIL - 없는 태그",
+			req:  domain.DeleteTagRequest{UserID: 1, ID: 10},
+			mock: func(ts tagServiceTestSuite) {
+				ts.tagRepository.EXPECT().GetTag(mock.Anything, 10).Return(nil, nil).Once()
+			},
+			wantKind: cerrors.NotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ts := setupTagServiceTestSuite(t)
+			tt.mock(ts)
+
+			// when
+			err := ts.tagService.DeleteTag(context.Background(), tt.req)
+
+			// then
+			if tt.wantKind != cerrors.Other {
+				assert.True(t, cerrors.Is(tt.wantKind, err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_tagService_ListTags(t *testing.T) {
+	// given
+	ts := setupTagServiceTestSuite(t)
+	ts.tagRepository.EXPECT().ListTags(mock.Anything, 1).Return(nil, nil).Once()
+
+	// when
+	got, err := ts.tagService.ListTags(context.Background(), domain.ListTagsRequest{UserID: 1})
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ListTagsResponse{Tags: []domain.TagDTO{}}, got)
+}
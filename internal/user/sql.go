@@ -1,5 +1,9 @@
 package user
 
-const createUserQuery = `INSERT INTO users (mobile_id, password, use_type) VALUES (?, ?, ?)`
+const createUserQuery = `INSERT INTO users (mobile_id, password, use_type, time_zone) VALUES (?, ?, ?, ?)`
 
-const findUserByMobileIDQuery = `SELECT id, mobile_id, password, use_type FROM users WHERE mobile_id = ?`
+const findUserByMobileIDQuery = `SELECT id, mobile_id, password, use_type, time_zone FROM users WHERE mobile_id = ?`
+
+const getUserQuery = `SELECT id, mobile_id, use_type, time_zone FROM users WHERE id = ? AND delete_date IS NULL`
+
+const listUsersAfterQuery = `SELECT id, mobile_id, use_type, time_zone FROM users WHERE delete_date IS NULL AND id > ? ORDER BY id LIMIT ?`
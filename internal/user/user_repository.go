@@ -23,7 +23,7 @@ var _ domain.UserRepository = (*userRepository)(nil)
 func (u userRepository) CreateUser(ctx context.Context, user domain.User) (int, error) {
 	const op cerrors.Op = "user/userRepository/createUser"
 
-	result, err := u.sqlDB.ExecContext(ctx, createUserQuery, user.MobileID, user.Password, user.UseType)
+	result, err := u.sqlDB.ExecContext(ctx, createUserQuery, user.MobileID, user.Password, user.UseType, user.TimeZone)
 	if err != nil {
 		return 0, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
 	}
@@ -41,7 +41,7 @@ func (u userRepository) FindUserByMobileID(ctx context.Context, mobileID string)
 	var user domain.User
 
 	err := u.sqlDB.QueryRowContext(ctx, findUserByMobileIDQuery, mobileID).
-		Scan(&user.ID, &user.MobileID, &user.Password, &user.UseType)
+		Scan(&user.ID, &user.MobileID, &user.Password, &user.UseType, &user.TimeZone)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -51,3 +51,45 @@ func (u userRepository) FindUserByMobileID(ctx context.Context, mobileID string)
 
 	return &user, nil
 }
+
+// GetUser
+// 비밀번호는 조회하지 않는다.
+func (u userRepository) GetUser(ctx context.Context, userID int) (*domain.User, error) {
+	const op cerrors.Op = "user/userRepository/GetUser"
+	var user domain.User
+
+	err := u.sqlDB.QueryRowContext(ctx, getUserQuery, userID).
+		Scan(&user.ID, &user.MobileID, &user.UseType, &user.TimeZone)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+
+	return &user, nil
+}
+
+// ListUsersAfter
+// 사장님을 ID 순으로 cursor 다음부터 limit 명 조회한다. 비밀번호는 조회하지 않는다.
+func (u userRepository) ListUsersAfter(ctx context.Context, cursor int, limit int) ([]domain.User, error) {
+	const op cerrors.Op = "user/userRepository/ListUsersAfter"
+
+	var users []domain.User
+
+	rows, err := u.sqlDB.QueryContext(ctx, listUsersAfterQuery, cursor, limit)
+	if err != nil {
+		return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user domain.User
+		if err := rows.Scan(&user.ID, &user.MobileID, &user.UseType, &user.TimeZone); err != nil {
+			return nil, cerrors.E(op, cerrors.Internal, err, "서버 에러가 발생했습니다.")
+		}
+		users = append(users, user)
+	}
+
+	return users, nil
+}
@@ -56,11 +56,12 @@ func Test_userRepository_CreateUser(t *testing.T) {
 					MobileID: "01012345678",
 					Password: "password",
 					UseType:  domain.UserUseTypePlace,
+					TimeZone: domain.DefaultTimeZone,
 				},
 			},
 			mock: func(ts userRepositoryTestSuite) {
 				ts.sqlMock.ExpectExec("INSERT INTO users").
-					WithArgs("01012345678", "password", "PLACE").
+					WithArgs("01012345678", "password", "PLACE", "Asia/Seoul").
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			want:    1,
@@ -74,11 +75,12 @@ func Test_userRepository_CreateUser(t *testing.T) {
 					MobileID: "01012345678",
 					Password: "password",
 					UseType:  domain.UserUseTypePlace,
+					TimeZone: domain.DefaultTimeZone,
 				},
 			},
 			mock: func(ts userRepositoryTestSuite) {
 				ts.sqlMock.ExpectExec("INSERT INTO users").
-					WithArgs("01012345678", "password", "PLACE").
+					WithArgs("01012345678", "password", "PLACE", "Asia/Seoul").
 					WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
 			},
 			want:    0,
@@ -124,9 +126,9 @@ func Test_userRepository_FindUserByMobileID(t *testing.T) {
 				userID: "01012345678",
 			},
 			mock: func(ts userRepositoryTestSuite) {
-				query := "SELECT id, mobile_id, password, use_type FROM users"
-				columns := []string{"id", "user_id", "password", "user_type"}
-				rows := sqlmock.NewRows(columns).AddRow(1, "01012345678", "password", "PLACE")
+				query := "SELECT id, mobile_id, password, use_type, time_zone FROM users"
+				columns := []string{"id", "user_id", "password", "user_type", "time_zone"}
+				rows := sqlmock.NewRows(columns).AddRow(1, "01012345678", "password", "PLACE", "Asia/Seoul")
 				ts.sqlMock.ExpectQuery(query).WithArgs("01012345678").WillReturnRows(rows)
 			},
 			want: &domain.User{
@@ -136,6 +138,7 @@ func Test_userRepository_FindUserByMobileID(t *testing.T) {
 				MobileID: "01012345678",
 				Password: "password",
 				UseType:  domain.UserUseTypePlace,
+				TimeZone: domain.DefaultTimeZone,
 			},
 			wantErr: false,
 		},
@@ -146,7 +149,7 @@ func Test_userRepository_FindUserByMobileID(t *testing.T) {
 				userID: "01012345678",
 			},
 			mock: func(ts userRepositoryTestSuite) {
-				query := "SELECT id, mobile_id, password, use_type FROM users"
+				query := "SELECT id, mobile_id, password, use_type, time_zone FROM users"
 				ts.sqlMock.ExpectQuery(query).WithArgs("01012345678").WillReturnError(sql.ErrNoRows)
 			},
 			want:    nil,
@@ -171,3 +174,45 @@ func Test_userRepository_FindUserByMobileID(t *testing.T) {
 		})
 	}
 }
+
+func Test_userRepository_ListUsersAfter(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	query := "SELECT id, mobile_id, use_type, time_zone FROM users WHERE delete_date IS NULL AND id > \\? ORDER BY id LIMIT \\?"
+	rows := sqlmock.NewRows([]string{"id", "mobile_id", "use_type", "time_zone"}).
+		AddRow(2, "01012345678", "PLACE", "Asia/Seoul").
+		AddRow(3, "01087654321", "PLACE", "America/New_York")
+	ts.sqlMock.ExpectQuery(query).WithArgs(1, 100).WillReturnRows(rows)
+
+	// when
+	got, err := ts.userRepository.ListUsersAfter(context.Background(), 1, 100)
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, "America/New_York", got[1].TimeZone)
+	assert.Empty(t, got[0].Password)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
+
+func Test_userRepository_GetUser(t *testing.T) {
+	// given
+	ts := setupUserRepositoryTestSuite()
+	query := "SELECT id, mobile_id, use_type, time_zone FROM users WHERE id = \\? AND delete_date IS NULL"
+	rows := sqlmock.NewRows([]string{"id", "mobile_id", "use_type", "time_zone"}).
+		AddRow(1, "01012345678", "PLACE", "America/New_York")
+	ts.sqlMock.ExpectQuery(query).WithArgs(1).WillReturnRows(rows)
+	ts.sqlMock.ExpectQuery(query).WithArgs(2).WillReturnError(sql.ErrNoRows)
+
+	// when
+	got, err := ts.userRepository.GetUser(context.Background(), 1)
+	notFound, notFoundErr := ts.userRepository.GetUser(context.Background(), 2)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, "America/New_York", got.TimeZone)
+	assert.Empty(t, got.Password)
+	assert.NoError(t, notFoundErr)
+	assert.Nil(t, notFound)
+	assert.NoError(t, ts.sqlMock.ExpectationsWereMet())
+}
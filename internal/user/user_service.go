@@ -62,6 +62,7 @@ func (us userService) CreateUser(ctx context.Context, req domain.CreateUserReque
 		MobileID: phoneNumber,
 		Password: hashedPassword,
 		UseType:  domain.UserUseTypePlace,
+		TimeZone: req.TimeZoneOrDefault(),
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,200 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CategoryController is an autogenerated mock type for the CategoryController type
+type CategoryController struct {
+	mock.Mock
+}
+
+type CategoryController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CategoryController) EXPECT() *CategoryController_Expecter {
+	return &CategoryController_Expecter{mock: &_m.Mock}
+}
+
+// CreateCategory provides a mock function with given fields: c
+func (_m *CategoryController) CreateCategory(c *gin.Context) {
+	_m.Called(c)
+}
+
+// CategoryController_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type CategoryController_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *CategoryController_Expecter) CreateCategory(c interface{}) *CategoryController_CreateCategory_Call {
+	return &CategoryController_CreateCategory_Call{Call: _e.mock.On("CreateCategory", c)}
+}
+
+func (_c *CategoryController_CreateCategory_Call) Run(run func(c *gin.Context)) *CategoryController_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *CategoryController_CreateCategory_Call) Return() *CategoryController_CreateCategory_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CategoryController_CreateCategory_Call) RunAndReturn(run func(*gin.Context)) *CategoryController_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function with given fields: c
+func (_m *CategoryController) DeleteCategory(c *gin.Context) {
+	_m.Called(c)
+}
+
+// CategoryController_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type CategoryController_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *CategoryController_Expecter) DeleteCategory(c interface{}) *CategoryController_DeleteCategory_Call {
+	return &CategoryController_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", c)}
+}
+
+func (_c *CategoryController_DeleteCategory_Call) Run(run func(c *gin.Context)) *CategoryController_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *CategoryController_DeleteCategory_Call) Return() *CategoryController_DeleteCategory_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CategoryController_DeleteCategory_Call) RunAndReturn(run func(*gin.Context)) *CategoryController_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategory provides a mock function with given fields: c
+func (_m *CategoryController) GetCategory(c *gin.Context) {
+	_m.Called(c)
+}
+
+// CategoryController_GetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategory'
+type CategoryController_GetCategory_Call struct {
+	*mock.Call
+}
+
+// GetCategory is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *CategoryController_Expecter) GetCategory(c interface{}) *CategoryController_GetCategory_Call {
+	return &CategoryController_GetCategory_Call{Call: _e.mock.On("GetCategory", c)}
+}
+
+func (_c *CategoryController_GetCategory_Call) Run(run func(c *gin.Context)) *CategoryController_GetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *CategoryController_GetCategory_Call) Return() *CategoryController_GetCategory_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CategoryController_GetCategory_Call) RunAndReturn(run func(*gin.Context)) *CategoryController_GetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategories provides a mock function with given fields: c
+func (_m *CategoryController) ListCategories(c *gin.Context) {
+	_m.Called(c)
+}
+
+// CategoryController_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
+type CategoryController_ListCategories_Call struct {
+	*mock.Call
+}
+
+// ListCategories is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *CategoryController_Expecter) ListCategories(c interface{}) *CategoryController_ListCategories_Call {
+	return &CategoryController_ListCategories_Call{Call: _e.mock.On("ListCategories", c)}
+}
+
+func (_c *CategoryController_ListCategories_Call) Run(run func(c *gin.Context)) *CategoryController_ListCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *CategoryController_ListCategories_Call) Return() *CategoryController_ListCategories_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CategoryController_ListCategories_Call) RunAndReturn(run func(*gin.Context)) *CategoryController_ListCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchCategory provides a mock function with given fields: c
+func (_m *CategoryController) PatchCategory(c *gin.Context) {
+	_m.Called(c)
+}
+
+// CategoryController_PatchCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchCategory'
+type CategoryController_PatchCategory_Call struct {
+	*mock.Call
+}
+
+// PatchCategory is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *CategoryController_Expecter) PatchCategory(c interface{}) *CategoryController_PatchCategory_Call {
+	return &CategoryController_PatchCategory_Call{Call: _e.mock.On("PatchCategory", c)}
+}
+
+func (_c *CategoryController_PatchCategory_Call) Run(run func(c *gin.Context)) *CategoryController_PatchCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *CategoryController_PatchCategory_Call) Return() *CategoryController_PatchCategory_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CategoryController_PatchCategory_Call) RunAndReturn(run func(*gin.Context)) *CategoryController_PatchCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCategoryController creates a new instance of CategoryController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCategoryController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CategoryController {
+	mock := &CategoryController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
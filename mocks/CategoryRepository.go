@@ -0,0 +1,341 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "payhere/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CategoryRepository is an autogenerated mock type for the CategoryRepository type
+type CategoryRepository struct {
+	mock.Mock
+}
+
+type CategoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CategoryRepository) EXPECT() *CategoryRepository_Expecter {
+	return &CategoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateCategory provides a mock function with given fields: ctx, category
+func (_m *CategoryRepository) CreateCategory(ctx context.Context, category domain.Category) (int, error) {
+	ret := _m.Called(ctx, category)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Category) (int, error)); ok {
+		return rf(ctx, category)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Category) int); ok {
+		r0 = rf(ctx, category)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Category) error); ok {
+		r1 = rf(ctx, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryRepository_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type CategoryRepository_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category domain.Category
+func (_e *CategoryRepository_Expecter) CreateCategory(ctx interface{}, category interface{}) *CategoryRepository_CreateCategory_Call {
+	return &CategoryRepository_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, category)}
+}
+
+func (_c *CategoryRepository_CreateCategory_Call) Run(run func(ctx context.Context, category domain.Category)) *CategoryRepository_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Category))
+	})
+	return _c
+}
+
+func (_c *CategoryRepository_CreateCategory_Call) Return(_a0 int, _a1 error) *CategoryRepository_CreateCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryRepository_CreateCategory_Call) RunAndReturn(run func(context.Context, domain.Category) (int, error)) *CategoryRepository_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function with given fields: ctx, categoryID
+func (_m *CategoryRepository) DeleteCategory(ctx context.Context, categoryID int) error {
+	ret := _m.Called(ctx, categoryID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, categoryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CategoryRepository_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type CategoryRepository_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryID int
+func (_e *CategoryRepository_Expecter) DeleteCategory(ctx interface{}, categoryID interface{}) *CategoryRepository_DeleteCategory_Call {
+	return &CategoryRepository_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", ctx, categoryID)}
+}
+
+func (_c *CategoryRepository_DeleteCategory_Call) Run(run func(ctx context.Context, categoryID int)) *CategoryRepository_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *CategoryRepository_DeleteCategory_Call) Return(_a0 error) *CategoryRepository_DeleteCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CategoryRepository_DeleteCategory_Call) RunAndReturn(run func(context.Context, int) error) *CategoryRepository_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCategoryByName provides a mock function with given fields: ctx, params
+func (_m *CategoryRepository) FindCategoryByName(ctx context.Context, params domain.FindCategoryByNameParams) (*domain.Category, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *domain.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.FindCategoryByNameParams) (*domain.Category, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.FindCategoryByNameParams) *domain.Category); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.FindCategoryByNameParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryRepository_FindCategoryByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCategoryByName'
+type CategoryRepository_FindCategoryByName_Call struct {
+	*mock.Call
+}
+
+// FindCategoryByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.FindCategoryByNameParams
+func (_e *CategoryRepository_Expecter) FindCategoryByName(ctx interface{}, params interface{}) *CategoryRepository_FindCategoryByName_Call {
+	return &CategoryRepository_FindCategoryByName_Call{Call: _e.mock.On("FindCategoryByName", ctx, params)}
+}
+
+func (_c *CategoryRepository_FindCategoryByName_Call) Run(run func(ctx context.Context, params domain.FindCategoryByNameParams)) *CategoryRepository_FindCategoryByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.FindCategoryByNameParams))
+	})
+	return _c
+}
+
+func (_c *CategoryRepository_FindCategoryByName_Call) Return(_a0 *domain.Category, _a1 error) *CategoryRepository_FindCategoryByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryRepository_FindCategoryByName_Call) RunAndReturn(run func(context.Context, domain.FindCategoryByNameParams) (*domain.Category, error)) *CategoryRepository_FindCategoryByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategory provides a mock function with given fields: ctx, categoryID
+func (_m *CategoryRepository) GetCategory(ctx context.Context, categoryID int) (*domain.Category, error) {
+	ret := _m.Called(ctx, categoryID)
+
+	var r0 *domain.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Category, error)); ok {
+		return rf(ctx, categoryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Category); ok {
+		r0 = rf(ctx, categoryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryRepository_GetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategory'
+type CategoryRepository_GetCategory_Call struct {
+	*mock.Call
+}
+
+// GetCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryID int
+func (_e *CategoryRepository_Expecter) GetCategory(ctx interface{}, categoryID interface{}) *CategoryRepository_GetCategory_Call {
+	return &CategoryRepository_GetCategory_Call{Call: _e.mock.On("GetCategory", ctx, categoryID)}
+}
+
+func (_c *CategoryRepository_GetCategory_Call) Run(run func(ctx context.Context, categoryID int)) *CategoryRepository_GetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *CategoryRepository_GetCategory_Call) Return(_a0 *domain.Category, _a1 error) *CategoryRepository_GetCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryRepository_GetCategory_Call) RunAndReturn(run func(context.Context, int) (*domain.Category, error)) *CategoryRepository_GetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategories provides a mock function with given fields: ctx, userID
+func (_m *CategoryRepository) ListCategories(ctx context.Context, userID int) ([]domain.Category, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.Category, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Category); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryRepository_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
+type CategoryRepository_ListCategories_Call struct {
+	*mock.Call
+}
+
+// ListCategories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *CategoryRepository_Expecter) ListCategories(ctx interface{}, userID interface{}) *CategoryRepository_ListCategories_Call {
+	return &CategoryRepository_ListCategories_Call{Call: _e.mock.On("ListCategories", ctx, userID)}
+}
+
+func (_c *CategoryRepository_ListCategories_Call) Run(run func(ctx context.Context, userID int)) *CategoryRepository_ListCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *CategoryRepository_ListCategories_Call) Return(_a0 []domain.Category, _a1 error) *CategoryRepository_ListCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryRepository_ListCategories_Call) RunAndReturn(run func(context.Context, int) ([]domain.Category, error)) *CategoryRepository_ListCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCategory provides a mock function with given fields: ctx, category
+func (_m *CategoryRepository) UpdateCategory(ctx context.Context, category domain.Category) error {
+	ret := _m.Called(ctx, category)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Category) error); ok {
+		r0 = rf(ctx, category)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CategoryRepository_UpdateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCategory'
+type CategoryRepository_UpdateCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category domain.Category
+func (_e *CategoryRepository_Expecter) UpdateCategory(ctx interface{}, category interface{}) *CategoryRepository_UpdateCategory_Call {
+	return &CategoryRepository_UpdateCategory_Call{Call: _e.mock.On("UpdateCategory", ctx, category)}
+}
+
+func (_c *CategoryRepository_UpdateCategory_Call) Run(run func(ctx context.Context, category domain.Category)) *CategoryRepository_UpdateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Category))
+	})
+	return _c
+}
+
+func (_c *CategoryRepository_UpdateCategory_Call) Return(_a0 error) *CategoryRepository_UpdateCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CategoryRepository_UpdateCategory_Call) RunAndReturn(run func(context.Context, domain.Category) error) *CategoryRepository_UpdateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCategoryRepository creates a new instance of CategoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCategoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CategoryRepository {
+	mock := &CategoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
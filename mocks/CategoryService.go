@@ -0,0 +1,272 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "payhere/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CategoryService is an autogenerated mock type for the CategoryService type
+type CategoryService struct {
+	mock.Mock
+}
+
+type CategoryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CategoryService) EXPECT() *CategoryService_Expecter {
+	return &CategoryService_Expecter{mock: &_m.Mock}
+}
+
+// CreateCategory provides a mock function with given fields: ctx, req
+func (_m *CategoryService) CreateCategory(ctx context.Context, req domain.CreateCategoryRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateCategoryRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CategoryService_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type CategoryService_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.CreateCategoryRequest
+func (_e *CategoryService_Expecter) CreateCategory(ctx interface{}, req interface{}) *CategoryService_CreateCategory_Call {
+	return &CategoryService_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, req)}
+}
+
+func (_c *CategoryService_CreateCategory_Call) Run(run func(ctx context.Context, req domain.CreateCategoryRequest)) *CategoryService_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CreateCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *CategoryService_CreateCategory_Call) Return(_a0 error) *CategoryService_CreateCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CategoryService_CreateCategory_Call) RunAndReturn(run func(context.Context, domain.CreateCategoryRequest) error) *CategoryService_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function with given fields: ctx, req
+func (_m *CategoryService) DeleteCategory(ctx context.Context, req domain.DeleteCategoryRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeleteCategoryRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CategoryService_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type CategoryService_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.DeleteCategoryRequest
+func (_e *CategoryService_Expecter) DeleteCategory(ctx interface{}, req interface{}) *CategoryService_DeleteCategory_Call {
+	return &CategoryService_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", ctx, req)}
+}
+
+func (_c *CategoryService_DeleteCategory_Call) Run(run func(ctx context.Context, req domain.DeleteCategoryRequest)) *CategoryService_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DeleteCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *CategoryService_DeleteCategory_Call) Return(_a0 error) *CategoryService_DeleteCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CategoryService_DeleteCategory_Call) RunAndReturn(run func(context.Context, domain.DeleteCategoryRequest) error) *CategoryService_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategory provides a mock function with given fields: ctx, req
+func (_m *CategoryService) GetCategory(ctx context.Context, req domain.GetCategoryRequest) (domain.GetCategoryResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.GetCategoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetCategoryRequest) (domain.GetCategoryResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetCategoryRequest) domain.GetCategoryResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.GetCategoryResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GetCategoryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryService_GetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategory'
+type CategoryService_GetCategory_Call struct {
+	*mock.Call
+}
+
+// GetCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.GetCategoryRequest
+func (_e *CategoryService_Expecter) GetCategory(ctx interface{}, req interface{}) *CategoryService_GetCategory_Call {
+	return &CategoryService_GetCategory_Call{Call: _e.mock.On("GetCategory", ctx, req)}
+}
+
+func (_c *CategoryService_GetCategory_Call) Run(run func(ctx context.Context, req domain.GetCategoryRequest)) *CategoryService_GetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GetCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *CategoryService_GetCategory_Call) Return(_a0 domain.GetCategoryResponse, _a1 error) *CategoryService_GetCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryService_GetCategory_Call) RunAndReturn(run func(context.Context, domain.GetCategoryRequest) (domain.GetCategoryResponse, error)) *CategoryService_GetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategories provides a mock function with given fields: ctx, req
+func (_m *CategoryService) ListCategories(ctx context.Context, req domain.ListCategoriesRequest) (domain.ListCategoriesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListCategoriesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListCategoriesRequest) (domain.ListCategoriesResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListCategoriesRequest) domain.ListCategoriesResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListCategoriesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListCategoriesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryService_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
+type CategoryService_ListCategories_Call struct {
+	*mock.Call
+}
+
+// ListCategories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListCategoriesRequest
+func (_e *CategoryService_Expecter) ListCategories(ctx interface{}, req interface{}) *CategoryService_ListCategories_Call {
+	return &CategoryService_ListCategories_Call{Call: _e.mock.On("ListCategories", ctx, req)}
+}
+
+func (_c *CategoryService_ListCategories_Call) Run(run func(ctx context.Context, req domain.ListCategoriesRequest)) *CategoryService_ListCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListCategoriesRequest))
+	})
+	return _c
+}
+
+func (_c *CategoryService_ListCategories_Call) Return(_a0 domain.ListCategoriesResponse, _a1 error) *CategoryService_ListCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryService_ListCategories_Call) RunAndReturn(run func(context.Context, domain.ListCategoriesRequest) (domain.ListCategoriesResponse, error)) *CategoryService_ListCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchCategory provides a mock function with given fields: ctx, req
+func (_m *CategoryService) PatchCategory(ctx context.Context, req domain.PatchCategoryRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PatchCategoryRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CategoryService_PatchCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchCategory'
+type CategoryService_PatchCategory_Call struct {
+	*mock.Call
+}
+
+// PatchCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.PatchCategoryRequest
+func (_e *CategoryService_Expecter) PatchCategory(ctx interface{}, req interface{}) *CategoryService_PatchCategory_Call {
+	return &CategoryService_PatchCategory_Call{Call: _e.mock.On("PatchCategory", ctx, req)}
+}
+
+func (_c *CategoryService_PatchCategory_Call) Run(run func(ctx context.Context, req domain.PatchCategoryRequest)) *CategoryService_PatchCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PatchCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *CategoryService_PatchCategory_Call) Return(_a0 error) *CategoryService_PatchCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CategoryService_PatchCategory_Call) RunAndReturn(run func(context.Context, domain.PatchCategoryRequest) error) *CategoryService_PatchCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCategoryService creates a new instance of CategoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCategoryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CategoryService {
+	mock := &CategoryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
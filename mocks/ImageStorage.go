@@ -0,0 +1,166 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ImageStorage is an autogenerated mock type for the ImageStorage type
+type ImageStorage struct {
+	mock.Mock
+}
+
+type ImageStorage_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ImageStorage) EXPECT() *ImageStorage_Expecter {
+	return &ImageStorage_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function with given fields: ctx, key
+func (_m *ImageStorage) Delete(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ImageStorage_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ImageStorage_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *ImageStorage_Expecter) Delete(ctx interface{}, key interface{}) *ImageStorage_Delete_Call {
+	return &ImageStorage_Delete_Call{Call: _e.mock.On("Delete", ctx, key)}
+}
+
+func (_c *ImageStorage_Delete_Call) Run(run func(ctx context.Context, key string)) *ImageStorage_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ImageStorage_Delete_Call) Return(_a0 error) *ImageStorage_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ImageStorage_Delete_Call) RunAndReturn(run func(context.Context, string) error) *ImageStorage_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function with given fields: ctx, key, contentType, data
+func (_m *ImageStorage) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	ret := _m.Called(ctx, key, contentType, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) error); ok {
+		r0 = rf(ctx, key, contentType, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ImageStorage_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type ImageStorage_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - contentType string
+//   - data []byte
+func (_e *ImageStorage_Expecter) Put(ctx interface{}, key interface{}, contentType interface{}, data interface{}) *ImageStorage_Put_Call {
+	return &ImageStorage_Put_Call{Call: _e.mock.On("Put", ctx, key, contentType, data)}
+}
+
+func (_c *ImageStorage_Put_Call) Run(run func(ctx context.Context, key string, contentType string, data []byte)) *ImageStorage_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]byte))
+	})
+	return _c
+}
+
+func (_c *ImageStorage_Put_Call) Return(_a0 error) *ImageStorage_Put_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ImageStorage_Put_Call) RunAndReturn(run func(context.Context, string, string, []byte) error) *ImageStorage_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// URL provides a mock function with given fields: key
+func (_m *ImageStorage) URL(key string) string {
+	ret := _m.Called(key)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// ImageStorage_URL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'URL'
+type ImageStorage_URL_Call struct {
+	*mock.Call
+}
+
+// URL is a helper method to define mock.On call
+//   - key string
+func (_e *ImageStorage_Expecter) URL(key interface{}) *ImageStorage_URL_Call {
+	return &ImageStorage_URL_Call{Call: _e.mock.On("URL", key)}
+}
+
+func (_c *ImageStorage_URL_Call) Run(run func(key string)) *ImageStorage_URL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ImageStorage_URL_Call) Return(_a0 string) *ImageStorage_URL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ImageStorage_URL_Call) RunAndReturn(run func(string) string) *ImageStorage_URL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewImageStorage creates a new instance of ImageStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewImageStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ImageStorage {
+	mock := &ImageStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
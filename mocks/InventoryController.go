@@ -0,0 +1,200 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InventoryController is an autogenerated mock type for the InventoryController type
+type InventoryController struct {
+	mock.Mock
+}
+
+type InventoryController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InventoryController) EXPECT() *InventoryController_Expecter {
+	return &InventoryController_Expecter{mock: &_m.Mock}
+}
+
+// CreateDisposal provides a mock function with given fields: c
+func (_m *InventoryController) CreateDisposal(c *gin.Context) {
+	_m.Called(c)
+}
+
+// InventoryController_CreateDisposal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDisposal'
+type InventoryController_CreateDisposal_Call struct {
+	*mock.Call
+}
+
+// CreateDisposal is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *InventoryController_Expecter) CreateDisposal(c interface{}) *InventoryController_CreateDisposal_Call {
+	return &InventoryController_CreateDisposal_Call{Call: _e.mock.On("CreateDisposal", c)}
+}
+
+func (_c *InventoryController_CreateDisposal_Call) Run(run func(c *gin.Context)) *InventoryController_CreateDisposal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryController_CreateDisposal_Call) Return() *InventoryController_CreateDisposal_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *InventoryController_CreateDisposal_Call) RunAndReturn(run func(*gin.Context)) *InventoryController_CreateDisposal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStockMovement provides a mock function with given fields: c
+func (_m *InventoryController) CreateStockMovement(c *gin.Context) {
+	_m.Called(c)
+}
+
+// InventoryController_CreateStockMovement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStockMovement'
+type InventoryController_CreateStockMovement_Call struct {
+	*mock.Call
+}
+
+// CreateStockMovement is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *InventoryController_Expecter) CreateStockMovement(c interface{}) *InventoryController_CreateStockMovement_Call {
+	return &InventoryController_CreateStockMovement_Call{Call: _e.mock.On("CreateStockMovement", c)}
+}
+
+func (_c *InventoryController_CreateStockMovement_Call) Run(run func(c *gin.Context)) *InventoryController_CreateStockMovement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryController_CreateStockMovement_Call) Return() *InventoryController_CreateStockMovement_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *InventoryController_CreateStockMovement_Call) RunAndReturn(run func(*gin.Context)) *InventoryController_CreateStockMovement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLowStockProducts provides a mock function with given fields: c
+func (_m *InventoryController) ListLowStockProducts(c *gin.Context) {
+	_m.Called(c)
+}
+
+// InventoryController_ListLowStockProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLowStockProducts'
+type InventoryController_ListLowStockProducts_Call struct {
+	*mock.Call
+}
+
+// ListLowStockProducts is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *InventoryController_Expecter) ListLowStockProducts(c interface{}) *InventoryController_ListLowStockProducts_Call {
+	return &InventoryController_ListLowStockProducts_Call{Call: _e.mock.On("ListLowStockProducts", c)}
+}
+
+func (_c *InventoryController_ListLowStockProducts_Call) Run(run func(c *gin.Context)) *InventoryController_ListLowStockProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryController_ListLowStockProducts_Call) Return() *InventoryController_ListLowStockProducts_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *InventoryController_ListLowStockProducts_Call) RunAndReturn(run func(*gin.Context)) *InventoryController_ListLowStockProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStockLots provides a mock function with given fields: c
+func (_m *InventoryController) ListStockLots(c *gin.Context) {
+	_m.Called(c)
+}
+
+// InventoryController_ListStockLots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStockLots'
+type InventoryController_ListStockLots_Call struct {
+	*mock.Call
+}
+
+// ListStockLots is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *InventoryController_Expecter) ListStockLots(c interface{}) *InventoryController_ListStockLots_Call {
+	return &InventoryController_ListStockLots_Call{Call: _e.mock.On("ListStockLots", c)}
+}
+
+func (_c *InventoryController_ListStockLots_Call) Run(run func(c *gin.Context)) *InventoryController_ListStockLots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryController_ListStockLots_Call) Return() *InventoryController_ListStockLots_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *InventoryController_ListStockLots_Call) RunAndReturn(run func(*gin.Context)) *InventoryController_ListStockLots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStockMovements provides a mock function with given fields: c
+func (_m *InventoryController) ListStockMovements(c *gin.Context) {
+	_m.Called(c)
+}
+
+// InventoryController_ListStockMovements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStockMovements'
+type InventoryController_ListStockMovements_Call struct {
+	*mock.Call
+}
+
+// ListStockMovements is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *InventoryController_Expecter) ListStockMovements(c interface{}) *InventoryController_ListStockMovements_Call {
+	return &InventoryController_ListStockMovements_Call{Call: _e.mock.On("ListStockMovements", c)}
+}
+
+func (_c *InventoryController_ListStockMovements_Call) Run(run func(c *gin.Context)) *InventoryController_ListStockMovements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryController_ListStockMovements_Call) Return() *InventoryController_ListStockMovements_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *InventoryController_ListStockMovements_Call) RunAndReturn(run func(*gin.Context)) *InventoryController_ListStockMovements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInventoryController creates a new instance of InventoryController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInventoryController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InventoryController {
+	mock := &InventoryController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,446 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "payhere/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InventoryRepository is an autogenerated mock type for the InventoryRepository type
+type InventoryRepository struct {
+	mock.Mock
+}
+
+type InventoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InventoryRepository) EXPECT() *InventoryRepository_Expecter {
+	return &InventoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimLowStockAlert provides a mock function with given fields: ctx, alert
+func (_m *InventoryRepository) ClaimLowStockAlert(ctx context.Context, alert domain.LowStockAlert) (bool, error) {
+	ret := _m.Called(ctx, alert)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LowStockAlert) (bool, error)); ok {
+		return rf(ctx, alert)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LowStockAlert) bool); ok {
+		r0 = rf(ctx, alert)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.LowStockAlert) error); ok {
+		r1 = rf(ctx, alert)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ClaimLowStockAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimLowStockAlert'
+type InventoryRepository_ClaimLowStockAlert_Call struct {
+	*mock.Call
+}
+
+// ClaimLowStockAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alert domain.LowStockAlert
+func (_e *InventoryRepository_Expecter) ClaimLowStockAlert(ctx interface{}, alert interface{}) *InventoryRepository_ClaimLowStockAlert_Call {
+	return &InventoryRepository_ClaimLowStockAlert_Call{Call: _e.mock.On("ClaimLowStockAlert", ctx, alert)}
+}
+
+func (_c *InventoryRepository_ClaimLowStockAlert_Call) Run(run func(ctx context.Context, alert domain.LowStockAlert)) *InventoryRepository_ClaimLowStockAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.LowStockAlert))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ClaimLowStockAlert_Call) Return(_a0 bool, _a1 error) *InventoryRepository_ClaimLowStockAlert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ClaimLowStockAlert_Call) RunAndReturn(run func(context.Context, domain.LowStockAlert) (bool, error)) *InventoryRepository_ClaimLowStockAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDisposal provides a mock function with given fields: ctx, disposal
+func (_m *InventoryRepository) CreateDisposal(ctx context.Context, disposal domain.Disposal) (domain.Disposal, error) {
+	ret := _m.Called(ctx, disposal)
+
+	var r0 domain.Disposal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Disposal) (domain.Disposal, error)); ok {
+		return rf(ctx, disposal)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Disposal) domain.Disposal); ok {
+		r0 = rf(ctx, disposal)
+	} else {
+		r0 = ret.Get(0).(domain.Disposal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Disposal) error); ok {
+		r1 = rf(ctx, disposal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_CreateDisposal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDisposal'
+type InventoryRepository_CreateDisposal_Call struct {
+	*mock.Call
+}
+
+// CreateDisposal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - disposal domain.Disposal
+func (_e *InventoryRepository_Expecter) CreateDisposal(ctx interface{}, disposal interface{}) *InventoryRepository_CreateDisposal_Call {
+	return &InventoryRepository_CreateDisposal_Call{Call: _e.mock.On("CreateDisposal", ctx, disposal)}
+}
+
+func (_c *InventoryRepository_CreateDisposal_Call) Run(run func(ctx context.Context, disposal domain.Disposal)) *InventoryRepository_CreateDisposal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Disposal))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_CreateDisposal_Call) Return(_a0 domain.Disposal, _a1 error) *InventoryRepository_CreateDisposal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_CreateDisposal_Call) RunAndReturn(run func(context.Context, domain.Disposal) (domain.Disposal, error)) *InventoryRepository_CreateDisposal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStockMovement provides a mock function with given fields: ctx, movement
+func (_m *InventoryRepository) CreateStockMovement(ctx context.Context, movement domain.StockMovement) (domain.StockMovement, error) {
+	ret := _m.Called(ctx, movement)
+
+	var r0 domain.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StockMovement) (domain.StockMovement, error)); ok {
+		return rf(ctx, movement)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StockMovement) domain.StockMovement); ok {
+		r0 = rf(ctx, movement)
+	} else {
+		r0 = ret.Get(0).(domain.StockMovement)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.StockMovement) error); ok {
+		r1 = rf(ctx, movement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_CreateStockMovement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStockMovement'
+type InventoryRepository_CreateStockMovement_Call struct {
+	*mock.Call
+}
+
+// CreateStockMovement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - movement domain.StockMovement
+func (_e *InventoryRepository_Expecter) CreateStockMovement(ctx interface{}, movement interface{}) *InventoryRepository_CreateStockMovement_Call {
+	return &InventoryRepository_CreateStockMovement_Call{Call: _e.mock.On("CreateStockMovement", ctx, movement)}
+}
+
+func (_c *InventoryRepository_CreateStockMovement_Call) Run(run func(ctx context.Context, movement domain.StockMovement)) *InventoryRepository_CreateStockMovement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.StockMovement))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_CreateStockMovement_Call) Return(_a0 domain.StockMovement, _a1 error) *InventoryRepository_CreateStockMovement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_CreateStockMovement_Call) RunAndReturn(run func(context.Context, domain.StockMovement) (domain.StockMovement, error)) *InventoryRepository_CreateStockMovement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPendingLowStockAlerts provides a mock function with given fields: ctx, limit
+func (_m *InventoryRepository) ListPendingLowStockAlerts(ctx context.Context, limit int) ([]domain.LowStockAlert, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 []domain.LowStockAlert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.LowStockAlert, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.LowStockAlert); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LowStockAlert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ListPendingLowStockAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPendingLowStockAlerts'
+type InventoryRepository_ListPendingLowStockAlerts_Call struct {
+	*mock.Call
+}
+
+// ListPendingLowStockAlerts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *InventoryRepository_Expecter) ListPendingLowStockAlerts(ctx interface{}, limit interface{}) *InventoryRepository_ListPendingLowStockAlerts_Call {
+	return &InventoryRepository_ListPendingLowStockAlerts_Call{Call: _e.mock.On("ListPendingLowStockAlerts", ctx, limit)}
+}
+
+func (_c *InventoryRepository_ListPendingLowStockAlerts_Call) Run(run func(ctx context.Context, limit int)) *InventoryRepository_ListPendingLowStockAlerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ListPendingLowStockAlerts_Call) Return(_a0 []domain.LowStockAlert, _a1 error) *InventoryRepository_ListPendingLowStockAlerts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ListPendingLowStockAlerts_Call) RunAndReturn(run func(context.Context, int) ([]domain.LowStockAlert, error)) *InventoryRepository_ListPendingLowStockAlerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStockLots provides a mock function with given fields: ctx, params
+func (_m *InventoryRepository) ListStockLots(ctx context.Context, params domain.ListStockLotsParams) ([]domain.StockLot, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.StockLot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListStockLotsParams) ([]domain.StockLot, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListStockLotsParams) []domain.StockLot); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.StockLot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListStockLotsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ListStockLots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStockLots'
+type InventoryRepository_ListStockLots_Call struct {
+	*mock.Call
+}
+
+// ListStockLots is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListStockLotsParams
+func (_e *InventoryRepository_Expecter) ListStockLots(ctx interface{}, params interface{}) *InventoryRepository_ListStockLots_Call {
+	return &InventoryRepository_ListStockLots_Call{Call: _e.mock.On("ListStockLots", ctx, params)}
+}
+
+func (_c *InventoryRepository_ListStockLots_Call) Run(run func(ctx context.Context, params domain.ListStockLotsParams)) *InventoryRepository_ListStockLots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListStockLotsParams))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ListStockLots_Call) Return(_a0 []domain.StockLot, _a1 error) *InventoryRepository_ListStockLots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ListStockLots_Call) RunAndReturn(run func(context.Context, domain.ListStockLotsParams) ([]domain.StockLot, error)) *InventoryRepository_ListStockLots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStockMovements provides a mock function with given fields: ctx, params
+func (_m *InventoryRepository) ListStockMovements(ctx context.Context, params domain.ListStockMovementsParams) ([]domain.StockMovement, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListStockMovementsParams) ([]domain.StockMovement, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListStockMovementsParams) []domain.StockMovement); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListStockMovementsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryRepository_ListStockMovements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStockMovements'
+type InventoryRepository_ListStockMovements_Call struct {
+	*mock.Call
+}
+
+// ListStockMovements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListStockMovementsParams
+func (_e *InventoryRepository_Expecter) ListStockMovements(ctx interface{}, params interface{}) *InventoryRepository_ListStockMovements_Call {
+	return &InventoryRepository_ListStockMovements_Call{Call: _e.mock.On("ListStockMovements", ctx, params)}
+}
+
+func (_c *InventoryRepository_ListStockMovements_Call) Run(run func(ctx context.Context, params domain.ListStockMovementsParams)) *InventoryRepository_ListStockMovements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListStockMovementsParams))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ListStockMovements_Call) Return(_a0 []domain.StockMovement, _a1 error) *InventoryRepository_ListStockMovements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryRepository_ListStockMovements_Call) RunAndReturn(run func(context.Context, domain.ListStockMovementsParams) ([]domain.StockMovement, error)) *InventoryRepository_ListStockMovements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseLowStockAlert provides a mock function with given fields: ctx, productID
+func (_m *InventoryRepository) ReleaseLowStockAlert(ctx context.Context, productID int) error {
+	ret := _m.Called(ctx, productID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryRepository_ReleaseLowStockAlert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseLowStockAlert'
+type InventoryRepository_ReleaseLowStockAlert_Call struct {
+	*mock.Call
+}
+
+// ReleaseLowStockAlert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int
+func (_e *InventoryRepository_Expecter) ReleaseLowStockAlert(ctx interface{}, productID interface{}) *InventoryRepository_ReleaseLowStockAlert_Call {
+	return &InventoryRepository_ReleaseLowStockAlert_Call{Call: _e.mock.On("ReleaseLowStockAlert", ctx, productID)}
+}
+
+func (_c *InventoryRepository_ReleaseLowStockAlert_Call) Run(run func(ctx context.Context, productID int)) *InventoryRepository_ReleaseLowStockAlert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ReleaseLowStockAlert_Call) Return(_a0 error) *InventoryRepository_ReleaseLowStockAlert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryRepository_ReleaseLowStockAlert_Call) RunAndReturn(run func(context.Context, int) error) *InventoryRepository_ReleaseLowStockAlert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetLowStockAlerts provides a mock function with given fields: ctx
+func (_m *InventoryRepository) ResetLowStockAlerts(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryRepository_ResetLowStockAlerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetLowStockAlerts'
+type InventoryRepository_ResetLowStockAlerts_Call struct {
+	*mock.Call
+}
+
+// ResetLowStockAlerts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *InventoryRepository_Expecter) ResetLowStockAlerts(ctx interface{}) *InventoryRepository_ResetLowStockAlerts_Call {
+	return &InventoryRepository_ResetLowStockAlerts_Call{Call: _e.mock.On("ResetLowStockAlerts", ctx)}
+}
+
+func (_c *InventoryRepository_ResetLowStockAlerts_Call) Run(run func(ctx context.Context)) *InventoryRepository_ResetLowStockAlerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *InventoryRepository_ResetLowStockAlerts_Call) Return(_a0 error) *InventoryRepository_ResetLowStockAlerts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryRepository_ResetLowStockAlerts_Call) RunAndReturn(run func(context.Context) error) *InventoryRepository_ResetLowStockAlerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInventoryRepository creates a new instance of InventoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInventoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InventoryRepository {
+	mock := &InventoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
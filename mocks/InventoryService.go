@@ -0,0 +1,302 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "payhere/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InventoryService is an autogenerated mock type for the InventoryService type
+type InventoryService struct {
+	mock.Mock
+}
+
+type InventoryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InventoryService) EXPECT() *InventoryService_Expecter {
+	return &InventoryService_Expecter{mock: &_m.Mock}
+}
+
+// CreateDisposal provides a mock function with given fields: ctx, req
+func (_m *InventoryService) CreateDisposal(ctx context.Context, req domain.CreateDisposalRequest) (domain.CreateDisposalResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.CreateDisposalResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateDisposalRequest) (domain.CreateDisposalResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateDisposalRequest) domain.CreateDisposalResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.CreateDisposalResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CreateDisposalRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_CreateDisposal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDisposal'
+type InventoryService_CreateDisposal_Call struct {
+	*mock.Call
+}
+
+// CreateDisposal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.CreateDisposalRequest
+func (_e *InventoryService_Expecter) CreateDisposal(ctx interface{}, req interface{}) *InventoryService_CreateDisposal_Call {
+	return &InventoryService_CreateDisposal_Call{Call: _e.mock.On("CreateDisposal", ctx, req)}
+}
+
+func (_c *InventoryService_CreateDisposal_Call) Run(run func(ctx context.Context, req domain.CreateDisposalRequest)) *InventoryService_CreateDisposal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CreateDisposalRequest))
+	})
+	return _c
+}
+
+func (_c *InventoryService_CreateDisposal_Call) Return(_a0 domain.CreateDisposalResponse, _a1 error) *InventoryService_CreateDisposal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_CreateDisposal_Call) RunAndReturn(run func(context.Context, domain.CreateDisposalRequest) (domain.CreateDisposalResponse, error)) *InventoryService_CreateDisposal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStockMovement provides a mock function with given fields: ctx, req
+func (_m *InventoryService) CreateStockMovement(ctx context.Context, req domain.CreateStockMovementRequest) (domain.CreateStockMovementResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.CreateStockMovementResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateStockMovementRequest) (domain.CreateStockMovementResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateStockMovementRequest) domain.CreateStockMovementResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.CreateStockMovementResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CreateStockMovementRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_CreateStockMovement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStockMovement'
+type InventoryService_CreateStockMovement_Call struct {
+	*mock.Call
+}
+
+// CreateStockMovement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.CreateStockMovementRequest
+func (_e *InventoryService_Expecter) CreateStockMovement(ctx interface{}, req interface{}) *InventoryService_CreateStockMovement_Call {
+	return &InventoryService_CreateStockMovement_Call{Call: _e.mock.On("CreateStockMovement", ctx, req)}
+}
+
+func (_c *InventoryService_CreateStockMovement_Call) Run(run func(ctx context.Context, req domain.CreateStockMovementRequest)) *InventoryService_CreateStockMovement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CreateStockMovementRequest))
+	})
+	return _c
+}
+
+func (_c *InventoryService_CreateStockMovement_Call) Return(_a0 domain.CreateStockMovementResponse, _a1 error) *InventoryService_CreateStockMovement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_CreateStockMovement_Call) RunAndReturn(run func(context.Context, domain.CreateStockMovementRequest) (domain.CreateStockMovementResponse, error)) *InventoryService_CreateStockMovement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLowStockProducts provides a mock function with given fields: ctx, req
+func (_m *InventoryService) ListLowStockProducts(ctx context.Context, req domain.ListLowStockProductsRequest) (domain.ListLowStockProductsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListLowStockProductsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListLowStockProductsRequest) (domain.ListLowStockProductsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListLowStockProductsRequest) domain.ListLowStockProductsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListLowStockProductsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListLowStockProductsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ListLowStockProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLowStockProducts'
+type InventoryService_ListLowStockProducts_Call struct {
+	*mock.Call
+}
+
+// ListLowStockProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListLowStockProductsRequest
+func (_e *InventoryService_Expecter) ListLowStockProducts(ctx interface{}, req interface{}) *InventoryService_ListLowStockProducts_Call {
+	return &InventoryService_ListLowStockProducts_Call{Call: _e.mock.On("ListLowStockProducts", ctx, req)}
+}
+
+func (_c *InventoryService_ListLowStockProducts_Call) Run(run func(ctx context.Context, req domain.ListLowStockProductsRequest)) *InventoryService_ListLowStockProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListLowStockProductsRequest))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ListLowStockProducts_Call) Return(_a0 domain.ListLowStockProductsResponse, _a1 error) *InventoryService_ListLowStockProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ListLowStockProducts_Call) RunAndReturn(run func(context.Context, domain.ListLowStockProductsRequest) (domain.ListLowStockProductsResponse, error)) *InventoryService_ListLowStockProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStockLots provides a mock function with given fields: ctx, req
+func (_m *InventoryService) ListStockLots(ctx context.Context, req domain.ListStockLotsRequest) (domain.ListStockLotsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListStockLotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListStockLotsRequest) (domain.ListStockLotsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListStockLotsRequest) domain.ListStockLotsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListStockLotsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListStockLotsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ListStockLots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStockLots'
+type InventoryService_ListStockLots_Call struct {
+	*mock.Call
+}
+
+// ListStockLots is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListStockLotsRequest
+func (_e *InventoryService_Expecter) ListStockLots(ctx interface{}, req interface{}) *InventoryService_ListStockLots_Call {
+	return &InventoryService_ListStockLots_Call{Call: _e.mock.On("ListStockLots", ctx, req)}
+}
+
+func (_c *InventoryService_ListStockLots_Call) Run(run func(ctx context.Context, req domain.ListStockLotsRequest)) *InventoryService_ListStockLots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListStockLotsRequest))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ListStockLots_Call) Return(_a0 domain.ListStockLotsResponse, _a1 error) *InventoryService_ListStockLots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ListStockLots_Call) RunAndReturn(run func(context.Context, domain.ListStockLotsRequest) (domain.ListStockLotsResponse, error)) *InventoryService_ListStockLots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStockMovements provides a mock function with given fields: ctx, req
+func (_m *InventoryService) ListStockMovements(ctx context.Context, req domain.ListStockMovementsRequest) (domain.ListStockMovementsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListStockMovementsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListStockMovementsRequest) (domain.ListStockMovementsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListStockMovementsRequest) domain.ListStockMovementsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListStockMovementsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListStockMovementsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryService_ListStockMovements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStockMovements'
+type InventoryService_ListStockMovements_Call struct {
+	*mock.Call
+}
+
+// ListStockMovements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListStockMovementsRequest
+func (_e *InventoryService_Expecter) ListStockMovements(ctx interface{}, req interface{}) *InventoryService_ListStockMovements_Call {
+	return &InventoryService_ListStockMovements_Call{Call: _e.mock.On("ListStockMovements", ctx, req)}
+}
+
+func (_c *InventoryService_ListStockMovements_Call) Run(run func(ctx context.Context, req domain.ListStockMovementsRequest)) *InventoryService_ListStockMovements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListStockMovementsRequest))
+	})
+	return _c
+}
+
+func (_c *InventoryService_ListStockMovements_Call) Return(_a0 domain.ListStockMovementsResponse, _a1 error) *InventoryService_ListStockMovements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryService_ListStockMovements_Call) RunAndReturn(run func(context.Context, domain.ListStockMovementsRequest) (domain.ListStockMovementsResponse, error)) *InventoryService_ListStockMovements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInventoryService creates a new instance of InventoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInventoryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InventoryService {
+	mock := &InventoryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,134 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MarkdownController is an autogenerated mock type for the MarkdownController type
+type MarkdownController struct {
+	mock.Mock
+}
+
+type MarkdownController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MarkdownController) EXPECT() *MarkdownController_Expecter {
+	return &MarkdownController_Expecter{mock: &_m.Mock}
+}
+
+// CreateMarkdownRule provides a mock function with given fields: c
+func (_m *MarkdownController) CreateMarkdownRule(c *gin.Context) {
+	_m.Called(c)
+}
+
+// MarkdownController_CreateMarkdownRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMarkdownRule'
+type MarkdownController_CreateMarkdownRule_Call struct {
+	*mock.Call
+}
+
+// CreateMarkdownRule is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MarkdownController_Expecter) CreateMarkdownRule(c interface{}) *MarkdownController_CreateMarkdownRule_Call {
+	return &MarkdownController_CreateMarkdownRule_Call{Call: _e.mock.On("CreateMarkdownRule", c)}
+}
+
+func (_c *MarkdownController_CreateMarkdownRule_Call) Run(run func(c *gin.Context)) *MarkdownController_CreateMarkdownRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *MarkdownController_CreateMarkdownRule_Call) Return() *MarkdownController_CreateMarkdownRule_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MarkdownController_CreateMarkdownRule_Call) RunAndReturn(run func(*gin.Context)) *MarkdownController_CreateMarkdownRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMarkdownRule provides a mock function with given fields: c
+func (_m *MarkdownController) DeleteMarkdownRule(c *gin.Context) {
+	_m.Called(c)
+}
+
+// MarkdownController_DeleteMarkdownRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMarkdownRule'
+type MarkdownController_DeleteMarkdownRule_Call struct {
+	*mock.Call
+}
+
+// DeleteMarkdownRule is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MarkdownController_Expecter) DeleteMarkdownRule(c interface{}) *MarkdownController_DeleteMarkdownRule_Call {
+	return &MarkdownController_DeleteMarkdownRule_Call{Call: _e.mock.On("DeleteMarkdownRule", c)}
+}
+
+func (_c *MarkdownController_DeleteMarkdownRule_Call) Run(run func(c *gin.Context)) *MarkdownController_DeleteMarkdownRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *MarkdownController_DeleteMarkdownRule_Call) Return() *MarkdownController_DeleteMarkdownRule_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MarkdownController_DeleteMarkdownRule_Call) RunAndReturn(run func(*gin.Context)) *MarkdownController_DeleteMarkdownRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMarkdownRules provides a mock function with given fields: c
+func (_m *MarkdownController) ListMarkdownRules(c *gin.Context) {
+	_m.Called(c)
+}
+
+// MarkdownController_ListMarkdownRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMarkdownRules'
+type MarkdownController_ListMarkdownRules_Call struct {
+	*mock.Call
+}
+
+// ListMarkdownRules is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *MarkdownController_Expecter) ListMarkdownRules(c interface{}) *MarkdownController_ListMarkdownRules_Call {
+	return &MarkdownController_ListMarkdownRules_Call{Call: _e.mock.On("ListMarkdownRules", c)}
+}
+
+func (_c *MarkdownController_ListMarkdownRules_Call) Run(run func(c *gin.Context)) *MarkdownController_ListMarkdownRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *MarkdownController_ListMarkdownRules_Call) Return() *MarkdownController_ListMarkdownRules_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MarkdownController_ListMarkdownRules_Call) RunAndReturn(run func(*gin.Context)) *MarkdownController_ListMarkdownRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMarkdownController creates a new instance of MarkdownController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarkdownController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarkdownController {
+	mock := &MarkdownController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
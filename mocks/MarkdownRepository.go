@@ -0,0 +1,351 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "payhere/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MarkdownRepository is an autogenerated mock type for the MarkdownRepository type
+type MarkdownRepository struct {
+	mock.Mock
+}
+
+type MarkdownRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MarkdownRepository) EXPECT() *MarkdownRepository_Expecter {
+	return &MarkdownRepository_Expecter{mock: &_m.Mock}
+}
+
+// ApplyMarkdown provides a mock function with given fields: ctx, change
+func (_m *MarkdownRepository) ApplyMarkdown(ctx context.Context, change domain.MarkdownChange) (bool, error) {
+	ret := _m.Called(ctx, change)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MarkdownChange) (bool, error)); ok {
+		return rf(ctx, change)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MarkdownChange) bool); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MarkdownChange) error); ok {
+		r1 = rf(ctx, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkdownRepository_ApplyMarkdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyMarkdown'
+type MarkdownRepository_ApplyMarkdown_Call struct {
+	*mock.Call
+}
+
+// ApplyMarkdown is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change domain.MarkdownChange
+func (_e *MarkdownRepository_Expecter) ApplyMarkdown(ctx interface{}, change interface{}) *MarkdownRepository_ApplyMarkdown_Call {
+	return &MarkdownRepository_ApplyMarkdown_Call{Call: _e.mock.On("ApplyMarkdown", ctx, change)}
+}
+
+func (_c *MarkdownRepository_ApplyMarkdown_Call) Run(run func(ctx context.Context, change domain.MarkdownChange)) *MarkdownRepository_ApplyMarkdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MarkdownChange))
+	})
+	return _c
+}
+
+func (_c *MarkdownRepository_ApplyMarkdown_Call) Return(_a0 bool, _a1 error) *MarkdownRepository_ApplyMarkdown_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarkdownRepository_ApplyMarkdown_Call) RunAndReturn(run func(context.Context, domain.MarkdownChange) (bool, error)) *MarkdownRepository_ApplyMarkdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMarkdownRule provides a mock function with given fields: ctx, rule
+func (_m *MarkdownRepository) CreateMarkdownRule(ctx context.Context, rule domain.MarkdownRule) (int, error) {
+	ret := _m.Called(ctx, rule)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MarkdownRule) (int, error)); ok {
+		return rf(ctx, rule)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.MarkdownRule) int); ok {
+		r0 = rf(ctx, rule)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.MarkdownRule) error); ok {
+		r1 = rf(ctx, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkdownRepository_CreateMarkdownRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMarkdownRule'
+type MarkdownRepository_CreateMarkdownRule_Call struct {
+	*mock.Call
+}
+
+// CreateMarkdownRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rule domain.MarkdownRule
+func (_e *MarkdownRepository_Expecter) CreateMarkdownRule(ctx interface{}, rule interface{}) *MarkdownRepository_CreateMarkdownRule_Call {
+	return &MarkdownRepository_CreateMarkdownRule_Call{Call: _e.mock.On("CreateMarkdownRule", ctx, rule)}
+}
+
+func (_c *MarkdownRepository_CreateMarkdownRule_Call) Run(run func(ctx context.Context, rule domain.MarkdownRule)) *MarkdownRepository_CreateMarkdownRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.MarkdownRule))
+	})
+	return _c
+}
+
+func (_c *MarkdownRepository_CreateMarkdownRule_Call) Return(_a0 int, _a1 error) *MarkdownRepository_CreateMarkdownRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarkdownRepository_CreateMarkdownRule_Call) RunAndReturn(run func(context.Context, domain.MarkdownRule) (int, error)) *MarkdownRepository_CreateMarkdownRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMarkdownRule provides a mock function with given fields: ctx, ruleID
+func (_m *MarkdownRepository) DeleteMarkdownRule(ctx context.Context, ruleID int) error {
+	ret := _m.Called(ctx, ruleID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, ruleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkdownRepository_DeleteMarkdownRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMarkdownRule'
+type MarkdownRepository_DeleteMarkdownRule_Call struct {
+	*mock.Call
+}
+
+// DeleteMarkdownRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleID int
+func (_e *MarkdownRepository_Expecter) DeleteMarkdownRule(ctx interface{}, ruleID interface{}) *MarkdownRepository_DeleteMarkdownRule_Call {
+	return &MarkdownRepository_DeleteMarkdownRule_Call{Call: _e.mock.On("DeleteMarkdownRule", ctx, ruleID)}
+}
+
+func (_c *MarkdownRepository_DeleteMarkdownRule_Call) Run(run func(ctx context.Context, ruleID int)) *MarkdownRepository_DeleteMarkdownRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MarkdownRepository_DeleteMarkdownRule_Call) Return(_a0 error) *MarkdownRepository_DeleteMarkdownRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarkdownRepository_DeleteMarkdownRule_Call) RunAndReturn(run func(context.Context, int) error) *MarkdownRepository_DeleteMarkdownRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMarkdownRule provides a mock function with given fields: ctx, ruleID
+func (_m *MarkdownRepository) GetMarkdownRule(ctx context.Context, ruleID int) (*domain.MarkdownRule, error) {
+	ret := _m.Called(ctx, ruleID)
+
+	var r0 *domain.MarkdownRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.MarkdownRule, error)); ok {
+		return rf(ctx, ruleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.MarkdownRule); ok {
+		r0 = rf(ctx, ruleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.MarkdownRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, ruleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkdownRepository_GetMarkdownRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMarkdownRule'
+type MarkdownRepository_GetMarkdownRule_Call struct {
+	*mock.Call
+}
+
+// GetMarkdownRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleID int
+func (_e *MarkdownRepository_Expecter) GetMarkdownRule(ctx interface{}, ruleID interface{}) *MarkdownRepository_GetMarkdownRule_Call {
+	return &MarkdownRepository_GetMarkdownRule_Call{Call: _e.mock.On("GetMarkdownRule", ctx, ruleID)}
+}
+
+func (_c *MarkdownRepository_GetMarkdownRule_Call) Run(run func(ctx context.Context, ruleID int)) *MarkdownRepository_GetMarkdownRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MarkdownRepository_GetMarkdownRule_Call) Return(_a0 *domain.MarkdownRule, _a1 error) *MarkdownRepository_GetMarkdownRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarkdownRepository_GetMarkdownRule_Call) RunAndReturn(run func(context.Context, int) (*domain.MarkdownRule, error)) *MarkdownRepository_GetMarkdownRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMarkdownCandidates provides a mock function with given fields: ctx, params
+func (_m *MarkdownRepository) ListMarkdownCandidates(ctx context.Context, params domain.ListMarkdownCandidatesParams) ([]domain.Product, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListMarkdownCandidatesParams) ([]domain.Product, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListMarkdownCandidatesParams) []domain.Product); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListMarkdownCandidatesParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkdownRepository_ListMarkdownCandidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMarkdownCandidates'
+type MarkdownRepository_ListMarkdownCandidates_Call struct {
+	*mock.Call
+}
+
+// ListMarkdownCandidates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListMarkdownCandidatesParams
+func (_e *MarkdownRepository_Expecter) ListMarkdownCandidates(ctx interface{}, params interface{}) *MarkdownRepository_ListMarkdownCandidates_Call {
+	return &MarkdownRepository_ListMarkdownCandidates_Call{Call: _e.mock.On("ListMarkdownCandidates", ctx, params)}
+}
+
+func (_c *MarkdownRepository_ListMarkdownCandidates_Call) Run(run func(ctx context.Context, params domain.ListMarkdownCandidatesParams)) *MarkdownRepository_ListMarkdownCandidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListMarkdownCandidatesParams))
+	})
+	return _c
+}
+
+func (_c *MarkdownRepository_ListMarkdownCandidates_Call) Return(_a0 []domain.Product, _a1 error) *MarkdownRepository_ListMarkdownCandidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarkdownRepository_ListMarkdownCandidates_Call) RunAndReturn(run func(context.Context, domain.ListMarkdownCandidatesParams) ([]domain.Product, error)) *MarkdownRepository_ListMarkdownCandidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMarkdownRules provides a mock function with given fields: ctx, userID
+func (_m *MarkdownRepository) ListMarkdownRules(ctx context.Context, userID int) ([]domain.MarkdownRule, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.MarkdownRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.MarkdownRule, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.MarkdownRule); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.MarkdownRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkdownRepository_ListMarkdownRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMarkdownRules'
+type MarkdownRepository_ListMarkdownRules_Call struct {
+	*mock.Call
+}
+
+// ListMarkdownRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MarkdownRepository_Expecter) ListMarkdownRules(ctx interface{}, userID interface{}) *MarkdownRepository_ListMarkdownRules_Call {
+	return &MarkdownRepository_ListMarkdownRules_Call{Call: _e.mock.On("ListMarkdownRules", ctx, userID)}
+}
+
+func (_c *MarkdownRepository_ListMarkdownRules_Call) Run(run func(ctx context.Context, userID int)) *MarkdownRepository_ListMarkdownRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MarkdownRepository_ListMarkdownRules_Call) Return(_a0 []domain.MarkdownRule, _a1 error) *MarkdownRepository_ListMarkdownRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarkdownRepository_ListMarkdownRules_Call) RunAndReturn(run func(context.Context, int) ([]domain.MarkdownRule, error)) *MarkdownRepository_ListMarkdownRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMarkdownRepository creates a new instance of MarkdownRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarkdownRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarkdownRepository {
+	mock := &MarkdownRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
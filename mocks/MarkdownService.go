@@ -0,0 +1,176 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "payhere/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MarkdownService is an autogenerated mock type for the MarkdownService type
+type MarkdownService struct {
+	mock.Mock
+}
+
+type MarkdownService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MarkdownService) EXPECT() *MarkdownService_Expecter {
+	return &MarkdownService_Expecter{mock: &_m.Mock}
+}
+
+// CreateMarkdownRule provides a mock function with given fields: ctx, req
+func (_m *MarkdownService) CreateMarkdownRule(ctx context.Context, req domain.CreateMarkdownRuleRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateMarkdownRuleRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkdownService_CreateMarkdownRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMarkdownRule'
+type MarkdownService_CreateMarkdownRule_Call struct {
+	*mock.Call
+}
+
+// CreateMarkdownRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.CreateMarkdownRuleRequest
+func (_e *MarkdownService_Expecter) CreateMarkdownRule(ctx interface{}, req interface{}) *MarkdownService_CreateMarkdownRule_Call {
+	return &MarkdownService_CreateMarkdownRule_Call{Call: _e.mock.On("CreateMarkdownRule", ctx, req)}
+}
+
+func (_c *MarkdownService_CreateMarkdownRule_Call) Run(run func(ctx context.Context, req domain.CreateMarkdownRuleRequest)) *MarkdownService_CreateMarkdownRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CreateMarkdownRuleRequest))
+	})
+	return _c
+}
+
+func (_c *MarkdownService_CreateMarkdownRule_Call) Return(_a0 error) *MarkdownService_CreateMarkdownRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarkdownService_CreateMarkdownRule_Call) RunAndReturn(run func(context.Context, domain.CreateMarkdownRuleRequest) error) *MarkdownService_CreateMarkdownRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMarkdownRule provides a mock function with given fields: ctx, req
+func (_m *MarkdownService) DeleteMarkdownRule(ctx context.Context, req domain.DeleteMarkdownRuleRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeleteMarkdownRuleRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkdownService_DeleteMarkdownRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMarkdownRule'
+type MarkdownService_DeleteMarkdownRule_Call struct {
+	*mock.Call
+}
+
+// DeleteMarkdownRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.DeleteMarkdownRuleRequest
+func (_e *MarkdownService_Expecter) DeleteMarkdownRule(ctx interface{}, req interface{}) *MarkdownService_DeleteMarkdownRule_Call {
+	return &MarkdownService_DeleteMarkdownRule_Call{Call: _e.mock.On("DeleteMarkdownRule", ctx, req)}
+}
+
+func (_c *MarkdownService_DeleteMarkdownRule_Call) Run(run func(ctx context.Context, req domain.DeleteMarkdownRuleRequest)) *MarkdownService_DeleteMarkdownRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DeleteMarkdownRuleRequest))
+	})
+	return _c
+}
+
+func (_c *MarkdownService_DeleteMarkdownRule_Call) Return(_a0 error) *MarkdownService_DeleteMarkdownRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarkdownService_DeleteMarkdownRule_Call) RunAndReturn(run func(context.Context, domain.DeleteMarkdownRuleRequest) error) *MarkdownService_DeleteMarkdownRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMarkdownRules provides a mock function with given fields: ctx, req
+func (_m *MarkdownService) ListMarkdownRules(ctx context.Context, req domain.ListMarkdownRulesRequest) (domain.ListMarkdownRulesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListMarkdownRulesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListMarkdownRulesRequest) (domain.ListMarkdownRulesResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListMarkdownRulesRequest) domain.ListMarkdownRulesResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListMarkdownRulesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListMarkdownRulesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkdownService_ListMarkdownRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMarkdownRules'
+type MarkdownService_ListMarkdownRules_Call struct {
+	*mock.Call
+}
+
+// ListMarkdownRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListMarkdownRulesRequest
+func (_e *MarkdownService_Expecter) ListMarkdownRules(ctx interface{}, req interface{}) *MarkdownService_ListMarkdownRules_Call {
+	return &MarkdownService_ListMarkdownRules_Call{Call: _e.mock.On("ListMarkdownRules", ctx, req)}
+}
+
+func (_c *MarkdownService_ListMarkdownRules_Call) Run(run func(ctx context.Context, req domain.ListMarkdownRulesRequest)) *MarkdownService_ListMarkdownRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListMarkdownRulesRequest))
+	})
+	return _c
+}
+
+func (_c *MarkdownService_ListMarkdownRules_Call) Return(_a0 domain.ListMarkdownRulesResponse, _a1 error) *MarkdownService_ListMarkdownRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarkdownService_ListMarkdownRules_Call) RunAndReturn(run func(context.Context, domain.ListMarkdownRulesRequest) (domain.ListMarkdownRulesResponse, error)) *MarkdownService_ListMarkdownRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMarkdownService creates a new instance of MarkdownService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarkdownService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarkdownService {
+	mock := &MarkdownService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,123 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "payhere/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Notifier is an autogenerated mock type for the Notifier type
+type Notifier struct {
+	mock.Mock
+}
+
+type Notifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Notifier) EXPECT() *Notifier_Expecter {
+	return &Notifier_Expecter{mock: &_m.Mock}
+}
+
+// NotifyExpiryDigest provides a mock function with given fields: ctx, digest
+func (_m *Notifier) NotifyExpiryDigest(ctx context.Context, digest domain.ExpiryDigest) error {
+	ret := _m.Called(ctx, digest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ExpiryDigest) error); ok {
+		r0 = rf(ctx, digest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Notifier_NotifyExpiryDigest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyExpiryDigest'
+type Notifier_NotifyExpiryDigest_Call struct {
+	*mock.Call
+}
+
+// NotifyExpiryDigest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - digest domain.ExpiryDigest
+func (_e *Notifier_Expecter) NotifyExpiryDigest(ctx interface{}, digest interface{}) *Notifier_NotifyExpiryDigest_Call {
+	return &Notifier_NotifyExpiryDigest_Call{Call: _e.mock.On("NotifyExpiryDigest", ctx, digest)}
+}
+
+func (_c *Notifier_NotifyExpiryDigest_Call) Run(run func(ctx context.Context, digest domain.ExpiryDigest)) *Notifier_NotifyExpiryDigest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ExpiryDigest))
+	})
+	return _c
+}
+
+func (_c *Notifier_NotifyExpiryDigest_Call) Return(_a0 error) *Notifier_NotifyExpiryDigest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Notifier_NotifyExpiryDigest_Call) RunAndReturn(run func(context.Context, domain.ExpiryDigest) error) *Notifier_NotifyExpiryDigest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyLowStock provides a mock function with given fields: ctx, alert
+func (_m *Notifier) NotifyLowStock(ctx context.Context, alert domain.LowStockAlert) error {
+	ret := _m.Called(ctx, alert)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LowStockAlert) error); ok {
+		r0 = rf(ctx, alert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Notifier_NotifyLowStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyLowStock'
+type Notifier_NotifyLowStock_Call struct {
+	*mock.Call
+}
+
+// NotifyLowStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alert domain.LowStockAlert
+func (_e *Notifier_Expecter) NotifyLowStock(ctx interface{}, alert interface{}) *Notifier_NotifyLowStock_Call {
+	return &Notifier_NotifyLowStock_Call{Call: _e.mock.On("NotifyLowStock", ctx, alert)}
+}
+
+func (_c *Notifier_NotifyLowStock_Call) Run(run func(ctx context.Context, alert domain.LowStockAlert)) *Notifier_NotifyLowStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.LowStockAlert))
+	})
+	return _c
+}
+
+func (_c *Notifier_NotifyLowStock_Call) Return(_a0 error) *Notifier_NotifyLowStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Notifier_NotifyLowStock_Call) RunAndReturn(run func(context.Context, domain.LowStockAlert) error) *Notifier_NotifyLowStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNotifier creates a new instance of Notifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Notifier {
+	mock := &Notifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -20,6 +20,105 @@ func (_m *ProductController) EXPECT() *ProductController_Expecter {
 	return &ProductController_Expecter{mock: &_m.Mock}
 }
 
+// ApplyProductPatch provides a mock function with given fields: c
+func (_m *ProductController) ApplyProductPatch(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_ApplyProductPatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyProductPatch'
+type ProductController_ApplyProductPatch_Call struct {
+	*mock.Call
+}
+
+// ApplyProductPatch is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) ApplyProductPatch(c interface{}) *ProductController_ApplyProductPatch_Call {
+	return &ProductController_ApplyProductPatch_Call{Call: _e.mock.On("ApplyProductPatch", c)}
+}
+
+func (_c *ProductController_ApplyProductPatch_Call) Run(run func(c *gin.Context)) *ProductController_ApplyProductPatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_ApplyProductPatch_Call) Return() *ProductController_ApplyProductPatch_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_ApplyProductPatch_Call) RunAndReturn(run func(*gin.Context)) *ProductController_ApplyProductPatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchProducts provides a mock function with given fields: c
+func (_m *ProductController) BatchProducts(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_BatchProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchProducts'
+type ProductController_BatchProducts_Call struct {
+	*mock.Call
+}
+
+// BatchProducts is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) BatchProducts(c interface{}) *ProductController_BatchProducts_Call {
+	return &ProductController_BatchProducts_Call{Call: _e.mock.On("BatchProducts", c)}
+}
+
+func (_c *ProductController_BatchProducts_Call) Run(run func(c *gin.Context)) *ProductController_BatchProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_BatchProducts_Call) Return() *ProductController_BatchProducts_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_BatchProducts_Call) RunAndReturn(run func(*gin.Context)) *ProductController_BatchProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelScheduledPriceChange provides a mock function with given fields: c
+func (_m *ProductController) CancelScheduledPriceChange(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_CancelScheduledPriceChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelScheduledPriceChange'
+type ProductController_CancelScheduledPriceChange_Call struct {
+	*mock.Call
+}
+
+// CancelScheduledPriceChange is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) CancelScheduledPriceChange(c interface{}) *ProductController_CancelScheduledPriceChange_Call {
+	return &ProductController_CancelScheduledPriceChange_Call{Call: _e.mock.On("CancelScheduledPriceChange", c)}
+}
+
+func (_c *ProductController_CancelScheduledPriceChange_Call) Run(run func(c *gin.Context)) *ProductController_CancelScheduledPriceChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_CancelScheduledPriceChange_Call) Return() *ProductController_CancelScheduledPriceChange_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_CancelScheduledPriceChange_Call) RunAndReturn(run func(*gin.Context)) *ProductController_CancelScheduledPriceChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateProduct provides a mock function with given fields: c
 func (_m *ProductController) CreateProduct(c *gin.Context) {
 	_m.Called(c)
@@ -53,6 +152,105 @@ func (_c *ProductController_CreateProduct_Call) RunAndReturn(run func(*gin.Conte
 	return _c
 }
 
+// CreateProductLabels provides a mock function with given fields: c
+func (_m *ProductController) CreateProductLabels(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_CreateProductLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProductLabels'
+type ProductController_CreateProductLabels_Call struct {
+	*mock.Call
+}
+
+// CreateProductLabels is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) CreateProductLabels(c interface{}) *ProductController_CreateProductLabels_Call {
+	return &ProductController_CreateProductLabels_Call{Call: _e.mock.On("CreateProductLabels", c)}
+}
+
+func (_c *ProductController_CreateProductLabels_Call) Run(run func(c *gin.Context)) *ProductController_CreateProductLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_CreateProductLabels_Call) Return() *ProductController_CreateProductLabels_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_CreateProductLabels_Call) RunAndReturn(run func(*gin.Context)) *ProductController_CreateProductLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateProductTemplate provides a mock function with given fields: c
+func (_m *ProductController) CreateProductTemplate(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_CreateProductTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProductTemplate'
+type ProductController_CreateProductTemplate_Call struct {
+	*mock.Call
+}
+
+// CreateProductTemplate is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) CreateProductTemplate(c interface{}) *ProductController_CreateProductTemplate_Call {
+	return &ProductController_CreateProductTemplate_Call{Call: _e.mock.On("CreateProductTemplate", c)}
+}
+
+func (_c *ProductController_CreateProductTemplate_Call) Run(run func(c *gin.Context)) *ProductController_CreateProductTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_CreateProductTemplate_Call) Return() *ProductController_CreateProductTemplate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_CreateProductTemplate_Call) RunAndReturn(run func(*gin.Context)) *ProductController_CreateProductTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateScheduledPriceChange provides a mock function with given fields: c
+func (_m *ProductController) CreateScheduledPriceChange(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_CreateScheduledPriceChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateScheduledPriceChange'
+type ProductController_CreateScheduledPriceChange_Call struct {
+	*mock.Call
+}
+
+// CreateScheduledPriceChange is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) CreateScheduledPriceChange(c interface{}) *ProductController_CreateScheduledPriceChange_Call {
+	return &ProductController_CreateScheduledPriceChange_Call{Call: _e.mock.On("CreateScheduledPriceChange", c)}
+}
+
+func (_c *ProductController_CreateScheduledPriceChange_Call) Run(run func(c *gin.Context)) *ProductController_CreateScheduledPriceChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_CreateScheduledPriceChange_Call) Return() *ProductController_CreateScheduledPriceChange_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_CreateScheduledPriceChange_Call) RunAndReturn(run func(*gin.Context)) *ProductController_CreateScheduledPriceChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteProduct provides a mock function with given fields: c
 func (_m *ProductController) DeleteProduct(c *gin.Context) {
 	_m.Called(c)
@@ -86,6 +284,138 @@ func (_c *ProductController_DeleteProduct_Call) RunAndReturn(run func(*gin.Conte
 	return _c
 }
 
+// DeleteProductImage provides a mock function with given fields: c
+func (_m *ProductController) DeleteProductImage(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_DeleteProductImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProductImage'
+type ProductController_DeleteProductImage_Call struct {
+	*mock.Call
+}
+
+// DeleteProductImage is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) DeleteProductImage(c interface{}) *ProductController_DeleteProductImage_Call {
+	return &ProductController_DeleteProductImage_Call{Call: _e.mock.On("DeleteProductImage", c)}
+}
+
+func (_c *ProductController_DeleteProductImage_Call) Run(run func(c *gin.Context)) *ProductController_DeleteProductImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_DeleteProductImage_Call) Return() *ProductController_DeleteProductImage_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_DeleteProductImage_Call) RunAndReturn(run func(*gin.Context)) *ProductController_DeleteProductImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProductTemplate provides a mock function with given fields: c
+func (_m *ProductController) DeleteProductTemplate(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_DeleteProductTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProductTemplate'
+type ProductController_DeleteProductTemplate_Call struct {
+	*mock.Call
+}
+
+// DeleteProductTemplate is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) DeleteProductTemplate(c interface{}) *ProductController_DeleteProductTemplate_Call {
+	return &ProductController_DeleteProductTemplate_Call{Call: _e.mock.On("DeleteProductTemplate", c)}
+}
+
+func (_c *ProductController_DeleteProductTemplate_Call) Run(run func(c *gin.Context)) *ProductController_DeleteProductTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_DeleteProductTemplate_Call) Return() *ProductController_DeleteProductTemplate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_DeleteProductTemplate_Call) RunAndReturn(run func(*gin.Context)) *ProductController_DeleteProductTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DuplicateProduct provides a mock function with given fields: c
+func (_m *ProductController) DuplicateProduct(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_DuplicateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DuplicateProduct'
+type ProductController_DuplicateProduct_Call struct {
+	*mock.Call
+}
+
+// DuplicateProduct is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) DuplicateProduct(c interface{}) *ProductController_DuplicateProduct_Call {
+	return &ProductController_DuplicateProduct_Call{Call: _e.mock.On("DuplicateProduct", c)}
+}
+
+func (_c *ProductController_DuplicateProduct_Call) Run(run func(c *gin.Context)) *ProductController_DuplicateProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_DuplicateProduct_Call) Return() *ProductController_DuplicateProduct_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_DuplicateProduct_Call) RunAndReturn(run func(*gin.Context)) *ProductController_DuplicateProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportProducts provides a mock function with given fields: c
+func (_m *ProductController) ExportProducts(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_ExportProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportProducts'
+type ProductController_ExportProducts_Call struct {
+	*mock.Call
+}
+
+// ExportProducts is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) ExportProducts(c interface{}) *ProductController_ExportProducts_Call {
+	return &ProductController_ExportProducts_Call{Call: _e.mock.On("ExportProducts", c)}
+}
+
+func (_c *ProductController_ExportProducts_Call) Run(run func(c *gin.Context)) *ProductController_ExportProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_ExportProducts_Call) Return() *ProductController_ExportProducts_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_ExportProducts_Call) RunAndReturn(run func(*gin.Context)) *ProductController_ExportProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProduct provides a mock function with given fields: c
 func (_m *ProductController) GetProduct(c *gin.Context) {
 	_m.Called(c)
@@ -119,68 +449,563 @@ func (_c *ProductController_GetProduct_Call) RunAndReturn(run func(*gin.Context)
 	return _c
 }
 
-// ListProducts provides a mock function with given fields: c
-func (_m *ProductController) ListProducts(c *gin.Context) {
+// GetProductBarcodeImage provides a mock function with given fields: c
+func (_m *ProductController) GetProductBarcodeImage(c *gin.Context) {
 	_m.Called(c)
 }
 
-// ProductController_ListProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProducts'
-type ProductController_ListProducts_Call struct {
+// ProductController_GetProductBarcodeImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductBarcodeImage'
+type ProductController_GetProductBarcodeImage_Call struct {
 	*mock.Call
 }
 
-// ListProducts is a helper method to define mock.On call
+// GetProductBarcodeImage is a helper method to define mock.On call
 //   - c *gin.Context
-func (_e *ProductController_Expecter) ListProducts(c interface{}) *ProductController_ListProducts_Call {
-	return &ProductController_ListProducts_Call{Call: _e.mock.On("ListProducts", c)}
+func (_e *ProductController_Expecter) GetProductBarcodeImage(c interface{}) *ProductController_GetProductBarcodeImage_Call {
+	return &ProductController_GetProductBarcodeImage_Call{Call: _e.mock.On("GetProductBarcodeImage", c)}
 }
 
-func (_c *ProductController_ListProducts_Call) Run(run func(c *gin.Context)) *ProductController_ListProducts_Call {
+func (_c *ProductController_GetProductBarcodeImage_Call) Run(run func(c *gin.Context)) *ProductController_GetProductBarcodeImage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(*gin.Context))
 	})
 	return _c
 }
 
-func (_c *ProductController_ListProducts_Call) Return() *ProductController_ListProducts_Call {
+func (_c *ProductController_GetProductBarcodeImage_Call) Return() *ProductController_GetProductBarcodeImage_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *ProductController_ListProducts_Call) RunAndReturn(run func(*gin.Context)) *ProductController_ListProducts_Call {
+func (_c *ProductController_GetProductBarcodeImage_Call) RunAndReturn(run func(*gin.Context)) *ProductController_GetProductBarcodeImage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PatchProduct provides a mock function with given fields: c
-func (_m *ProductController) PatchProduct(c *gin.Context) {
+// GetProductByBarcode provides a mock function with given fields: c
+func (_m *ProductController) GetProductByBarcode(c *gin.Context) {
 	_m.Called(c)
 }
 
-// ProductController_PatchProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchProduct'
-type ProductController_PatchProduct_Call struct {
+// ProductController_GetProductByBarcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductByBarcode'
+type ProductController_GetProductByBarcode_Call struct {
 	*mock.Call
 }
 
-// PatchProduct is a helper method to define mock.On call
+// GetProductByBarcode is a helper method to define mock.On call
 //   - c *gin.Context
-func (_e *ProductController_Expecter) PatchProduct(c interface{}) *ProductController_PatchProduct_Call {
-	return &ProductController_PatchProduct_Call{Call: _e.mock.On("PatchProduct", c)}
+func (_e *ProductController_Expecter) GetProductByBarcode(c interface{}) *ProductController_GetProductByBarcode_Call {
+	return &ProductController_GetProductByBarcode_Call{Call: _e.mock.On("GetProductByBarcode", c)}
 }
 
-func (_c *ProductController_PatchProduct_Call) Run(run func(c *gin.Context)) *ProductController_PatchProduct_Call {
+func (_c *ProductController_GetProductByBarcode_Call) Run(run func(c *gin.Context)) *ProductController_GetProductByBarcode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(*gin.Context))
 	})
 	return _c
 }
 
-func (_c *ProductController_PatchProduct_Call) Return() *ProductController_PatchProduct_Call {
+func (_c *ProductController_GetProductByBarcode_Call) Return() *ProductController_GetProductByBarcode_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *ProductController_PatchProduct_Call) RunAndReturn(run func(*gin.Context)) *ProductController_PatchProduct_Call {
+func (_c *ProductController_GetProductByBarcode_Call) RunAndReturn(run func(*gin.Context)) *ProductController_GetProductByBarcode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductPriceHistory provides a mock function with given fields: c
+func (_m *ProductController) GetProductPriceHistory(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_GetProductPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductPriceHistory'
+type ProductController_GetProductPriceHistory_Call struct {
+	*mock.Call
+}
+
+// GetProductPriceHistory is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) GetProductPriceHistory(c interface{}) *ProductController_GetProductPriceHistory_Call {
+	return &ProductController_GetProductPriceHistory_Call{Call: _e.mock.On("GetProductPriceHistory", c)}
+}
+
+func (_c *ProductController_GetProductPriceHistory_Call) Run(run func(c *gin.Context)) *ProductController_GetProductPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_GetProductPriceHistory_Call) Return() *ProductController_GetProductPriceHistory_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_GetProductPriceHistory_Call) RunAndReturn(run func(*gin.Context)) *ProductController_GetProductPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductQRCode provides a mock function with given fields: c
+func (_m *ProductController) GetProductQRCode(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_GetProductQRCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductQRCode'
+type ProductController_GetProductQRCode_Call struct {
+	*mock.Call
+}
+
+// GetProductQRCode is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) GetProductQRCode(c interface{}) *ProductController_GetProductQRCode_Call {
+	return &ProductController_GetProductQRCode_Call{Call: _e.mock.On("GetProductQRCode", c)}
+}
+
+func (_c *ProductController_GetProductQRCode_Call) Run(run func(c *gin.Context)) *ProductController_GetProductQRCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_GetProductQRCode_Call) Return() *ProductController_GetProductQRCode_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_GetProductQRCode_Call) RunAndReturn(run func(*gin.Context)) *ProductController_GetProductQRCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportProducts provides a mock function with given fields: c
+func (_m *ProductController) ImportProducts(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_ImportProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportProducts'
+type ProductController_ImportProducts_Call struct {
+	*mock.Call
+}
+
+// ImportProducts is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) ImportProducts(c interface{}) *ProductController_ImportProducts_Call {
+	return &ProductController_ImportProducts_Call{Call: _e.mock.On("ImportProducts", c)}
+}
+
+func (_c *ProductController_ImportProducts_Call) Run(run func(c *gin.Context)) *ProductController_ImportProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_ImportProducts_Call) Return() *ProductController_ImportProducts_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_ImportProducts_Call) RunAndReturn(run func(*gin.Context)) *ProductController_ImportProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExpiringProducts provides a mock function with given fields: c
+func (_m *ProductController) ListExpiringProducts(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_ListExpiringProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExpiringProducts'
+type ProductController_ListExpiringProducts_Call struct {
+	*mock.Call
+}
+
+// ListExpiringProducts is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) ListExpiringProducts(c interface{}) *ProductController_ListExpiringProducts_Call {
+	return &ProductController_ListExpiringProducts_Call{Call: _e.mock.On("ListExpiringProducts", c)}
+}
+
+func (_c *ProductController_ListExpiringProducts_Call) Run(run func(c *gin.Context)) *ProductController_ListExpiringProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_ListExpiringProducts_Call) Return() *ProductController_ListExpiringProducts_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_ListExpiringProducts_Call) RunAndReturn(run func(*gin.Context)) *ProductController_ListExpiringProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductTemplates provides a mock function with given fields: c
+func (_m *ProductController) ListProductTemplates(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_ListProductTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductTemplates'
+type ProductController_ListProductTemplates_Call struct {
+	*mock.Call
+}
+
+// ListProductTemplates is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) ListProductTemplates(c interface{}) *ProductController_ListProductTemplates_Call {
+	return &ProductController_ListProductTemplates_Call{Call: _e.mock.On("ListProductTemplates", c)}
+}
+
+func (_c *ProductController_ListProductTemplates_Call) Run(run func(c *gin.Context)) *ProductController_ListProductTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_ListProductTemplates_Call) Return() *ProductController_ListProductTemplates_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_ListProductTemplates_Call) RunAndReturn(run func(*gin.Context)) *ProductController_ListProductTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProducts provides a mock function with given fields: c
+func (_m *ProductController) ListProducts(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_ListProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProducts'
+type ProductController_ListProducts_Call struct {
+	*mock.Call
+}
+
+// ListProducts is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) ListProducts(c interface{}) *ProductController_ListProducts_Call {
+	return &ProductController_ListProducts_Call{Call: _e.mock.On("ListProducts", c)}
+}
+
+func (_c *ProductController_ListProducts_Call) Run(run func(c *gin.Context)) *ProductController_ListProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_ListProducts_Call) Return() *ProductController_ListProducts_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_ListProducts_Call) RunAndReturn(run func(*gin.Context)) *ProductController_ListProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduledPriceChanges provides a mock function with given fields: c
+func (_m *ProductController) ListScheduledPriceChanges(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_ListScheduledPriceChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledPriceChanges'
+type ProductController_ListScheduledPriceChanges_Call struct {
+	*mock.Call
+}
+
+// ListScheduledPriceChanges is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) ListScheduledPriceChanges(c interface{}) *ProductController_ListScheduledPriceChanges_Call {
+	return &ProductController_ListScheduledPriceChanges_Call{Call: _e.mock.On("ListScheduledPriceChanges", c)}
+}
+
+func (_c *ProductController_ListScheduledPriceChanges_Call) Run(run func(c *gin.Context)) *ProductController_ListScheduledPriceChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_ListScheduledPriceChanges_Call) Return() *ProductController_ListScheduledPriceChanges_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_ListScheduledPriceChanges_Call) RunAndReturn(run func(*gin.Context)) *ProductController_ListScheduledPriceChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTrashProducts provides a mock function with given fields: c
+func (_m *ProductController) ListTrashProducts(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_ListTrashProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrashProducts'
+type ProductController_ListTrashProducts_Call struct {
+	*mock.Call
+}
+
+// ListTrashProducts is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) ListTrashProducts(c interface{}) *ProductController_ListTrashProducts_Call {
+	return &ProductController_ListTrashProducts_Call{Call: _e.mock.On("ListTrashProducts", c)}
+}
+
+func (_c *ProductController_ListTrashProducts_Call) Run(run func(c *gin.Context)) *ProductController_ListTrashProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_ListTrashProducts_Call) Return() *ProductController_ListTrashProducts_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_ListTrashProducts_Call) RunAndReturn(run func(*gin.Context)) *ProductController_ListTrashProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchProduct provides a mock function with given fields: c
+func (_m *ProductController) PatchProduct(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_PatchProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchProduct'
+type ProductController_PatchProduct_Call struct {
+	*mock.Call
+}
+
+// PatchProduct is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) PatchProduct(c interface{}) *ProductController_PatchProduct_Call {
+	return &ProductController_PatchProduct_Call{Call: _e.mock.On("PatchProduct", c)}
+}
+
+func (_c *ProductController_PatchProduct_Call) Run(run func(c *gin.Context)) *ProductController_PatchProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_PatchProduct_Call) Return() *ProductController_PatchProduct_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_PatchProduct_Call) RunAndReturn(run func(*gin.Context)) *ProductController_PatchProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchProductImages provides a mock function with given fields: c
+func (_m *ProductController) PatchProductImages(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_PatchProductImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchProductImages'
+type ProductController_PatchProductImages_Call struct {
+	*mock.Call
+}
+
+// PatchProductImages is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) PatchProductImages(c interface{}) *ProductController_PatchProductImages_Call {
+	return &ProductController_PatchProductImages_Call{Call: _e.mock.On("PatchProductImages", c)}
+}
+
+func (_c *ProductController_PatchProductImages_Call) Run(run func(c *gin.Context)) *ProductController_PatchProductImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_PatchProductImages_Call) Return() *ProductController_PatchProductImages_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_PatchProductImages_Call) RunAndReturn(run func(*gin.Context)) *ProductController_PatchProductImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeProduct provides a mock function with given fields: c
+func (_m *ProductController) PurgeProduct(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_PurgeProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeProduct'
+type ProductController_PurgeProduct_Call struct {
+	*mock.Call
+}
+
+// PurgeProduct is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) PurgeProduct(c interface{}) *ProductController_PurgeProduct_Call {
+	return &ProductController_PurgeProduct_Call{Call: _e.mock.On("PurgeProduct", c)}
+}
+
+func (_c *ProductController_PurgeProduct_Call) Run(run func(c *gin.Context)) *ProductController_PurgeProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_PurgeProduct_Call) Return() *ProductController_PurgeProduct_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_PurgeProduct_Call) RunAndReturn(run func(*gin.Context)) *ProductController_PurgeProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceProduct provides a mock function with given fields: c
+func (_m *ProductController) ReplaceProduct(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_ReplaceProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceProduct'
+type ProductController_ReplaceProduct_Call struct {
+	*mock.Call
+}
+
+// ReplaceProduct is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) ReplaceProduct(c interface{}) *ProductController_ReplaceProduct_Call {
+	return &ProductController_ReplaceProduct_Call{Call: _e.mock.On("ReplaceProduct", c)}
+}
+
+func (_c *ProductController_ReplaceProduct_Call) Run(run func(c *gin.Context)) *ProductController_ReplaceProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_ReplaceProduct_Call) Return() *ProductController_ReplaceProduct_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_ReplaceProduct_Call) RunAndReturn(run func(*gin.Context)) *ProductController_ReplaceProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreProduct provides a mock function with given fields: c
+func (_m *ProductController) RestoreProduct(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_RestoreProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreProduct'
+type ProductController_RestoreProduct_Call struct {
+	*mock.Call
+}
+
+// RestoreProduct is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) RestoreProduct(c interface{}) *ProductController_RestoreProduct_Call {
+	return &ProductController_RestoreProduct_Call{Call: _e.mock.On("RestoreProduct", c)}
+}
+
+func (_c *ProductController_RestoreProduct_Call) Run(run func(c *gin.Context)) *ProductController_RestoreProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_RestoreProduct_Call) Return() *ProductController_RestoreProduct_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_RestoreProduct_Call) RunAndReturn(run func(*gin.Context)) *ProductController_RestoreProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SuggestProducts provides a mock function with given fields: c
+func (_m *ProductController) SuggestProducts(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_SuggestProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuggestProducts'
+type ProductController_SuggestProducts_Call struct {
+	*mock.Call
+}
+
+// SuggestProducts is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) SuggestProducts(c interface{}) *ProductController_SuggestProducts_Call {
+	return &ProductController_SuggestProducts_Call{Call: _e.mock.On("SuggestProducts", c)}
+}
+
+func (_c *ProductController_SuggestProducts_Call) Run(run func(c *gin.Context)) *ProductController_SuggestProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_SuggestProducts_Call) Return() *ProductController_SuggestProducts_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_SuggestProducts_Call) RunAndReturn(run func(*gin.Context)) *ProductController_SuggestProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadProductImage provides a mock function with given fields: c
+func (_m *ProductController) UploadProductImage(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ProductController_UploadProductImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadProductImage'
+type ProductController_UploadProductImage_Call struct {
+	*mock.Call
+}
+
+// UploadProductImage is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ProductController_Expecter) UploadProductImage(c interface{}) *ProductController_UploadProductImage_Call {
+	return &ProductController_UploadProductImage_Call{Call: _e.mock.On("UploadProductImage", c)}
+}
+
+func (_c *ProductController_UploadProductImage_Call) Run(run func(c *gin.Context)) *ProductController_UploadProductImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ProductController_UploadProductImage_Call) Return() *ProductController_UploadProductImage_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ProductController_UploadProductImage_Call) RunAndReturn(run func(*gin.Context)) *ProductController_UploadProductImage_Call {
 	_c.Call.Return(run)
 	return _c
 }
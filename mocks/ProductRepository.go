@@ -7,6 +7,8 @@ import (
 	domain "payhere/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // ProductRepository is an autogenerated mock type for the ProductRepository type
@@ -22,6 +24,211 @@ func (_m *ProductRepository) EXPECT() *ProductRepository_Expecter {
 	return &ProductRepository_Expecter{mock: &_m.Mock}
 }
 
+// CancelScheduledPriceChange provides a mock function with given fields: ctx, scheduleID, now
+func (_m *ProductRepository) CancelScheduledPriceChange(ctx context.Context, scheduleID int, now time.Time) (bool, error) {
+	ret := _m.Called(ctx, scheduleID, now)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (bool, error)); ok {
+		return rf(ctx, scheduleID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) bool); ok {
+		r0 = rf(ctx, scheduleID, now)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, scheduleID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_CancelScheduledPriceChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelScheduledPriceChange'
+type ProductRepository_CancelScheduledPriceChange_Call struct {
+	*mock.Call
+}
+
+// CancelScheduledPriceChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleID int
+//   - now time.Time
+func (_e *ProductRepository_Expecter) CancelScheduledPriceChange(ctx interface{}, scheduleID interface{}, now interface{}) *ProductRepository_CancelScheduledPriceChange_Call {
+	return &ProductRepository_CancelScheduledPriceChange_Call{Call: _e.mock.On("CancelScheduledPriceChange", ctx, scheduleID, now)}
+}
+
+func (_c *ProductRepository_CancelScheduledPriceChange_Call) Run(run func(ctx context.Context, scheduleID int, now time.Time)) *ProductRepository_CancelScheduledPriceChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_CancelScheduledPriceChange_Call) Return(_a0 bool, _a1 error) *ProductRepository_CancelScheduledPriceChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_CancelScheduledPriceChange_Call) RunAndReturn(run func(context.Context, int, time.Time) (bool, error)) *ProductRepository_CancelScheduledPriceChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimExpiryDigest provides a mock function with given fields: ctx, userID, date
+func (_m *ProductRepository) ClaimExpiryDigest(ctx context.Context, userID int, date string) (bool, error) {
+	ret := _m.Called(ctx, userID, date)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (bool, error)); ok {
+		return rf(ctx, userID, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) bool); ok {
+		r0 = rf(ctx, userID, date)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, userID, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ClaimExpiryDigest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimExpiryDigest'
+type ProductRepository_ClaimExpiryDigest_Call struct {
+	*mock.Call
+}
+
+// ClaimExpiryDigest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - date string
+func (_e *ProductRepository_Expecter) ClaimExpiryDigest(ctx interface{}, userID interface{}, date interface{}) *ProductRepository_ClaimExpiryDigest_Call {
+	return &ProductRepository_ClaimExpiryDigest_Call{Call: _e.mock.On("ClaimExpiryDigest", ctx, userID, date)}
+}
+
+func (_c *ProductRepository_ClaimExpiryDigest_Call) Run(run func(ctx context.Context, userID int, date string)) *ProductRepository_ClaimExpiryDigest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ClaimExpiryDigest_Call) Return(_a0 bool, _a1 error) *ProductRepository_ClaimExpiryDigest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ClaimExpiryDigest_Call) RunAndReturn(run func(context.Context, int, string) (bool, error)) *ProductRepository_ClaimExpiryDigest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimScheduledPriceChange provides a mock function with given fields: ctx, scheduleID, now
+func (_m *ProductRepository) ClaimScheduledPriceChange(ctx context.Context, scheduleID int, now time.Time) (bool, error) {
+	ret := _m.Called(ctx, scheduleID, now)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) (bool, error)); ok {
+		return rf(ctx, scheduleID, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) bool); ok {
+		r0 = rf(ctx, scheduleID, now)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, scheduleID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ClaimScheduledPriceChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimScheduledPriceChange'
+type ProductRepository_ClaimScheduledPriceChange_Call struct {
+	*mock.Call
+}
+
+// ClaimScheduledPriceChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleID int
+//   - now time.Time
+func (_e *ProductRepository_Expecter) ClaimScheduledPriceChange(ctx interface{}, scheduleID interface{}, now interface{}) *ProductRepository_ClaimScheduledPriceChange_Call {
+	return &ProductRepository_ClaimScheduledPriceChange_Call{Call: _e.mock.On("ClaimScheduledPriceChange", ctx, scheduleID, now)}
+}
+
+func (_c *ProductRepository_ClaimScheduledPriceChange_Call) Run(run func(ctx context.Context, scheduleID int, now time.Time)) *ProductRepository_ClaimScheduledPriceChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ClaimScheduledPriceChange_Call) Return(_a0 bool, _a1 error) *ProductRepository_ClaimScheduledPriceChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ClaimScheduledPriceChange_Call) RunAndReturn(run func(context.Context, int, time.Time) (bool, error)) *ProductRepository_ClaimScheduledPriceChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePriceHistory provides a mock function with given fields: ctx, history
+func (_m *ProductRepository) CreatePriceHistory(ctx context.Context, history domain.PriceHistory) error {
+	ret := _m.Called(ctx, history)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PriceHistory) error); ok {
+		r0 = rf(ctx, history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductRepository_CreatePriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePriceHistory'
+type ProductRepository_CreatePriceHistory_Call struct {
+	*mock.Call
+}
+
+// CreatePriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - history domain.PriceHistory
+func (_e *ProductRepository_Expecter) CreatePriceHistory(ctx interface{}, history interface{}) *ProductRepository_CreatePriceHistory_Call {
+	return &ProductRepository_CreatePriceHistory_Call{Call: _e.mock.On("CreatePriceHistory", ctx, history)}
+}
+
+func (_c *ProductRepository_CreatePriceHistory_Call) Run(run func(ctx context.Context, history domain.PriceHistory)) *ProductRepository_CreatePriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PriceHistory))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_CreatePriceHistory_Call) Return(_a0 error) *ProductRepository_CreatePriceHistory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductRepository_CreatePriceHistory_Call) RunAndReturn(run func(context.Context, domain.PriceHistory) error) *ProductRepository_CreatePriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateProduct provides a mock function with given fields: ctx, product
 func (_m *ProductRepository) CreateProduct(ctx context.Context, product domain.Product) (int, error) {
 	ret := _m.Called(ctx, product)
@@ -75,68 +282,76 @@ func (_c *ProductRepository_CreateProduct_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
-// DeleteProduct provides a mock function with given fields: ctx, productID
-func (_m *ProductRepository) DeleteProduct(ctx context.Context, productID int) error {
-	ret := _m.Called(ctx, productID)
+// CreateProductImage provides a mock function with given fields: ctx, image
+func (_m *ProductRepository) CreateProductImage(ctx context.Context, image domain.ProductImage) (domain.ProductImage, error) {
+	ret := _m.Called(ctx, image)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
-		r0 = rf(ctx, productID)
+	var r0 domain.ProductImage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProductImage) (domain.ProductImage, error)); ok {
+		return rf(ctx, image)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProductImage) domain.ProductImage); ok {
+		r0 = rf(ctx, image)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(domain.ProductImage)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProductImage) error); ok {
+		r1 = rf(ctx, image)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ProductRepository_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
-type ProductRepository_DeleteProduct_Call struct {
+// ProductRepository_CreateProductImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProductImage'
+type ProductRepository_CreateProductImage_Call struct {
 	*mock.Call
 }
 
-// DeleteProduct is a helper method to define mock.On call
+// CreateProductImage is a helper method to define mock.On call
 //   - ctx context.Context
-//   - productID int
-func (_e *ProductRepository_Expecter) DeleteProduct(ctx interface{}, productID interface{}) *ProductRepository_DeleteProduct_Call {
-	return &ProductRepository_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, productID)}
+//   - image domain.ProductImage
+func (_e *ProductRepository_Expecter) CreateProductImage(ctx interface{}, image interface{}) *ProductRepository_CreateProductImage_Call {
+	return &ProductRepository_CreateProductImage_Call{Call: _e.mock.On("CreateProductImage", ctx, image)}
 }
 
-func (_c *ProductRepository_DeleteProduct_Call) Run(run func(ctx context.Context, productID int)) *ProductRepository_DeleteProduct_Call {
+func (_c *ProductRepository_CreateProductImage_Call) Run(run func(ctx context.Context, image domain.ProductImage)) *ProductRepository_CreateProductImage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(domain.ProductImage))
 	})
 	return _c
 }
 
-func (_c *ProductRepository_DeleteProduct_Call) Return(_a0 error) *ProductRepository_DeleteProduct_Call {
-	_c.Call.Return(_a0)
+func (_c *ProductRepository_CreateProductImage_Call) Return(_a0 domain.ProductImage, _a1 error) *ProductRepository_CreateProductImage_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ProductRepository_DeleteProduct_Call) RunAndReturn(run func(context.Context, int) error) *ProductRepository_DeleteProduct_Call {
+func (_c *ProductRepository_CreateProductImage_Call) RunAndReturn(run func(context.Context, domain.ProductImage) (domain.ProductImage, error)) *ProductRepository_CreateProductImage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProduct provides a mock function with given fields: ctx, productID
-func (_m *ProductRepository) GetProduct(ctx context.Context, productID int) (*domain.Product, error) {
-	ret := _m.Called(ctx, productID)
+// CreateProductTemplate provides a mock function with given fields: ctx, template
+func (_m *ProductRepository) CreateProductTemplate(ctx context.Context, template domain.ProductTemplate) (int, error) {
+	ret := _m.Called(ctx, template)
 
-	var r0 *domain.Product
+	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Product, error)); ok {
-		return rf(ctx, productID)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProductTemplate) (int, error)); ok {
+		return rf(ctx, template)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Product); ok {
-		r0 = rf(ctx, productID)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProductTemplate) int); ok {
+		r0 = rf(ctx, template)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.Product)
-		}
+		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, productID)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ProductTemplate) error); ok {
+		r1 = rf(ctx, template)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -144,54 +359,54 @@ func (_m *ProductRepository) GetProduct(ctx context.Context, productID int) (*do
 	return r0, r1
 }
 
-// ProductRepository_GetProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProduct'
-type ProductRepository_GetProduct_Call struct {
+// ProductRepository_CreateProductTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProductTemplate'
+type ProductRepository_CreateProductTemplate_Call struct {
 	*mock.Call
 }
 
-// GetProduct is a helper method to define mock.On call
+// CreateProductTemplate is a helper method to define mock.On call
 //   - ctx context.Context
-//   - productID int
-func (_e *ProductRepository_Expecter) GetProduct(ctx interface{}, productID interface{}) *ProductRepository_GetProduct_Call {
-	return &ProductRepository_GetProduct_Call{Call: _e.mock.On("GetProduct", ctx, productID)}
+//   - template domain.ProductTemplate
+func (_e *ProductRepository_Expecter) CreateProductTemplate(ctx interface{}, template interface{}) *ProductRepository_CreateProductTemplate_Call {
+	return &ProductRepository_CreateProductTemplate_Call{Call: _e.mock.On("CreateProductTemplate", ctx, template)}
 }
 
-func (_c *ProductRepository_GetProduct_Call) Run(run func(ctx context.Context, productID int)) *ProductRepository_GetProduct_Call {
+func (_c *ProductRepository_CreateProductTemplate_Call) Run(run func(ctx context.Context, template domain.ProductTemplate)) *ProductRepository_CreateProductTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int))
+		run(args[0].(context.Context), args[1].(domain.ProductTemplate))
 	})
 	return _c
 }
 
-func (_c *ProductRepository_GetProduct_Call) Return(_a0 *domain.Product, _a1 error) *ProductRepository_GetProduct_Call {
+func (_c *ProductRepository_CreateProductTemplate_Call) Return(_a0 int, _a1 error) *ProductRepository_CreateProductTemplate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ProductRepository_GetProduct_Call) RunAndReturn(run func(context.Context, int) (*domain.Product, error)) *ProductRepository_GetProduct_Call {
+func (_c *ProductRepository_CreateProductTemplate_Call) RunAndReturn(run func(context.Context, domain.ProductTemplate) (int, error)) *ProductRepository_CreateProductTemplate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListProducts provides a mock function with given fields: ctx, params
-func (_m *ProductRepository) ListProducts(ctx context.Context, params domain.ListProductsParams) ([]domain.Product, error) {
-	ret := _m.Called(ctx, params)
+// CreateProducts provides a mock function with given fields: ctx, products
+func (_m *ProductRepository) CreateProducts(ctx context.Context, products []domain.Product) ([]int, error) {
+	ret := _m.Called(ctx, products)
 
-	var r0 []domain.Product
+	var r0 []int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ListProductsParams) ([]domain.Product, error)); ok {
-		return rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Product) ([]int, error)); ok {
+		return rf(ctx, products)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ListProductsParams) []domain.Product); ok {
-		r0 = rf(ctx, params)
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Product) []int); ok {
+		r0 = rf(ctx, products)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Product)
+			r0 = ret.Get(0).([]int)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.ListProductsParams) error); ok {
-		r1 = rf(ctx, params)
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.Product) error); ok {
+		r1 = rf(ctx, products)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -199,42 +414,95 @@ func (_m *ProductRepository) ListProducts(ctx context.Context, params domain.Lis
 	return r0, r1
 }
 
-// ProductRepository_ListProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProducts'
-type ProductRepository_ListProducts_Call struct {
+// ProductRepository_CreateProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProducts'
+type ProductRepository_CreateProducts_Call struct {
 	*mock.Call
 }
 
-// ListProducts is a helper method to define mock.On call
+// CreateProducts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - params domain.ListProductsParams
-func (_e *ProductRepository_Expecter) ListProducts(ctx interface{}, params interface{}) *ProductRepository_ListProducts_Call {
-	return &ProductRepository_ListProducts_Call{Call: _e.mock.On("ListProducts", ctx, params)}
+//   - products []domain.Product
+func (_e *ProductRepository_Expecter) CreateProducts(ctx interface{}, products interface{}) *ProductRepository_CreateProducts_Call {
+	return &ProductRepository_CreateProducts_Call{Call: _e.mock.On("CreateProducts", ctx, products)}
 }
 
-func (_c *ProductRepository_ListProducts_Call) Run(run func(ctx context.Context, params domain.ListProductsParams)) *ProductRepository_ListProducts_Call {
+func (_c *ProductRepository_CreateProducts_Call) Run(run func(ctx context.Context, products []domain.Product)) *ProductRepository_CreateProducts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.ListProductsParams))
+		run(args[0].(context.Context), args[1].([]domain.Product))
 	})
 	return _c
 }
 
-func (_c *ProductRepository_ListProducts_Call) Return(_a0 []domain.Product, _a1 error) *ProductRepository_ListProducts_Call {
+func (_c *ProductRepository_CreateProducts_Call) Return(_a0 []int, _a1 error) *ProductRepository_CreateProducts_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ProductRepository_ListProducts_Call) RunAndReturn(run func(context.Context, domain.ListProductsParams) ([]domain.Product, error)) *ProductRepository_ListProducts_Call {
+func (_c *ProductRepository_CreateProducts_Call) RunAndReturn(run func(context.Context, []domain.Product) ([]int, error)) *ProductRepository_CreateProducts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateProduct provides a mock function with given fields: ctx, product
-func (_m *ProductRepository) UpdateProduct(ctx context.Context, product domain.Product) error {
-	ret := _m.Called(ctx, product)
+// CreateScheduledPriceChange provides a mock function with given fields: ctx, change
+func (_m *ProductRepository) CreateScheduledPriceChange(ctx context.Context, change domain.ScheduledPriceChange) (int, error) {
+	ret := _m.Called(ctx, change)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ScheduledPriceChange) (int, error)); ok {
+		return rf(ctx, change)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ScheduledPriceChange) int); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ScheduledPriceChange) error); ok {
+		r1 = rf(ctx, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_CreateScheduledPriceChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateScheduledPriceChange'
+type ProductRepository_CreateScheduledPriceChange_Call struct {
+	*mock.Call
+}
+
+// CreateScheduledPriceChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change domain.ScheduledPriceChange
+func (_e *ProductRepository_Expecter) CreateScheduledPriceChange(ctx interface{}, change interface{}) *ProductRepository_CreateScheduledPriceChange_Call {
+	return &ProductRepository_CreateScheduledPriceChange_Call{Call: _e.mock.On("CreateScheduledPriceChange", ctx, change)}
+}
+
+func (_c *ProductRepository_CreateScheduledPriceChange_Call) Run(run func(ctx context.Context, change domain.ScheduledPriceChange)) *ProductRepository_CreateScheduledPriceChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ScheduledPriceChange))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_CreateScheduledPriceChange_Call) Return(_a0 int, _a1 error) *ProductRepository_CreateScheduledPriceChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_CreateScheduledPriceChange_Call) RunAndReturn(run func(context.Context, domain.ScheduledPriceChange) (int, error)) *ProductRepository_CreateScheduledPriceChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProduct provides a mock function with given fields: ctx, productID, version
+func (_m *ProductRepository) DeleteProduct(ctx context.Context, productID int, version int) error {
+	ret := _m.Called(ctx, productID, version)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.Product) error); ok {
-		r0 = rf(ctx, product)
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = rf(ctx, productID, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -242,31 +510,1683 @@ func (_m *ProductRepository) UpdateProduct(ctx context.Context, product domain.P
 	return r0
 }
 
-// ProductRepository_UpdateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProduct'
-type ProductRepository_UpdateProduct_Call struct {
+// ProductRepository_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
+type ProductRepository_DeleteProduct_Call struct {
 	*mock.Call
 }
 
-// UpdateProduct is a helper method to define mock.On call
+// DeleteProduct is a helper method to define mock.On call
 //   - ctx context.Context
-//   - product domain.Product
-func (_e *ProductRepository_Expecter) UpdateProduct(ctx interface{}, product interface{}) *ProductRepository_UpdateProduct_Call {
-	return &ProductRepository_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", ctx, product)}
+//   - productID int
+//   - version int
+func (_e *ProductRepository_Expecter) DeleteProduct(ctx interface{}, productID interface{}, version interface{}) *ProductRepository_DeleteProduct_Call {
+	return &ProductRepository_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, productID, version)}
 }
 
-func (_c *ProductRepository_UpdateProduct_Call) Run(run func(ctx context.Context, product domain.Product)) *ProductRepository_UpdateProduct_Call {
+func (_c *ProductRepository_DeleteProduct_Call) Run(run func(ctx context.Context, productID int, version int)) *ProductRepository_DeleteProduct_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.Product))
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
 	})
 	return _c
 }
 
-func (_c *ProductRepository_UpdateProduct_Call) Return(_a0 error) *ProductRepository_UpdateProduct_Call {
+func (_c *ProductRepository_DeleteProduct_Call) Return(_a0 error) *ProductRepository_DeleteProduct_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *ProductRepository_UpdateProduct_Call) RunAndReturn(run func(context.Context, domain.Product) error) *ProductRepository_UpdateProduct_Call {
+func (_c *ProductRepository_DeleteProduct_Call) RunAndReturn(run func(context.Context, int, int) error) *ProductRepository_DeleteProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProductImage provides a mock function with given fields: ctx, image
+func (_m *ProductRepository) DeleteProductImage(ctx context.Context, image domain.ProductImage) error {
+	ret := _m.Called(ctx, image)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ProductImage) error); ok {
+		r0 = rf(ctx, image)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductRepository_DeleteProductImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProductImage'
+type ProductRepository_DeleteProductImage_Call struct {
+	*mock.Call
+}
+
+// DeleteProductImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - image domain.ProductImage
+func (_e *ProductRepository_Expecter) DeleteProductImage(ctx interface{}, image interface{}) *ProductRepository_DeleteProductImage_Call {
+	return &ProductRepository_DeleteProductImage_Call{Call: _e.mock.On("DeleteProductImage", ctx, image)}
+}
+
+func (_c *ProductRepository_DeleteProductImage_Call) Run(run func(ctx context.Context, image domain.ProductImage)) *ProductRepository_DeleteProductImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ProductImage))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_DeleteProductImage_Call) Return(_a0 error) *ProductRepository_DeleteProductImage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductRepository_DeleteProductImage_Call) RunAndReturn(run func(context.Context, domain.ProductImage) error) *ProductRepository_DeleteProductImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProductTemplate provides a mock function with given fields: ctx, templateID
+func (_m *ProductRepository) DeleteProductTemplate(ctx context.Context, templateID int) error {
+	ret := _m.Called(ctx, templateID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, templateID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductRepository_DeleteProductTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProductTemplate'
+type ProductRepository_DeleteProductTemplate_Call struct {
+	*mock.Call
+}
+
+// DeleteProductTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - templateID int
+func (_e *ProductRepository_Expecter) DeleteProductTemplate(ctx interface{}, templateID interface{}) *ProductRepository_DeleteProductTemplate_Call {
+	return &ProductRepository_DeleteProductTemplate_Call{Call: _e.mock.On("DeleteProductTemplate", ctx, templateID)}
+}
+
+func (_c *ProductRepository_DeleteProductTemplate_Call) Run(run func(ctx context.Context, templateID int)) *ProductRepository_DeleteProductTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_DeleteProductTemplate_Call) Return(_a0 error) *ProductRepository_DeleteProductTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductRepository_DeleteProductTemplate_Call) RunAndReturn(run func(context.Context, int) error) *ProductRepository_DeleteProductTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EachProduct provides a mock function with given fields: ctx, params, fn
+func (_m *ProductRepository) EachProduct(ctx context.Context, params domain.ListProductsParams, fn func(domain.Product) error) error {
+	ret := _m.Called(ctx, params, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListProductsParams, func(domain.Product) error) error); ok {
+		r0 = rf(ctx, params, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductRepository_EachProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EachProduct'
+type ProductRepository_EachProduct_Call struct {
+	*mock.Call
+}
+
+// EachProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListProductsParams
+//   - fn func(domain.Product) error
+func (_e *ProductRepository_Expecter) EachProduct(ctx interface{}, params interface{}, fn interface{}) *ProductRepository_EachProduct_Call {
+	return &ProductRepository_EachProduct_Call{Call: _e.mock.On("EachProduct", ctx, params, fn)}
+}
+
+func (_c *ProductRepository_EachProduct_Call) Run(run func(ctx context.Context, params domain.ListProductsParams, fn func(domain.Product) error)) *ProductRepository_EachProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListProductsParams), args[2].(func(domain.Product) error))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_EachProduct_Call) Return(_a0 error) *ProductRepository_EachProduct_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductRepository_EachProduct_Call) RunAndReturn(run func(context.Context, domain.ListProductsParams, func(domain.Product) error) error) *ProductRepository_EachProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeletedProduct provides a mock function with given fields: ctx, productID
+func (_m *ProductRepository) GetDeletedProduct(ctx context.Context, productID int) (*domain.Product, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 *domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Product, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Product); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_GetDeletedProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeletedProduct'
+type ProductRepository_GetDeletedProduct_Call struct {
+	*mock.Call
+}
+
+// GetDeletedProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int
+func (_e *ProductRepository_Expecter) GetDeletedProduct(ctx interface{}, productID interface{}) *ProductRepository_GetDeletedProduct_Call {
+	return &ProductRepository_GetDeletedProduct_Call{Call: _e.mock.On("GetDeletedProduct", ctx, productID)}
+}
+
+func (_c *ProductRepository_GetDeletedProduct_Call) Run(run func(ctx context.Context, productID int)) *ProductRepository_GetDeletedProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_GetDeletedProduct_Call) Return(_a0 *domain.Product, _a1 error) *ProductRepository_GetDeletedProduct_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_GetDeletedProduct_Call) RunAndReturn(run func(context.Context, int) (*domain.Product, error)) *ProductRepository_GetDeletedProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProduct provides a mock function with given fields: ctx, productID
+func (_m *ProductRepository) GetProduct(ctx context.Context, productID int) (*domain.Product, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 *domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Product, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Product); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_GetProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProduct'
+type ProductRepository_GetProduct_Call struct {
+	*mock.Call
+}
+
+// GetProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int
+func (_e *ProductRepository_Expecter) GetProduct(ctx interface{}, productID interface{}) *ProductRepository_GetProduct_Call {
+	return &ProductRepository_GetProduct_Call{Call: _e.mock.On("GetProduct", ctx, productID)}
+}
+
+func (_c *ProductRepository_GetProduct_Call) Run(run func(ctx context.Context, productID int)) *ProductRepository_GetProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_GetProduct_Call) Return(_a0 *domain.Product, _a1 error) *ProductRepository_GetProduct_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_GetProduct_Call) RunAndReturn(run func(context.Context, int) (*domain.Product, error)) *ProductRepository_GetProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductByBarcode provides a mock function with given fields: ctx, userID, barcode
+func (_m *ProductRepository) GetProductByBarcode(ctx context.Context, userID int, barcode string) (*domain.Product, error) {
+	ret := _m.Called(ctx, userID, barcode)
+
+	var r0 *domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*domain.Product, error)); ok {
+		return rf(ctx, userID, barcode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *domain.Product); ok {
+		r0 = rf(ctx, userID, barcode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, userID, barcode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_GetProductByBarcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductByBarcode'
+type ProductRepository_GetProductByBarcode_Call struct {
+	*mock.Call
+}
+
+// GetProductByBarcode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - barcode string
+func (_e *ProductRepository_Expecter) GetProductByBarcode(ctx interface{}, userID interface{}, barcode interface{}) *ProductRepository_GetProductByBarcode_Call {
+	return &ProductRepository_GetProductByBarcode_Call{Call: _e.mock.On("GetProductByBarcode", ctx, userID, barcode)}
+}
+
+func (_c *ProductRepository_GetProductByBarcode_Call) Run(run func(ctx context.Context, userID int, barcode string)) *ProductRepository_GetProductByBarcode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_GetProductByBarcode_Call) Return(_a0 *domain.Product, _a1 error) *ProductRepository_GetProductByBarcode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_GetProductByBarcode_Call) RunAndReturn(run func(context.Context, int, string) (*domain.Product, error)) *ProductRepository_GetProductByBarcode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductTemplate provides a mock function with given fields: ctx, templateID
+func (_m *ProductRepository) GetProductTemplate(ctx context.Context, templateID int) (*domain.ProductTemplate, error) {
+	ret := _m.Called(ctx, templateID)
+
+	var r0 *domain.ProductTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.ProductTemplate, error)); ok {
+		return rf(ctx, templateID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.ProductTemplate); ok {
+		r0 = rf(ctx, templateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ProductTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, templateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_GetProductTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductTemplate'
+type ProductRepository_GetProductTemplate_Call struct {
+	*mock.Call
+}
+
+// GetProductTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - templateID int
+func (_e *ProductRepository_Expecter) GetProductTemplate(ctx interface{}, templateID interface{}) *ProductRepository_GetProductTemplate_Call {
+	return &ProductRepository_GetProductTemplate_Call{Call: _e.mock.On("GetProductTemplate", ctx, templateID)}
+}
+
+func (_c *ProductRepository_GetProductTemplate_Call) Run(run func(ctx context.Context, templateID int)) *ProductRepository_GetProductTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_GetProductTemplate_Call) Return(_a0 *domain.ProductTemplate, _a1 error) *ProductRepository_GetProductTemplate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_GetProductTemplate_Call) RunAndReturn(run func(context.Context, int) (*domain.ProductTemplate, error)) *ProductRepository_GetProductTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScheduledPriceChange provides a mock function with given fields: ctx, scheduleID
+func (_m *ProductRepository) GetScheduledPriceChange(ctx context.Context, scheduleID int) (*domain.ScheduledPriceChange, error) {
+	ret := _m.Called(ctx, scheduleID)
+
+	var r0 *domain.ScheduledPriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.ScheduledPriceChange, error)); ok {
+		return rf(ctx, scheduleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.ScheduledPriceChange); ok {
+		r0 = rf(ctx, scheduleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ScheduledPriceChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, scheduleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_GetScheduledPriceChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScheduledPriceChange'
+type ProductRepository_GetScheduledPriceChange_Call struct {
+	*mock.Call
+}
+
+// GetScheduledPriceChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scheduleID int
+func (_e *ProductRepository_Expecter) GetScheduledPriceChange(ctx interface{}, scheduleID interface{}) *ProductRepository_GetScheduledPriceChange_Call {
+	return &ProductRepository_GetScheduledPriceChange_Call{Call: _e.mock.On("GetScheduledPriceChange", ctx, scheduleID)}
+}
+
+func (_c *ProductRepository_GetScheduledPriceChange_Call) Run(run func(ctx context.Context, scheduleID int)) *ProductRepository_GetScheduledPriceChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_GetScheduledPriceChange_Call) Return(_a0 *domain.ScheduledPriceChange, _a1 error) *ProductRepository_GetScheduledPriceChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_GetScheduledPriceChange_Call) RunAndReturn(run func(context.Context, int) (*domain.ScheduledPriceChange, error)) *ProductRepository_GetScheduledPriceChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAllProductNamesAfter provides a mock function with given fields: ctx, cursor, limit
+func (_m *ProductRepository) ListAllProductNamesAfter(ctx context.Context, cursor int, limit int) ([]domain.ProductName, error) {
+	ret := _m.Called(ctx, cursor, limit)
+
+	var r0 []domain.ProductName
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]domain.ProductName, error)); ok {
+		return rf(ctx, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []domain.ProductName); ok {
+		r0 = rf(ctx, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductName)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListAllProductNamesAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAllProductNamesAfter'
+type ProductRepository_ListAllProductNamesAfter_Call struct {
+	*mock.Call
+}
+
+// ListAllProductNamesAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cursor int
+//   - limit int
+func (_e *ProductRepository_Expecter) ListAllProductNamesAfter(ctx interface{}, cursor interface{}, limit interface{}) *ProductRepository_ListAllProductNamesAfter_Call {
+	return &ProductRepository_ListAllProductNamesAfter_Call{Call: _e.mock.On("ListAllProductNamesAfter", ctx, cursor, limit)}
+}
+
+func (_c *ProductRepository_ListAllProductNamesAfter_Call) Run(run func(ctx context.Context, cursor int, limit int)) *ProductRepository_ListAllProductNamesAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListAllProductNamesAfter_Call) Return(_a0 []domain.ProductName, _a1 error) *ProductRepository_ListAllProductNamesAfter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListAllProductNamesAfter_Call) RunAndReturn(run func(context.Context, int, int) ([]domain.ProductName, error)) *ProductRepository_ListAllProductNamesAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeletedProducts provides a mock function with given fields: ctx, userID, cursor
+func (_m *ProductRepository) ListDeletedProducts(ctx context.Context, userID int, cursor *int) ([]domain.Product, error) {
+	ret := _m.Called(ctx, userID, cursor)
+
+	var r0 []domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *int) ([]domain.Product, error)); ok {
+		return rf(ctx, userID, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *int) []domain.Product); ok {
+		r0 = rf(ctx, userID, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *int) error); ok {
+		r1 = rf(ctx, userID, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListDeletedProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeletedProducts'
+type ProductRepository_ListDeletedProducts_Call struct {
+	*mock.Call
+}
+
+// ListDeletedProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - cursor *int
+func (_e *ProductRepository_Expecter) ListDeletedProducts(ctx interface{}, userID interface{}, cursor interface{}) *ProductRepository_ListDeletedProducts_Call {
+	return &ProductRepository_ListDeletedProducts_Call{Call: _e.mock.On("ListDeletedProducts", ctx, userID, cursor)}
+}
+
+func (_c *ProductRepository_ListDeletedProducts_Call) Run(run func(ctx context.Context, userID int, cursor *int)) *ProductRepository_ListDeletedProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListDeletedProducts_Call) Return(_a0 []domain.Product, _a1 error) *ProductRepository_ListDeletedProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListDeletedProducts_Call) RunAndReturn(run func(context.Context, int, *int) ([]domain.Product, error)) *ProductRepository_ListDeletedProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDueScheduledPriceChanges provides a mock function with given fields: ctx, now, limit
+func (_m *ProductRepository) ListDueScheduledPriceChanges(ctx context.Context, now time.Time, limit int) ([]domain.ScheduledPriceChange, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	var r0 []domain.ScheduledPriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]domain.ScheduledPriceChange, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []domain.ScheduledPriceChange); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ScheduledPriceChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListDueScheduledPriceChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDueScheduledPriceChanges'
+type ProductRepository_ListDueScheduledPriceChanges_Call struct {
+	*mock.Call
+}
+
+// ListDueScheduledPriceChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - limit int
+func (_e *ProductRepository_Expecter) ListDueScheduledPriceChanges(ctx interface{}, now interface{}, limit interface{}) *ProductRepository_ListDueScheduledPriceChanges_Call {
+	return &ProductRepository_ListDueScheduledPriceChanges_Call{Call: _e.mock.On("ListDueScheduledPriceChanges", ctx, now, limit)}
+}
+
+func (_c *ProductRepository_ListDueScheduledPriceChanges_Call) Run(run func(ctx context.Context, now time.Time, limit int)) *ProductRepository_ListDueScheduledPriceChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListDueScheduledPriceChanges_Call) Return(_a0 []domain.ScheduledPriceChange, _a1 error) *ProductRepository_ListDueScheduledPriceChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListDueScheduledPriceChanges_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]domain.ScheduledPriceChange, error)) *ProductRepository_ListDueScheduledPriceChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExpiringProducts provides a mock function with given fields: ctx, params
+func (_m *ProductRepository) ListExpiringProducts(ctx context.Context, params domain.ListExpiringProductsParams) ([]domain.Product, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListExpiringProductsParams) ([]domain.Product, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListExpiringProductsParams) []domain.Product); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListExpiringProductsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListExpiringProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExpiringProducts'
+type ProductRepository_ListExpiringProducts_Call struct {
+	*mock.Call
+}
+
+// ListExpiringProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListExpiringProductsParams
+func (_e *ProductRepository_Expecter) ListExpiringProducts(ctx interface{}, params interface{}) *ProductRepository_ListExpiringProducts_Call {
+	return &ProductRepository_ListExpiringProducts_Call{Call: _e.mock.On("ListExpiringProducts", ctx, params)}
+}
+
+func (_c *ProductRepository_ListExpiringProducts_Call) Run(run func(ctx context.Context, params domain.ListExpiringProductsParams)) *ProductRepository_ListExpiringProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListExpiringProductsParams))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListExpiringProducts_Call) Return(_a0 []domain.Product, _a1 error) *ProductRepository_ListExpiringProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListExpiringProducts_Call) RunAndReturn(run func(context.Context, domain.ListExpiringProductsParams) ([]domain.Product, error)) *ProductRepository_ListExpiringProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLowStockProducts provides a mock function with given fields: ctx, params
+func (_m *ProductRepository) ListLowStockProducts(ctx context.Context, params domain.ListLowStockProductsParams) ([]domain.Product, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListLowStockProductsParams) ([]domain.Product, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListLowStockProductsParams) []domain.Product); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListLowStockProductsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListLowStockProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLowStockProducts'
+type ProductRepository_ListLowStockProducts_Call struct {
+	*mock.Call
+}
+
+// ListLowStockProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListLowStockProductsParams
+func (_e *ProductRepository_Expecter) ListLowStockProducts(ctx interface{}, params interface{}) *ProductRepository_ListLowStockProducts_Call {
+	return &ProductRepository_ListLowStockProducts_Call{Call: _e.mock.On("ListLowStockProducts", ctx, params)}
+}
+
+func (_c *ProductRepository_ListLowStockProducts_Call) Run(run func(ctx context.Context, params domain.ListLowStockProductsParams)) *ProductRepository_ListLowStockProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListLowStockProductsParams))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListLowStockProducts_Call) Return(_a0 []domain.Product, _a1 error) *ProductRepository_ListLowStockProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListLowStockProducts_Call) RunAndReturn(run func(context.Context, domain.ListLowStockProductsParams) ([]domain.Product, error)) *ProductRepository_ListLowStockProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPriceHistory provides a mock function with given fields: ctx, productID, since
+func (_m *ProductRepository) ListPriceHistory(ctx context.Context, productID int, since time.Time) ([]domain.PriceHistory, error) {
+	ret := _m.Called(ctx, productID, since)
+
+	var r0 []domain.PriceHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) ([]domain.PriceHistory, error)); ok {
+		return rf(ctx, productID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) []domain.PriceHistory); ok {
+		r0 = rf(ctx, productID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PriceHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time) error); ok {
+		r1 = rf(ctx, productID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPriceHistory'
+type ProductRepository_ListPriceHistory_Call struct {
+	*mock.Call
+}
+
+// ListPriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int
+//   - since time.Time
+func (_e *ProductRepository_Expecter) ListPriceHistory(ctx interface{}, productID interface{}, since interface{}) *ProductRepository_ListPriceHistory_Call {
+	return &ProductRepository_ListPriceHistory_Call{Call: _e.mock.On("ListPriceHistory", ctx, productID, since)}
+}
+
+func (_c *ProductRepository_ListPriceHistory_Call) Run(run func(ctx context.Context, productID int, since time.Time)) *ProductRepository_ListPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListPriceHistory_Call) Return(_a0 []domain.PriceHistory, _a1 error) *ProductRepository_ListPriceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListPriceHistory_Call) RunAndReturn(run func(context.Context, int, time.Time) ([]domain.PriceHistory, error)) *ProductRepository_ListPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductImages provides a mock function with given fields: ctx, productIDs
+func (_m *ProductRepository) ListProductImages(ctx context.Context, productIDs []int) ([]domain.ProductImage, error) {
+	ret := _m.Called(ctx, productIDs)
+
+	var r0 []domain.ProductImage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) ([]domain.ProductImage, error)); ok {
+		return rf(ctx, productIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) []domain.ProductImage); ok {
+		r0 = rf(ctx, productIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductImage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, productIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListProductImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductImages'
+type ProductRepository_ListProductImages_Call struct {
+	*mock.Call
+}
+
+// ListProductImages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productIDs []int
+func (_e *ProductRepository_Expecter) ListProductImages(ctx interface{}, productIDs interface{}) *ProductRepository_ListProductImages_Call {
+	return &ProductRepository_ListProductImages_Call{Call: _e.mock.On("ListProductImages", ctx, productIDs)}
+}
+
+func (_c *ProductRepository_ListProductImages_Call) Run(run func(ctx context.Context, productIDs []int)) *ProductRepository_ListProductImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListProductImages_Call) Return(_a0 []domain.ProductImage, _a1 error) *ProductRepository_ListProductImages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListProductImages_Call) RunAndReturn(run func(context.Context, []int) ([]domain.ProductImage, error)) *ProductRepository_ListProductImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductNames provides a mock function with given fields: ctx, userID
+func (_m *ProductRepository) ListProductNames(ctx context.Context, userID int) ([]domain.ProductName, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.ProductName
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.ProductName, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.ProductName); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductName)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListProductNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductNames'
+type ProductRepository_ListProductNames_Call struct {
+	*mock.Call
+}
+
+// ListProductNames is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *ProductRepository_Expecter) ListProductNames(ctx interface{}, userID interface{}) *ProductRepository_ListProductNames_Call {
+	return &ProductRepository_ListProductNames_Call{Call: _e.mock.On("ListProductNames", ctx, userID)}
+}
+
+func (_c *ProductRepository_ListProductNames_Call) Run(run func(ctx context.Context, userID int)) *ProductRepository_ListProductNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListProductNames_Call) Return(_a0 []domain.ProductName, _a1 error) *ProductRepository_ListProductNames_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListProductNames_Call) RunAndReturn(run func(context.Context, int) ([]domain.ProductName, error)) *ProductRepository_ListProductNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductOptionGroups provides a mock function with given fields: ctx, productIDs
+func (_m *ProductRepository) ListProductOptionGroups(ctx context.Context, productIDs []int) ([]domain.ProductOptionGroup, error) {
+	ret := _m.Called(ctx, productIDs)
+
+	var r0 []domain.ProductOptionGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) ([]domain.ProductOptionGroup, error)); ok {
+		return rf(ctx, productIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) []domain.ProductOptionGroup); ok {
+		r0 = rf(ctx, productIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductOptionGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, productIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListProductOptionGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductOptionGroups'
+type ProductRepository_ListProductOptionGroups_Call struct {
+	*mock.Call
+}
+
+// ListProductOptionGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productIDs []int
+func (_e *ProductRepository_Expecter) ListProductOptionGroups(ctx interface{}, productIDs interface{}) *ProductRepository_ListProductOptionGroups_Call {
+	return &ProductRepository_ListProductOptionGroups_Call{Call: _e.mock.On("ListProductOptionGroups", ctx, productIDs)}
+}
+
+func (_c *ProductRepository_ListProductOptionGroups_Call) Run(run func(ctx context.Context, productIDs []int)) *ProductRepository_ListProductOptionGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListProductOptionGroups_Call) Return(_a0 []domain.ProductOptionGroup, _a1 error) *ProductRepository_ListProductOptionGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListProductOptionGroups_Call) RunAndReturn(run func(context.Context, []int) ([]domain.ProductOptionGroup, error)) *ProductRepository_ListProductOptionGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductTags provides a mock function with given fields: ctx, productIDs
+func (_m *ProductRepository) ListProductTags(ctx context.Context, productIDs []int) ([]domain.ProductTag, error) {
+	ret := _m.Called(ctx, productIDs)
+
+	var r0 []domain.ProductTag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) ([]domain.ProductTag, error)); ok {
+		return rf(ctx, productIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) []domain.ProductTag); ok {
+		r0 = rf(ctx, productIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductTag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, productIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListProductTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductTags'
+type ProductRepository_ListProductTags_Call struct {
+	*mock.Call
+}
+
+// ListProductTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productIDs []int
+func (_e *ProductRepository_Expecter) ListProductTags(ctx interface{}, productIDs interface{}) *ProductRepository_ListProductTags_Call {
+	return &ProductRepository_ListProductTags_Call{Call: _e.mock.On("ListProductTags", ctx, productIDs)}
+}
+
+func (_c *ProductRepository_ListProductTags_Call) Run(run func(ctx context.Context, productIDs []int)) *ProductRepository_ListProductTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListProductTags_Call) Return(_a0 []domain.ProductTag, _a1 error) *ProductRepository_ListProductTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListProductTags_Call) RunAndReturn(run func(context.Context, []int) ([]domain.ProductTag, error)) *ProductRepository_ListProductTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductTemplates provides a mock function with given fields: ctx, userID
+func (_m *ProductRepository) ListProductTemplates(ctx context.Context, userID int) ([]domain.ProductTemplate, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.ProductTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.ProductTemplate, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.ProductTemplate); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListProductTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductTemplates'
+type ProductRepository_ListProductTemplates_Call struct {
+	*mock.Call
+}
+
+// ListProductTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *ProductRepository_Expecter) ListProductTemplates(ctx interface{}, userID interface{}) *ProductRepository_ListProductTemplates_Call {
+	return &ProductRepository_ListProductTemplates_Call{Call: _e.mock.On("ListProductTemplates", ctx, userID)}
+}
+
+func (_c *ProductRepository_ListProductTemplates_Call) Run(run func(ctx context.Context, userID int)) *ProductRepository_ListProductTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListProductTemplates_Call) Return(_a0 []domain.ProductTemplate, _a1 error) *ProductRepository_ListProductTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListProductTemplates_Call) RunAndReturn(run func(context.Context, int) ([]domain.ProductTemplate, error)) *ProductRepository_ListProductTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProducts provides a mock function with given fields: ctx, params
+func (_m *ProductRepository) ListProducts(ctx context.Context, params domain.ListProductsParams) ([]domain.Product, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListProductsParams) ([]domain.Product, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListProductsParams) []domain.Product); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListProductsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProducts'
+type ProductRepository_ListProducts_Call struct {
+	*mock.Call
+}
+
+// ListProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListProductsParams
+func (_e *ProductRepository_Expecter) ListProducts(ctx interface{}, params interface{}) *ProductRepository_ListProducts_Call {
+	return &ProductRepository_ListProducts_Call{Call: _e.mock.On("ListProducts", ctx, params)}
+}
+
+func (_c *ProductRepository_ListProducts_Call) Run(run func(ctx context.Context, params domain.ListProductsParams)) *ProductRepository_ListProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListProductsParams))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListProducts_Call) Return(_a0 []domain.Product, _a1 error) *ProductRepository_ListProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListProducts_Call) RunAndReturn(run func(context.Context, domain.ListProductsParams) ([]domain.Product, error)) *ProductRepository_ListProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductsByIDs provides a mock function with given fields: ctx, userID, productIDs
+func (_m *ProductRepository) ListProductsByIDs(ctx context.Context, userID int, productIDs []int) ([]domain.Product, error) {
+	ret := _m.Called(ctx, userID, productIDs)
+
+	var r0 []domain.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) ([]domain.Product, error)); ok {
+		return rf(ctx, userID, productIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) []domain.Product); ok {
+		r0 = rf(ctx, userID, productIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, userID, productIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListProductsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductsByIDs'
+type ProductRepository_ListProductsByIDs_Call struct {
+	*mock.Call
+}
+
+// ListProductsByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - productIDs []int
+func (_e *ProductRepository_Expecter) ListProductsByIDs(ctx interface{}, userID interface{}, productIDs interface{}) *ProductRepository_ListProductsByIDs_Call {
+	return &ProductRepository_ListProductsByIDs_Call{Call: _e.mock.On("ListProductsByIDs", ctx, userID, productIDs)}
+}
+
+func (_c *ProductRepository_ListProductsByIDs_Call) Run(run func(ctx context.Context, userID int, productIDs []int)) *ProductRepository_ListProductsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListProductsByIDs_Call) Return(_a0 []domain.Product, _a1 error) *ProductRepository_ListProductsByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListProductsByIDs_Call) RunAndReturn(run func(context.Context, int, []int) ([]domain.Product, error)) *ProductRepository_ListProductsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPurgeableProductIDs provides a mock function with given fields: ctx, deletedBefore, limit
+func (_m *ProductRepository) ListPurgeableProductIDs(ctx context.Context, deletedBefore time.Time, limit int) ([]int, error) {
+	ret := _m.Called(ctx, deletedBefore, limit)
+
+	var r0 []int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]int, error)); ok {
+		return rf(ctx, deletedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []int); ok {
+		r0 = rf(ctx, deletedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, deletedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListPurgeableProductIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPurgeableProductIDs'
+type ProductRepository_ListPurgeableProductIDs_Call struct {
+	*mock.Call
+}
+
+// ListPurgeableProductIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deletedBefore time.Time
+//   - limit int
+func (_e *ProductRepository_Expecter) ListPurgeableProductIDs(ctx interface{}, deletedBefore interface{}, limit interface{}) *ProductRepository_ListPurgeableProductIDs_Call {
+	return &ProductRepository_ListPurgeableProductIDs_Call{Call: _e.mock.On("ListPurgeableProductIDs", ctx, deletedBefore, limit)}
+}
+
+func (_c *ProductRepository_ListPurgeableProductIDs_Call) Run(run func(ctx context.Context, deletedBefore time.Time, limit int)) *ProductRepository_ListPurgeableProductIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListPurgeableProductIDs_Call) Return(_a0 []int, _a1 error) *ProductRepository_ListPurgeableProductIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListPurgeableProductIDs_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]int, error)) *ProductRepository_ListPurgeableProductIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduledPriceChanges provides a mock function with given fields: ctx, params
+func (_m *ProductRepository) ListScheduledPriceChanges(ctx context.Context, params domain.ListScheduledPriceChangesParams) ([]domain.ScheduledPriceChange, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.ScheduledPriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListScheduledPriceChangesParams) ([]domain.ScheduledPriceChange, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListScheduledPriceChangesParams) []domain.ScheduledPriceChange); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ScheduledPriceChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListScheduledPriceChangesParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_ListScheduledPriceChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledPriceChanges'
+type ProductRepository_ListScheduledPriceChanges_Call struct {
+	*mock.Call
+}
+
+// ListScheduledPriceChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListScheduledPriceChangesParams
+func (_e *ProductRepository_Expecter) ListScheduledPriceChanges(ctx interface{}, params interface{}) *ProductRepository_ListScheduledPriceChanges_Call {
+	return &ProductRepository_ListScheduledPriceChanges_Call{Call: _e.mock.On("ListScheduledPriceChanges", ctx, params)}
+}
+
+func (_c *ProductRepository_ListScheduledPriceChanges_Call) Run(run func(ctx context.Context, params domain.ListScheduledPriceChangesParams)) *ProductRepository_ListScheduledPriceChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListScheduledPriceChangesParams))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ListScheduledPriceChanges_Call) Return(_a0 []domain.ScheduledPriceChange, _a1 error) *ProductRepository_ListScheduledPriceChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_ListScheduledPriceChanges_Call) RunAndReturn(run func(context.Context, domain.ListScheduledPriceChangesParams) ([]domain.ScheduledPriceChange, error)) *ProductRepository_ListScheduledPriceChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeProduct provides a mock function with given fields: ctx, productID
+func (_m *ProductRepository) PurgeProduct(ctx context.Context, productID int) (bool, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (bool, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) bool); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_PurgeProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeProduct'
+type ProductRepository_PurgeProduct_Call struct {
+	*mock.Call
+}
+
+// PurgeProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int
+func (_e *ProductRepository_Expecter) PurgeProduct(ctx interface{}, productID interface{}) *ProductRepository_PurgeProduct_Call {
+	return &ProductRepository_PurgeProduct_Call{Call: _e.mock.On("PurgeProduct", ctx, productID)}
+}
+
+func (_c *ProductRepository_PurgeProduct_Call) Run(run func(ctx context.Context, productID int)) *ProductRepository_PurgeProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_PurgeProduct_Call) Return(_a0 bool, _a1 error) *ProductRepository_PurgeProduct_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_PurgeProduct_Call) RunAndReturn(run func(context.Context, int) (bool, error)) *ProductRepository_PurgeProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseExpiryDigest provides a mock function with given fields: ctx, userID, date
+func (_m *ProductRepository) ReleaseExpiryDigest(ctx context.Context, userID int, date string) error {
+	ret := _m.Called(ctx, userID, date)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, userID, date)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductRepository_ReleaseExpiryDigest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseExpiryDigest'
+type ProductRepository_ReleaseExpiryDigest_Call struct {
+	*mock.Call
+}
+
+// ReleaseExpiryDigest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - date string
+func (_e *ProductRepository_Expecter) ReleaseExpiryDigest(ctx interface{}, userID interface{}, date interface{}) *ProductRepository_ReleaseExpiryDigest_Call {
+	return &ProductRepository_ReleaseExpiryDigest_Call{Call: _e.mock.On("ReleaseExpiryDigest", ctx, userID, date)}
+}
+
+func (_c *ProductRepository_ReleaseExpiryDigest_Call) Run(run func(ctx context.Context, userID int, date string)) *ProductRepository_ReleaseExpiryDigest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ReleaseExpiryDigest_Call) Return(_a0 error) *ProductRepository_ReleaseExpiryDigest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductRepository_ReleaseExpiryDigest_Call) RunAndReturn(run func(context.Context, int, string) error) *ProductRepository_ReleaseExpiryDigest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceProductOptionGroups provides a mock function with given fields: ctx, productID, groups
+func (_m *ProductRepository) ReplaceProductOptionGroups(ctx context.Context, productID int, groups []domain.ProductOptionGroup) error {
+	ret := _m.Called(ctx, productID, groups)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []domain.ProductOptionGroup) error); ok {
+		r0 = rf(ctx, productID, groups)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductRepository_ReplaceProductOptionGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceProductOptionGroups'
+type ProductRepository_ReplaceProductOptionGroups_Call struct {
+	*mock.Call
+}
+
+// ReplaceProductOptionGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int
+//   - groups []domain.ProductOptionGroup
+func (_e *ProductRepository_Expecter) ReplaceProductOptionGroups(ctx interface{}, productID interface{}, groups interface{}) *ProductRepository_ReplaceProductOptionGroups_Call {
+	return &ProductRepository_ReplaceProductOptionGroups_Call{Call: _e.mock.On("ReplaceProductOptionGroups", ctx, productID, groups)}
+}
+
+func (_c *ProductRepository_ReplaceProductOptionGroups_Call) Run(run func(ctx context.Context, productID int, groups []domain.ProductOptionGroup)) *ProductRepository_ReplaceProductOptionGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]domain.ProductOptionGroup))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ReplaceProductOptionGroups_Call) Return(_a0 error) *ProductRepository_ReplaceProductOptionGroups_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductRepository_ReplaceProductOptionGroups_Call) RunAndReturn(run func(context.Context, int, []domain.ProductOptionGroup) error) *ProductRepository_ReplaceProductOptionGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceProductTags provides a mock function with given fields: ctx, userID, productID, names
+func (_m *ProductRepository) ReplaceProductTags(ctx context.Context, userID int, productID int, names []string) error {
+	ret := _m.Called(ctx, userID, productID, names)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, []string) error); ok {
+		r0 = rf(ctx, userID, productID, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductRepository_ReplaceProductTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceProductTags'
+type ProductRepository_ReplaceProductTags_Call struct {
+	*mock.Call
+}
+
+// ReplaceProductTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - productID int
+//   - names []string
+func (_e *ProductRepository_Expecter) ReplaceProductTags(ctx interface{}, userID interface{}, productID interface{}, names interface{}) *ProductRepository_ReplaceProductTags_Call {
+	return &ProductRepository_ReplaceProductTags_Call{Call: _e.mock.On("ReplaceProductTags", ctx, userID, productID, names)}
+}
+
+func (_c *ProductRepository_ReplaceProductTags_Call) Run(run func(ctx context.Context, userID int, productID int, names []string)) *ProductRepository_ReplaceProductTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_ReplaceProductTags_Call) Return(_a0 error) *ProductRepository_ReplaceProductTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductRepository_ReplaceProductTags_Call) RunAndReturn(run func(context.Context, int, int, []string) error) *ProductRepository_ReplaceProductTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreProduct provides a mock function with given fields: ctx, productID
+func (_m *ProductRepository) RestoreProduct(ctx context.Context, productID int) (bool, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (bool, error)); ok {
+		return rf(ctx, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) bool); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductRepository_RestoreProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreProduct'
+type ProductRepository_RestoreProduct_Call struct {
+	*mock.Call
+}
+
+// RestoreProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int
+func (_e *ProductRepository_Expecter) RestoreProduct(ctx interface{}, productID interface{}) *ProductRepository_RestoreProduct_Call {
+	return &ProductRepository_RestoreProduct_Call{Call: _e.mock.On("RestoreProduct", ctx, productID)}
+}
+
+func (_c *ProductRepository_RestoreProduct_Call) Run(run func(ctx context.Context, productID int)) *ProductRepository_RestoreProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_RestoreProduct_Call) Return(_a0 bool, _a1 error) *ProductRepository_RestoreProduct_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductRepository_RestoreProduct_Call) RunAndReturn(run func(context.Context, int) (bool, error)) *ProductRepository_RestoreProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProduct provides a mock function with given fields: ctx, product
+func (_m *ProductRepository) UpdateProduct(ctx context.Context, product domain.Product) error {
+	ret := _m.Called(ctx, product)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Product) error); ok {
+		r0 = rf(ctx, product)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductRepository_UpdateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProduct'
+type ProductRepository_UpdateProduct_Call struct {
+	*mock.Call
+}
+
+// UpdateProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - product domain.Product
+func (_e *ProductRepository_Expecter) UpdateProduct(ctx interface{}, product interface{}) *ProductRepository_UpdateProduct_Call {
+	return &ProductRepository_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", ctx, product)}
+}
+
+func (_c *ProductRepository_UpdateProduct_Call) Run(run func(ctx context.Context, product domain.Product)) *ProductRepository_UpdateProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Product))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_UpdateProduct_Call) Return(_a0 error) *ProductRepository_UpdateProduct_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductRepository_UpdateProduct_Call) RunAndReturn(run func(context.Context, domain.Product) error) *ProductRepository_UpdateProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProductImages provides a mock function with given fields: ctx, productID, imageIDs, primaryImageID
+func (_m *ProductRepository) UpdateProductImages(ctx context.Context, productID int, imageIDs []int, primaryImageID *int) error {
+	ret := _m.Called(ctx, productID, imageIDs, primaryImageID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int, *int) error); ok {
+		r0 = rf(ctx, productID, imageIDs, primaryImageID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductRepository_UpdateProductImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProductImages'
+type ProductRepository_UpdateProductImages_Call struct {
+	*mock.Call
+}
+
+// UpdateProductImages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int
+//   - imageIDs []int
+//   - primaryImageID *int
+func (_e *ProductRepository_Expecter) UpdateProductImages(ctx interface{}, productID interface{}, imageIDs interface{}, primaryImageID interface{}) *ProductRepository_UpdateProductImages_Call {
+	return &ProductRepository_UpdateProductImages_Call{Call: _e.mock.On("UpdateProductImages", ctx, productID, imageIDs, primaryImageID)}
+}
+
+func (_c *ProductRepository_UpdateProductImages_Call) Run(run func(ctx context.Context, productID int, imageIDs []int, primaryImageID *int)) *ProductRepository_UpdateProductImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]int), args[3].(*int))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_UpdateProductImages_Call) Return(_a0 error) *ProductRepository_UpdateProductImages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductRepository_UpdateProductImages_Call) RunAndReturn(run func(context.Context, int, []int, *int) error) *ProductRepository_UpdateProductImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProductRomanized provides a mock function with given fields: ctx, productID, romanized
+func (_m *ProductRepository) UpdateProductRomanized(ctx context.Context, productID int, romanized string) error {
+	ret := _m.Called(ctx, productID, romanized)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, productID, romanized)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductRepository_UpdateProductRomanized_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProductRomanized'
+type ProductRepository_UpdateProductRomanized_Call struct {
+	*mock.Call
+}
+
+// UpdateProductRomanized is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int
+//   - romanized string
+func (_e *ProductRepository_Expecter) UpdateProductRomanized(ctx interface{}, productID interface{}, romanized interface{}) *ProductRepository_UpdateProductRomanized_Call {
+	return &ProductRepository_UpdateProductRomanized_Call{Call: _e.mock.On("UpdateProductRomanized", ctx, productID, romanized)}
+}
+
+func (_c *ProductRepository_UpdateProductRomanized_Call) Run(run func(ctx context.Context, productID int, romanized string)) *ProductRepository_UpdateProductRomanized_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_UpdateProductRomanized_Call) Return(_a0 error) *ProductRepository_UpdateProductRomanized_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductRepository_UpdateProductRomanized_Call) RunAndReturn(run func(context.Context, int, string) error) *ProductRepository_UpdateProductRomanized_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTx provides a mock function with given fields: ctx, fn
+func (_m *ProductRepository) WithTx(ctx context.Context, fn func(domain.ProductRepository) error) error {
+	ret := _m.Called(ctx, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(domain.ProductRepository) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductRepository_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type ProductRepository_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(domain.ProductRepository) error
+func (_e *ProductRepository_Expecter) WithTx(ctx interface{}, fn interface{}) *ProductRepository_WithTx_Call {
+	return &ProductRepository_WithTx_Call{Call: _e.mock.On("WithTx", ctx, fn)}
+}
+
+func (_c *ProductRepository_WithTx_Call) Run(run func(ctx context.Context, fn func(domain.ProductRepository) error)) *ProductRepository_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(domain.ProductRepository) error))
+	})
+	return _c
+}
+
+func (_c *ProductRepository_WithTx_Call) Return(_a0 error) *ProductRepository_WithTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductRepository_WithTx_Call) RunAndReturn(run func(context.Context, func(domain.ProductRepository) error) error) *ProductRepository_WithTx_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6,6 +6,8 @@ import (
 	context "context"
 	domain "payhere/domain"
 
+	io "io"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,6 +24,145 @@ func (_m *ProductService) EXPECT() *ProductService_Expecter {
 	return &ProductService_Expecter{mock: &_m.Mock}
 }
 
+// ApplyProductPatch provides a mock function with given fields: ctx, req
+func (_m *ProductService) ApplyProductPatch(ctx context.Context, req domain.ApplyProductPatchRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ApplyProductPatchRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductService_ApplyProductPatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyProductPatch'
+type ProductService_ApplyProductPatch_Call struct {
+	*mock.Call
+}
+
+// ApplyProductPatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ApplyProductPatchRequest
+func (_e *ProductService_Expecter) ApplyProductPatch(ctx interface{}, req interface{}) *ProductService_ApplyProductPatch_Call {
+	return &ProductService_ApplyProductPatch_Call{Call: _e.mock.On("ApplyProductPatch", ctx, req)}
+}
+
+func (_c *ProductService_ApplyProductPatch_Call) Run(run func(ctx context.Context, req domain.ApplyProductPatchRequest)) *ProductService_ApplyProductPatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ApplyProductPatchRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_ApplyProductPatch_Call) Return(_a0 error) *ProductService_ApplyProductPatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductService_ApplyProductPatch_Call) RunAndReturn(run func(context.Context, domain.ApplyProductPatchRequest) error) *ProductService_ApplyProductPatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchProducts provides a mock function with given fields: ctx, req
+func (_m *ProductService) BatchProducts(ctx context.Context, req domain.BatchProductsRequest) (domain.BatchProductsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.BatchProductsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.BatchProductsRequest) (domain.BatchProductsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.BatchProductsRequest) domain.BatchProductsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.BatchProductsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.BatchProductsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_BatchProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchProducts'
+type ProductService_BatchProducts_Call struct {
+	*mock.Call
+}
+
+// BatchProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.BatchProductsRequest
+func (_e *ProductService_Expecter) BatchProducts(ctx interface{}, req interface{}) *ProductService_BatchProducts_Call {
+	return &ProductService_BatchProducts_Call{Call: _e.mock.On("BatchProducts", ctx, req)}
+}
+
+func (_c *ProductService_BatchProducts_Call) Run(run func(ctx context.Context, req domain.BatchProductsRequest)) *ProductService_BatchProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.BatchProductsRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_BatchProducts_Call) Return(_a0 domain.BatchProductsResponse, _a1 error) *ProductService_BatchProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_BatchProducts_Call) RunAndReturn(run func(context.Context, domain.BatchProductsRequest) (domain.BatchProductsResponse, error)) *ProductService_BatchProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelScheduledPriceChange provides a mock function with given fields: ctx, req
+func (_m *ProductService) CancelScheduledPriceChange(ctx context.Context, req domain.CancelScheduledPriceChangeRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CancelScheduledPriceChangeRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductService_CancelScheduledPriceChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelScheduledPriceChange'
+type ProductService_CancelScheduledPriceChange_Call struct {
+	*mock.Call
+}
+
+// CancelScheduledPriceChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.CancelScheduledPriceChangeRequest
+func (_e *ProductService_Expecter) CancelScheduledPriceChange(ctx interface{}, req interface{}) *ProductService_CancelScheduledPriceChange_Call {
+	return &ProductService_CancelScheduledPriceChange_Call{Call: _e.mock.On("CancelScheduledPriceChange", ctx, req)}
+}
+
+func (_c *ProductService_CancelScheduledPriceChange_Call) Run(run func(ctx context.Context, req domain.CancelScheduledPriceChangeRequest)) *ProductService_CancelScheduledPriceChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CancelScheduledPriceChangeRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_CancelScheduledPriceChange_Call) Return(_a0 error) *ProductService_CancelScheduledPriceChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductService_CancelScheduledPriceChange_Call) RunAndReturn(run func(context.Context, domain.CancelScheduledPriceChangeRequest) error) *ProductService_CancelScheduledPriceChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateProduct provides a mock function with given fields: ctx, req
 func (_m *ProductService) CreateProduct(ctx context.Context, req domain.CreateProductRequest) error {
 	ret := _m.Called(ctx, req)
@@ -65,65 +206,77 @@ func (_c *ProductService_CreateProduct_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
-// DeleteProduct provides a mock function with given fields: ctx, req
-func (_m *ProductService) DeleteProduct(ctx context.Context, req domain.DeleteProductRequest) error {
+// CreateProductLabels provides a mock function with given fields: ctx, req
+func (_m *ProductService) CreateProductLabels(ctx context.Context, req domain.CreateProductLabelsRequest) ([]byte, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.DeleteProductRequest) error); ok {
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateProductLabelsRequest) ([]byte, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateProductLabelsRequest) []byte); ok {
 		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CreateProductLabelsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ProductService_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
-type ProductService_DeleteProduct_Call struct {
+// ProductService_CreateProductLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProductLabels'
+type ProductService_CreateProductLabels_Call struct {
 	*mock.Call
 }
 
-// DeleteProduct is a helper method to define mock.On call
+// CreateProductLabels is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req domain.DeleteProductRequest
-func (_e *ProductService_Expecter) DeleteProduct(ctx interface{}, req interface{}) *ProductService_DeleteProduct_Call {
-	return &ProductService_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, req)}
+//   - req domain.CreateProductLabelsRequest
+func (_e *ProductService_Expecter) CreateProductLabels(ctx interface{}, req interface{}) *ProductService_CreateProductLabels_Call {
+	return &ProductService_CreateProductLabels_Call{Call: _e.mock.On("CreateProductLabels", ctx, req)}
 }
 
-func (_c *ProductService_DeleteProduct_Call) Run(run func(ctx context.Context, req domain.DeleteProductRequest)) *ProductService_DeleteProduct_Call {
+func (_c *ProductService_CreateProductLabels_Call) Run(run func(ctx context.Context, req domain.CreateProductLabelsRequest)) *ProductService_CreateProductLabels_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.DeleteProductRequest))
+		run(args[0].(context.Context), args[1].(domain.CreateProductLabelsRequest))
 	})
 	return _c
 }
 
-func (_c *ProductService_DeleteProduct_Call) Return(_a0 error) *ProductService_DeleteProduct_Call {
-	_c.Call.Return(_a0)
+func (_c *ProductService_CreateProductLabels_Call) Return(_a0 []byte, _a1 error) *ProductService_CreateProductLabels_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ProductService_DeleteProduct_Call) RunAndReturn(run func(context.Context, domain.DeleteProductRequest) error) *ProductService_DeleteProduct_Call {
+func (_c *ProductService_CreateProductLabels_Call) RunAndReturn(run func(context.Context, domain.CreateProductLabelsRequest) ([]byte, error)) *ProductService_CreateProductLabels_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProduct provides a mock function with given fields: ctx, req
-func (_m *ProductService) GetProduct(ctx context.Context, req domain.GetProductRequest) (domain.GetProductResponse, error) {
+// CreateProductTemplate provides a mock function with given fields: ctx, req
+func (_m *ProductService) CreateProductTemplate(ctx context.Context, req domain.CreateProductTemplateRequest) (domain.CreateProductTemplateResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 domain.GetProductResponse
+	var r0 domain.CreateProductTemplateResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.GetProductRequest) (domain.GetProductResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateProductTemplateRequest) (domain.CreateProductTemplateResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.GetProductRequest) domain.GetProductResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateProductTemplateRequest) domain.CreateProductTemplateResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Get(0).(domain.GetProductResponse)
+		r0 = ret.Get(0).(domain.CreateProductTemplateResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.GetProductRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CreateProductTemplateRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -132,51 +285,51 @@ func (_m *ProductService) GetProduct(ctx context.Context, req domain.GetProductR
 	return r0, r1
 }
 
-// ProductService_GetProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProduct'
-type ProductService_GetProduct_Call struct {
+// ProductService_CreateProductTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProductTemplate'
+type ProductService_CreateProductTemplate_Call struct {
 	*mock.Call
 }
 
-// GetProduct is a helper method to define mock.On call
+// CreateProductTemplate is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req domain.GetProductRequest
-func (_e *ProductService_Expecter) GetProduct(ctx interface{}, req interface{}) *ProductService_GetProduct_Call {
-	return &ProductService_GetProduct_Call{Call: _e.mock.On("GetProduct", ctx, req)}
+//   - req domain.CreateProductTemplateRequest
+func (_e *ProductService_Expecter) CreateProductTemplate(ctx interface{}, req interface{}) *ProductService_CreateProductTemplate_Call {
+	return &ProductService_CreateProductTemplate_Call{Call: _e.mock.On("CreateProductTemplate", ctx, req)}
 }
 
-func (_c *ProductService_GetProduct_Call) Run(run func(ctx context.Context, req domain.GetProductRequest)) *ProductService_GetProduct_Call {
+func (_c *ProductService_CreateProductTemplate_Call) Run(run func(ctx context.Context, req domain.CreateProductTemplateRequest)) *ProductService_CreateProductTemplate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.GetProductRequest))
+		run(args[0].(context.Context), args[1].(domain.CreateProductTemplateRequest))
 	})
 	return _c
 }
 
-func (_c *ProductService_GetProduct_Call) Return(_a0 domain.GetProductResponse, _a1 error) *ProductService_GetProduct_Call {
+func (_c *ProductService_CreateProductTemplate_Call) Return(_a0 domain.CreateProductTemplateResponse, _a1 error) *ProductService_CreateProductTemplate_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ProductService_GetProduct_Call) RunAndReturn(run func(context.Context, domain.GetProductRequest) (domain.GetProductResponse, error)) *ProductService_GetProduct_Call {
+func (_c *ProductService_CreateProductTemplate_Call) RunAndReturn(run func(context.Context, domain.CreateProductTemplateRequest) (domain.CreateProductTemplateResponse, error)) *ProductService_CreateProductTemplate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListProducts provides a mock function with given fields: ctx, req
-func (_m *ProductService) ListProducts(ctx context.Context, req domain.ListProductsRequest) (domain.ListProductsResponse, error) {
+// CreateScheduledPriceChange provides a mock function with given fields: ctx, req
+func (_m *ProductService) CreateScheduledPriceChange(ctx context.Context, req domain.CreateScheduledPriceChangeRequest) (domain.CreateScheduledPriceChangeResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 domain.ListProductsResponse
+	var r0 domain.CreateScheduledPriceChangeResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ListProductsRequest) (domain.ListProductsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateScheduledPriceChangeRequest) (domain.CreateScheduledPriceChangeResponse, error)); ok {
 		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.ListProductsRequest) domain.ListProductsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateScheduledPriceChangeRequest) domain.CreateScheduledPriceChangeResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Get(0).(domain.ListProductsResponse)
+		r0 = ret.Get(0).(domain.CreateScheduledPriceChangeResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.ListProductsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CreateScheduledPriceChangeRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -185,41 +338,41 @@ func (_m *ProductService) ListProducts(ctx context.Context, req domain.ListProdu
 	return r0, r1
 }
 
-// ProductService_ListProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProducts'
-type ProductService_ListProducts_Call struct {
+// ProductService_CreateScheduledPriceChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateScheduledPriceChange'
+type ProductService_CreateScheduledPriceChange_Call struct {
 	*mock.Call
 }
 
-// ListProducts is a helper method to define mock.On call
+// CreateScheduledPriceChange is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req domain.ListProductsRequest
-func (_e *ProductService_Expecter) ListProducts(ctx interface{}, req interface{}) *ProductService_ListProducts_Call {
-	return &ProductService_ListProducts_Call{Call: _e.mock.On("ListProducts", ctx, req)}
+//   - req domain.CreateScheduledPriceChangeRequest
+func (_e *ProductService_Expecter) CreateScheduledPriceChange(ctx interface{}, req interface{}) *ProductService_CreateScheduledPriceChange_Call {
+	return &ProductService_CreateScheduledPriceChange_Call{Call: _e.mock.On("CreateScheduledPriceChange", ctx, req)}
 }
 
-func (_c *ProductService_ListProducts_Call) Run(run func(ctx context.Context, req domain.ListProductsRequest)) *ProductService_ListProducts_Call {
+func (_c *ProductService_CreateScheduledPriceChange_Call) Run(run func(ctx context.Context, req domain.CreateScheduledPriceChangeRequest)) *ProductService_CreateScheduledPriceChange_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.ListProductsRequest))
+		run(args[0].(context.Context), args[1].(domain.CreateScheduledPriceChangeRequest))
 	})
 	return _c
 }
 
-func (_c *ProductService_ListProducts_Call) Return(_a0 domain.ListProductsResponse, _a1 error) *ProductService_ListProducts_Call {
+func (_c *ProductService_CreateScheduledPriceChange_Call) Return(_a0 domain.CreateScheduledPriceChangeResponse, _a1 error) *ProductService_CreateScheduledPriceChange_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ProductService_ListProducts_Call) RunAndReturn(run func(context.Context, domain.ListProductsRequest) (domain.ListProductsResponse, error)) *ProductService_ListProducts_Call {
+func (_c *ProductService_CreateScheduledPriceChange_Call) RunAndReturn(run func(context.Context, domain.CreateScheduledPriceChangeRequest) (domain.CreateScheduledPriceChangeResponse, error)) *ProductService_CreateScheduledPriceChange_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PatchProduct provides a mock function with given fields: ctx, req
-func (_m *ProductService) PatchProduct(ctx context.Context, req domain.PatchProductRequest) error {
+// DeleteProduct provides a mock function with given fields: ctx, req
+func (_m *ProductService) DeleteProduct(ctx context.Context, req domain.DeleteProductRequest) error {
 	ret := _m.Called(ctx, req)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.PatchProductRequest) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeleteProductRequest) error); ok {
 		r0 = rf(ctx, req)
 	} else {
 		r0 = ret.Error(0)
@@ -228,31 +381,1138 @@ func (_m *ProductService) PatchProduct(ctx context.Context, req domain.PatchProd
 	return r0
 }
 
-// ProductService_PatchProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchProduct'
-type ProductService_PatchProduct_Call struct {
+// ProductService_DeleteProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProduct'
+type ProductService_DeleteProduct_Call struct {
 	*mock.Call
 }
 
-// PatchProduct is a helper method to define mock.On call
+// DeleteProduct is a helper method to define mock.On call
 //   - ctx context.Context
-//   - req domain.PatchProductRequest
-func (_e *ProductService_Expecter) PatchProduct(ctx interface{}, req interface{}) *ProductService_PatchProduct_Call {
-	return &ProductService_PatchProduct_Call{Call: _e.mock.On("PatchProduct", ctx, req)}
+//   - req domain.DeleteProductRequest
+func (_e *ProductService_Expecter) DeleteProduct(ctx interface{}, req interface{}) *ProductService_DeleteProduct_Call {
+	return &ProductService_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, req)}
 }
 
-func (_c *ProductService_PatchProduct_Call) Run(run func(ctx context.Context, req domain.PatchProductRequest)) *ProductService_PatchProduct_Call {
+func (_c *ProductService_DeleteProduct_Call) Run(run func(ctx context.Context, req domain.DeleteProductRequest)) *ProductService_DeleteProduct_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.PatchProductRequest))
+		run(args[0].(context.Context), args[1].(domain.DeleteProductRequest))
 	})
 	return _c
 }
 
-func (_c *ProductService_PatchProduct_Call) Return(_a0 error) *ProductService_PatchProduct_Call {
+func (_c *ProductService_DeleteProduct_Call) Return(_a0 error) *ProductService_DeleteProduct_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *ProductService_PatchProduct_Call) RunAndReturn(run func(context.Context, domain.PatchProductRequest) error) *ProductService_PatchProduct_Call {
+func (_c *ProductService_DeleteProduct_Call) RunAndReturn(run func(context.Context, domain.DeleteProductRequest) error) *ProductService_DeleteProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProductImage provides a mock function with given fields: ctx, req
+func (_m *ProductService) DeleteProductImage(ctx context.Context, req domain.DeleteProductImageRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeleteProductImageRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductService_DeleteProductImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProductImage'
+type ProductService_DeleteProductImage_Call struct {
+	*mock.Call
+}
+
+// DeleteProductImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.DeleteProductImageRequest
+func (_e *ProductService_Expecter) DeleteProductImage(ctx interface{}, req interface{}) *ProductService_DeleteProductImage_Call {
+	return &ProductService_DeleteProductImage_Call{Call: _e.mock.On("DeleteProductImage", ctx, req)}
+}
+
+func (_c *ProductService_DeleteProductImage_Call) Run(run func(ctx context.Context, req domain.DeleteProductImageRequest)) *ProductService_DeleteProductImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DeleteProductImageRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_DeleteProductImage_Call) Return(_a0 error) *ProductService_DeleteProductImage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductService_DeleteProductImage_Call) RunAndReturn(run func(context.Context, domain.DeleteProductImageRequest) error) *ProductService_DeleteProductImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProductTemplate provides a mock function with given fields: ctx, req
+func (_m *ProductService) DeleteProductTemplate(ctx context.Context, req domain.DeleteProductTemplateRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeleteProductTemplateRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductService_DeleteProductTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProductTemplate'
+type ProductService_DeleteProductTemplate_Call struct {
+	*mock.Call
+}
+
+// DeleteProductTemplate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.DeleteProductTemplateRequest
+func (_e *ProductService_Expecter) DeleteProductTemplate(ctx interface{}, req interface{}) *ProductService_DeleteProductTemplate_Call {
+	return &ProductService_DeleteProductTemplate_Call{Call: _e.mock.On("DeleteProductTemplate", ctx, req)}
+}
+
+func (_c *ProductService_DeleteProductTemplate_Call) Run(run func(ctx context.Context, req domain.DeleteProductTemplateRequest)) *ProductService_DeleteProductTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DeleteProductTemplateRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_DeleteProductTemplate_Call) Return(_a0 error) *ProductService_DeleteProductTemplate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductService_DeleteProductTemplate_Call) RunAndReturn(run func(context.Context, domain.DeleteProductTemplateRequest) error) *ProductService_DeleteProductTemplate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DuplicateProduct provides a mock function with given fields: ctx, req
+func (_m *ProductService) DuplicateProduct(ctx context.Context, req domain.DuplicateProductRequest) (domain.GetProductResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.GetProductResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DuplicateProductRequest) (domain.GetProductResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DuplicateProductRequest) domain.GetProductResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.GetProductResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.DuplicateProductRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_DuplicateProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DuplicateProduct'
+type ProductService_DuplicateProduct_Call struct {
+	*mock.Call
+}
+
+// DuplicateProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.DuplicateProductRequest
+func (_e *ProductService_Expecter) DuplicateProduct(ctx interface{}, req interface{}) *ProductService_DuplicateProduct_Call {
+	return &ProductService_DuplicateProduct_Call{Call: _e.mock.On("DuplicateProduct", ctx, req)}
+}
+
+func (_c *ProductService_DuplicateProduct_Call) Run(run func(ctx context.Context, req domain.DuplicateProductRequest)) *ProductService_DuplicateProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DuplicateProductRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_DuplicateProduct_Call) Return(_a0 domain.GetProductResponse, _a1 error) *ProductService_DuplicateProduct_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_DuplicateProduct_Call) RunAndReturn(run func(context.Context, domain.DuplicateProductRequest) (domain.GetProductResponse, error)) *ProductService_DuplicateProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportProducts provides a mock function with given fields: ctx, req, w
+func (_m *ProductService) ExportProducts(ctx context.Context, req domain.ExportProductsRequest, w io.Writer) error {
+	ret := _m.Called(ctx, req, w)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ExportProductsRequest, io.Writer) error); ok {
+		r0 = rf(ctx, req, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductService_ExportProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportProducts'
+type ProductService_ExportProducts_Call struct {
+	*mock.Call
+}
+
+// ExportProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ExportProductsRequest
+//   - w io.Writer
+func (_e *ProductService_Expecter) ExportProducts(ctx interface{}, req interface{}, w interface{}) *ProductService_ExportProducts_Call {
+	return &ProductService_ExportProducts_Call{Call: _e.mock.On("ExportProducts", ctx, req, w)}
+}
+
+func (_c *ProductService_ExportProducts_Call) Run(run func(ctx context.Context, req domain.ExportProductsRequest, w io.Writer)) *ProductService_ExportProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ExportProductsRequest), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *ProductService_ExportProducts_Call) Return(_a0 error) *ProductService_ExportProducts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductService_ExportProducts_Call) RunAndReturn(run func(context.Context, domain.ExportProductsRequest, io.Writer) error) *ProductService_ExportProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProduct provides a mock function with given fields: ctx, req
+func (_m *ProductService) GetProduct(ctx context.Context, req domain.GetProductRequest) (domain.GetProductResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.GetProductResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetProductRequest) (domain.GetProductResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetProductRequest) domain.GetProductResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.GetProductResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GetProductRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_GetProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProduct'
+type ProductService_GetProduct_Call struct {
+	*mock.Call
+}
+
+// GetProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.GetProductRequest
+func (_e *ProductService_Expecter) GetProduct(ctx interface{}, req interface{}) *ProductService_GetProduct_Call {
+	return &ProductService_GetProduct_Call{Call: _e.mock.On("GetProduct", ctx, req)}
+}
+
+func (_c *ProductService_GetProduct_Call) Run(run func(ctx context.Context, req domain.GetProductRequest)) *ProductService_GetProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GetProductRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_GetProduct_Call) Return(_a0 domain.GetProductResponse, _a1 error) *ProductService_GetProduct_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_GetProduct_Call) RunAndReturn(run func(context.Context, domain.GetProductRequest) (domain.GetProductResponse, error)) *ProductService_GetProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductBarcodeImage provides a mock function with given fields: ctx, req
+func (_m *ProductService) GetProductBarcodeImage(ctx context.Context, req domain.GetProductBarcodeImageRequest) (domain.BarcodeImage, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.BarcodeImage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetProductBarcodeImageRequest) (domain.BarcodeImage, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetProductBarcodeImageRequest) domain.BarcodeImage); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.BarcodeImage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GetProductBarcodeImageRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_GetProductBarcodeImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductBarcodeImage'
+type ProductService_GetProductBarcodeImage_Call struct {
+	*mock.Call
+}
+
+// GetProductBarcodeImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.GetProductBarcodeImageRequest
+func (_e *ProductService_Expecter) GetProductBarcodeImage(ctx interface{}, req interface{}) *ProductService_GetProductBarcodeImage_Call {
+	return &ProductService_GetProductBarcodeImage_Call{Call: _e.mock.On("GetProductBarcodeImage", ctx, req)}
+}
+
+func (_c *ProductService_GetProductBarcodeImage_Call) Run(run func(ctx context.Context, req domain.GetProductBarcodeImageRequest)) *ProductService_GetProductBarcodeImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GetProductBarcodeImageRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_GetProductBarcodeImage_Call) Return(_a0 domain.BarcodeImage, _a1 error) *ProductService_GetProductBarcodeImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_GetProductBarcodeImage_Call) RunAndReturn(run func(context.Context, domain.GetProductBarcodeImageRequest) (domain.BarcodeImage, error)) *ProductService_GetProductBarcodeImage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductByBarcode provides a mock function with given fields: ctx, req
+func (_m *ProductService) GetProductByBarcode(ctx context.Context, req domain.GetProductByBarcodeRequest) (domain.GetProductResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.GetProductResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetProductByBarcodeRequest) (domain.GetProductResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetProductByBarcodeRequest) domain.GetProductResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.GetProductResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GetProductByBarcodeRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_GetProductByBarcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductByBarcode'
+type ProductService_GetProductByBarcode_Call struct {
+	*mock.Call
+}
+
+// GetProductByBarcode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.GetProductByBarcodeRequest
+func (_e *ProductService_Expecter) GetProductByBarcode(ctx interface{}, req interface{}) *ProductService_GetProductByBarcode_Call {
+	return &ProductService_GetProductByBarcode_Call{Call: _e.mock.On("GetProductByBarcode", ctx, req)}
+}
+
+func (_c *ProductService_GetProductByBarcode_Call) Run(run func(ctx context.Context, req domain.GetProductByBarcodeRequest)) *ProductService_GetProductByBarcode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GetProductByBarcodeRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_GetProductByBarcode_Call) Return(_a0 domain.GetProductResponse, _a1 error) *ProductService_GetProductByBarcode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_GetProductByBarcode_Call) RunAndReturn(run func(context.Context, domain.GetProductByBarcodeRequest) (domain.GetProductResponse, error)) *ProductService_GetProductByBarcode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductPriceHistory provides a mock function with given fields: ctx, req
+func (_m *ProductService) GetProductPriceHistory(ctx context.Context, req domain.GetProductPriceHistoryRequest) (domain.GetProductPriceHistoryResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.GetProductPriceHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetProductPriceHistoryRequest) (domain.GetProductPriceHistoryResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetProductPriceHistoryRequest) domain.GetProductPriceHistoryResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.GetProductPriceHistoryResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GetProductPriceHistoryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_GetProductPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductPriceHistory'
+type ProductService_GetProductPriceHistory_Call struct {
+	*mock.Call
+}
+
+// GetProductPriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.GetProductPriceHistoryRequest
+func (_e *ProductService_Expecter) GetProductPriceHistory(ctx interface{}, req interface{}) *ProductService_GetProductPriceHistory_Call {
+	return &ProductService_GetProductPriceHistory_Call{Call: _e.mock.On("GetProductPriceHistory", ctx, req)}
+}
+
+func (_c *ProductService_GetProductPriceHistory_Call) Run(run func(ctx context.Context, req domain.GetProductPriceHistoryRequest)) *ProductService_GetProductPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GetProductPriceHistoryRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_GetProductPriceHistory_Call) Return(_a0 domain.GetProductPriceHistoryResponse, _a1 error) *ProductService_GetProductPriceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_GetProductPriceHistory_Call) RunAndReturn(run func(context.Context, domain.GetProductPriceHistoryRequest) (domain.GetProductPriceHistoryResponse, error)) *ProductService_GetProductPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProductQRCode provides a mock function with given fields: ctx, req
+func (_m *ProductService) GetProductQRCode(ctx context.Context, req domain.GetProductQRCodeRequest) (domain.BarcodeImage, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.BarcodeImage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetProductQRCodeRequest) (domain.BarcodeImage, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetProductQRCodeRequest) domain.BarcodeImage); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.BarcodeImage)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GetProductQRCodeRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_GetProductQRCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductQRCode'
+type ProductService_GetProductQRCode_Call struct {
+	*mock.Call
+}
+
+// GetProductQRCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.GetProductQRCodeRequest
+func (_e *ProductService_Expecter) GetProductQRCode(ctx interface{}, req interface{}) *ProductService_GetProductQRCode_Call {
+	return &ProductService_GetProductQRCode_Call{Call: _e.mock.On("GetProductQRCode", ctx, req)}
+}
+
+func (_c *ProductService_GetProductQRCode_Call) Run(run func(ctx context.Context, req domain.GetProductQRCodeRequest)) *ProductService_GetProductQRCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GetProductQRCodeRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_GetProductQRCode_Call) Return(_a0 domain.BarcodeImage, _a1 error) *ProductService_GetProductQRCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_GetProductQRCode_Call) RunAndReturn(run func(context.Context, domain.GetProductQRCodeRequest) (domain.BarcodeImage, error)) *ProductService_GetProductQRCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportProducts provides a mock function with given fields: ctx, req
+func (_m *ProductService) ImportProducts(ctx context.Context, req domain.ImportProductsRequest) (domain.ImportProductsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ImportProductsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ImportProductsRequest) (domain.ImportProductsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ImportProductsRequest) domain.ImportProductsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ImportProductsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ImportProductsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_ImportProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportProducts'
+type ProductService_ImportProducts_Call struct {
+	*mock.Call
+}
+
+// ImportProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ImportProductsRequest
+func (_e *ProductService_Expecter) ImportProducts(ctx interface{}, req interface{}) *ProductService_ImportProducts_Call {
+	return &ProductService_ImportProducts_Call{Call: _e.mock.On("ImportProducts", ctx, req)}
+}
+
+func (_c *ProductService_ImportProducts_Call) Run(run func(ctx context.Context, req domain.ImportProductsRequest)) *ProductService_ImportProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ImportProductsRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_ImportProducts_Call) Return(_a0 domain.ImportProductsResponse, _a1 error) *ProductService_ImportProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_ImportProducts_Call) RunAndReturn(run func(context.Context, domain.ImportProductsRequest) (domain.ImportProductsResponse, error)) *ProductService_ImportProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExpiringProducts provides a mock function with given fields: ctx, req
+func (_m *ProductService) ListExpiringProducts(ctx context.Context, req domain.ListExpiringProductsRequest) (domain.ListExpiringProductsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListExpiringProductsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListExpiringProductsRequest) (domain.ListExpiringProductsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListExpiringProductsRequest) domain.ListExpiringProductsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListExpiringProductsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListExpiringProductsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_ListExpiringProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExpiringProducts'
+type ProductService_ListExpiringProducts_Call struct {
+	*mock.Call
+}
+
+// ListExpiringProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListExpiringProductsRequest
+func (_e *ProductService_Expecter) ListExpiringProducts(ctx interface{}, req interface{}) *ProductService_ListExpiringProducts_Call {
+	return &ProductService_ListExpiringProducts_Call{Call: _e.mock.On("ListExpiringProducts", ctx, req)}
+}
+
+func (_c *ProductService_ListExpiringProducts_Call) Run(run func(ctx context.Context, req domain.ListExpiringProductsRequest)) *ProductService_ListExpiringProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListExpiringProductsRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_ListExpiringProducts_Call) Return(_a0 domain.ListExpiringProductsResponse, _a1 error) *ProductService_ListExpiringProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_ListExpiringProducts_Call) RunAndReturn(run func(context.Context, domain.ListExpiringProductsRequest) (domain.ListExpiringProductsResponse, error)) *ProductService_ListExpiringProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductTemplates provides a mock function with given fields: ctx, req
+func (_m *ProductService) ListProductTemplates(ctx context.Context, req domain.ListProductTemplatesRequest) (domain.ListProductTemplatesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListProductTemplatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListProductTemplatesRequest) (domain.ListProductTemplatesResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListProductTemplatesRequest) domain.ListProductTemplatesResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListProductTemplatesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListProductTemplatesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_ListProductTemplates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductTemplates'
+type ProductService_ListProductTemplates_Call struct {
+	*mock.Call
+}
+
+// ListProductTemplates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListProductTemplatesRequest
+func (_e *ProductService_Expecter) ListProductTemplates(ctx interface{}, req interface{}) *ProductService_ListProductTemplates_Call {
+	return &ProductService_ListProductTemplates_Call{Call: _e.mock.On("ListProductTemplates", ctx, req)}
+}
+
+func (_c *ProductService_ListProductTemplates_Call) Run(run func(ctx context.Context, req domain.ListProductTemplatesRequest)) *ProductService_ListProductTemplates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListProductTemplatesRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_ListProductTemplates_Call) Return(_a0 domain.ListProductTemplatesResponse, _a1 error) *ProductService_ListProductTemplates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_ListProductTemplates_Call) RunAndReturn(run func(context.Context, domain.ListProductTemplatesRequest) (domain.ListProductTemplatesResponse, error)) *ProductService_ListProductTemplates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProducts provides a mock function with given fields: ctx, req
+func (_m *ProductService) ListProducts(ctx context.Context, req domain.ListProductsRequest) (domain.ListProductsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListProductsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListProductsRequest) (domain.ListProductsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListProductsRequest) domain.ListProductsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListProductsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListProductsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_ListProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProducts'
+type ProductService_ListProducts_Call struct {
+	*mock.Call
+}
+
+// ListProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListProductsRequest
+func (_e *ProductService_Expecter) ListProducts(ctx interface{}, req interface{}) *ProductService_ListProducts_Call {
+	return &ProductService_ListProducts_Call{Call: _e.mock.On("ListProducts", ctx, req)}
+}
+
+func (_c *ProductService_ListProducts_Call) Run(run func(ctx context.Context, req domain.ListProductsRequest)) *ProductService_ListProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListProductsRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_ListProducts_Call) Return(_a0 domain.ListProductsResponse, _a1 error) *ProductService_ListProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_ListProducts_Call) RunAndReturn(run func(context.Context, domain.ListProductsRequest) (domain.ListProductsResponse, error)) *ProductService_ListProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduledPriceChanges provides a mock function with given fields: ctx, req
+func (_m *ProductService) ListScheduledPriceChanges(ctx context.Context, req domain.ListScheduledPriceChangesRequest) (domain.ListScheduledPriceChangesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListScheduledPriceChangesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListScheduledPriceChangesRequest) (domain.ListScheduledPriceChangesResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListScheduledPriceChangesRequest) domain.ListScheduledPriceChangesResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListScheduledPriceChangesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListScheduledPriceChangesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_ListScheduledPriceChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledPriceChanges'
+type ProductService_ListScheduledPriceChanges_Call struct {
+	*mock.Call
+}
+
+// ListScheduledPriceChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListScheduledPriceChangesRequest
+func (_e *ProductService_Expecter) ListScheduledPriceChanges(ctx interface{}, req interface{}) *ProductService_ListScheduledPriceChanges_Call {
+	return &ProductService_ListScheduledPriceChanges_Call{Call: _e.mock.On("ListScheduledPriceChanges", ctx, req)}
+}
+
+func (_c *ProductService_ListScheduledPriceChanges_Call) Run(run func(ctx context.Context, req domain.ListScheduledPriceChangesRequest)) *ProductService_ListScheduledPriceChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListScheduledPriceChangesRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_ListScheduledPriceChanges_Call) Return(_a0 domain.ListScheduledPriceChangesResponse, _a1 error) *ProductService_ListScheduledPriceChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_ListScheduledPriceChanges_Call) RunAndReturn(run func(context.Context, domain.ListScheduledPriceChangesRequest) (domain.ListScheduledPriceChangesResponse, error)) *ProductService_ListScheduledPriceChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTrashProducts provides a mock function with given fields: ctx, req
+func (_m *ProductService) ListTrashProducts(ctx context.Context, req domain.ListTrashProductsRequest) (domain.ListTrashProductsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListTrashProductsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListTrashProductsRequest) (domain.ListTrashProductsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListTrashProductsRequest) domain.ListTrashProductsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListTrashProductsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListTrashProductsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_ListTrashProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrashProducts'
+type ProductService_ListTrashProducts_Call struct {
+	*mock.Call
+}
+
+// ListTrashProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListTrashProductsRequest
+func (_e *ProductService_Expecter) ListTrashProducts(ctx interface{}, req interface{}) *ProductService_ListTrashProducts_Call {
+	return &ProductService_ListTrashProducts_Call{Call: _e.mock.On("ListTrashProducts", ctx, req)}
+}
+
+func (_c *ProductService_ListTrashProducts_Call) Run(run func(ctx context.Context, req domain.ListTrashProductsRequest)) *ProductService_ListTrashProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListTrashProductsRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_ListTrashProducts_Call) Return(_a0 domain.ListTrashProductsResponse, _a1 error) *ProductService_ListTrashProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_ListTrashProducts_Call) RunAndReturn(run func(context.Context, domain.ListTrashProductsRequest) (domain.ListTrashProductsResponse, error)) *ProductService_ListTrashProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchProduct provides a mock function with given fields: ctx, req
+func (_m *ProductService) PatchProduct(ctx context.Context, req domain.PatchProductRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PatchProductRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductService_PatchProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchProduct'
+type ProductService_PatchProduct_Call struct {
+	*mock.Call
+}
+
+// PatchProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.PatchProductRequest
+func (_e *ProductService_Expecter) PatchProduct(ctx interface{}, req interface{}) *ProductService_PatchProduct_Call {
+	return &ProductService_PatchProduct_Call{Call: _e.mock.On("PatchProduct", ctx, req)}
+}
+
+func (_c *ProductService_PatchProduct_Call) Run(run func(ctx context.Context, req domain.PatchProductRequest)) *ProductService_PatchProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PatchProductRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_PatchProduct_Call) Return(_a0 error) *ProductService_PatchProduct_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductService_PatchProduct_Call) RunAndReturn(run func(context.Context, domain.PatchProductRequest) error) *ProductService_PatchProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchProductImages provides a mock function with given fields: ctx, req
+func (_m *ProductService) PatchProductImages(ctx context.Context, req domain.PatchProductImagesRequest) (domain.PatchProductImagesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.PatchProductImagesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PatchProductImagesRequest) (domain.PatchProductImagesResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PatchProductImagesRequest) domain.PatchProductImagesResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.PatchProductImagesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PatchProductImagesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_PatchProductImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchProductImages'
+type ProductService_PatchProductImages_Call struct {
+	*mock.Call
+}
+
+// PatchProductImages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.PatchProductImagesRequest
+func (_e *ProductService_Expecter) PatchProductImages(ctx interface{}, req interface{}) *ProductService_PatchProductImages_Call {
+	return &ProductService_PatchProductImages_Call{Call: _e.mock.On("PatchProductImages", ctx, req)}
+}
+
+func (_c *ProductService_PatchProductImages_Call) Run(run func(ctx context.Context, req domain.PatchProductImagesRequest)) *ProductService_PatchProductImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PatchProductImagesRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_PatchProductImages_Call) Return(_a0 domain.PatchProductImagesResponse, _a1 error) *ProductService_PatchProductImages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_PatchProductImages_Call) RunAndReturn(run func(context.Context, domain.PatchProductImagesRequest) (domain.PatchProductImagesResponse, error)) *ProductService_PatchProductImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeProduct provides a mock function with given fields: ctx, req
+func (_m *ProductService) PurgeProduct(ctx context.Context, req domain.PurgeProductRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PurgeProductRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductService_PurgeProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeProduct'
+type ProductService_PurgeProduct_Call struct {
+	*mock.Call
+}
+
+// PurgeProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.PurgeProductRequest
+func (_e *ProductService_Expecter) PurgeProduct(ctx interface{}, req interface{}) *ProductService_PurgeProduct_Call {
+	return &ProductService_PurgeProduct_Call{Call: _e.mock.On("PurgeProduct", ctx, req)}
+}
+
+func (_c *ProductService_PurgeProduct_Call) Run(run func(ctx context.Context, req domain.PurgeProductRequest)) *ProductService_PurgeProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PurgeProductRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_PurgeProduct_Call) Return(_a0 error) *ProductService_PurgeProduct_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductService_PurgeProduct_Call) RunAndReturn(run func(context.Context, domain.PurgeProductRequest) error) *ProductService_PurgeProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceProduct provides a mock function with given fields: ctx, req
+func (_m *ProductService) ReplaceProduct(ctx context.Context, req domain.ReplaceProductRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ReplaceProductRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProductService_ReplaceProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceProduct'
+type ProductService_ReplaceProduct_Call struct {
+	*mock.Call
+}
+
+// ReplaceProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ReplaceProductRequest
+func (_e *ProductService_Expecter) ReplaceProduct(ctx interface{}, req interface{}) *ProductService_ReplaceProduct_Call {
+	return &ProductService_ReplaceProduct_Call{Call: _e.mock.On("ReplaceProduct", ctx, req)}
+}
+
+func (_c *ProductService_ReplaceProduct_Call) Run(run func(ctx context.Context, req domain.ReplaceProductRequest)) *ProductService_ReplaceProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ReplaceProductRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_ReplaceProduct_Call) Return(_a0 error) *ProductService_ReplaceProduct_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProductService_ReplaceProduct_Call) RunAndReturn(run func(context.Context, domain.ReplaceProductRequest) error) *ProductService_ReplaceProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreProduct provides a mock function with given fields: ctx, req
+func (_m *ProductService) RestoreProduct(ctx context.Context, req domain.RestoreProductRequest) (domain.GetProductResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.GetProductResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RestoreProductRequest) (domain.GetProductResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RestoreProductRequest) domain.GetProductResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.GetProductResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.RestoreProductRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_RestoreProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreProduct'
+type ProductService_RestoreProduct_Call struct {
+	*mock.Call
+}
+
+// RestoreProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.RestoreProductRequest
+func (_e *ProductService_Expecter) RestoreProduct(ctx interface{}, req interface{}) *ProductService_RestoreProduct_Call {
+	return &ProductService_RestoreProduct_Call{Call: _e.mock.On("RestoreProduct", ctx, req)}
+}
+
+func (_c *ProductService_RestoreProduct_Call) Run(run func(ctx context.Context, req domain.RestoreProductRequest)) *ProductService_RestoreProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.RestoreProductRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_RestoreProduct_Call) Return(_a0 domain.GetProductResponse, _a1 error) *ProductService_RestoreProduct_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_RestoreProduct_Call) RunAndReturn(run func(context.Context, domain.RestoreProductRequest) (domain.GetProductResponse, error)) *ProductService_RestoreProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SuggestProducts provides a mock function with given fields: ctx, req
+func (_m *ProductService) SuggestProducts(ctx context.Context, req domain.SuggestProductsRequest) (domain.SuggestProductsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.SuggestProductsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SuggestProductsRequest) (domain.SuggestProductsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SuggestProductsRequest) domain.SuggestProductsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.SuggestProductsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.SuggestProductsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_SuggestProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuggestProducts'
+type ProductService_SuggestProducts_Call struct {
+	*mock.Call
+}
+
+// SuggestProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.SuggestProductsRequest
+func (_e *ProductService_Expecter) SuggestProducts(ctx interface{}, req interface{}) *ProductService_SuggestProducts_Call {
+	return &ProductService_SuggestProducts_Call{Call: _e.mock.On("SuggestProducts", ctx, req)}
+}
+
+func (_c *ProductService_SuggestProducts_Call) Run(run func(ctx context.Context, req domain.SuggestProductsRequest)) *ProductService_SuggestProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.SuggestProductsRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_SuggestProducts_Call) Return(_a0 domain.SuggestProductsResponse, _a1 error) *ProductService_SuggestProducts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_SuggestProducts_Call) RunAndReturn(run func(context.Context, domain.SuggestProductsRequest) (domain.SuggestProductsResponse, error)) *ProductService_SuggestProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadProductImage provides a mock function with given fields: ctx, req
+func (_m *ProductService) UploadProductImage(ctx context.Context, req domain.UploadProductImageRequest) (domain.UploadProductImageResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.UploadProductImageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UploadProductImageRequest) (domain.UploadProductImageResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.UploadProductImageRequest) domain.UploadProductImageResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.UploadProductImageResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.UploadProductImageRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProductService_UploadProductImage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadProductImage'
+type ProductService_UploadProductImage_Call struct {
+	*mock.Call
+}
+
+// UploadProductImage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.UploadProductImageRequest
+func (_e *ProductService_Expecter) UploadProductImage(ctx interface{}, req interface{}) *ProductService_UploadProductImage_Call {
+	return &ProductService_UploadProductImage_Call{Call: _e.mock.On("UploadProductImage", ctx, req)}
+}
+
+func (_c *ProductService_UploadProductImage_Call) Run(run func(ctx context.Context, req domain.UploadProductImageRequest)) *ProductService_UploadProductImage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.UploadProductImageRequest))
+	})
+	return _c
+}
+
+func (_c *ProductService_UploadProductImage_Call) Return(_a0 domain.UploadProductImageResponse, _a1 error) *ProductService_UploadProductImage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProductService_UploadProductImage_Call) RunAndReturn(run func(context.Context, domain.UploadProductImageRequest) (domain.UploadProductImageResponse, error)) *ProductService_UploadProductImage_Call {
 	_c.Call.Return(run)
 	return _c
 }
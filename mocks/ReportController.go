@@ -0,0 +1,101 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReportController is an autogenerated mock type for the ReportController type
+type ReportController struct {
+	mock.Mock
+}
+
+type ReportController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReportController) EXPECT() *ReportController_Expecter {
+	return &ReportController_Expecter{mock: &_m.Mock}
+}
+
+// GetMarginReport provides a mock function with given fields: c
+func (_m *ReportController) GetMarginReport(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ReportController_GetMarginReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMarginReport'
+type ReportController_GetMarginReport_Call struct {
+	*mock.Call
+}
+
+// GetMarginReport is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ReportController_Expecter) GetMarginReport(c interface{}) *ReportController_GetMarginReport_Call {
+	return &ReportController_GetMarginReport_Call{Call: _e.mock.On("GetMarginReport", c)}
+}
+
+func (_c *ReportController_GetMarginReport_Call) Run(run func(c *gin.Context)) *ReportController_GetMarginReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ReportController_GetMarginReport_Call) Return() *ReportController_GetMarginReport_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ReportController_GetMarginReport_Call) RunAndReturn(run func(*gin.Context)) *ReportController_GetMarginReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWasteReport provides a mock function with given fields: c
+func (_m *ReportController) GetWasteReport(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ReportController_GetWasteReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWasteReport'
+type ReportController_GetWasteReport_Call struct {
+	*mock.Call
+}
+
+// GetWasteReport is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *ReportController_Expecter) GetWasteReport(c interface{}) *ReportController_GetWasteReport_Call {
+	return &ReportController_GetWasteReport_Call{Call: _e.mock.On("GetWasteReport", c)}
+}
+
+func (_c *ReportController_GetWasteReport_Call) Run(run func(c *gin.Context)) *ReportController_GetWasteReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *ReportController_GetWasteReport_Call) Return() *ReportController_GetWasteReport_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ReportController_GetWasteReport_Call) RunAndReturn(run func(*gin.Context)) *ReportController_GetWasteReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReportController creates a new instance of ReportController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReportController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportController {
+	mock := &ReportController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
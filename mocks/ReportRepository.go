@@ -0,0 +1,257 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "payhere/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReportRepository is an autogenerated mock type for the ReportRepository type
+type ReportRepository struct {
+	mock.Mock
+}
+
+type ReportRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReportRepository) EXPECT() *ReportRepository_Expecter {
+	return &ReportRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListCategoryMargins provides a mock function with given fields: ctx, params
+func (_m *ReportRepository) ListCategoryMargins(ctx context.Context, params domain.ListMarginsParams) ([]domain.CategoryMargin, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.CategoryMargin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListMarginsParams) ([]domain.CategoryMargin, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListMarginsParams) []domain.CategoryMargin); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CategoryMargin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListMarginsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRepository_ListCategoryMargins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategoryMargins'
+type ReportRepository_ListCategoryMargins_Call struct {
+	*mock.Call
+}
+
+// ListCategoryMargins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListMarginsParams
+func (_e *ReportRepository_Expecter) ListCategoryMargins(ctx interface{}, params interface{}) *ReportRepository_ListCategoryMargins_Call {
+	return &ReportRepository_ListCategoryMargins_Call{Call: _e.mock.On("ListCategoryMargins", ctx, params)}
+}
+
+func (_c *ReportRepository_ListCategoryMargins_Call) Run(run func(ctx context.Context, params domain.ListMarginsParams)) *ReportRepository_ListCategoryMargins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListMarginsParams))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_ListCategoryMargins_Call) Return(_a0 []domain.CategoryMargin, _a1 error) *ReportRepository_ListCategoryMargins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportRepository_ListCategoryMargins_Call) RunAndReturn(run func(context.Context, domain.ListMarginsParams) ([]domain.CategoryMargin, error)) *ReportRepository_ListCategoryMargins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductMargins provides a mock function with given fields: ctx, params
+func (_m *ReportRepository) ListProductMargins(ctx context.Context, params domain.ListMarginsParams) ([]domain.ProductMargin, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.ProductMargin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListMarginsParams) ([]domain.ProductMargin, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListMarginsParams) []domain.ProductMargin); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductMargin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListMarginsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRepository_ListProductMargins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductMargins'
+type ReportRepository_ListProductMargins_Call struct {
+	*mock.Call
+}
+
+// ListProductMargins is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListMarginsParams
+func (_e *ReportRepository_Expecter) ListProductMargins(ctx interface{}, params interface{}) *ReportRepository_ListProductMargins_Call {
+	return &ReportRepository_ListProductMargins_Call{Call: _e.mock.On("ListProductMargins", ctx, params)}
+}
+
+func (_c *ReportRepository_ListProductMargins_Call) Run(run func(ctx context.Context, params domain.ListMarginsParams)) *ReportRepository_ListProductMargins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListMarginsParams))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_ListProductMargins_Call) Return(_a0 []domain.ProductMargin, _a1 error) *ReportRepository_ListProductMargins_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportRepository_ListProductMargins_Call) RunAndReturn(run func(context.Context, domain.ListMarginsParams) ([]domain.ProductMargin, error)) *ReportRepository_ListProductMargins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProductsBelowTargetMargin provides a mock function with given fields: ctx, params
+func (_m *ReportRepository) ListProductsBelowTargetMargin(ctx context.Context, params domain.ListMarginsParams) ([]domain.ProductMargin, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.ProductMargin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListMarginsParams) ([]domain.ProductMargin, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListMarginsParams) []domain.ProductMargin); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ProductMargin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListMarginsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRepository_ListProductsBelowTargetMargin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProductsBelowTargetMargin'
+type ReportRepository_ListProductsBelowTargetMargin_Call struct {
+	*mock.Call
+}
+
+// ListProductsBelowTargetMargin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListMarginsParams
+func (_e *ReportRepository_Expecter) ListProductsBelowTargetMargin(ctx interface{}, params interface{}) *ReportRepository_ListProductsBelowTargetMargin_Call {
+	return &ReportRepository_ListProductsBelowTargetMargin_Call{Call: _e.mock.On("ListProductsBelowTargetMargin", ctx, params)}
+}
+
+func (_c *ReportRepository_ListProductsBelowTargetMargin_Call) Run(run func(ctx context.Context, params domain.ListMarginsParams)) *ReportRepository_ListProductsBelowTargetMargin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListMarginsParams))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_ListProductsBelowTargetMargin_Call) Return(_a0 []domain.ProductMargin, _a1 error) *ReportRepository_ListProductsBelowTargetMargin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportRepository_ListProductsBelowTargetMargin_Call) RunAndReturn(run func(context.Context, domain.ListMarginsParams) ([]domain.ProductMargin, error)) *ReportRepository_ListProductsBelowTargetMargin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWasteTotals provides a mock function with given fields: ctx, params
+func (_m *ReportRepository) ListWasteTotals(ctx context.Context, params domain.ListWasteTotalsParams) ([]domain.WasteTotal, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 []domain.WasteTotal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListWasteTotalsParams) ([]domain.WasteTotal, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListWasteTotalsParams) []domain.WasteTotal); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WasteTotal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListWasteTotalsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportRepository_ListWasteTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWasteTotals'
+type ReportRepository_ListWasteTotals_Call struct {
+	*mock.Call
+}
+
+// ListWasteTotals is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params domain.ListWasteTotalsParams
+func (_e *ReportRepository_Expecter) ListWasteTotals(ctx interface{}, params interface{}) *ReportRepository_ListWasteTotals_Call {
+	return &ReportRepository_ListWasteTotals_Call{Call: _e.mock.On("ListWasteTotals", ctx, params)}
+}
+
+func (_c *ReportRepository_ListWasteTotals_Call) Run(run func(ctx context.Context, params domain.ListWasteTotalsParams)) *ReportRepository_ListWasteTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListWasteTotalsParams))
+	})
+	return _c
+}
+
+func (_c *ReportRepository_ListWasteTotals_Call) Return(_a0 []domain.WasteTotal, _a1 error) *ReportRepository_ListWasteTotals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportRepository_ListWasteTotals_Call) RunAndReturn(run func(context.Context, domain.ListWasteTotalsParams) ([]domain.WasteTotal, error)) *ReportRepository_ListWasteTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReportRepository creates a new instance of ReportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportRepository {
+	mock := &ReportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
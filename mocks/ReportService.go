@@ -0,0 +1,143 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "payhere/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReportService is an autogenerated mock type for the ReportService type
+type ReportService struct {
+	mock.Mock
+}
+
+type ReportService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReportService) EXPECT() *ReportService_Expecter {
+	return &ReportService_Expecter{mock: &_m.Mock}
+}
+
+// GetMarginReport provides a mock function with given fields: ctx, req
+func (_m *ReportService) GetMarginReport(ctx context.Context, req domain.GetMarginReportRequest) (domain.GetMarginReportResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.GetMarginReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetMarginReportRequest) (domain.GetMarginReportResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetMarginReportRequest) domain.GetMarginReportResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.GetMarginReportResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GetMarginReportRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportService_GetMarginReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMarginReport'
+type ReportService_GetMarginReport_Call struct {
+	*mock.Call
+}
+
+// GetMarginReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.GetMarginReportRequest
+func (_e *ReportService_Expecter) GetMarginReport(ctx interface{}, req interface{}) *ReportService_GetMarginReport_Call {
+	return &ReportService_GetMarginReport_Call{Call: _e.mock.On("GetMarginReport", ctx, req)}
+}
+
+func (_c *ReportService_GetMarginReport_Call) Run(run func(ctx context.Context, req domain.GetMarginReportRequest)) *ReportService_GetMarginReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GetMarginReportRequest))
+	})
+	return _c
+}
+
+func (_c *ReportService_GetMarginReport_Call) Return(_a0 domain.GetMarginReportResponse, _a1 error) *ReportService_GetMarginReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportService_GetMarginReport_Call) RunAndReturn(run func(context.Context, domain.GetMarginReportRequest) (domain.GetMarginReportResponse, error)) *ReportService_GetMarginReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWasteReport provides a mock function with given fields: ctx, req
+func (_m *ReportService) GetWasteReport(ctx context.Context, req domain.GetWasteReportRequest) (domain.GetWasteReportResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.GetWasteReportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetWasteReportRequest) (domain.GetWasteReportResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.GetWasteReportRequest) domain.GetWasteReportResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.GetWasteReportResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.GetWasteReportRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportService_GetWasteReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWasteReport'
+type ReportService_GetWasteReport_Call struct {
+	*mock.Call
+}
+
+// GetWasteReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.GetWasteReportRequest
+func (_e *ReportService_Expecter) GetWasteReport(ctx interface{}, req interface{}) *ReportService_GetWasteReport_Call {
+	return &ReportService_GetWasteReport_Call{Call: _e.mock.On("GetWasteReport", ctx, req)}
+}
+
+func (_c *ReportService_GetWasteReport_Call) Run(run func(ctx context.Context, req domain.GetWasteReportRequest)) *ReportService_GetWasteReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.GetWasteReportRequest))
+	})
+	return _c
+}
+
+func (_c *ReportService_GetWasteReport_Call) Return(_a0 domain.GetWasteReportResponse, _a1 error) *ReportService_GetWasteReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportService_GetWasteReport_Call) RunAndReturn(run func(context.Context, domain.GetWasteReportRequest) (domain.GetWasteReportResponse, error)) *ReportService_GetWasteReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReportService creates a new instance of ReportService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReportService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportService {
+	mock := &ReportService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
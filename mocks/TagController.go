@@ -0,0 +1,167 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagController is an autogenerated mock type for the TagController type
+type TagController struct {
+	mock.Mock
+}
+
+type TagController_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagController) EXPECT() *TagController_Expecter {
+	return &TagController_Expecter{mock: &_m.Mock}
+}
+
+// CreateTag provides a mock function with given fields: c
+func (_m *TagController) CreateTag(c *gin.Context) {
+	_m.Called(c)
+}
+
+// TagController_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type TagController_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *TagController_Expecter) CreateTag(c interface{}) *TagController_CreateTag_Call {
+	return &TagController_CreateTag_Call{Call: _e.mock.On("CreateTag", c)}
+}
+
+func (_c *TagController_CreateTag_Call) Run(run func(c *gin.Context)) *TagController_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *TagController_CreateTag_Call) Return() *TagController_CreateTag_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TagController_CreateTag_Call) RunAndReturn(run func(*gin.Context)) *TagController_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: c
+func (_m *TagController) DeleteTag(c *gin.Context) {
+	_m.Called(c)
+}
+
+// TagController_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type TagController_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *TagController_Expecter) DeleteTag(c interface{}) *TagController_DeleteTag_Call {
+	return &TagController_DeleteTag_Call{Call: _e.mock.On("DeleteTag", c)}
+}
+
+func (_c *TagController_DeleteTag_Call) Run(run func(c *gin.Context)) *TagController_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *TagController_DeleteTag_Call) Return() *TagController_DeleteTag_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TagController_DeleteTag_Call) RunAndReturn(run func(*gin.Context)) *TagController_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTags provides a mock function with given fields: c
+func (_m *TagController) ListTags(c *gin.Context) {
+	_m.Called(c)
+}
+
+// TagController_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type TagController_ListTags_Call struct {
+	*mock.Call
+}
+
+// ListTags is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *TagController_Expecter) ListTags(c interface{}) *TagController_ListTags_Call {
+	return &TagController_ListTags_Call{Call: _e.mock.On("ListTags", c)}
+}
+
+func (_c *TagController_ListTags_Call) Run(run func(c *gin.Context)) *TagController_ListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *TagController_ListTags_Call) Return() *TagController_ListTags_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TagController_ListTags_Call) RunAndReturn(run func(*gin.Context)) *TagController_ListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchTag provides a mock function with given fields: c
+func (_m *TagController) PatchTag(c *gin.Context) {
+	_m.Called(c)
+}
+
+// TagController_PatchTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchTag'
+type TagController_PatchTag_Call struct {
+	*mock.Call
+}
+
+// PatchTag is a helper method to define mock.On call
+//   - c *gin.Context
+func (_e *TagController_Expecter) PatchTag(c interface{}) *TagController_PatchTag_Call {
+	return &TagController_PatchTag_Call{Call: _e.mock.On("PatchTag", c)}
+}
+
+func (_c *TagController_PatchTag_Call) Run(run func(c *gin.Context)) *TagController_PatchTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gin.Context))
+	})
+	return _c
+}
+
+func (_c *TagController_PatchTag_Call) Return() *TagController_PatchTag_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TagController_PatchTag_Call) RunAndReturn(run func(*gin.Context)) *TagController_PatchTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagController creates a new instance of TagController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagController(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagController {
+	mock := &TagController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,342 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "payhere/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagRepository is an autogenerated mock type for the TagRepository type
+type TagRepository struct {
+	mock.Mock
+}
+
+type TagRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagRepository) EXPECT() *TagRepository_Expecter {
+	return &TagRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateTag provides a mock function with given fields: ctx, tag
+func (_m *TagRepository) CreateTag(ctx context.Context, tag domain.Tag) (int, error) {
+	ret := _m.Called(ctx, tag)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Tag) (int, error)); ok {
+		return rf(ctx, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Tag) int); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Tag) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type TagRepository_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag domain.Tag
+func (_e *TagRepository_Expecter) CreateTag(ctx interface{}, tag interface{}) *TagRepository_CreateTag_Call {
+	return &TagRepository_CreateTag_Call{Call: _e.mock.On("CreateTag", ctx, tag)}
+}
+
+func (_c *TagRepository_CreateTag_Call) Run(run func(ctx context.Context, tag domain.Tag)) *TagRepository_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Tag))
+	})
+	return _c
+}
+
+func (_c *TagRepository_CreateTag_Call) Return(_a0 int, _a1 error) *TagRepository_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_CreateTag_Call) RunAndReturn(run func(context.Context, domain.Tag) (int, error)) *TagRepository_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: ctx, tagID
+func (_m *TagRepository) DeleteTag(ctx context.Context, tagID int) error {
+	ret := _m.Called(ctx, tagID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, tagID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagRepository_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type TagRepository_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagID int
+func (_e *TagRepository_Expecter) DeleteTag(ctx interface{}, tagID interface{}) *TagRepository_DeleteTag_Call {
+	return &TagRepository_DeleteTag_Call{Call: _e.mock.On("DeleteTag", ctx, tagID)}
+}
+
+func (_c *TagRepository_DeleteTag_Call) Run(run func(ctx context.Context, tagID int)) *TagRepository_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *TagRepository_DeleteTag_Call) Return(_a0 error) *TagRepository_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagRepository_DeleteTag_Call) RunAndReturn(run func(context.Context, int) error) *TagRepository_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTagByName provides a mock function with given fields: ctx, userID, name
+func (_m *TagRepository) FindTagByName(ctx context.Context, userID int, name string) (*domain.Tag, error) {
+	ret := _m.Called(ctx, userID, name)
+
+	var r0 *domain.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*domain.Tag, error)); ok {
+		return rf(ctx, userID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *domain.Tag); ok {
+		r0 = rf(ctx, userID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, userID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_FindTagByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTagByName'
+type TagRepository_FindTagByName_Call struct {
+	*mock.Call
+}
+
+// FindTagByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - name string
+func (_e *TagRepository_Expecter) FindTagByName(ctx interface{}, userID interface{}, name interface{}) *TagRepository_FindTagByName_Call {
+	return &TagRepository_FindTagByName_Call{Call: _e.mock.On("FindTagByName", ctx, userID, name)}
+}
+
+func (_c *TagRepository_FindTagByName_Call) Run(run func(ctx context.Context, userID int, name string)) *TagRepository_FindTagByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TagRepository_FindTagByName_Call) Return(_a0 *domain.Tag, _a1 error) *TagRepository_FindTagByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_FindTagByName_Call) RunAndReturn(run func(context.Context, int, string) (*domain.Tag, error)) *TagRepository_FindTagByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTag provides a mock function with given fields: ctx, tagID
+func (_m *TagRepository) GetTag(ctx context.Context, tagID int) (*domain.Tag, error) {
+	ret := _m.Called(ctx, tagID)
+
+	var r0 *domain.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.Tag, error)); ok {
+		return rf(ctx, tagID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.Tag); ok {
+		r0 = rf(ctx, tagID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, tagID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_GetTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTag'
+type TagRepository_GetTag_Call struct {
+	*mock.Call
+}
+
+// GetTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tagID int
+func (_e *TagRepository_Expecter) GetTag(ctx interface{}, tagID interface{}) *TagRepository_GetTag_Call {
+	return &TagRepository_GetTag_Call{Call: _e.mock.On("GetTag", ctx, tagID)}
+}
+
+func (_c *TagRepository_GetTag_Call) Run(run func(ctx context.Context, tagID int)) *TagRepository_GetTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *TagRepository_GetTag_Call) Return(_a0 *domain.Tag, _a1 error) *TagRepository_GetTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_GetTag_Call) RunAndReturn(run func(context.Context, int) (*domain.Tag, error)) *TagRepository_GetTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTags provides a mock function with given fields: ctx, userID
+func (_m *TagRepository) ListTags(ctx context.Context, userID int) ([]domain.Tag, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []domain.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]domain.Tag, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []domain.Tag); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagRepository_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type TagRepository_ListTags_Call struct {
+	*mock.Call
+}
+
+// ListTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *TagRepository_Expecter) ListTags(ctx interface{}, userID interface{}) *TagRepository_ListTags_Call {
+	return &TagRepository_ListTags_Call{Call: _e.mock.On("ListTags", ctx, userID)}
+}
+
+func (_c *TagRepository_ListTags_Call) Run(run func(ctx context.Context, userID int)) *TagRepository_ListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *TagRepository_ListTags_Call) Return(_a0 []domain.Tag, _a1 error) *TagRepository_ListTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagRepository_ListTags_Call) RunAndReturn(run func(context.Context, int) ([]domain.Tag, error)) *TagRepository_ListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTag provides a mock function with given fields: ctx, tag
+func (_m *TagRepository) UpdateTag(ctx context.Context, tag domain.Tag) error {
+	ret := _m.Called(ctx, tag)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Tag) error); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagRepository_UpdateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTag'
+type TagRepository_UpdateTag_Call struct {
+	*mock.Call
+}
+
+// UpdateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag domain.Tag
+func (_e *TagRepository_Expecter) UpdateTag(ctx interface{}, tag interface{}) *TagRepository_UpdateTag_Call {
+	return &TagRepository_UpdateTag_Call{Call: _e.mock.On("UpdateTag", ctx, tag)}
+}
+
+func (_c *TagRepository_UpdateTag_Call) Run(run func(ctx context.Context, tag domain.Tag)) *TagRepository_UpdateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.Tag))
+	})
+	return _c
+}
+
+func (_c *TagRepository_UpdateTag_Call) Return(_a0 error) *TagRepository_UpdateTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagRepository_UpdateTag_Call) RunAndReturn(run func(context.Context, domain.Tag) error) *TagRepository_UpdateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagRepository creates a new instance of TagRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagRepository {
+	mock := &TagRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
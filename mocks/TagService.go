@@ -0,0 +1,229 @@
+// Code generated by mockery v2.32.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "payhere/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagService is an autogenerated mock type for the TagService type
+type TagService struct {
+	mock.Mock
+}
+
+type TagService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagService) EXPECT() *TagService_Expecter {
+	return &TagService_Expecter{mock: &_m.Mock}
+}
+
+// CreateTag provides a mock function with given fields: ctx, req
+func (_m *TagService) CreateTag(ctx context.Context, req domain.CreateTagRequest) (domain.CreateTagResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.CreateTagResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateTagRequest) (domain.CreateTagResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CreateTagRequest) domain.CreateTagResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.CreateTagResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CreateTagRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_CreateTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTag'
+type TagService_CreateTag_Call struct {
+	*mock.Call
+}
+
+// CreateTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.CreateTagRequest
+func (_e *TagService_Expecter) CreateTag(ctx interface{}, req interface{}) *TagService_CreateTag_Call {
+	return &TagService_CreateTag_Call{Call: _e.mock.On("CreateTag", ctx, req)}
+}
+
+func (_c *TagService_CreateTag_Call) Run(run func(ctx context.Context, req domain.CreateTagRequest)) *TagService_CreateTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.CreateTagRequest))
+	})
+	return _c
+}
+
+func (_c *TagService_CreateTag_Call) Return(_a0 domain.CreateTagResponse, _a1 error) *TagService_CreateTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_CreateTag_Call) RunAndReturn(run func(context.Context, domain.CreateTagRequest) (domain.CreateTagResponse, error)) *TagService_CreateTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: ctx, req
+func (_m *TagService) DeleteTag(ctx context.Context, req domain.DeleteTagRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DeleteTagRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagService_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type TagService_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.DeleteTagRequest
+func (_e *TagService_Expecter) DeleteTag(ctx interface{}, req interface{}) *TagService_DeleteTag_Call {
+	return &TagService_DeleteTag_Call{Call: _e.mock.On("DeleteTag", ctx, req)}
+}
+
+func (_c *TagService_DeleteTag_Call) Run(run func(ctx context.Context, req domain.DeleteTagRequest)) *TagService_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.DeleteTagRequest))
+	})
+	return _c
+}
+
+func (_c *TagService_DeleteTag_Call) Return(_a0 error) *TagService_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagService_DeleteTag_Call) RunAndReturn(run func(context.Context, domain.DeleteTagRequest) error) *TagService_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTags provides a mock function with given fields: ctx, req
+func (_m *TagService) ListTags(ctx context.Context, req domain.ListTagsRequest) (domain.ListTagsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 domain.ListTagsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListTagsRequest) (domain.ListTagsResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListTagsRequest) domain.ListTagsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ListTagsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListTagsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagService_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type TagService_ListTags_Call struct {
+	*mock.Call
+}
+
+// ListTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.ListTagsRequest
+func (_e *TagService_Expecter) ListTags(ctx interface{}, req interface{}) *TagService_ListTags_Call {
+	return &TagService_ListTags_Call{Call: _e.mock.On("ListTags", ctx, req)}
+}
+
+func (_c *TagService_ListTags_Call) Run(run func(ctx context.Context, req domain.ListTagsRequest)) *TagService_ListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListTagsRequest))
+	})
+	return _c
+}
+
+func (_c *TagService_ListTags_Call) Return(_a0 domain.ListTagsResponse, _a1 error) *TagService_ListTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagService_ListTags_Call) RunAndReturn(run func(context.Context, domain.ListTagsRequest) (domain.ListTagsResponse, error)) *TagService_ListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchTag provides a mock function with given fields: ctx, req
+func (_m *TagService) PatchTag(ctx context.Context, req domain.PatchTagRequest) error {
+	ret := _m.Called(ctx, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PatchTagRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagService_PatchTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchTag'
+type TagService_PatchTag_Call struct {
+	*mock.Call
+}
+
+// PatchTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req domain.PatchTagRequest
+func (_e *TagService_Expecter) PatchTag(ctx interface{}, req interface{}) *TagService_PatchTag_Call {
+	return &TagService_PatchTag_Call{Call: _e.mock.On("PatchTag", ctx, req)}
+}
+
+func (_c *TagService_PatchTag_Call) Run(run func(ctx context.Context, req domain.PatchTagRequest)) *TagService_PatchTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.PatchTagRequest))
+	})
+	return _c
+}
+
+func (_c *TagService_PatchTag_Call) Return(_a0 error) *TagService_PatchTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagService_PatchTag_Call) RunAndReturn(run func(context.Context, domain.PatchTagRequest) error) *TagService_PatchTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagService creates a new instance of TagService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagService {
+	mock := &TagService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
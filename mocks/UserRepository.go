@@ -130,6 +130,117 @@ func (_c *UserRepository_FindUserByMobileID_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetUser provides a mock function with given fields: ctx, userID
+func (_m *UserRepository) GetUser(ctx context.Context, userID int) (*domain.User, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*domain.User, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *domain.User); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type UserRepository_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *UserRepository_Expecter) GetUser(ctx interface{}, userID interface{}) *UserRepository_GetUser_Call {
+	return &UserRepository_GetUser_Call{Call: _e.mock.On("GetUser", ctx, userID)}
+}
+
+func (_c *UserRepository_GetUser_Call) Run(run func(ctx context.Context, userID int)) *UserRepository_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetUser_Call) Return(_a0 *domain.User, _a1 error) *UserRepository_GetUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_GetUser_Call) RunAndReturn(run func(context.Context, int) (*domain.User, error)) *UserRepository_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUsersAfter provides a mock function with given fields: ctx, cursor, limit
+func (_m *UserRepository) ListUsersAfter(ctx context.Context, cursor int, limit int) ([]domain.User, error) {
+	ret := _m.Called(ctx, cursor, limit)
+
+	var r0 []domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]domain.User, error)); ok {
+		return rf(ctx, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []domain.User); ok {
+		r0 = rf(ctx, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_ListUsersAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsersAfter'
+type UserRepository_ListUsersAfter_Call struct {
+	*mock.Call
+}
+
+// ListUsersAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cursor int
+//   - limit int
+func (_e *UserRepository_Expecter) ListUsersAfter(ctx interface{}, cursor interface{}, limit interface{}) *UserRepository_ListUsersAfter_Call {
+	return &UserRepository_ListUsersAfter_Call{Call: _e.mock.On("ListUsersAfter", ctx, cursor, limit)}
+}
+
+func (_c *UserRepository_ListUsersAfter_Call) Run(run func(ctx context.Context, cursor int, limit int)) *UserRepository_ListUsersAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *UserRepository_ListUsersAfter_Call) Return(_a0 []domain.User, _a1 error) *UserRepository_ListUsersAfter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_ListUsersAfter_Call) RunAndReturn(run func(context.Context, int, int) ([]domain.User, error)) *UserRepository_ListUsersAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUserRepository creates a new instance of UserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserRepository(t interface {
@@ -0,0 +1,184 @@
+package barcode
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/ean"
+	"github.com/boombuler/barcode/qr"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+// ErrTooSmall
+// 요청한 이미지 크기에 모듈(막대 또는 QR 의 칸)을 1px 이상으로 그릴 수 없는 경우
+var ErrTooSmall = errors.New("barcode: image is too small for the code")
+
+const (
+	// 바코드 양 옆과 QR 코드 둘레의 여백(모듈 수). 스캐너가 시작과 끝을 찾을 수 있도록 규격의 최소값을 쓴다.
+	linearQuietZone = 10
+	qrQuietZone     = 4
+)
+
+// Code
+// 인코딩된 바코드. 모듈 하나를 같은 정수 픽셀로 그려 막대 폭이 뭉개지지 않는다.
+type Code struct {
+	code      barcode.Barcode
+	quietZone int
+}
+
+func Code128(content string) (Code, error) {
+	code, err := code128.Encode(content)
+	if err != nil {
+		return Code{}, err
+	}
+	return Code{code: code, quietZone: linearQuietZone}, nil
+}
+
+// EAN
+// 8자리 또는 13자리 숫자를 EAN-8, EAN-13 으로 인코딩한다.
+func EAN(content string) (Code, error) {
+	code, err := ean.Encode(content)
+	if err != nil {
+		return Code{}, err
+	}
+	return Code{code: code, quietZone: linearQuietZone}, nil
+}
+
+func QR(content string) (Code, error) {
+	code, err := qr.Encode(content, qr.M, qr.Auto)
+	if err != nil {
+		return Code{}, err
+	}
+	return Code{code: code, quietZone: qrQuietZone}, nil
+}
+
+// PNG
+// 흑백 PNG 로 그린다. 모듈 크기는 정수 픽셀로 맞추고 남는 공간은 흰색으로 채워 가운데 정렬한다.
+func (c Code) PNG(width, height int) ([]byte, error) {
+	layout, err := c.layout(width, height)
+	if err != nil {
+		return nil, err
+	}
+
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+	c.eachDark(func(x, y, w, h int) {
+		for py := layout.y(y); py < layout.y(y+h); py++ {
+			for px := layout.x(x); px < layout.x(x+w); px++ {
+				img.SetGray(px, py, color.Gray{Y: 0})
+			}
+		}
+	})
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// SVG
+// PNG 와 같은 배치를 벡터로 그려 인쇄 배율을 바꿔도 막대 경계가 흐려지지 않는다.
+func (c Code) SVG(width, height int) ([]byte, error) {
+	layout, err := c.layout(width, height)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`,
+		width, height, width, height)
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, width, height)
+	c.eachDark(func(x, y, w, h int) {
+		fmt.Fprintf(&buf, "M%d %dh%dv%dh-%dz", layout.x(x), layout.y(y), layout.x(x+w)-layout.x(x), layout.y(y+h)-layout.y(y), layout.x(x+w)-layout.x(x))
+	})
+	buf.WriteString(`"/></svg>`)
+
+	return buf.Bytes(), nil
+}
+
+// layout
+// 모듈 좌표를 픽셀 좌표로 바꾸는 값. 1D 바코드의 막대는 높이 전체를 채운다.
+type layout struct {
+	module  int
+	offsetX int
+	offsetY int
+	barH    int
+	linear  bool
+}
+
+func (l layout) x(module int) int {
+	return l.offsetX + module*l.module
+}
+
+func (l layout) y(module int) int {
+	if l.linear {
+		return l.offsetY + module*l.barH
+	}
+	return l.offsetY + module*l.module
+}
+
+func (c Code) layout(width, height int) (layout, error) {
+	bounds := c.code.Bounds()
+	modulesX := bounds.Dx() + 2*c.quietZone
+	linear := c.code.Metadata().Dimensions == 1
+
+	if linear {
+		module := width / modulesX
+		if module < 1 || height < 1 {
+			return layout{}, ErrTooSmall
+		}
+		return layout{
+			module:  module,
+			offsetX: (width-module*modulesX)/2 + c.quietZone*module,
+			barH:    height,
+			linear:  true,
+		}, nil
+	}
+
+	modulesY := bounds.Dy() + 2*c.quietZone
+	module := min(width/modulesX, height/modulesY)
+	if module < 1 {
+		return layout{}, ErrTooSmall
+	}
+	return layout{
+		module:  module,
+		offsetX: (width-module*modulesX)/2 + c.quietZone*module,
+		offsetY: (height-module*modulesY)/2 + c.quietZone*module,
+	}, nil
+}
+
+// eachDark
+// 검은 모듈을 줄마다 가로로 이어진 구간 단위로 넘긴다. 1D 바코드는 한 줄만 있다.
+func (c Code) eachDark(fn func(x, y, w, h int)) {
+	bounds := c.code.Bounds()
+	rows := bounds.Dy()
+	if c.code.Metadata().Dimensions == 1 {
+		rows = 1
+	}
+
+	for y := 0; y < rows; y++ {
+		start := -1
+		for x := 0; x <= bounds.Dx(); x++ {
+			dark := x < bounds.Dx() && c.isDark(bounds.Min.X+x, bounds.Min.Y+y)
+			if dark && start < 0 {
+				start = x
+			}
+			if !dark && start >= 0 {
+				fn(start, y, x-start, 1)
+				start = -1
+			}
+		}
+	}
+}
+
+func (c Code) isDark(x, y int) bool {
+	r, _, _, _ := c.code.At(x, y).RGBA()
+	return r < 0x8000
+}
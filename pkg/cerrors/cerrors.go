@@ -12,13 +12,14 @@ type Kind uint8
 var Separator = ":\n\t"
 
 const (
-	Other      Kind = iota // 분류되지 않은 오류의 경우 (이 값은 메시지에 포함하지 않음).
-	Invalid                // 유효하지 않은 행위를 한 경우.
-	Auth                   // 비인증.
-	Permission             // 권한이 옳바르지 않은 경우.
-	Exist                  // 이미 존재하는 경우.
-	NotExist               // 존재하지 않는 경우.
-	Internal               // 로직 오류의 경우.
+	Other              Kind = iota // 분류되지 않은 오류의 경우 (이 값은 메시지에 포함하지 않음).
+	Invalid                        // 유효하지 않은 행위를 한 경우.
+	Auth                           // 비인증.
+	Permission                     // 권한이 옳바르지 않은 경우.
+	Exist                          // 이미 존재하는 경우.
+	NotExist                       // 존재하지 않는 경우.
+	Internal                       // 로직 오류의 경우.
+	PreconditionFailed             // 조건부 요청의 버전이 현재 버전과 다른 경우.
 )
 
 type Error struct {
@@ -87,6 +88,16 @@ func E(args ...interface{}) error {
 	return e
 }
 
+// Is
+// err 가 주어진 종류의 *Error 인지 확인한다. 하위 계층의 에러를 그대로 전달할지 판단할 때 사용한다.
+func Is(kind Kind, err error) bool {
+	var e *Error
+	if !errors.As(err, &e) {
+		return false
+	}
+	return e.Kind == kind
+}
+
 func (k Kind) String() string {
 	switch k {
 	case Other:
@@ -103,6 +114,8 @@ func (k Kind) String() string {
 		return "item does not exist"
 	case Internal:
 		return "internal error"
+	case PreconditionFailed:
+		return "precondition failed"
 	}
 	return "unknown error kind"
 }
@@ -44,6 +44,8 @@ func ToSentinelAPIError(err error) (int, SentinelAPIError) {
 			return NewSentinelAPIError(http.StatusConflict, cErr.ServiceMessage)
 		case NotExist:
 			return NewSentinelAPIError(http.StatusNotFound, cErr.ServiceMessage)
+		case PreconditionFailed:
+			return NewSentinelAPIError(http.StatusPreconditionFailed, cErr.ServiceMessage)
 		default:
 			return NewSentinelAPIError(http.StatusInternalServerError, cErr.ServiceMessage)
 		}
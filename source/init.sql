@@ -4,30 +4,275 @@ CREATE TABLE users
     mobile_id   VARCHAR(255) UNIQUE NOT NULL,
     password    VARCHAR(255)        NOT NULL,
     use_type    ENUM ('PLACE') DEFAULT 'PLACE',
+    -- 매장 시간대 (IANA 이름). 유통기한 요약을 보내는 시각과 날짜를 이 시간대로 계산한다.
+    time_zone   VARCHAR(64)    NOT NULL DEFAULT 'Asia/Seoul',
     create_date TIMESTAMP      DEFAULT CURRENT_TIMESTAMP,
     update_date TIMESTAMP      DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     delete_date TIMESTAMP           NULL
 );
 
+CREATE TABLE categories
+(
+    id            INT AUTO_INCREMENT PRIMARY KEY,
+    user_id       INT          NOT NULL,
+    parent_id     INT          NULL,
+    name          VARCHAR(255) NOT NULL,
+    display_order INT       DEFAULT 0,
+    create_date   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date   TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    delete_date   TIMESTAMP    NULL,
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (parent_id) REFERENCES categories (id),
+    INDEX idx_categories_user_id_parent_id (user_id, parent_id)
+);
+
 CREATE TABLE products
 (
     id          INT AUTO_INCREMENT PRIMARY KEY,
-    category    VARCHAR(255),
+    category_id INT          NOT NULL,
     user_id     INT,
     name        VARCHAR(255),
     initial     VARCHAR(255),
+    romanized   VARCHAR(255) NOT NULL DEFAULT '',
     price       DECIMAL(10, 2),
     cost        DECIMAL(10, 2),
     description TEXT,
     barcode     VARCHAR(50),
+    -- 재고가 남은 로트가 있으면 가장 먼저 끝나는 로트의 유통기한
     expiry_date TIMESTAMP NOT NULL,
-    size        VARCHAR(50),
+    -- 재고 원장(stock_movements)을 반영한 현재 재고
+    stock_quantity INT NOT NULL DEFAULT 0,
+    -- 재고가 이 수량 이하로 떨어지면 재고 부족 알림을 보낸다. (NULL 이면 알림 없음)
+    reorder_point    INT NULL,
+    reorder_quantity INT NOT NULL DEFAULT 0,
+    -- 유통기한 임박 할인 중인 판매가 (NULL 이면 정가로 판매)
+    markdown_price DECIMAL(10, 2) NULL,
+    -- 사장님이 상품을 수정하거나 삭제할 때마다 1 씩 올린다. (ETag)
+    version     INT NOT NULL DEFAULT 1,
     create_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     update_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     delete_date TIMESTAMP NULL,
+    -- 삭제되지 않은 상품만 바코드 유니크 인덱스에 포함한다. (NULL 은 중복 허용)
+    active_barcode VARCHAR(50) AS (IF(delete_date IS NULL, barcode, NULL)) STORED,
     FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (category_id) REFERENCES categories (id),
     INDEX idx_products_initial (initial),
-    INDEX idx_products_name (name)
+    INDEX idx_products_name (name),
+    INDEX idx_products_romanized (romanized),
+    -- 휴지통에서 보관 기간이 지난 상품을 찾기 위한 인덱스
+    INDEX idx_products_delete_date (delete_date),
+    UNIQUE INDEX uq_products_user_id_active_barcode (user_id, active_barcode)
+);
+
+CREATE TABLE product_option_groups
+(
+    id            INT AUTO_INCREMENT PRIMARY KEY,
+    product_id    INT                       NOT NULL,
+    name          VARCHAR(255)              NOT NULL,
+    select_type   ENUM ('single', 'multi')  NOT NULL DEFAULT 'single',
+    required      BOOLEAN                   NOT NULL DEFAULT FALSE,
+    min_select    INT                       NOT NULL DEFAULT 0,
+    max_select    INT                       NOT NULL DEFAULT 1,
+    display_order INT                       NOT NULL DEFAULT 0,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    INDEX idx_product_option_groups_product_id (product_id)
+);
+
+CREATE TABLE product_options
+(
+    id              INT AUTO_INCREMENT PRIMARY KEY,
+    option_group_id INT            NOT NULL,
+    name            VARCHAR(255)   NOT NULL,
+    price_delta     DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    cost_delta      DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    display_order   INT            NOT NULL DEFAULT 0,
+    FOREIGN KEY (option_group_id) REFERENCES product_option_groups (id),
+    INDEX idx_product_options_option_group_id (option_group_id)
+);
+
+CREATE TABLE product_images
+(
+    id            INT AUTO_INCREMENT PRIMARY KEY,
+    product_id    INT          NOT NULL,
+    storage_key   VARCHAR(255) NOT NULL,
+    content_type  VARCHAR(50)  NOT NULL,
+    width         INT          NOT NULL,
+    height        INT          NOT NULL,
+    display_order INT          NOT NULL DEFAULT 0,
+    is_primary    BOOLEAN      NOT NULL DEFAULT FALSE,
+    create_date   TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    INDEX idx_product_images_product_id_display_order (product_id, display_order)
+);
+
+CREATE TABLE stock_movements
+(
+    id             INT AUTO_INCREMENT PRIMARY KEY,
+    product_id     INT                                              NOT NULL,
+    user_id        INT                                              NOT NULL,
+    type           ENUM ('receive', 'sale', 'adjustment', 'waste')  NOT NULL,
+    quantity       INT                                              NOT NULL,
+    quantity_after INT                                              NOT NULL,
+    note           VARCHAR(255)                                     NOT NULL DEFAULT '',
+    create_date    TIMESTAMP                                        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    INDEX idx_stock_movements_product_id_id (product_id, id)
+);
+
+CREATE TABLE stock_lots
+(
+    id                 INT AUTO_INCREMENT PRIMARY KEY,
+    product_id         INT          NOT NULL,
+    lot_number         VARCHAR(50)  NOT NULL DEFAULT '',
+    received_date      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expiry_date        TIMESTAMP    NOT NULL,
+    quantity           INT          NOT NULL,
+    remaining_quantity INT          NOT NULL,
+    create_date        TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    -- 유통기한이 먼저 끝나는 로트부터 꺼내기 위한 인덱스
+    INDEX idx_stock_lots_product_id_expiry_date (product_id, expiry_date)
+);
+
+-- 재고 부족 알림을 보낸 상품. 재고가 기준 수량 위로 올라가면 지운다.
+CREATE TABLE low_stock_alerts
+(
+    product_id     INT PRIMARY KEY,
+    stock_quantity INT       NOT NULL,
+    create_date    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id)
+);
+
+-- 유통기한 임박 할인 규칙. category_id 와 product_id 중 하나만 정한다.
+CREATE TABLE markdown_rules
+(
+    id               INT AUTO_INCREMENT PRIMARY KEY,
+    user_id          INT       NOT NULL,
+    category_id      INT       NULL,
+    product_id       INT       NULL,
+    within_minutes   INT       NOT NULL,
+    discount_percent INT       NOT NULL,
+    create_date      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_date      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    delete_date      TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (category_id) REFERENCES categories (id),
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    INDEX idx_markdown_rules_user_id (user_id)
+);
+
+-- 상품의 정가, 원가, 실제 판매가가 바뀐 기록. user_id 가 NULL 이면 서버 작업이 바꾼 것이다.
+CREATE TABLE product_price_history
+(
+    id                  INT AUTO_INCREMENT PRIMARY KEY,
+    product_id          INT            NOT NULL,
+    user_id             INT            NULL,
+    reason              VARCHAR(20)    NOT NULL,
+    old_price           DECIMAL(10, 2) NOT NULL,
+    new_price           DECIMAL(10, 2) NOT NULL,
+    old_cost            DECIMAL(10, 2) NOT NULL,
+    new_cost            DECIMAL(10, 2) NOT NULL,
+    old_effective_price DECIMAL(10, 2) NOT NULL,
+    new_effective_price DECIMAL(10, 2) NOT NULL,
+    create_date         TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    INDEX idx_product_price_history_product_id_create_date (product_id, create_date)
+);
+
+-- 상품을 만들 때 시작점으로 쓰는 템플릿. 상품마다 달라야 하는 바코드와 유통기한은 저장하지 않는다.
+CREATE TABLE product_templates
+(
+    id               INT AUTO_INCREMENT PRIMARY KEY,
+    user_id          INT            NOT NULL,
+    name             VARCHAR(100)   NOT NULL,
+    category_id      INT            NOT NULL,
+    price            DECIMAL(10, 2) NOT NULL,
+    cost             DECIMAL(10, 2) NOT NULL,
+    product_name     VARCHAR(255)   NOT NULL,
+    description      TEXT           NOT NULL,
+    reorder_point    INT            NULL,
+    reorder_quantity INT            NOT NULL DEFAULT 0,
+    -- 상품 생성 요청의 optionGroups 와 같은 JSON
+    option_groups    TEXT           NOT NULL,
+    create_date      TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (category_id) REFERENCES categories (id),
+    INDEX idx_product_templates_user_id (user_id)
+);
+
+-- 사장님이 상품에 자유롭게 붙이는 태그. 이름은 사장님 안에서 겹치지 않는다. (대소문자 구분 없음)
+CREATE TABLE tags
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    user_id     INT         NOT NULL,
+    name        VARCHAR(30) NOT NULL,
+    create_date TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    UNIQUE INDEX uq_tags_user_id_name (user_id, name)
+);
+
+CREATE TABLE product_tags
+(
+    product_id INT NOT NULL,
+    tag_id     INT NOT NULL,
+    PRIMARY KEY (product_id, tag_id),
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    FOREIGN KEY (tag_id) REFERENCES tags (id),
+    -- 태그로 상품을 찾기 위한 인덱스
+    INDEX idx_product_tags_tag_id (tag_id)
+);
+
+-- 정해진 시각에 정가나 원가를 바꾸는 가격 예약. apply_date 와 cancel_date 가 모두 NULL 이면 아직 적용하지 않은 예약이다.
+CREATE TABLE scheduled_price_changes
+(
+    id             INT AUTO_INCREMENT PRIMARY KEY,
+    product_id     INT            NOT NULL,
+    -- 가격을 예약한 사장님. 적용할 때 가격 변경 기록에 남긴다.
+    user_id        INT            NOT NULL,
+    -- NULL 이면 바꾸지 않는다.
+    price          DECIMAL(10, 2) NULL,
+    cost           DECIMAL(10, 2) NULL,
+    effective_date TIMESTAMP      NOT NULL,
+    apply_date     TIMESTAMP      NULL,
+    cancel_date    TIMESTAMP      NULL,
+    create_date    TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    -- 적용할 시각이 된 예약을 찾기 위한 인덱스
+    INDEX idx_scheduled_price_changes_effective_date (effective_date)
+);
+
+-- 유통기한 요약을 보낸 날짜. 사장님의 매장 시간대 기준으로 하루 한 번만 보낸다.
+CREATE TABLE expiry_digests
+(
+    user_id     INT       NOT NULL,
+    digest_date DATE      NOT NULL,
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, digest_date),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+-- 상품 폐기 기록. 원가와 카테고리는 폐기한 시점의 값을 그대로 남긴다.
+-- 상품을 휴지통에서 완전히 지우면 product_id 와 movement_id 만 NULL 이 되고 기록은 남는다.
+CREATE TABLE disposals
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    product_id  INT                                  NULL,
+    user_id     INT                                  NOT NULL,
+    category_id INT                                  NOT NULL,
+    movement_id INT                                  NULL,
+    quantity    INT                                  NOT NULL,
+    reason      ENUM ('expired', 'damaged', 'other') NOT NULL,
+    note        VARCHAR(255)                         NOT NULL DEFAULT '',
+    unit_cost   DECIMAL(10, 2)                       NOT NULL,
+    loss_amount DECIMAL(12, 2)                       NOT NULL,
+    create_date TIMESTAMP                            NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (category_id) REFERENCES categories (id),
+    FOREIGN KEY (movement_id) REFERENCES stock_movements (id),
+    INDEX idx_disposals_user_id_create_date (user_id, create_date)
 );
 
 CREATE TABLE auth_tokens
@@ -46,35 +291,104 @@ CREATE TABLE auth_tokens
 INSERT INTO users (mobile_id, password) VALUES ('01011111111', '$2a$10$y8k/LZCyzGRnWlFCB2DzOenf5cQWbsUQGyISzulWww.trbs4FwQeq');
 INSERT INTO users (mobile_id, password) VALUES ('01022222222', '$2a$10$y8k/LZCyzGRnWlFCB2DzOenf5cQWbsUQGyISzulWww.trbs4FwQeq');
 
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '아메리카노', 'ㅇㅁㄹㅋㄴ', 3000, 1500, '아메리카노 판매합니다.', '12345678', '2024-03-01 09:00:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '카페라떼', 'ㅋㅍㄹㄸ', 3500, 1800, '카페라떼 판매합니다.', '23456789', '2024-03-01 09:30:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '카페모카', 'ㅋㅍㅁㅋ', 3800, 2000, '카페모카 판매합니다.', '34567890', '2024-03-01 10:00:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '헤이즐넛라떼', 'ㅎㅇㅈㄴㄹㄸ', 4000, 2000, '헤이즐넛라떼 판매합니다.', '45678901', '2024-03-01 10:30:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '바닐라라떼', 'ㅂㄴㄹㄹㄸ', 4000, 2000, '바닐라라떼 판매합니다.', '56789012', '2024-03-01 11:00:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '카푸치노', 'ㅋㅍㅊㄴ', 3700, 1900, '카푸치노 판매합니다.', '67890123', '2024-03-01 11:30:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '모카라떼', 'ㅁㅋㄹㄸ', 3900, 2000, '모카라떼 판매합니다.', '78901234', '2024-03-01 12:00:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '콜드브루', 'ㅋㄷㅂㄹ', 4500, 2200, '콜드브루 판매합니다.', '89012345', '2024-03-01 12:30:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '아이스티', 'ㅇㅇㅅㅌ', 3200, 1600, '아이스티 판매합니다.', '90123456', '2024-03-01 13:00:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '스무디', 'ㅅㅁㄷ', 5000, 2500, '스무디 판매합니다.', '01234567', '2024-03-01 13:30:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '플레인요거트', 'ㅍㄹㅇㅇㄱㅌ', 5500, 2700, '플레인요거트 판매합니다.', '12345678', '2024-03-01 14:00:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '딸기요거트', 'ㄸㄱㅇㄱㅌ', 5800, 2800, '딸기요거트 판매합니다.', '23456789', '2024-03-01 14:30:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '딸기 요거트', 'ㄸㄱ ㅇㄱㅌ', 5500, 2700, '딸기 요거트 판매합니다.', '12345678', '2024-03-01 14:00:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '블루베리 요거트', 'ㅂㄹㅂㄹ ㅇㄱㅌ', 5800, 2800, '블루베리 요거트 판매합니다.', '23456789', '2024-03-01 14:30:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '치즈 케이크', 'ㅊㅈ ㅋㅇㅋ', 7000, 3500, '치즈 케이크 판매합니다.', '34567890', '2024-03-01 15:00:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '초코 브라우니', 'ㅊㅋ ㅂㄹㅇㄴ', 6000, 3000, '초코 브라우니 판매합니다.', '45678901', '2024-03-01 15:30:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '카라멜 마카롱', 'ㅋㄹㅁ ㅁㅋㄹ', 6500, 3200, '카라멜 마카롱 판매합니다.', '56789012', '2024-03-01 16:00:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '말차 빙수', 'ㅁㅊ ㅂㅅ', 7500, 3700, '말차 빙수 판매합니다.', '67890123', '2024-03-01 16:30:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '아이스크림', 'ㅇㅇㅅㅋㄹ', 4000, 2000, '아이스크림 판매합니다.', '78901234', '2024-03-01 17:00:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '딸기 쉐이크', 'ㄸㄱ ㅅㅇㅋ', 4800, 2400, '딸기 쉐이크 판매합니다.', '89012345', '2024-03-01 17:30:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '바나나 크림', 'ㅂㄴㄴ ㅋㄹ', 5500, 2700, '바나나 크림 판매합니다.', '90123456', '2024-03-01 18:00:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('payhere', 1, '망고 스무디', 'ㅁㄱ ㅅㅁㄷ', 6300, 3100, '망고 스무디 판매합니다.', '01234567', '2024-03-01 18:30:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('fashion', 1, '나이키 운동화', 'ㄴㅇㅋ ㅇㄷㅎ', 80000, 50000, '나이키 운동화 판매합니다.', '12345678', '2024-03-01 14:00:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('fashion', 1, '아디다스 운동화', 'ㅇㄷㄷㅅ ㅇㄷㅎ', 90000, 60000, '아디다스 운동화 판매합니다.', '23456789', '2024-03-01 14:30:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('fashion', 1, '지오다노 티셔츠', 'ㅈㅇㄷㄴ ㅌㅅㅊ', 35000, 25000, '지오다노 티셔츠 판매합니다.', '34567890', '2024-03-01 15:00:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('fashion', 1, '폴로 셔츠', 'ㅍㄹ ㅅㅊ', 45000, 30000, '폴로 셔츠 판매합니다.', '45678901', '2024-03-01 15:30:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('fashion', 1, '구찌 반지갑', 'ㄱㅉ ㅂㅈㄱ', 150000, 100000, '구찌 반지갑 판매합니다.', '56789012', '2024-03-01 16:00:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('fashion', 1, '루이비통 가방', 'ㄹㅇㅂㅌ ㄱㅂ', 300000, 200000, '루이비통 가방 판매합니다.', '67890123', '2024-03-01 16:30:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('fashion', 1, '샤넬 향수', 'ㅅㄴ ㅎㅅ', 250000, 150000, '샤넬 향수 판매합니다.', '78901234', '2024-03-01 17:00:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('fashion', 1, '에르메스 벨트', 'ㅇㄹㅁㅅ ㅂㅌ', 180000, 120000, '에르메스 벨트 판매합니다.', '89012345', '2024-03-01 17:30:00', 'large');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('fashion', 1, '디올 클러치백', 'ㄷㅇ ㅋㄹㅊㅂ', 220000, 180000, '디올 클러치백 판매합니다.', '90123456', '2024-03-01 18:00:00', 'small');
-INSERT INTO products (category, user_id, name, initial, price, cost, description, barcode, expiry_date, size) VALUES ('fashion', 1, '프라다 선글라스', 'ㅍㄹㄷ ㅅㄱㄹㅅ', 200000, 160000, '프라다 선글라스 판매합니다.', '01234567', '2024-03-01 18:30:00', 'large');
\ No newline at end of file
+INSERT INTO categories (user_id, name, display_order) VALUES (1, 'payhere', 0);
+INSERT INTO categories (user_id, name, display_order) VALUES (1, 'fashion', 1);
+
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '아메리카노', 'ㅇㅁㄹㅋㄴ', 'amerikano', 3000, 1500, '아메리카노 판매합니다.', '8801000000012', '2024-03-01 09:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '카페라떼', 'ㅋㅍㄹㄸ', 'kaperatte', 3500, 1800, '카페라떼 판매합니다.', '8801000000029', '2024-03-01 09:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '카페모카', 'ㅋㅍㅁㅋ', 'kapemoka', 3800, 2000, '카페모카 판매합니다.', '8801000000036', '2024-03-01 10:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '헤이즐넛라떼', 'ㅎㅇㅈㄴㄹㄸ', 'heijeulleonnatte', 4000, 2000, '헤이즐넛라떼 판매합니다.', '8801000000043', '2024-03-01 10:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '바닐라라떼', 'ㅂㄴㄹㄹㄸ', 'banillaratte', 4000, 2000, '바닐라라떼 판매합니다.', '8801000000050', '2024-03-01 11:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '카푸치노', 'ㅋㅍㅊㄴ', 'kapuchino', 3700, 1900, '카푸치노 판매합니다.', '8801000000067', '2024-03-01 11:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '모카라떼', 'ㅁㅋㄹㄸ', 'mokaratte', 3900, 2000, '모카라떼 판매합니다.', '8801000000074', '2024-03-01 12:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '콜드브루', 'ㅋㄷㅂㄹ', 'koldeubeuru', 4500, 2200, '콜드브루 판매합니다.', '8801000000081', '2024-03-01 12:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '아이스티', 'ㅇㅇㅅㅌ', 'aiseuti', 3200, 1600, '아이스티 판매합니다.', '8801000000098', '2024-03-01 13:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '스무디', 'ㅅㅁㄷ', 'seumudi', 5000, 2500, '스무디 판매합니다.', '8801000000104', '2024-03-01 13:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '플레인요거트', 'ㅍㄹㅇㅇㄱㅌ', 'peulleinyogeoteu', 5500, 2700, '플레인요거트 판매합니다.', '8801000000111', '2024-03-01 14:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '딸기요거트', 'ㄸㄱㅇㄱㅌ', 'ttalgiyogeoteu', 5800, 2800, '딸기요거트 판매합니다.', '8801000000128', '2024-03-01 14:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '딸기 요거트', 'ㄸㄱ ㅇㄱㅌ', 'ttalgi yogeoteu', 5500, 2700, '딸기 요거트 판매합니다.', '8801000000135', '2024-03-01 14:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '블루베리 요거트', 'ㅂㄹㅂㄹ ㅇㄱㅌ', 'beulluberi yogeoteu', 5800, 2800, '블루베리 요거트 판매합니다.', '8801000000142', '2024-03-01 14:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '치즈 케이크', 'ㅊㅈ ㅋㅇㅋ', 'chijeu keikeu', 7000, 3500, '치즈 케이크 판매합니다.', '8801000000159', '2024-03-01 15:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '초코 브라우니', 'ㅊㅋ ㅂㄹㅇㄴ', 'choko beurauni', 6000, 3000, '초코 브라우니 판매합니다.', '8801000000166', '2024-03-01 15:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '카라멜 마카롱', 'ㅋㄹㅁ ㅁㅋㄹ', 'karamel makarong', 6500, 3200, '카라멜 마카롱 판매합니다.', '8801000000173', '2024-03-01 16:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '말차 빙수', 'ㅁㅊ ㅂㅅ', 'malcha bingsu', 7500, 3700, '말차 빙수 판매합니다.', '8801000000180', '2024-03-01 16:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '아이스크림', 'ㅇㅇㅅㅋㄹ', 'aiseukeurim', 4000, 2000, '아이스크림 판매합니다.', '8801000000197', '2024-03-01 17:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '딸기 쉐이크', 'ㄸㄱ ㅅㅇㅋ', 'ttalgi sweikeu', 4800, 2400, '딸기 쉐이크 판매합니다.', '8801000000203', '2024-03-01 17:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '바나나 크림', 'ㅂㄴㄴ ㅋㄹ', 'banana keurim', 5500, 2700, '바나나 크림 판매합니다.', '8801000000210', '2024-03-01 18:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (1, 1, '망고 스무디', 'ㅁㄱ ㅅㅁㄷ', 'manggo seumudi', 6300, 3100, '망고 스무디 판매합니다.', '8801000000227', '2024-03-01 18:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (2, 1, '나이키 운동화', 'ㄴㅇㅋ ㅇㄷㅎ', 'naiki undonghwa', 80000, 50000, '나이키 운동화 판매합니다.', '8801000000234', '2024-03-01 14:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (2, 1, '아디다스 운동화', 'ㅇㄷㄷㅅ ㅇㄷㅎ', 'adidaseu undonghwa', 90000, 60000, '아디다스 운동화 판매합니다.', '8801000000241', '2024-03-01 14:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (2, 1, '지오다노 티셔츠', 'ㅈㅇㄷㄴ ㅌㅅㅊ', 'jiodano tisyeocheu', 35000, 25000, '지오다노 티셔츠 판매합니다.', '8801000000258', '2024-03-01 15:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (2, 1, '폴로 셔츠', 'ㅍㄹ ㅅㅊ', 'pollo syeocheu', 45000, 30000, '폴로 셔츠 판매합니다.', '8801000000265', '2024-03-01 15:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (2, 1, '구찌 반지갑', 'ㄱㅉ ㅂㅈㄱ', 'gujji banjigap', 150000, 100000, '구찌 반지갑 판매합니다.', '8801000000272', '2024-03-01 16:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (2, 1, '루이비통 가방', 'ㄹㅇㅂㅌ ㄱㅂ', 'ruibitong gabang', 300000, 200000, '루이비통 가방 판매합니다.', '8801000000289', '2024-03-01 16:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (2, 1, '샤넬 향수', 'ㅅㄴ ㅎㅅ', 'syanel hyangsu', 250000, 150000, '샤넬 향수 판매합니다.', '8801000000296', '2024-03-01 17:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (2, 1, '에르메스 벨트', 'ㅇㄹㅁㅅ ㅂㅌ', 'ereumeseu belteu', 180000, 120000, '에르메스 벨트 판매합니다.', '8801000000302', '2024-03-01 17:30:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (2, 1, '디올 클러치백', 'ㄷㅇ ㅋㄹㅊㅂ', 'diol keulleochibaek', 220000, 180000, '디올 클러치백 판매합니다.', '8801000000319', '2024-03-01 18:00:00');
+INSERT INTO products (category_id, user_id, name, initial, romanized, price, cost, description, barcode, expiry_date) VALUES (2, 1, '프라다 선글라스', 'ㅍㄹㄷ ㅅㄱㄹㅅ', 'peurada seongeullaseu', 200000, 160000, '프라다 선글라스 판매합니다.', '8801000000326', '2024-03-01 18:30:00');
+
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (1, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (2, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (3, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (4, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (5, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (6, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (7, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (8, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (9, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (10, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (11, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (12, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (13, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (14, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (15, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (16, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (17, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (18, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (19, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (20, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (21, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (22, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (23, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (24, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (25, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (26, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (27, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (28, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (29, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (30, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (31, '사이즈', 'single', TRUE, 1, 1);
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select) VALUES (32, '사이즈', 'single', TRUE, 1, 1);
+
+INSERT INTO product_options (option_group_id, name) VALUES (1, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (2, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (3, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (4, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (5, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (6, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (7, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (8, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (9, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (10, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (11, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (12, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (13, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (14, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (15, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (16, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (17, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (18, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (19, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (20, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (21, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (22, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (23, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (24, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (25, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (26, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (27, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (28, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (29, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (30, 'large');
+INSERT INTO product_options (option_group_id, name) VALUES (31, 'small');
+INSERT INTO product_options (option_group_id, name) VALUES (32, 'large');
@@ -0,0 +1,5 @@
+-- 상품명 로마자 표기 검색 (init.sql 로 새로 만든 DB에는 이미 반영되어 있음)
+-- 컬럼 추가 후 `go run ./cmd/romanize` 로 기존 상품의 romanized 값을 채운다.
+ALTER TABLE products
+    ADD COLUMN romanized VARCHAR(255) NOT NULL DEFAULT '' AFTER initial,
+    ADD INDEX idx_products_romanized (romanized);
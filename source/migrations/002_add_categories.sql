@@ -0,0 +1,50 @@
+-- 상품 카테고리를 문자열 컬럼에서 사장님별 카테고리 테이블로 분리한다.
+-- 기존 category 문자열은 사장님별로 중복을 제거해 최상위 카테고리로 옮긴다.
+-- category 가 비어 있던 상품은 사장님별 '미분류' 카테고리로 옮겨 모든 상품이 카테고리를 갖게 한다.
+CREATE TABLE categories
+(
+    id            INT AUTO_INCREMENT PRIMARY KEY,
+    user_id       INT          NOT NULL,
+    parent_id     INT          NULL,
+    name          VARCHAR(255) NOT NULL,
+    display_order INT       DEFAULT 0,
+    create_date   TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    update_date   TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    delete_date   TIMESTAMP    NULL,
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (parent_id) REFERENCES categories (id),
+    INDEX idx_categories_user_id_parent_id (user_id, parent_id)
+);
+
+INSERT INTO categories (user_id, name)
+SELECT DISTINCT user_id, TRIM(category)
+FROM products
+WHERE category IS NOT NULL
+  AND TRIM(category) <> '';
+
+ALTER TABLE products
+    ADD COLUMN category_id INT NULL AFTER id,
+    ADD FOREIGN KEY (category_id) REFERENCES categories (id);
+
+UPDATE products p
+    JOIN categories c ON c.user_id = p.user_id AND c.parent_id IS NULL AND c.name = TRIM(p.category)
+SET p.category_id = c.id;
+
+INSERT INTO categories (user_id, name)
+SELECT DISTINCT p.user_id, '미분류'
+FROM products p
+WHERE p.category_id IS NULL
+  AND NOT EXISTS (SELECT 1
+                  FROM categories c
+                  WHERE c.user_id = p.user_id
+                    AND c.parent_id IS NULL
+                    AND c.name = '미분류');
+
+UPDATE products p
+    JOIN categories c ON c.user_id = p.user_id AND c.parent_id IS NULL AND c.name = '미분류'
+SET p.category_id = c.id
+WHERE p.category_id IS NULL;
+
+ALTER TABLE products
+    MODIFY COLUMN category_id INT NOT NULL,
+    DROP COLUMN category;
@@ -0,0 +1,42 @@
+-- 상품 옵션 그룹과 옵션을 추가한다.
+-- 기존 size 값은 상품마다 필수 단일 선택 "사이즈" 옵션 그룹의 옵션으로 옮기고 size 컬럼을 삭제한다.
+CREATE TABLE product_option_groups
+(
+    id            INT AUTO_INCREMENT PRIMARY KEY,
+    product_id    INT                       NOT NULL,
+    name          VARCHAR(255)              NOT NULL,
+    select_type   ENUM ('single', 'multi')  NOT NULL DEFAULT 'single',
+    required      BOOLEAN                   NOT NULL DEFAULT FALSE,
+    min_select    INT                       NOT NULL DEFAULT 0,
+    max_select    INT                       NOT NULL DEFAULT 1,
+    display_order INT                       NOT NULL DEFAULT 0,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    INDEX idx_product_option_groups_product_id (product_id)
+);
+
+CREATE TABLE product_options
+(
+    id              INT AUTO_INCREMENT PRIMARY KEY,
+    option_group_id INT            NOT NULL,
+    name            VARCHAR(255)   NOT NULL,
+    price_delta     DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    cost_delta      DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    display_order   INT            NOT NULL DEFAULT 0,
+    FOREIGN KEY (option_group_id) REFERENCES product_option_groups (id),
+    INDEX idx_product_options_option_group_id (option_group_id)
+);
+
+INSERT INTO product_option_groups (product_id, name, select_type, required, min_select, max_select)
+SELECT id, '사이즈', 'single', TRUE, 1, 1
+FROM products
+WHERE size IS NOT NULL
+  AND size <> '';
+
+INSERT INTO product_options (option_group_id, name)
+SELECT g.id, p.size
+FROM product_option_groups g
+         JOIN products p ON p.id = g.product_id
+WHERE g.name = '사이즈';
+
+ALTER TABLE products
+    DROP COLUMN size;
@@ -0,0 +1,15 @@
+-- 사장님별로 삭제되지 않은 상품의 바코드를 유일하게 만든다.
+-- 이미 중복된 바코드는 가장 먼저 등록된 상품만 남기고 나머지 상품의 바코드 뒤에 "-상품ID" 를 붙인다.
+UPDATE products p
+    JOIN (SELECT user_id, barcode, MIN(id) AS keep_id
+          FROM products
+          WHERE delete_date IS NULL
+          GROUP BY user_id, barcode
+          HAVING COUNT(*) > 1) d ON d.user_id = p.user_id AND d.barcode = p.barcode
+SET p.barcode = CONCAT(p.barcode, '-', p.id)
+WHERE p.delete_date IS NULL
+  AND p.id <> d.keep_id;
+
+ALTER TABLE products
+    ADD COLUMN active_barcode VARCHAR(50) AS (IF(delete_date IS NULL, barcode, NULL)) STORED AFTER delete_date,
+    ADD UNIQUE INDEX uq_products_user_id_active_barcode (user_id, active_barcode);
@@ -0,0 +1,18 @@
+-- 상품의 현재 재고 컬럼과 입출고를 기록하는 재고 원장을 추가한다.
+ALTER TABLE products
+    ADD COLUMN stock_quantity INT NOT NULL DEFAULT 0 AFTER expiry_date;
+
+CREATE TABLE stock_movements
+(
+    id             INT AUTO_INCREMENT PRIMARY KEY,
+    product_id     INT                                              NOT NULL,
+    user_id        INT                                              NOT NULL,
+    type           ENUM ('receive', 'sale', 'adjustment', 'waste')  NOT NULL,
+    quantity       INT                                              NOT NULL,
+    quantity_after INT                                              NOT NULL,
+    note           VARCHAR(255)                                     NOT NULL DEFAULT '',
+    create_date    TIMESTAMP                                        NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    INDEX idx_stock_movements_product_id_id (product_id, id)
+);
@@ -0,0 +1,12 @@
+-- 상품별 재고 알림 기준 수량과 발주 수량, 재고 부족 알림 기록을 추가한다.
+ALTER TABLE products
+    ADD COLUMN reorder_point    INT NULL AFTER stock_quantity,
+    ADD COLUMN reorder_quantity INT NOT NULL DEFAULT 0 AFTER reorder_point;
+
+CREATE TABLE low_stock_alerts
+(
+    product_id     INT PRIMARY KEY,
+    stock_quantity INT       NOT NULL,
+    create_date    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id)
+);
@@ -0,0 +1,21 @@
+-- 입고 단위로 유통기한과 남은 수량을 관리하는 로트를 추가한다.
+-- 이미 재고가 있는 상품은 상품의 유통기한으로 로트 하나를 만들어 옮긴다.
+CREATE TABLE stock_lots
+(
+    id                 INT AUTO_INCREMENT PRIMARY KEY,
+    product_id         INT          NOT NULL,
+    lot_number         VARCHAR(50)  NOT NULL DEFAULT '',
+    received_date      TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expiry_date        TIMESTAMP    NOT NULL,
+    quantity           INT          NOT NULL,
+    remaining_quantity INT          NOT NULL,
+    create_date        TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    -- 유통기한이 먼저 끝나는 로트부터 꺼내기 위한 인덱스
+    INDEX idx_stock_lots_product_id_expiry_date (product_id, expiry_date)
+);
+
+INSERT INTO stock_lots (product_id, received_date, expiry_date, quantity, remaining_quantity)
+SELECT id, create_date, expiry_date, stock_quantity, stock_quantity
+FROM products
+WHERE stock_quantity > 0;
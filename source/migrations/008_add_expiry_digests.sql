@@ -0,0 +1,12 @@
+-- 매장 시간대와 유통기한 요약 발송 기록을 추가한다.
+ALTER TABLE users
+    ADD COLUMN time_zone VARCHAR(64) NOT NULL DEFAULT 'Asia/Seoul' AFTER use_type;
+
+CREATE TABLE expiry_digests
+(
+    user_id     INT       NOT NULL,
+    digest_date DATE      NOT NULL,
+    create_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, digest_date),
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
@@ -0,0 +1,37 @@
+-- 유통기한 임박 할인 규칙과 할인가, 가격 변경 기록을 추가한다.
+ALTER TABLE products
+    ADD COLUMN markdown_price DECIMAL(10, 2) NULL AFTER reorder_quantity;
+
+CREATE TABLE markdown_rules
+(
+    id               INT AUTO_INCREMENT PRIMARY KEY,
+    user_id          INT       NOT NULL,
+    category_id      INT       NULL,
+    product_id       INT       NULL,
+    within_minutes   INT       NOT NULL,
+    discount_percent INT       NOT NULL,
+    create_date      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_date      TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    delete_date      TIMESTAMP NULL,
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (category_id) REFERENCES categories (id),
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    INDEX idx_markdown_rules_user_id (user_id)
+);
+
+CREATE TABLE product_price_history
+(
+    id                  INT AUTO_INCREMENT PRIMARY KEY,
+    product_id          INT            NOT NULL,
+    user_id             INT            NULL,
+    reason              VARCHAR(20)    NOT NULL,
+    old_price           DECIMAL(10, 2) NOT NULL,
+    new_price           DECIMAL(10, 2) NOT NULL,
+    old_cost            DECIMAL(10, 2) NOT NULL,
+    new_cost            DECIMAL(10, 2) NOT NULL,
+    old_effective_price DECIMAL(10, 2) NOT NULL,
+    new_effective_price DECIMAL(10, 2) NOT NULL,
+    create_date         TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    INDEX idx_product_price_history_product_id_create_date (product_id, create_date)
+);
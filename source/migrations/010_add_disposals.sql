@@ -0,0 +1,20 @@
+-- 상품 폐기 기록을 추가한다. 원가와 카테고리는 폐기한 시점의 값을 그대로 남긴다.
+CREATE TABLE disposals
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    product_id  INT                                  NOT NULL,
+    user_id     INT                                  NOT NULL,
+    category_id INT                                  NOT NULL,
+    movement_id INT                                  NOT NULL,
+    quantity    INT                                  NOT NULL,
+    reason      ENUM ('expired', 'damaged', 'other') NOT NULL,
+    note        VARCHAR(255)                         NOT NULL DEFAULT '',
+    unit_cost   DECIMAL(10, 2)                       NOT NULL,
+    loss_amount DECIMAL(12, 2)                       NOT NULL,
+    create_date TIMESTAMP                            NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (category_id) REFERENCES categories (id),
+    FOREIGN KEY (movement_id) REFERENCES stock_movements (id),
+    INDEX idx_disposals_user_id_create_date (user_id, create_date)
+);
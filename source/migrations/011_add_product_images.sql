@@ -0,0 +1,15 @@
+-- 상품 이미지를 추가한다. 원본과 썸네일 파일은 storage_key 아래에 크기별로 저장한다.
+CREATE TABLE product_images
+(
+    id            INT AUTO_INCREMENT PRIMARY KEY,
+    product_id    INT          NOT NULL,
+    storage_key   VARCHAR(255) NOT NULL,
+    content_type  VARCHAR(50)  NOT NULL,
+    width         INT          NOT NULL,
+    height        INT          NOT NULL,
+    display_order INT          NOT NULL DEFAULT 0,
+    is_primary    BOOLEAN      NOT NULL DEFAULT FALSE,
+    create_date   TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    INDEX idx_product_images_product_id_display_order (product_id, display_order)
+);
@@ -0,0 +1,8 @@
+-- 휴지통에서 보관 기간이 지난 상품을 완전히 지울 수 있게 한다.
+-- 완전히 지운 상품의 폐기 기록은 손실 금액 리포트를 위해 남기고 상품과 재고 이동 연결만 끊는다.
+ALTER TABLE disposals
+    MODIFY product_id  INT NULL,
+    MODIFY movement_id INT NULL;
+
+ALTER TABLE products
+    ADD INDEX idx_products_delete_date (delete_date);
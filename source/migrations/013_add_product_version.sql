@@ -0,0 +1,4 @@
+-- 같은 상품을 동시에 수정할 때 나중에 저장한 쪽이 앞의 수정을 덮어쓰지 않도록 상품 버전을 추가한다.
+-- 사장님이 상품을 수정하거나 삭제할 때마다 1 씩 올리고 ETag 로 응답한다.
+ALTER TABLE products
+    ADD COLUMN version INT NOT NULL DEFAULT 1 AFTER markdown_price;
@@ -0,0 +1,20 @@
+-- 상품을 만들 때 시작점으로 쓰는 템플릿을 추가한다. 상품마다 달라야 하는 바코드와 유통기한은 저장하지 않는다.
+CREATE TABLE product_templates
+(
+    id               INT AUTO_INCREMENT PRIMARY KEY,
+    user_id          INT            NOT NULL,
+    name             VARCHAR(100)   NOT NULL,
+    category_id      INT            NOT NULL,
+    price            DECIMAL(10, 2) NOT NULL,
+    cost             DECIMAL(10, 2) NOT NULL,
+    product_name     VARCHAR(255)   NOT NULL,
+    description      TEXT           NOT NULL,
+    reorder_point    INT            NULL,
+    reorder_quantity INT            NOT NULL DEFAULT 0,
+    -- 상품 생성 요청의 optionGroups 와 같은 JSON
+    option_groups    TEXT           NOT NULL,
+    create_date      TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    FOREIGN KEY (category_id) REFERENCES categories (id),
+    INDEX idx_product_templates_user_id (user_id)
+);
@@ -0,0 +1,21 @@
+-- 상품 태그를 추가한다. 태그는 사장님마다 따로 관리하고 상품과 다대다로 연결한다.
+CREATE TABLE tags
+(
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    user_id     INT         NOT NULL,
+    name        VARCHAR(30) NOT NULL,
+    create_date TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    UNIQUE INDEX uq_tags_user_id_name (user_id, name)
+);
+
+CREATE TABLE product_tags
+(
+    product_id INT NOT NULL,
+    tag_id     INT NOT NULL,
+    PRIMARY KEY (product_id, tag_id),
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    FOREIGN KEY (tag_id) REFERENCES tags (id),
+    -- 태그로 상품을 찾기 위한 인덱스
+    INDEX idx_product_tags_tag_id (tag_id)
+);
@@ -0,0 +1,20 @@
+-- 정해진 시각에 정가나 원가를 바꾸는 가격 예약을 추가한다.
+-- apply_date 를 먼저 기록한 서버만 가격을 바꾸므로 여러 서버에서 함께 실행해도 예약은 한 번만 적용된다.
+CREATE TABLE scheduled_price_changes
+(
+    id             INT AUTO_INCREMENT PRIMARY KEY,
+    product_id     INT            NOT NULL,
+    -- 가격을 예약한 사장님. 적용할 때 가격 변경 기록에 남긴다.
+    user_id        INT            NOT NULL,
+    -- NULL 이면 바꾸지 않는다.
+    price          DECIMAL(10, 2) NULL,
+    cost           DECIMAL(10, 2) NULL,
+    effective_date TIMESTAMP      NOT NULL,
+    apply_date     TIMESTAMP      NULL,
+    cancel_date    TIMESTAMP      NULL,
+    create_date    TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (product_id) REFERENCES products (id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    -- 적용할 시각이 된 예약을 찾기 위한 인덱스
+    INDEX idx_scheduled_price_changes_effective_date (effective_date)
+);